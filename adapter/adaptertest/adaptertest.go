@@ -0,0 +1,96 @@
+// Package adaptertest cung cấp bộ kiểm thử tương thích dùng chung cho mọi implementation
+// của adapter.Adapter. Mỗi adapter chạy cùng một bảng kịch bản (params, thứ tự middleware,
+// abort, streaming, body lớn, keep-alive, xử lý lỗi) để đảm bảo hành vi nhất quán giữa các adapter.
+//
+// Ví dụ trong test của một adapter:
+//
+//	func TestConformance(t *testing.T) {
+//		adaptertest.Run(t, func() adapter.Adapter {
+//			return myadapter.New(myadapter.Config{})
+//		})
+//	}
+package adaptertest
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.fork.vn/fork/adapter"
+	"go.fork.vn/fork/router"
+)
+
+// Factory tạo một adapter mới cho mỗi kịch bản kiểm thử.
+type Factory func() adapter.Adapter
+
+// Case là một kịch bản mà mọi adapter phải thỏa mãn.
+type Case struct {
+	// Name là tên kịch bản, dùng làm tên subtest
+	Name string
+
+	// Setup đăng ký middleware và routes trên router mới của kịch bản
+	Setup func(r router.Router)
+
+	// Do gửi request tới server và trả về lỗi nếu kết quả không đúng kỳ vọng
+	Do func(client *http.Client, baseURL string) error
+}
+
+// Run chạy toàn bộ kịch bản của Cases với adapter do factory tạo ra,
+// mỗi kịch bản là một subtest với adapter và router riêng.
+//
+// Parameters:
+//   - t: Test đang chạy
+//   - factory: Hàm tạo adapter cần kiểm thử
+func Run(t *testing.T, factory Factory) {
+	t.Helper()
+
+	for _, c := range Cases() {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			if err := RunCase(factory(), c); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// RunCase chạy một kịch bản với adapter đã cho.
+// Router được gắn vào adapter qua SetHandler giống như WebApp.SetAdapter, và adapter
+// được phục vụ qua ServeHTTP trên một server TCP thật để kiểm tra cả streaming và keep-alive.
+//
+// Parameters:
+//   - adp: Adapter cần kiểm thử
+//   - c: Kịch bản cần chạy
+//
+// Returns:
+//   - error: Lỗi mô tả hành vi sai lệch, nil nếu adapter thỏa mãn kịch bản
+func RunCase(adp adapter.Adapter, c Case) error {
+	if adp == nil {
+		return fmt.Errorf("%s: factory returned nil adapter", c.Name)
+	}
+
+	r := router.NewRouter()
+	if c.Setup != nil {
+		c.Setup(r)
+	}
+	adp.SetHandler(r)
+
+	server := httptest.NewUnstartedServer(adp)
+	// Panic trong handler là một phần của kịch bản, không cần ghi log ra stderr
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.Start()
+	defer server.Close()
+
+	transport := &http.Transport{}
+	defer transport.CloseIdleConnections()
+	client := &http.Client{Transport: transport, Timeout: 10 * time.Second}
+
+	if err := c.Do(client, server.URL); err != nil {
+		return fmt.Errorf("%s (%s): %w", c.Name, adp.Name(), err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package adaptertest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.fork.vn/fork/adapter"
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// stdAdapter là adapter tối thiểu dựa trên net/http dùng làm chuẩn tham chiếu.
+type stdAdapter struct {
+	handler http.Handler
+}
+
+func (a *stdAdapter) Name() string                                            { return "std" }
+func (a *stdAdapter) Serve() error                                            { return nil }
+func (a *stdAdapter) RunTLS(certFile, keyFile string) error                   { return nil }
+func (a *stdAdapter) HandleFunc(method, path string, h func(forkCtx.Context)) {}
+func (a *stdAdapter) Use(middleware func(forkCtx.Context))                    {}
+func (a *stdAdapter) SetHandler(handler http.Handler)                         { a.handler = handler }
+func (a *stdAdapter) Shutdown() error                                         { return nil }
+
+func (a *stdAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.handler.ServeHTTP(w, r)
+}
+
+// bufferingAdapter gom toàn bộ response trước khi gửi, vi phạm yêu cầu streaming.
+type bufferingAdapter struct {
+	stdAdapter
+}
+
+func (a *bufferingAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	buf := &bufferedWriter{header: http.Header{}, status: http.StatusOK}
+	a.handler.ServeHTTP(buf, r)
+	for k, v := range buf.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(buf.status)
+	w.Write([]byte(buf.body.String()))
+}
+
+type bufferedWriter struct {
+	header http.Header
+	status int
+	body   strings.Builder
+}
+
+func (w *bufferedWriter) Header() http.Header         { return w.header }
+func (w *bufferedWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+func (w *bufferedWriter) WriteHeader(code int)        { w.status = code }
+
+func TestRunWithStandardAdapter(t *testing.T) {
+	Run(t, func() adapter.Adapter { return &stdAdapter{} })
+}
+
+func TestRunCaseDetectsBufferedStreaming(t *testing.T) {
+	var streaming Case
+	for _, c := range Cases() {
+		if c.Name == "Streaming" {
+			streaming = c
+		}
+	}
+	if streaming.Do == nil {
+		t.Fatal("Expected Streaming case")
+	}
+
+	err := RunCase(&bufferingAdapter{}, streaming)
+	if err == nil || !strings.Contains(err.Error(), "flushed chunk was not delivered") {
+		t.Errorf("Expected streaming violation, got %v", err)
+	}
+	if err != nil && !strings.Contains(err.Error(), "Streaming (std)") {
+		t.Errorf("Expected error to name the case and adapter, got %v", err)
+	}
+}
+
+func TestRunCaseNilAdapter(t *testing.T) {
+	if err := RunCase(nil, Case{Name: "Nil"}); err == nil {
+		t.Error("Expected error for nil adapter")
+	}
+}
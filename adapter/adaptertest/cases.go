@@ -0,0 +1,524 @@
+package adaptertest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing/fstest"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// LargeBodySize là kích thước body dùng trong các kịch bản request và response lớn.
+const LargeBodySize = 8 << 20
+
+// Cases trả về bảng kịch bản tương thích. Mỗi lần gọi tạo các kịch bản mới
+// với trạng thái riêng nên có thể chạy song song nhiều bộ kiểm thử.
+//
+// Returns:
+//   - []Case: Danh sách kịch bản theo thứ tự chạy
+func Cases() []Case {
+	return []Case{
+		routeParamsCase(),
+		queryAndHeadersCase(),
+		middlewareOrderCase(),
+		abortCase(),
+		notFoundCase(),
+		fallbackHandlersCase(),
+		staticFSCase(),
+		handlerErrorCase(),
+		panicRecoveryCase(),
+		streamingCase(),
+		largeRequestBodyCase(),
+		largeResponseBodyCase(),
+		keepAliveCase(),
+	}
+}
+
+// routeParamsCase kiểm tra tham số route và wildcard được truyền tới handler.
+func routeParamsCase() Case {
+	return Case{
+		Name: "RouteParams",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodGet, "/users/:id/posts/:post", func(ctx forkCtx.Context) {
+				ctx.String(http.StatusOK, "%s:%s", ctx.Param("id"), ctx.Param("post"))
+			})
+			r.Handle(http.MethodGet, "/files/*path", func(ctx forkCtx.Context) {
+				ctx.String(http.StatusOK, "%s", ctx.Param("path"))
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			if err := expect(client, http.MethodGet, baseURL+"/users/42/posts/7", http.StatusOK, "42:7"); err != nil {
+				return err
+			}
+			return expect(client, http.MethodGet, baseURL+"/files/docs/readme.md", http.StatusOK, "docs/readme.md")
+		},
+	}
+}
+
+// queryAndHeadersCase kiểm tra query string, request header, response header và status code.
+func queryAndHeadersCase() Case {
+	return Case{
+		Name: "QueryAndHeaders",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodPost, "/echo", func(ctx forkCtx.Context) {
+				ctx.Header("X-Echo", ctx.GetHeader("X-Request"))
+				ctx.String(http.StatusCreated, "%s|%s", ctx.Query("q"), strings.Join(ctx.QueryArray("tag"), ","))
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			req, err := http.NewRequest(http.MethodPost, baseURL+"/echo?q=fork+adapter&tag=a&tag=b", nil)
+			if err != nil {
+				return err
+			}
+			req.Header.Set("X-Request", "conformance")
+			resp, body, err := do(client, req)
+			if err != nil {
+				return err
+			}
+			if err := check(resp, body, http.StatusCreated, "fork adapter|a,b"); err != nil {
+				return err
+			}
+			if got := resp.Header.Get("X-Echo"); got != "conformance" {
+				return fmt.Errorf("expected X-Echo header %q, got %q", "conformance", got)
+			}
+			return nil
+		},
+	}
+}
+
+// middlewareOrderCase kiểm tra middleware chạy theo thứ tự đăng ký trước handler.
+func middlewareOrderCase() Case {
+	trace := func(name string) router.HandlerFunc {
+		return func(ctx forkCtx.Context) {
+			ctx.Set("trace", ctx.GetString("trace")+name+",")
+			ctx.Next()
+		}
+	}
+	return Case{
+		Name: "MiddlewareOrder",
+		Setup: func(r router.Router) {
+			r.Use(trace("first"), trace("second"))
+			r.Handle(http.MethodGet, "/order", trace("route"), func(ctx forkCtx.Context) {
+				ctx.String(http.StatusOK, "%shandler", ctx.GetString("trace"))
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			return expect(client, http.MethodGet, baseURL+"/order", http.StatusOK, "first,second,route,handler")
+		},
+	}
+}
+
+// abortCase kiểm tra Abort trong middleware ngăn handler phía sau chạy.
+func abortCase() Case {
+	var handled atomic.Int32
+	return Case{
+		Name: "Abort",
+		Setup: func(r router.Router) {
+			r.Use(func(ctx forkCtx.Context) {
+				if ctx.GetHeader("Authorization") == "" {
+					ctx.String(http.StatusUnauthorized, "denied")
+					ctx.Abort()
+					return
+				}
+				ctx.Next()
+			})
+			r.Handle(http.MethodGet, "/private", func(ctx forkCtx.Context) {
+				handled.Add(1)
+				ctx.String(http.StatusOK, "secret")
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			if err := expect(client, http.MethodGet, baseURL+"/private", http.StatusUnauthorized, "denied"); err != nil {
+				return err
+			}
+			if n := handled.Load(); n != 0 {
+				return fmt.Errorf("expected handler not to run after Abort, ran %d times", n)
+			}
+			return nil
+		},
+	}
+}
+
+// notFoundCase kiểm tra route không tồn tại và method không khớp trả về 404.
+func notFoundCase() Case {
+	return Case{
+		Name: "NotFound",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodGet, "/exists", func(ctx forkCtx.Context) {
+				ctx.String(http.StatusOK, "ok")
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			if err := expect(client, http.MethodGet, baseURL+"/missing", http.StatusNotFound, "404 page not found"); err != nil {
+				return err
+			}
+			return expect(client, http.MethodDelete, baseURL+"/exists", http.StatusNotFound, "404 page not found")
+		},
+	}
+}
+
+// fallbackHandlersCase kiểm tra NoRoute và NoMethod chạy qua middleware và
+// header Allow của phản hồi 405.
+func fallbackHandlersCase() Case {
+	return Case{
+		Name: "FallbackHandlers",
+		Setup: func(r router.Router) {
+			r.Use(func(ctx forkCtx.Context) {
+				ctx.Header("X-Middleware", "on")
+				ctx.Next()
+			})
+			r.Handle(http.MethodGet, "/items", func(ctx forkCtx.Context) {
+				ctx.String(http.StatusOK, "items")
+			})
+			r.Handle(http.MethodPost, "/items", func(ctx forkCtx.Context) {
+				ctx.String(http.StatusCreated, "created")
+			})
+			r.NoRoute(func(ctx forkCtx.Context) {
+				ctx.String(http.StatusNotFound, "custom not found")
+			})
+			r.NoMethod(func(ctx forkCtx.Context) {
+				ctx.String(http.StatusMethodNotAllowed, "custom method not allowed")
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			req, err := http.NewRequest(http.MethodDelete, baseURL+"/items", nil)
+			if err != nil {
+				return err
+			}
+			resp, body, err := do(client, req)
+			if err != nil {
+				return err
+			}
+			if err := check(resp, body, http.StatusMethodNotAllowed, "custom method not allowed"); err != nil {
+				return err
+			}
+			if got := resp.Header.Get("Allow"); got != "GET, OPTIONS, POST" {
+				return fmt.Errorf("expected Allow %q, got %q", "GET, OPTIONS, POST", got)
+			}
+			if got := resp.Header.Get("X-Middleware"); got != "on" {
+				return fmt.Errorf("expected middleware to run for 405, got X-Middleware %q", got)
+			}
+
+			req, err = http.NewRequest(http.MethodGet, baseURL+"/missing", nil)
+			if err != nil {
+				return err
+			}
+			resp, body, err = do(client, req)
+			if err != nil {
+				return err
+			}
+			if err := check(resp, body, http.StatusNotFound, "custom not found"); err != nil {
+				return err
+			}
+			if got := resp.Header.Get("X-Middleware"); got != "on" {
+				return fmt.Errorf("expected middleware to run for 404, got X-Middleware %q", got)
+			}
+			return nil
+		},
+	}
+}
+
+// staticFSCase kiểm tra phục vụ static files từ fs.FS.
+func staticFSCase() Case {
+	return Case{
+		Name: "StaticFS",
+		Setup: func(r router.Router) {
+			r.StaticFS("/assets", fstest.MapFS{
+				"index.html":  {Data: []byte("<h1>home</h1>")},
+				"css/app.css": {Data: []byte("body{}")},
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			req, err := http.NewRequest(http.MethodGet, baseURL+"/assets/css/app.css", nil)
+			if err != nil {
+				return err
+			}
+			resp, body, err := do(client, req)
+			if err != nil {
+				return err
+			}
+			if err := check(resp, body, http.StatusOK, "body{}"); err != nil {
+				return err
+			}
+			if got := resp.Header.Get("Content-Type"); !strings.HasPrefix(got, "text/css") {
+				return fmt.Errorf("expected text/css Content-Type, got %q", got)
+			}
+			if err := expect(client, http.MethodGet, baseURL+"/assets/", http.StatusOK, "<h1>home</h1>"); err != nil {
+				return err
+			}
+			return expect(client, http.MethodGet, baseURL+"/assets/missing.js", http.StatusNotFound, "404 page not found")
+		},
+	}
+}
+
+// handlerErrorCase kiểm tra ctx.Error trả về 500 kèm thông điệp lỗi.
+func handlerErrorCase() Case {
+	return Case{
+		Name: "HandlerError",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodGet, "/error", func(ctx forkCtx.Context) {
+				ctx.Error(errors.New("upstream unavailable"))
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			return expect(client, http.MethodGet, baseURL+"/error", http.StatusInternalServerError, "upstream unavailable\n")
+		},
+	}
+}
+
+// panicRecoveryCase kiểm tra panic trong handler không làm dừng server.
+// Request gây panic có thể thất bại, nhưng các request sau phải được phục vụ bình thường.
+func panicRecoveryCase() Case {
+	return Case{
+		Name: "PanicRecovery",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodGet, "/panic", func(ctx forkCtx.Context) {
+				panic("handler panic")
+			})
+			r.Handle(http.MethodGet, "/ok", func(ctx forkCtx.Context) {
+				ctx.String(http.StatusOK, "alive")
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			req, err := http.NewRequest(http.MethodGet, baseURL+"/panic", nil)
+			if err != nil {
+				return err
+			}
+			if resp, _, err := do(client, req); err == nil && resp.StatusCode < http.StatusInternalServerError {
+				return fmt.Errorf("expected panicking handler to fail, got status %d", resp.StatusCode)
+			}
+			return expect(client, http.MethodGet, baseURL+"/ok", http.StatusOK, "alive")
+		},
+	}
+}
+
+// streamingCase kiểm tra dữ liệu đã Flush tới client trước khi handler kết thúc.
+func streamingCase() Case {
+	release := make(chan struct{})
+	return Case{
+		Name: "Streaming",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodGet, "/stream", func(ctx forkCtx.Context) {
+				ctx.Header("Content-Type", "text/plain")
+				ctx.Status(http.StatusOK)
+				ctx.Response().Write([]byte("chunk-1\n"))
+				ctx.Response().Flush()
+
+				select {
+				case <-release:
+				case <-time.After(5 * time.Second):
+				}
+				ctx.Response().Write([]byte("chunk-2\n"))
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			type result struct {
+				resp *http.Response
+				err  error
+			}
+			first := make(chan result, 1)
+			go func() {
+				resp, err := client.Get(baseURL + "/stream")
+				if err != nil {
+					first <- result{err: err}
+					return
+				}
+				buf := make([]byte, len("chunk-1\n"))
+				if _, err = io.ReadFull(resp.Body, buf); err == nil && string(buf) != "chunk-1\n" {
+					err = fmt.Errorf("expected first chunk %q, got %q", "chunk-1\n", buf)
+				}
+				first <- result{resp: resp, err: err}
+			}()
+
+			var resp *http.Response
+			select {
+			case res := <-first:
+				close(release)
+				if res.resp != nil {
+					defer res.resp.Body.Close()
+				}
+				if res.err != nil {
+					return res.err
+				}
+				resp = res.resp
+			case <-time.After(2 * time.Second):
+				close(release)
+				if res := <-first; res.resp != nil {
+					res.resp.Body.Close()
+				}
+				return errors.New("flushed chunk was not delivered before the handler finished")
+			}
+
+			rest, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return err
+			}
+			if string(rest) != "chunk-2\n" {
+				return fmt.Errorf("expected second chunk %q, got %q", "chunk-2\n", rest)
+			}
+			return nil
+		},
+	}
+}
+
+// largeRequestBodyCase kiểm tra handler nhận đầy đủ request body lớn.
+func largeRequestBodyCase() Case {
+	return Case{
+		Name: "LargeRequestBody",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodPost, "/upload", func(ctx forkCtx.Context) {
+				data, err := ctx.GetRawData()
+				if err != nil {
+					ctx.Error(err)
+					return
+				}
+				ctx.String(http.StatusOK, "%d:%s", len(data), digest(data))
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			payload := largePayload()
+			req, err := http.NewRequest(http.MethodPost, baseURL+"/upload", bytes.NewReader(payload))
+			if err != nil {
+				return err
+			}
+			resp, body, err := do(client, req)
+			if err != nil {
+				return err
+			}
+			return check(resp, body, http.StatusOK, strconv.Itoa(len(payload))+":"+digest(payload))
+		},
+	}
+}
+
+// largeResponseBodyCase kiểm tra client nhận đầy đủ response body lớn.
+func largeResponseBodyCase() Case {
+	payload := largePayload()
+	return Case{
+		Name: "LargeResponseBody",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodGet, "/download", func(ctx forkCtx.Context) {
+				ctx.Blob(http.StatusOK, "application/octet-stream", payload)
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			req, err := http.NewRequest(http.MethodGet, baseURL+"/download", nil)
+			if err != nil {
+				return err
+			}
+			resp, body, err := do(client, req)
+			if err != nil {
+				return err
+			}
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+			if len(body) != len(payload) || digest(body) != digest(payload) {
+				return fmt.Errorf("expected %d bytes with digest %s, got %d bytes with digest %s",
+					len(payload), digest(payload), len(body), digest(body))
+			}
+			return nil
+		},
+	}
+}
+
+// keepAliveCase kiểm tra các request liên tiếp tái sử dụng cùng một kết nối.
+func keepAliveCase() Case {
+	return Case{
+		Name: "KeepAlive",
+		Setup: func(r router.Router) {
+			r.Handle(http.MethodGet, "/ping", func(ctx forkCtx.Context) {
+				ctx.String(http.StatusOK, "pong")
+			})
+		},
+		Do: func(client *http.Client, baseURL string) error {
+			for i := 0; i < 3; i++ {
+				var reused bool
+				trace := &httptrace.ClientTrace{
+					GotConn: func(info httptrace.GotConnInfo) { reused = info.Reused },
+				}
+				req, err := http.NewRequest(http.MethodGet, baseURL+"/ping", nil)
+				if err != nil {
+					return err
+				}
+				req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+
+				resp, body, err := do(client, req)
+				if err != nil {
+					return err
+				}
+				if err := check(resp, body, http.StatusOK, "pong"); err != nil {
+					return err
+				}
+				if i > 0 && !reused {
+					return fmt.Errorf("expected request %d to reuse the connection", i+1)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// do gửi request và đọc toàn bộ body để kết nối có thể được tái sử dụng.
+func do(client *http.Client, req *http.Request) (*http.Response, []byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, err
+	}
+	return resp, body, nil
+}
+
+// expect gửi request không có body và kiểm tra status code và body.
+func expect(client *http.Client, method, url string, status int, body string) error {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, got, err := do(client, req)
+	if err != nil {
+		return err
+	}
+	return check(resp, got, status, body)
+}
+
+// check so sánh status code và body của response với giá trị kỳ vọng.
+func check(resp *http.Response, body []byte, status int, expected string) error {
+	if resp.StatusCode != status {
+		return fmt.Errorf("%s %s: expected status %d, got %d", resp.Request.Method, resp.Request.URL.Path, status, resp.StatusCode)
+	}
+	if string(body) != expected {
+		return fmt.Errorf("%s %s: expected body %q, got %q", resp.Request.Method, resp.Request.URL.Path, expected, body)
+	}
+	return nil
+}
+
+// largePayload tạo dữ liệu có kích thước LargeBodySize với nội dung không lặp theo khối nhỏ.
+func largePayload() []byte {
+	payload := make([]byte, LargeBodySize)
+	for i := range payload {
+		payload[i] = byte(i*7 + i/251)
+	}
+	return payload
+}
+
+// digest trả về SHA-256 dạng hex của dữ liệu.
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,320 @@
+// Package client cung cấp HTTP client cho các lời gọi service-to-service.
+//
+// Client bọc http.Client và tự động truyền request ID, trace context (W3C traceparent,
+// tracestate, baggage) và deadline từ fork Context sang request gửi đi, đồng thời
+// thu thập metrics connection pool theo từng host.
+package client
+
+import (
+	gocontext "context"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// DefaultRequestIDHeader là header mặc định chứa request ID.
+const DefaultRequestIDHeader = forkCtx.RequestIDHeader
+
+// RequestIDKey là khóa trong context store được kiểm tra trước khi đọc header request ID,
+// trùng với khóa do ctx.RequestID() thiết lập.
+const RequestIDKey = forkCtx.RequestIDKey
+
+// DefaultPropagateHeaders là các header trace context được truyền mặc định.
+var DefaultPropagateHeaders = []string{"traceparent", "tracestate", "baggage"}
+
+// Metrics là interface nhận sự kiện của các lời gọi đi để xuất ra hệ thống giám sát.
+type Metrics interface {
+	// ObserveRequest được gọi sau mỗi request với status code (0 nếu lỗi) và thời gian thực hiện.
+	ObserveRequest(host string, statusCode int, err error, duration time.Duration)
+
+	// ObserveConn được gọi khi request nhận được connection từ pool.
+	ObserveConn(host string, reused bool)
+}
+
+// Config chứa cấu hình cho Client.
+type Config struct {
+	// Transport là RoundTripper gốc. Mặc định: http.DefaultTransport
+	Transport http.RoundTripper
+
+	// Timeout là thời gian tối đa cho mỗi request (bên cạnh deadline của fork Context).
+	// Giá trị 0 không giới hạn.
+	Timeout time.Duration
+
+	// RequestIDHeader là header dùng để truyền request ID.
+	// Mặc định: "X-Request-ID"
+	RequestIDHeader string
+
+	// PropagateHeaders là danh sách header được sao chép từ request đến sang request đi.
+	// Mặc định: DefaultPropagateHeaders
+	PropagateHeaders []string
+
+	// DeadlineHeader nếu khác rỗng, thời gian còn lại (milliseconds) của deadline
+	// được gửi kèm trong header này để upstream có thể tự giới hạn thời gian xử lý.
+	DeadlineHeader string
+
+	// Metrics nhận các sự kiện request và connection.
+	Metrics Metrics
+}
+
+// HostStats là thống kê connection pool và request của một host.
+type HostStats struct {
+	Host         string
+	Requests     int64
+	InFlight     int64
+	Errors       int64
+	NewConns     int64
+	ReusedConns  int64
+	TotalLatency time.Duration
+}
+
+// hostCounters lưu các bộ đếm atomic của một host.
+type hostCounters struct {
+	requests    atomic.Int64
+	inFlight    atomic.Int64
+	errors      atomic.Int64
+	newConns    atomic.Int64
+	reusedConns atomic.Int64
+	latency     atomic.Int64
+}
+
+// Client là HTTP client truyền thông tin từ fork Context sang các lời gọi đi.
+type Client struct {
+	config Config
+	http   *http.Client
+	hosts  sync.Map
+}
+
+// New tạo Client mới với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình client
+//
+// Returns:
+//   - *Client: Client đã khởi tạo
+func New(config Config) *Client {
+	if config.Transport == nil {
+		config.Transport = http.DefaultTransport
+	}
+	if config.RequestIDHeader == "" {
+		config.RequestIDHeader = DefaultRequestIDHeader
+	}
+	if config.PropagateHeaders == nil {
+		config.PropagateHeaders = DefaultPropagateHeaders
+	}
+
+	c := &Client{config: config}
+	c.http = &http.Client{
+		Transport: &instrumentedTransport{base: config.Transport, client: c},
+		Timeout:   config.Timeout,
+	}
+	return c
+}
+
+// HTTPClient trả về http.Client đã được instrument, dùng khi cần truyền cho thư viện khác.
+// Request gửi qua client này được thu thập metrics nhưng không được truyền thông tin từ fork Context.
+//
+// Returns:
+//   - *http.Client: HTTP client bên dưới
+func (c *Client) HTTPClient() *http.Client {
+	return c.http
+}
+
+// NewRequest tạo request gửi đi gắn với fork Context.
+//
+// Parameters:
+//   - ctx: fork Context của request đang xử lý
+//   - method: HTTP method
+//   - url: URL đích
+//   - body: Body của request (có thể nil)
+//
+// Returns:
+//   - *http.Request: Request đã được truyền request ID, trace context và deadline
+//   - error: Lỗi nếu không tạo được request
+func (c *Client) NewRequest(ctx forkCtx.Context, method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx.Context(), method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	c.propagate(ctx, req)
+	return req, nil
+}
+
+// Do gửi request, truyền thông tin từ fork Context nếu ctx khác nil.
+// Nếu request đã được tạo với một context khác context.Background, context đó được giữ nguyên.
+//
+// Parameters:
+//   - ctx: fork Context của request đang xử lý (có thể nil)
+//   - req: Request cần gửi
+//
+// Returns:
+//   - *http.Response: Response từ upstream
+//   - error: Lỗi nếu request thất bại
+func (c *Client) Do(ctx forkCtx.Context, req *http.Request) (*http.Response, error) {
+	if ctx != nil {
+		// Request chưa gắn context riêng sẽ dùng context (và deadline) của request đang xử lý
+		if req.Context() == gocontext.Background() {
+			req = req.WithContext(ctx.Context())
+		}
+		c.propagate(ctx, req)
+	}
+	return c.http.Do(req)
+}
+
+// Get gửi GET request gắn với fork Context.
+//
+// Parameters:
+//   - ctx: fork Context của request đang xử lý
+//   - url: URL đích
+//
+// Returns:
+//   - *http.Response: Response từ upstream
+//   - error: Lỗi nếu request thất bại
+func (c *Client) Get(ctx forkCtx.Context, url string) (*http.Response, error) {
+	req, err := c.NewRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.http.Do(req)
+}
+
+// Post gửi POST request gắn với fork Context.
+//
+// Parameters:
+//   - ctx: fork Context của request đang xử lý
+//   - url: URL đích
+//   - contentType: Content-Type của body
+//   - body: Body của request
+//
+// Returns:
+//   - *http.Response: Response từ upstream
+//   - error: Lỗi nếu request thất bại
+func (c *Client) Post(ctx forkCtx.Context, url, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := c.NewRequest(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	return c.http.Do(req)
+}
+
+// Stats trả về snapshot thống kê của tất cả hosts, sắp xếp theo tên host.
+//
+// Returns:
+//   - []HostStats: Thống kê theo host
+func (c *Client) Stats() []HostStats {
+	var stats []HostStats
+	c.hosts.Range(func(key, value interface{}) bool {
+		counters := value.(*hostCounters)
+		stats = append(stats, HostStats{
+			Host:         key.(string),
+			Requests:     counters.requests.Load(),
+			InFlight:     counters.inFlight.Load(),
+			Errors:       counters.errors.Load(),
+			NewConns:     counters.newConns.Load(),
+			ReusedConns:  counters.reusedConns.Load(),
+			TotalLatency: time.Duration(counters.latency.Load()),
+		})
+		return true
+	})
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Host < stats[j].Host })
+	return stats
+}
+
+// propagate sao chép request ID, trace context và deadline sang request gửi đi.
+// Header đã được thiết lập sẵn trên request gửi đi không bị ghi đè.
+func (c *Client) propagate(ctx forkCtx.Context, req *http.Request) {
+	if req.Header.Get(c.config.RequestIDHeader) == "" {
+		// Header request ID tùy chỉnh của request đến được dùng nếu handler chưa gán ID;
+		// còn lại ctx.RequestID() trả về ID hiện có hoặc sinh ID mới cho request
+		var requestID string
+		if c.config.RequestIDHeader != DefaultRequestIDHeader && ctx.GetString(RequestIDKey) == "" {
+			requestID = ctx.GetHeader(c.config.RequestIDHeader)
+		}
+		if requestID == "" {
+			requestID = ctx.RequestID()
+		}
+		req.Header.Set(c.config.RequestIDHeader, requestID)
+	}
+
+	incoming := ctx.Request().Header()
+	for _, name := range c.config.PropagateHeaders {
+		if req.Header.Get(name) != "" {
+			continue
+		}
+		if values := incoming.Values(name); len(values) > 0 {
+			req.Header[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+		}
+	}
+
+	if c.config.DeadlineHeader != "" {
+		if deadline, ok := req.Context().Deadline(); ok {
+			remaining := time.Until(deadline).Milliseconds()
+			if remaining < 0 {
+				remaining = 0
+			}
+			req.Header.Set(c.config.DeadlineHeader, strconv.FormatInt(remaining, 10))
+		}
+	}
+}
+
+// counters trả về bộ đếm của host, tạo mới nếu chưa tồn tại.
+func (c *Client) counters(host string) *hostCounters {
+	if value, ok := c.hosts.Load(host); ok {
+		return value.(*hostCounters)
+	}
+	value, _ := c.hosts.LoadOrStore(host, &hostCounters{})
+	return value.(*hostCounters)
+}
+
+// instrumentedTransport thu thập metrics theo host cho mỗi request.
+type instrumentedTransport struct {
+	base   http.RoundTripper
+	client *Client
+}
+
+// RoundTrip thực hiện request và ghi nhận metrics.
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	counters := t.client.counters(host)
+	metrics := t.client.config.Metrics
+
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Reused {
+				counters.reusedConns.Add(1)
+			} else {
+				counters.newConns.Add(1)
+			}
+			if metrics != nil {
+				metrics.ObserveConn(host, info.Reused)
+			}
+		},
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+
+	counters.requests.Add(1)
+	counters.inFlight.Add(1)
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	duration := time.Since(start)
+	counters.inFlight.Add(-1)
+	counters.latency.Add(int64(duration))
+
+	statusCode := 0
+	if err != nil {
+		counters.errors.Add(1)
+	} else {
+		statusCode = resp.StatusCode
+	}
+	if metrics != nil {
+		metrics.ObserveRequest(host, statusCode, err, duration)
+	}
+	return resp, err
+}
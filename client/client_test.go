@@ -0,0 +1,192 @@
+package client
+
+import (
+	gocontext "context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// newIncoming tạo fork Context cho một request đến.
+func newIncoming(headers map[string]string) forkCtx.Context {
+	req := httptest.NewRequest(http.MethodGet, "/incoming", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return forkCtx.NewContext(httptest.NewRecorder(), req)
+}
+
+func TestClientPropagatesHeaders(t *testing.T) {
+	var got http.Header
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer upstream.Close()
+
+	ctx := newIncoming(map[string]string{
+		"X-Request-ID": "req-1",
+		"traceparent":  "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"tracestate":   "vendor=value",
+		"X-Private":    "secret",
+	})
+
+	c := New(Config{})
+	resp, err := c.Get(ctx, upstream.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if got.Get("X-Request-ID") != "req-1" {
+		t.Errorf("Expected request ID to be propagated, got %q", got.Get("X-Request-ID"))
+	}
+	if !strings.HasPrefix(got.Get("traceparent"), "00-4bf92f") {
+		t.Errorf("Expected traceparent to be propagated, got %q", got.Get("traceparent"))
+	}
+	if got.Get("tracestate") != "vendor=value" {
+		t.Errorf("Expected tracestate to be propagated, got %q", got.Get("tracestate"))
+	}
+	if got.Get("X-Private") != "" {
+		t.Error("Expected non-trace headers not to be propagated")
+	}
+}
+
+func TestClientRequestIDFromStoreTakesPrecedence(t *testing.T) {
+	var got string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Request-ID")
+	}))
+	defer upstream.Close()
+
+	ctx := newIncoming(map[string]string{"X-Request-ID": "from-header"})
+	ctx.Set(RequestIDKey, "from-store")
+
+	c := New(Config{})
+	req, _ := http.NewRequest(http.MethodGet, upstream.URL, nil)
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if got != "from-store" {
+		t.Errorf("Expected request ID from store, got %q", got)
+	}
+}
+
+func TestClientGeneratesRequestID(t *testing.T) {
+	var got string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Request-ID")
+	}))
+	defer upstream.Close()
+
+	// Handler chưa gọi ctx.RequestID() và request đến không có header
+	ctx := newIncoming(nil)
+
+	c := New(Config{})
+	req, _ := http.NewRequest(http.MethodGet, upstream.URL, nil)
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if got == "" || got != ctx.RequestID() {
+		t.Errorf("Expected generated request ID %q to be propagated, got %q", ctx.RequestID(), got)
+	}
+}
+
+func TestClientPropagatesDeadline(t *testing.T) {
+	headers := make(chan string, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Get("X-Deadline-Ms")
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer upstream.Close()
+
+	ctx := newIncoming(nil)
+	deadlineCtx, cancel := gocontext.WithTimeout(gocontext.Background(), 100*time.Millisecond)
+	defer cancel()
+	ctx = ctx.WithContext(deadlineCtx)
+
+	c := New(Config{DeadlineHeader: "X-Deadline-Ms"})
+	start := time.Now()
+	_, err := c.Get(ctx, upstream.URL)
+	if err == nil {
+		t.Fatal("Expected deadline exceeded error")
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("Expected request to be cut by deadline, took %v", time.Since(start))
+	}
+
+	deadlineHeader := <-headers
+	remaining, convErr := strconv.Atoi(deadlineHeader)
+	if convErr != nil || remaining <= 0 || remaining > 100 {
+		t.Errorf("Expected remaining deadline in (0, 100]ms, got %q", deadlineHeader)
+	}
+}
+
+type recordingMetrics struct {
+	mu       sync.Mutex
+	requests int
+	conns    int
+}
+
+func (m *recordingMetrics) ObserveRequest(string, int, error, time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests++
+}
+
+func (m *recordingMetrics) ObserveConn(string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.conns++
+}
+
+func TestClientPoolStats(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer upstream.Close()
+
+	metrics := &recordingMetrics{}
+	c := New(Config{Transport: &http.Transport{}, Metrics: metrics})
+	ctx := newIncoming(nil)
+
+	for i := 0; i < 3; i++ {
+		resp, err := c.Get(ctx, upstream.URL)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	stats := c.Stats()
+	if len(stats) != 1 {
+		t.Fatalf("Expected stats for 1 host, got %d", len(stats))
+	}
+	s := stats[0]
+	if s.Host != strings.TrimPrefix(upstream.URL, "http://") {
+		t.Errorf("Unexpected host %q", s.Host)
+	}
+	if s.Requests != 3 || s.InFlight != 0 || s.Errors != 0 {
+		t.Errorf("Unexpected request counters: %+v", s)
+	}
+	if s.NewConns != 1 || s.ReusedConns != 2 {
+		t.Errorf("Expected 1 new and 2 reused connections, got %+v", s)
+	}
+	if metrics.requests != 3 || metrics.conns != 3 {
+		t.Errorf("Expected metrics callbacks, got requests=%d conns=%d", metrics.requests, metrics.conns)
+	}
+}
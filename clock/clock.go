@@ -0,0 +1,89 @@
+// Package clock cung cấp interface Clock để các tính năng phụ thuộc thời gian (graceful
+// shutdown, cache, quota, circuit breaker) có thể được điều khiển trong test.
+//
+// Code production dùng clock.New() (thời gian hệ thống); test dùng clock.NewMock() và
+// tua nhanh thời gian bằng Mock.Add thay vì sleep.
+package clock
+
+import "time"
+
+// Clock là nguồn thời gian có thể thay thế.
+type Clock interface {
+	// Now trả về thời điểm hiện tại.
+	Now() time.Time
+
+	// Since trả về khoảng thời gian đã trôi qua kể từ t.
+	Since(t time.Time) time.Duration
+
+	// Until trả về khoảng thời gian còn lại tới t.
+	Until(t time.Time) time.Duration
+
+	// After trả về channel nhận thời điểm hiện tại sau khoảng d.
+	After(d time.Duration) <-chan time.Time
+
+	// Sleep tạm dừng goroutine hiện tại trong khoảng d.
+	Sleep(d time.Duration)
+
+	// NewTimer tạo Timer kích hoạt sau khoảng d.
+	NewTimer(d time.Duration) Timer
+
+	// NewTicker tạo Ticker kích hoạt định kỳ sau mỗi khoảng d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer là bộ hẹn giờ một lần, tương ứng với *time.Timer.
+type Timer interface {
+	// C trả về channel nhận thời điểm timer kích hoạt.
+	C() <-chan time.Time
+
+	// Stop dừng timer; trả về false nếu timer đã kích hoạt hoặc đã dừng.
+	Stop() bool
+
+	// Reset đặt lại timer kích hoạt sau khoảng d; trả về true nếu timer đang chạy.
+	Reset(d time.Duration) bool
+}
+
+// Ticker là bộ hẹn giờ định kỳ, tương ứng với *time.Ticker.
+type Ticker interface {
+	// C trả về channel nhận thời điểm của mỗi lần kích hoạt.
+	C() <-chan time.Time
+
+	// Stop dừng ticker.
+	Stop()
+
+	// Reset đặt lại chu kỳ của ticker.
+	Reset(d time.Duration)
+}
+
+// New trả về Clock dùng thời gian hệ thống.
+//
+// Returns:
+//   - Clock: Clock thực
+func New() Clock {
+	return realClock{}
+}
+
+// realClock triển khai Clock bằng package time.
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Since(t time.Time) time.Duration        { return time.Since(t) }
+func (realClock) Until(t time.Time) time.Duration        { return time.Until(t) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (realClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTimer struct{ *time.Timer }
+
+func (t realTimer) C() <-chan time.Time { return t.Timer.C }
+
+type realTicker struct{ *time.Ticker }
+
+func (t realTicker) C() <-chan time.Time { return t.Ticker.C }
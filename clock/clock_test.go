@@ -0,0 +1,29 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRealClock(t *testing.T) {
+	c := New()
+	before := time.Now()
+	if now := c.Now(); now.Before(before) {
+		t.Errorf("Expected Now to be after %v, got %v", before, now)
+	}
+	if c.Since(before) < 0 || c.Until(before) > 0 {
+		t.Error("Expected Since/Until relative to system time")
+	}
+
+	timer := c.NewTimer(time.Millisecond)
+	select {
+	case <-timer.C():
+	case <-time.After(time.Second):
+		t.Fatal("Expected real timer to fire")
+	}
+
+	ticker := c.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	<-ticker.C()
+	<-c.After(time.Millisecond)
+}
@@ -0,0 +1,220 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Mock là Clock có thời gian chỉ thay đổi khi gọi Add hoặc Set, dùng cho test.
+// Timer, ticker, After và Sleep kích hoạt khi thời gian được tua tới thời điểm hết hạn.
+type Mock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*mockWaiter
+	changed chan struct{}
+}
+
+// mockWaiter là một timer hoặc ticker đang chờ trên Mock.
+type mockWaiter struct {
+	clock    *Mock
+	deadline time.Time
+	period   time.Duration
+	ch       chan time.Time
+}
+
+// NewMock tạo Mock bắt đầu tại thời điểm đã cho.
+//
+// Parameters:
+//   - start: Thời điểm bắt đầu; thời điểm zero được thay bằng 2000-01-01 UTC
+//
+// Returns:
+//   - *Mock: Clock giả lập
+func NewMock(start time.Time) *Mock {
+	if start.IsZero() {
+		start = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return &Mock{now: start, changed: make(chan struct{})}
+}
+
+// Now trả về thời điểm hiện tại của Mock.
+func (m *Mock) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.now
+}
+
+// Since trả về khoảng thời gian từ t tới thời điểm hiện tại của Mock.
+func (m *Mock) Since(t time.Time) time.Duration {
+	return m.Now().Sub(t)
+}
+
+// Until trả về khoảng thời gian từ thời điểm hiện tại của Mock tới t.
+func (m *Mock) Until(t time.Time) time.Duration {
+	return t.Sub(m.Now())
+}
+
+// After trả về channel nhận thời điểm khi Mock được tua qua khoảng d.
+func (m *Mock) After(d time.Duration) <-chan time.Time {
+	return m.NewTimer(d).C()
+}
+
+// Sleep chặn cho tới khi Mock được tua qua khoảng d.
+func (m *Mock) Sleep(d time.Duration) {
+	<-m.After(d)
+}
+
+// NewTimer tạo Timer kích hoạt khi Mock được tua qua khoảng d.
+func (m *Mock) NewTimer(d time.Duration) Timer {
+	return m.addWaiter(d, 0)
+}
+
+// NewTicker tạo Ticker kích hoạt mỗi khi Mock được tua qua một chu kỳ d.
+//
+// Panics:
+//   - Nếu d <= 0
+func (m *Mock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	return &mockTicker{m.addWaiter(d, d)}
+}
+
+// Add tua thời gian của Mock thêm d và kích hoạt các timer, ticker đến hạn theo thứ tự.
+//
+// Parameters:
+//   - d: Khoảng thời gian tua
+func (m *Mock) Add(d time.Duration) {
+	m.Set(m.Now().Add(d))
+}
+
+// Set đặt thời gian của Mock tới t và kích hoạt các timer, ticker đến hạn theo thứ tự.
+// Thời điểm trước thời gian hiện tại chỉ thay đổi Now mà không kích hoạt gì.
+//
+// Parameters:
+//   - t: Thời điểm mới
+func (m *Mock) Set(t time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for {
+		sort.SliceStable(m.waiters, func(i, j int) bool {
+			return m.waiters[i].deadline.Before(m.waiters[j].deadline)
+		})
+		if len(m.waiters) == 0 || m.waiters[0].deadline.After(t) {
+			break
+		}
+
+		w := m.waiters[0]
+		m.now = w.deadline
+		select {
+		case w.ch <- w.deadline:
+		default:
+			// Giống time.Ticker: bỏ qua lần kích hoạt khi channel còn đầy
+		}
+		if w.period > 0 {
+			w.deadline = w.deadline.Add(w.period)
+		} else {
+			m.waiters = m.waiters[1:]
+		}
+	}
+	if t.After(m.now) {
+		m.now = t
+	}
+	m.notifyLocked()
+}
+
+// Waiters trả về số timer, ticker và lời gọi After/Sleep đang chờ.
+func (m *Mock) Waiters() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.waiters)
+}
+
+// BlockUntil chặn cho tới khi có ít nhất n timer, ticker hoặc lời gọi After/Sleep đang chờ.
+// Dùng để đồng bộ với goroutine đang được test trước khi gọi Add.
+//
+// Parameters:
+//   - n: Số waiter cần chờ
+func (m *Mock) BlockUntil(n int) {
+	for {
+		m.mu.Lock()
+		if len(m.waiters) >= n {
+			m.mu.Unlock()
+			return
+		}
+		changed := m.changed
+		m.mu.Unlock()
+		<-changed
+	}
+}
+
+func (m *Mock) addWaiter(d, period time.Duration) *mockWaiter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w := &mockWaiter{clock: m, deadline: m.now.Add(d), period: period, ch: make(chan time.Time, 1)}
+	if d <= 0 && period == 0 {
+		// Timer không có thời gian chờ kích hoạt ngay, giống time.NewTimer
+		w.ch <- m.now
+		return w
+	}
+	m.waiters = append(m.waiters, w)
+	m.notifyLocked()
+	return w
+}
+
+// removeLocked gỡ waiter khỏi Mock; trả về true nếu waiter đang chờ.
+func (m *Mock) removeLocked(w *mockWaiter) bool {
+	for i, waiter := range m.waiters {
+		if waiter == w {
+			m.waiters = append(m.waiters[:i], m.waiters[i+1:]...)
+			m.notifyLocked()
+			return true
+		}
+	}
+	return false
+}
+
+// notifyLocked đánh thức các goroutine đang chờ trong BlockUntil.
+func (m *Mock) notifyLocked() {
+	close(m.changed)
+	m.changed = make(chan struct{})
+}
+
+func (w *mockWaiter) C() <-chan time.Time {
+	return w.ch
+}
+
+func (w *mockWaiter) Stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	return w.clock.removeLocked(w)
+}
+
+func (w *mockWaiter) Reset(d time.Duration) bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+
+	active := w.clock.removeLocked(w)
+	w.deadline = w.clock.now.Add(d)
+	if w.period > 0 {
+		w.period = d
+	} else if d <= 0 {
+		select {
+		case w.ch <- w.clock.now:
+		default:
+		}
+		return active
+	}
+	w.clock.waiters = append(w.clock.waiters, w)
+	w.clock.notifyLocked()
+	return active
+}
+
+// mockTicker bọc mockWaiter để có chữ ký Stop/Reset của Ticker.
+type mockTicker struct{ w *mockWaiter }
+
+func (t *mockTicker) C() <-chan time.Time   { return t.w.ch }
+func (t *mockTicker) Stop()                 { t.w.Stop() }
+func (t *mockTicker) Reset(d time.Duration) { t.w.Reset(d) }
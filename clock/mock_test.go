@@ -0,0 +1,113 @@
+package clock
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestMockNowAndAdd(t *testing.T) {
+	m := NewMock(start)
+	m.Add(90 * time.Second)
+	if !m.Now().Equal(start.Add(90 * time.Second)) {
+		t.Errorf("Expected clock to advance, got %v", m.Now())
+	}
+	if m.Since(start) != 90*time.Second || m.Until(start.Add(2*time.Minute)) != 30*time.Second {
+		t.Error("Expected Since/Until relative to mock time")
+	}
+	if NewMock(time.Time{}).Now().IsZero() {
+		t.Error("Expected zero start time to be replaced")
+	}
+}
+
+func TestMockTimer(t *testing.T) {
+	m := NewMock(start)
+	timer := m.NewTimer(time.Minute)
+
+	m.Add(59 * time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("Timer fired too early")
+	default:
+	}
+
+	m.Add(time.Second)
+	select {
+	case fired := <-timer.C():
+		if !fired.Equal(start.Add(time.Minute)) {
+			t.Errorf("Expected fire time %v, got %v", start.Add(time.Minute), fired)
+		}
+	default:
+		t.Fatal("Expected timer to fire")
+	}
+	if timer.Stop() {
+		t.Error("Expected Stop on fired timer to return false")
+	}
+
+	if timer.Reset(time.Second) {
+		t.Error("Expected Reset on fired timer to return false")
+	}
+	if !timer.Stop() {
+		t.Error("Expected Stop on active timer to return true")
+	}
+	m.Add(time.Hour)
+	select {
+	case <-timer.C():
+		t.Error("Stopped timer must not fire")
+	default:
+	}
+}
+
+func TestMockTicker(t *testing.T) {
+	m := NewMock(start)
+	ticker := m.NewTicker(10 * time.Second)
+	var ticks []time.Time
+
+	for i := 0; i < 3; i++ {
+		m.Add(10 * time.Second)
+		ticks = append(ticks, <-ticker.C())
+	}
+	if !ticks[2].Equal(start.Add(30 * time.Second)) {
+		t.Errorf("Unexpected ticks %v", ticks)
+	}
+
+	ticker.Stop()
+	if m.Waiters() != 0 {
+		t.Errorf("Expected stopped ticker to be removed, got %d waiters", m.Waiters())
+	}
+}
+
+func TestMockOrdersWaitersAndSetsTimeAtFire(t *testing.T) {
+	m := NewMock(start)
+	late := m.After(2 * time.Minute)
+	early := m.After(time.Minute)
+
+	m.Add(5 * time.Minute)
+	if got := <-early; !got.Equal(start.Add(time.Minute)) {
+		t.Errorf("Expected early waiter at +1m, got %v", got)
+	}
+	if got := <-late; !got.Equal(start.Add(2 * time.Minute)) {
+		t.Errorf("Expected late waiter at +2m, got %v", got)
+	}
+	if !m.Now().Equal(start.Add(5 * time.Minute)) {
+		t.Errorf("Expected final time +5m, got %v", m.Now())
+	}
+}
+
+func TestMockSleepAndBlockUntil(t *testing.T) {
+	m := NewMock(start)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			defer wg.Done()
+			m.Sleep(time.Hour)
+		}()
+	}
+
+	m.BlockUntil(2)
+	m.Add(time.Hour)
+	wg.Wait()
+}
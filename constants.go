@@ -520,6 +520,14 @@ var (
 	// ErrInvalidConfiguration được trả về khi cấu hình không hợp lệ.
 	// Điều này xảy ra khi các giá trị cấu hình không đúng định dạng hoặc nằm ngoài phạm vi cho phép.
 	ErrInvalidConfiguration = errors.New("invalid configuration")
+
+	// ErrPluginConflict được trả về khi đăng ký plugin trùng tên hoặc sau khi plugins đã boot.
+	// Điều này xảy ra khi gọi RegisterPlugin với tên đã tồn tại hoặc sau BootPlugins.
+	ErrPluginConflict = errors.New("plugin: conflict")
+
+	// ErrPluginDependency được trả về khi phụ thuộc của plugin không thể thỏa mãn.
+	// Điều này xảy ra khi plugin yêu cầu plugin chưa đăng ký hoặc các plugin phụ thuộc vòng.
+	ErrPluginDependency = errors.New("plugin: unresolved dependency")
 )
 
 // Adapter engine types định nghĩa các loại HTTP server engine hỗ trợ.
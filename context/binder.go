@@ -0,0 +1,70 @@
+package context
+
+import "sync"
+
+// Binder bind dữ liệu của request vào một đối tượng cho một Content-Type.
+// Ứng dụng implement Binder và đăng ký bằng RegisterBinder để Bind hỗ trợ
+// Content-Type mới.
+type Binder interface {
+	// Bind đọc dữ liệu từ request của ctx và gán vào obj.
+	//
+	// Parameters:
+	//   - ctx: Context của request cần bind
+	//   - obj: Con trỏ đến đối tượng nhận dữ liệu
+	//
+	// Returns:
+	//   - error: Lỗi nếu không thể bind
+	Bind(ctx Context, obj interface{}) error
+}
+
+// BinderFunc cho phép dùng một hàm thông thường như Binder.
+type BinderFunc func(ctx Context, obj interface{}) error
+
+// Bind gọi f(ctx, obj).
+func (f BinderFunc) Bind(ctx Context, obj interface{}) error {
+	return f(ctx, obj)
+}
+
+var (
+	bindersMu sync.RWMutex
+	binders   = make(map[string]Binder)
+)
+
+// RegisterBinder đăng ký binder cho một Content-Type. Bind dùng binder đã đăng ký trước
+// các binder có sẵn, nên đăng ký cho Content-Type có sẵn (ví dụ "application/json") sẽ
+// thay thế binder mặc định. Content-Type được so khớp theo media type, không phân biệt
+// hoa thường và bỏ qua tham số. Đăng ký lại cùng Content-Type thay thế binder cũ.
+//
+// Parameters:
+//   - contentType: Media type, ví dụ "application/vnd.api+json"
+//   - binder: Binder xử lý Content-Type, nil để gỡ binder đã đăng ký
+//
+// Panics:
+//   - Nếu contentType rỗng
+func RegisterBinder(contentType string, binder Binder) {
+	name := mediaType(contentType)
+	if name == "" {
+		panic("context: binder content type must not be empty")
+	}
+
+	bindersMu.Lock()
+	defer bindersMu.Unlock()
+	if binder == nil {
+		delete(binders, name)
+		return
+	}
+	binders[name] = binder
+}
+
+// lookupBinder trả về binder đã đăng ký cho media type.
+//
+// Parameters:
+//   - contentType: Media type đã chuẩn hóa
+//
+// Returns:
+//   - Binder: Binder đã đăng ký, nil nếu không có
+func lookupBinder(contentType string) Binder {
+	bindersMu.RLock()
+	defer bindersMu.RUnlock()
+	return binders[contentType]
+}
@@ -0,0 +1,71 @@
+package context
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func bindRequest(t *testing.T, contentType, body string, obj interface{}) error {
+	t.Helper()
+	req := httptest.NewRequest("POST", "/import", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", contentType)
+	return NewContext(httptest.NewRecorder(), req).Bind(obj)
+}
+
+func TestRegisterBinder(t *testing.T) {
+	csvBinder := BinderFunc(func(ctx Context, obj interface{}) error {
+		rows, ok := obj.(*[][]string)
+		if !ok {
+			return ErrUnsupportedBinding
+		}
+		records, err := csv.NewReader(ctx.Request().Body()).ReadAll()
+		*rows = records
+		return err
+	})
+	RegisterBinder("Text/CSV", csvBinder)
+	defer RegisterBinder("text/csv", nil)
+
+	var rows [][]string
+	if err := bindRequest(t, "text/csv; charset=utf-8", "a,b\n1,2\n", &rows); err != nil {
+		t.Fatalf("Failed to bind with registered binder: %v", err)
+	}
+	if !reflect.DeepEqual(rows, [][]string{{"a", "b"}, {"1", "2"}}) {
+		t.Errorf("Unexpected rows %v", rows)
+	}
+
+	RegisterBinder("text/csv", nil)
+	if err := bindRequest(t, "text/csv", "a,b\n", &rows); !errors.Is(err, ErrUnsupportedBinding) {
+		t.Errorf("Expected ErrUnsupportedBinding after removing binder, got %v", err)
+	}
+}
+
+func TestRegisterBinderOverridesBuiltin(t *testing.T) {
+	errCustom := errors.New("custom json binder")
+	RegisterBinder("application/json", BinderFunc(func(ctx Context, obj interface{}) error {
+		return errCustom
+	}))
+
+	var body map[string]interface{}
+	err := bindRequest(t, "application/json", `{}`, &body)
+	RegisterBinder("application/json", nil)
+	if !errors.Is(err, errCustom) {
+		t.Errorf("Expected registered binder to replace built-in JSON binding, got %v", err)
+	}
+
+	if err := bindRequest(t, "application/json", `{"a":1}`, &body); err != nil || body["a"] != float64(1) {
+		t.Errorf("Expected built-in JSON binding after removing binder, got %v %v", body, err)
+	}
+}
+
+func TestRegisterBinderEmptyContentType(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for empty content type")
+		}
+	}()
+	RegisterBinder(" ", BinderFunc(func(ctx Context, obj interface{}) error { return nil }))
+}
@@ -0,0 +1,57 @@
+package context
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DefaultBindLimit là kích thước tối đa (bytes) của request body mà Bind chấp nhận, áp dụng cho
+// Bind, ShouldBind và BindAndValidate. Giá trị <= 0 tắt giới hạn (mặc định); body vẫn bị
+// giới hạn bởi MaxBodyBufferSize khi được đệm. Chỉ nên thay đổi khi khởi tạo ứng dụng.
+var DefaultBindLimit int64 = 0
+
+// BindWithLimit bind request body như Bind nhưng từ chối body lớn hơn maxBytes trước khi
+// unmarshal: Content-Length vượt giới hạn bị từ chối ngay mà không đọc body, body không khai
+// báo độ dài (chunked) được đọc qua http.MaxBytesReader và dừng ở giới hạn.
+// BindAndValidate trả về 413 Request Entity Too Large cho lỗi này.
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//   - maxBytes: Số bytes tối đa của body, <= 0 để không giới hạn
+//
+// Returns:
+//   - error: ErrBodyTooLarge nếu body vượt quá maxBytes, hoặc lỗi của Bind
+func (c *forkContext) BindWithLimit(obj interface{}, maxBytes int64) error {
+	if maxBytes > 0 {
+		if err := c.limitBody(maxBytes); err != nil {
+			return err
+		}
+	}
+	return c.bind(obj)
+}
+
+// limitBody giới hạn request body ở maxBytes bytes.
+//
+// Params:
+//   - maxBytes: Số bytes tối đa của body
+//
+// Returns:
+//   - error: ErrBodyTooLarge nếu body đã đệm hoặc Content-Length vượt quá maxBytes
+func (c *forkContext) limitBody(maxBytes int64) error {
+	// Body đã được đệm bởi GetRawData: chỉ cần kiểm tra kích thước
+	if c.body != nil {
+		if int64(len(c.body)) > maxBytes {
+			return fmt.Errorf("%w: exceeds %d bytes", ErrBodyTooLarge, maxBytes)
+		}
+		return nil
+	}
+
+	req := c.request.Request()
+	if req.ContentLength > maxBytes {
+		return fmt.Errorf("%w: exceeds %d bytes", ErrBodyTooLarge, maxBytes)
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body = http.MaxBytesReader(c.response.ResponseWriter(), req.Body, maxBytes)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package context
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// chunkedJSONContext tạo context với body JSON không khai báo Content-Length.
+func chunkedJSONContext(body string) (Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest("POST", "/", io.NopCloser(strings.NewReader(body)))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	return NewContext(w, req), w
+}
+
+func TestContextBindWithLimit(t *testing.T) {
+	type Payload struct {
+		Name string `json:"name"`
+	}
+	body := `{"name":"` + strings.Repeat("a", 100) + `"}`
+
+	var payload Payload
+	ctx, _ := jsonContext(body)
+	if err := ctx.BindWithLimit(&payload, 1024); err != nil || len(payload.Name) != 100 {
+		t.Errorf("Expected body within limit to bind, got %v", err)
+	}
+
+	ctx, _ = jsonContext(body)
+	if err := ctx.BindWithLimit(&payload, 16); !errors.Is(err, ErrBodyTooLarge) {
+		t.Errorf("Expected ErrBodyTooLarge from Content-Length, got %v", err)
+	}
+
+	ctx, _ = chunkedJSONContext(body)
+	err := ctx.BindWithLimit(&payload, 16)
+	var maxBytesErr *http.MaxBytesError
+	if !errors.Is(err, ErrBodyTooLarge) || !errors.As(err, &maxBytesErr) {
+		t.Errorf("Expected ErrBodyTooLarge wrapping http.MaxBytesError, got %v", err)
+	}
+
+	ctx, _ = jsonContext(body)
+	if _, err := ctx.GetRawData(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.BindWithLimit(&payload, 16); !errors.Is(err, ErrBodyTooLarge) {
+		t.Errorf("Expected ErrBodyTooLarge for buffered body, got %v", err)
+	}
+}
+
+func TestContextDefaultBindLimit(t *testing.T) {
+	defer func(limit int64) { DefaultBindLimit = limit }(DefaultBindLimit)
+	DefaultBindLimit = 16
+
+	type Payload struct {
+		Name string `json:"name" validate:"required"`
+	}
+	ctx, w := chunkedJSONContext(`{"name":"` + strings.Repeat("a", 100) + `"}`)
+	var payload Payload
+	if err := ctx.BindAndValidate(&payload); err == nil {
+		t.Fatal("Expected BindAndValidate to reject large body")
+	}
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected status 413, got %d", w.Code)
+	}
+	if payload.Name != "" {
+		t.Errorf("Expected body not to be unmarshalled, got %q", payload.Name)
+	}
+
+	ctx, _ = jsonContext(`{"name":"ok"}`)
+	if err := ctx.Bind(&payload); err != nil || payload.Name != "ok" {
+		t.Errorf("Expected small body to bind, got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package context
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// MaxBodyBufferSize là kích thước tối đa (bytes) của request body mà GetRawData đệm lại để
+// đọc nhiều lần (ví dụ middleware xác thực chữ ký rồi handler bind body), mặc định 10MB.
+// Giá trị <= 0 tắt giới hạn. Chỉ nên thay đổi khi khởi tạo ứng dụng.
+var MaxBodyBufferSize int64 = 10 << 20
+
+// bufferedBody là request body được phục hồi sau khi đọc: đọc lại phần đã đệm rồi phần
+// còn lại của body gốc, Close đóng body gốc.
+type bufferedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// GetRawData đọc và trả về toàn bộ nội dung của request body. Body được đệm lại nên
+// GetRawData, các phương thức Bind* và Request().Body có thể đọc lại body nhiều lần.
+//
+// Returns:
+//   - []byte: Dữ liệu body, dùng chung giữa các lần gọi nên không được sửa đổi
+//   - error: ErrBodyTooLarge nếu body vượt quá MaxBodyBufferSize hoặc giới hạn của
+//     http.MaxBytesReader, hoặc lỗi đọc body
+func (c *forkContext) GetRawData() ([]byte, error) {
+	if c.body != nil {
+		return c.body, nil
+	}
+
+	req := c.request.Request()
+	if req.Body == nil {
+		c.body = []byte{}
+		return c.body, nil
+	}
+
+	limit := MaxBodyBufferSize
+	reader := io.Reader(req.Body)
+	if limit > 0 {
+		reader = io.LimitReader(req.Body, limit+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		// Body bị giới hạn bởi http.MaxBytesReader (BindWithLimit hoặc giới hạn của router)
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, fmt.Errorf("%w: %w", ErrBodyTooLarge, err)
+		}
+		return nil, err
+	}
+
+	if limit > 0 && int64(len(data)) > limit {
+		// Không đệm body quá lớn nhưng phục hồi phần đã đọc để handler vẫn có thể stream body
+		req.Body = bufferedBody{Reader: io.MultiReader(bytes.NewReader(data), req.Body), Closer: req.Body}
+		return nil, fmt.Errorf("%w: exceeds %d bytes", ErrBodyTooLarge, limit)
+	}
+
+	c.body = data
+	req.Body = bufferedBody{Reader: bytes.NewReader(data), Closer: req.Body}
+	return c.body, nil
+}
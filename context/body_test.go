@@ -0,0 +1,65 @@
+package context
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestContextGetRawDataRereadable(t *testing.T) {
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{"name":"alice"}`))
+	req.Header.Set("Content-Type", "application/json")
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	// Middleware đọc body để xác thực chữ ký, handler bind body sau đó
+	first, err := ctx.GetRawData()
+	if err != nil || string(first) != `{"name":"alice"}` {
+		t.Fatalf("Expected body, got %q (%v)", first, err)
+	}
+	second, err := ctx.GetRawData()
+	if err != nil || string(second) != string(first) {
+		t.Errorf("Expected same body on second read, got %q (%v)", second, err)
+	}
+
+	var user struct {
+		Name string `json:"name"`
+	}
+	if err := ctx.BindJSON(&user); err != nil || user.Name != "alice" {
+		t.Errorf("Expected BindJSON after GetRawData, got %+v (%v)", user, err)
+	}
+	user.Name = ""
+	if err := ctx.Bind(&user); err != nil || user.Name != "alice" {
+		t.Errorf("Expected Bind after BindJSON, got %+v (%v)", user, err)
+	}
+
+	// Request().Body được phục hồi cho code đọc trực tiếp
+	direct, _ := io.ReadAll(ctx.Request().Body())
+	if string(direct) != `{"name":"alice"}` {
+		t.Errorf("Expected restored request body, got %q", direct)
+	}
+}
+
+func TestContextGetRawDataLimit(t *testing.T) {
+	old := MaxBodyBufferSize
+	MaxBodyBufferSize = 8
+	defer func() { MaxBodyBufferSize = old }()
+
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("POST", "/", strings.NewReader("0123456789abcdef")))
+	if _, err := ctx.GetRawData(); !errors.Is(err, ErrBodyTooLarge) {
+		t.Errorf("Expected ErrBodyTooLarge, got %v", err)
+	}
+
+	// Body quá lớn không bị đệm nhưng vẫn đọc được đầy đủ bằng stream
+	rest, _ := io.ReadAll(ctx.Request().Body())
+	if string(rest) != "0123456789abcdef" {
+		t.Errorf("Expected full body to remain readable, got %q", rest)
+	}
+
+	MaxBodyBufferSize = 0
+	ctx = NewContext(httptest.NewRecorder(), httptest.NewRequest("POST", "/", strings.NewReader("0123456789abcdef")))
+	if body, err := ctx.GetRawData(); err != nil || len(body) != 16 {
+		t.Errorf("Expected unlimited buffering, got %q (%v)", body, err)
+	}
+}
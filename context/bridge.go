@@ -0,0 +1,147 @@
+package context
+
+import (
+	"context"
+	"time"
+)
+
+// StoreKey là kiểu khóa dùng để đọc giá trị của context store từ context.Context
+// sau khi gọi BridgeStore. Kiểu riêng tránh xung đột với khóa của package khác.
+//
+// Ví dụ:
+//
+//	requestID, _ := stdCtx.Value(forkCtx.StoreKey("request_id")).(string)
+type StoreKey string
+
+// StoreValue đọc giá trị của context store từ context.Context được bridge.
+// Hữu ích cho code chỉ nhận context.Context như DB driver hoặc HTTP client.
+//
+// Parameters:
+//   - ctx: context.Context của request (hoặc context dẫn xuất từ nó)
+//   - key: Khóa trong context store
+//
+// Returns:
+//   - interface{}: Giá trị lưu trữ
+//   - bool: true nếu khóa được bridge và tồn tại
+func StoreValue(ctx context.Context, key string) (interface{}, bool) {
+	value := ctx.Value(StoreKey(key))
+	return value, value != nil
+}
+
+// bridgeMarker là khóa nội bộ để nhận biết context.Context đã chứa bridge.
+type bridgeMarker struct{}
+
+// storeBridge xác định các khóa của context store được chia sẻ qua context.Context.
+// Các trường được bảo vệ bởi mutex của forkContext.
+type storeBridge struct {
+	c    *forkContext
+	all  bool
+	keys map[string]bool
+}
+
+// bridgeContext là context.Context trả về giá trị của context store cho các StoreKey được bridge.
+// Giá trị được đọc tại thời điểm gọi Value nên các lần Set sau khi bridge vẫn được nhìn thấy.
+type bridgeContext struct {
+	context.Context
+	bridge *storeBridge
+}
+
+// Value trả về giá trị của context store cho StoreKey được bridge, ngược lại ủy quyền cho context cha.
+func (b *bridgeContext) Value(key any) any {
+	switch k := key.(type) {
+	case bridgeMarker:
+		return b.bridge
+	case StoreKey:
+		if value, ok := b.bridge.lookup(string(k)); ok {
+			return value
+		}
+	}
+	return b.Context.Value(key)
+}
+
+// lookup đọc giá trị từ context store nếu khóa được bridge.
+func (s *storeBridge) lookup(key string) (interface{}, bool) {
+	s.c.mu.RLock()
+	defer s.c.mu.RUnlock()
+	if !s.all && !s.keys[key] {
+		return nil, false
+	}
+	value, ok := s.c.store[key]
+	return value, ok
+}
+
+// wrap gắn bridge vào ctx nếu ctx chưa chứa bridge này.
+func (s *storeBridge) wrap(ctx context.Context) context.Context {
+	if ctx.Value(bridgeMarker{}) == s {
+		return ctx
+	}
+	return &bridgeContext{Context: ctx, bridge: s}
+}
+
+// BridgeStore chia sẻ các giá trị của context store qua context.Context.
+//
+// Params:
+//   - keys: Các khóa được chia sẻ, rỗng để chia sẻ toàn bộ store
+func (c *forkContext) BridgeStore(keys ...string) {
+	c.mu.Lock()
+	bridge := c.bridge
+	if bridge == nil {
+		bridge = &storeBridge{c: c, keys: make(map[string]bool)}
+		c.bridge = bridge
+	}
+	if len(keys) == 0 {
+		bridge.all = true
+	}
+	for _, key := range keys {
+		bridge.keys[key] = true
+	}
+	c.mu.Unlock()
+
+	c.ctx = bridge.wrap(c.ctx)
+}
+
+// Deadline trả về deadline của context.Context hiện tại.
+//
+// Returns:
+//   - time.Time: Thời điểm hết hạn
+//   - bool: false nếu context không có deadline
+func (c *forkContext) Deadline() (time.Time, bool) {
+	return c.ctx.Deadline()
+}
+
+// Done trả về channel được đóng khi context.Context hiện tại bị hủy, bao gồm khi client
+// ngắt kết nối.
+//
+// Returns:
+//   - <-chan struct{}: Channel Done của context, nil nếu context không hỗ trợ hủy
+func (c *forkContext) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+// Err trả về lý do context.Context hiện tại bị hủy.
+//
+// Returns:
+//   - error: context.Canceled hoặc context.DeadlineExceeded, nil nếu Done chưa đóng
+func (c *forkContext) Err() error {
+	return c.ctx.Err()
+}
+
+// Value trả về giá trị của context store với khóa kiểu StoreKey (không cần BridgeStore),
+// các khóa khác được ủy quyền cho context.Context hiện tại.
+//
+// Params:
+//   - key: Khóa cần truy xuất
+//
+// Returns:
+//   - any: Giá trị tương ứng, nil nếu không tồn tại
+func (c *forkContext) Value(key any) any {
+	if k, ok := key.(StoreKey); ok {
+		c.mu.RLock()
+		value, exists := c.store[string(k)]
+		c.mu.RUnlock()
+		if exists {
+			return value
+		}
+	}
+	return c.ctx.Value(key)
+}
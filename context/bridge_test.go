@@ -0,0 +1,138 @@
+package context
+
+import (
+	gocontext "context"
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// requestIDFromStd mô phỏng code chỉ nhận context.Context
+func requestIDFromStd(ctx gocontext.Context) string {
+	id, _ := ctx.Value(StoreKey("request_id")).(string)
+	return id
+}
+
+func TestBridgeStore(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	ctx.Set("request_id", "req-1")
+	ctx.Set("secret", "hidden")
+
+	if requestIDFromStd(ctx.Context()) != "" {
+		t.Error("Expected store values to be hidden before BridgeStore")
+	}
+
+	ctx.BridgeStore("request_id", "user")
+	if got := requestIDFromStd(ctx.Context()); got != "req-1" {
+		t.Errorf("Expected request_id req-1, got %q", got)
+	}
+	if _, ok := StoreValue(ctx.Context(), "secret"); ok {
+		t.Error("Expected non-bridged key to stay hidden")
+	}
+
+	// Giá trị được Set sau khi bridge vẫn được nhìn thấy, kể cả từ context dẫn xuất
+	derived, cancel := gocontext.WithTimeout(ctx.Context(), time.Second)
+	defer cancel()
+	ctx.Set("user", "alice")
+	if value, ok := StoreValue(derived, "user"); !ok || value != "alice" {
+		t.Errorf("Expected user alice from derived context, got %v", value)
+	}
+
+	// Chuỗi khóa thông thường không truy cập được store
+	if ctx.Context().Value("request_id") != nil {
+		t.Error("Expected plain string key to be ignored")
+	}
+
+	// Bridge được giữ lại khi thay context.Context
+	type traceKey struct{}
+	ctx.WithContext(gocontext.WithValue(gocontext.Background(), traceKey{}, "trace"))
+	if requestIDFromStd(ctx.Context()) != "req-1" || ctx.Context().Value(traceKey{}) != "trace" {
+		t.Error("Expected bridge and new values after WithContext")
+	}
+	ctx.WithContext(gocontext.WithValue(ctx.Context(), traceKey{}, "nested"))
+	if _, double := ctx.Context().(*bridgeContext); double {
+		t.Error("Expected derived context not to be wrapped twice")
+	}
+
+	// Không có khóa: chia sẻ toàn bộ store
+	ctx.BridgeStore()
+	if value, ok := StoreValue(ctx.Context(), "secret"); !ok || value != "hidden" {
+		t.Errorf("Expected whole store to be bridged, got %v", value)
+	}
+}
+
+func TestBridgeStoreConcurrent(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	ctx.BridgeStore()
+	std := ctx.Context()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			ctx.Set("counter", i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			StoreValue(std, "counter")
+		}
+	}()
+	wg.Wait()
+}
+
+// traceKey là khóa của request context trong test
+type traceKey struct{}
+
+func TestContextImplementsStdContext(t *testing.T) {
+	reqCtx, cancel := gocontext.WithTimeout(gocontext.WithValue(gocontext.Background(), traceKey{}, "t-1"), time.Minute)
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil).WithContext(reqCtx))
+	ctx.Set("request_id", "req-1")
+
+	var std gocontext.Context = ctx
+	if _, ok := std.Deadline(); !ok {
+		t.Error("Expected deadline from request context")
+	}
+	if std.Err() != nil {
+		t.Errorf("Expected nil Err before cancel, got %v", std.Err())
+	}
+	if got := requestIDFromStd(std); got != "req-1" {
+		t.Errorf("Expected request_id from store without BridgeStore, got %q", got)
+	}
+	if got := std.Value(traceKey{}); got != "t-1" {
+		t.Errorf("Expected value delegated to request context, got %v", got)
+	}
+	if got := std.Value("request_id"); got != nil {
+		t.Errorf("Expected plain string key not to read the store, got %v", got)
+	}
+
+	// Context dẫn xuất thấy giá trị Set sau đó và bị hủy cùng request
+	derived, cancelDerived := gocontext.WithCancel(std)
+	defer cancelDerived()
+	ctx.Set("user", "alice")
+	if value, ok := StoreValue(derived, "user"); !ok || value != "alice" {
+		t.Errorf("Expected user alice from derived context, got %v", value)
+	}
+	cancel()
+	select {
+	case <-derived.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Expected derived context to be canceled with the request")
+	}
+	if !errors.Is(std.Err(), gocontext.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", std.Err())
+	}
+
+	// Deadline/Done/Err theo context thay thế bởi WithContext
+	timeoutCtx, cancelTimeout := gocontext.WithTimeout(gocontext.Background(), time.Millisecond)
+	defer cancelTimeout()
+	ctx.WithContext(timeoutCtx)
+	<-ctx.Done()
+	if !errors.Is(ctx.Err(), gocontext.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", ctx.Err())
+	}
+}
@@ -4,16 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"os"
 	"reflect"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/go-playground/validator/v10"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/vmihailenco/msgpack/v5"
 	forkerrors "go.fork.vn/fork/errors"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
 )
 
 // forkContext là implementation private cho Context interface.
@@ -30,9 +38,15 @@ type forkContext struct {
 	// ctx là context.Context gốc từ request, dùng để kiểm soát timeout, hủy bỏ, truyền dữ liệu giữa các goroutine
 	ctx context.Context
 
-	// params chứa các tham số từ URL path (route parameters)
+	// params chứa các tham số từ URL path (route parameters), do router thiết lập qua SetParams
 	params map[string]string
 
+	// routeMeta là metadata của route đã khớp, do router thiết lập qua SetRouteMeta
+	routeMeta map[string]interface{}
+
+	// fullPath là pattern của route đã khớp, do router thiết lập qua SetFullPath
+	fullPath string
+
 	// handlers là mảng các middleware functions cho request hiện tại
 	handlers []func(Context)
 
@@ -45,14 +59,42 @@ type forkContext struct {
 	// store lưu trữ dữ liệu tùy chỉnh trong phạm vi của request (key-value)
 	store map[string]interface{}
 
-	// validator dùng để xác thực struct theo validation tags
-	validator *validator.Validate
+	// validator dùng để xác thực struct, nil để dùng validator dùng chung
+	validator Validator
+
+	// logger là logger của ứng dụng, dùng làm logger gốc cho Logger
+	logger Logger
+
+	// mu bảo vệ store khi giá trị được đọc qua context.Context từ goroutine khác
+	mu sync.RWMutex
+
+	// bridge chia sẻ các giá trị của store qua context.Context, nil nếu chưa gọi BridgeStore
+	bridge *storeBridge
+
+	// clientGone ghi nhớ client đã ngắt kết nối
+	clientGone bool
+
+	// streaming đánh dấu response đang được stream bởi Stream
+	streaming bool
+
+	// body là request body đã được đệm bởi GetRawData, nil nếu body chưa được đọc
+	body []byte
+
+	// timeout là context.Context có deadline đặt bởi WithTimeout, nil nếu chưa gọi
+	timeout context.Context
+
+	// timeoutCancel giải phóng timers của các deadline đặt bởi WithTimeout
+	timeoutCancel context.CancelFunc
+
+	// errors là các lỗi được ghi nhận bởi AddError, được bảo vệ bởi mu
+	errors []error
 }
 
 // NewContext tạo một context mới cho mỗi HTTP request.
 //
 // Hàm này khởi tạo và trả về một Context mới từ HTTP request và response.
-// Thiết lập validator và các cấu hình mặc định cho context.
+// Context dùng validator mặc định dùng chung cho đến khi router hoặc WebApp gắn validator
+// của ứng dụng qua SetValidator. Router dùng AcquireContext để tái sử dụng context qua sync.Pool.
 //
 // Params:
 //   - w: http.ResponseWriter để ghi HTTP response
@@ -61,34 +103,19 @@ type forkContext struct {
 // Returns:
 //   - Context: Context mới đã được khởi tạo
 func NewContext(w http.ResponseWriter, r *http.Request) Context {
-	// Khởi tạo validator với cấu hình mặc định
-	validate := validator.New()
-
-	// Đăng ký hàm định dạng lỗi tùy chỉnh
-	// Ưu tiên sử dụng tên từ tag json, sau đó là form, cuối cùng là tên trường
-	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
-		name := fld.Tag.Get("json")
-		if name == "" {
-			name = fld.Tag.Get("form")
-		}
-		if name == "" {
-			name = fld.Name
-		}
-		return name
-	})
-
-	// Lưu ý: Không còn sử dụng translator nữa
+	c := newForkContext()
+	c.Reset(w, r)
+	return c
+}
 
+// newForkContext cấp phát forkContext rỗng cùng các wrapper request/response và store,
+// được tái sử dụng qua Reset.
+func newForkContext() *forkContext {
 	return &forkContext{
-		request:   NewRequest(r),
-		response:  NewResponse(w),
-		ctx:       r.Context(),
-		params:    make(map[string]string),
-		handlers:  nil,
-		index:     -1,
-		aborted:   false,
-		store:     make(map[string]interface{}),
-		validator: validate,
+		request:  &forkRequest{},
+		response: &forkResponse{statusCode: http.StatusOK},
+		index:    -1,
+		store:    make(map[string]interface{}),
 	}
 }
 
@@ -124,6 +151,9 @@ func (c *forkContext) Context() context.Context {
 // Returns:
 //   - Context: Context đã cập nhật context.Context
 func (c *forkContext) WithContext(ctx context.Context) Context {
+	if c.bridge != nil {
+		ctx = c.bridge.wrap(ctx)
+	}
 	c.ctx = ctx
 	return c
 }
@@ -136,7 +166,12 @@ func (c *forkContext) Next() {
 	// Tăng index để trỏ đến handler tiếp theo
 	c.index++
 	// Thực thi tất cả handlers còn lại cho đến khi kết thúc hoặc bị abort
-	for c.index < len(c.handlers) && !c.aborted {
+	for c.index < len(c.handlers) && !c.IsAborted() {
+		// Route streaming/SSE dừng ngay khi client đã ngắt kết nối
+		if c.isStreaming() && c.IsClientGone() {
+			c.Abort()
+			return
+		}
 		c.handlers[c.index](c)
 		c.index++
 	}
@@ -147,12 +182,13 @@ func (c *forkContext) Abort() {
 	c.aborted = true
 }
 
-// IsAborted kiểm tra context có đã bị abort hay không.
+// IsAborted kiểm tra context có đã bị abort hay không, kể cả khi deadline đặt bởi
+// WithTimeout đã hết hạn.
 //
 // Returns:
 //   - bool: true nếu đã bị abort, ngược lại là false
 func (c *forkContext) IsAborted() bool {
-	return c.aborted
+	return c.aborted || c.timedOut()
 }
 
 // Set lưu trữ một giá trị vào context với key được chỉ định.
@@ -161,7 +197,9 @@ func (c *forkContext) IsAborted() bool {
 //   - key: Tên key
 //   - value: Giá trị lưu trữ (interface{})
 func (c *forkContext) Set(key string, value interface{}) {
+	c.mu.Lock()
 	c.store[key] = value
+	c.mu.Unlock()
 }
 
 // Get lấy giá trị từ context dựa theo key.
@@ -173,10 +211,54 @@ func (c *forkContext) Set(key string, value interface{}) {
 //   - interface{}: Giá trị lưu trữ
 //   - bool: true nếu tồn tại, false nếu không
 func (c *forkContext) Get(key string) (interface{}, bool) {
+	c.mu.RLock()
 	value, exists := c.store[key]
+	c.mu.RUnlock()
 	return value, exists
 }
 
+// MustGet lấy giá trị từ context dựa theo key, panic nếu key không tồn tại.
+//
+// Params:
+//   - key: Tên key
+//
+// Returns:
+//   - interface{}: Giá trị lưu trữ
+//
+// Panics:
+//   - Nếu key không tồn tại trong context
+func (c *forkContext) MustGet(key string) interface{} {
+	if value, exists := c.Get(key); exists {
+		return value
+	}
+	panic(fmt.Sprintf("context: key %q does not exist", key))
+}
+
+// GetOrSet trả về giá trị của key, hoặc tính giá trị bằng fn, lưu vào context và trả về
+// nếu key chưa tồn tại. fn chạy ngoài lock nên có thể dùng Get/Set; nếu nhiều goroutine
+// cùng tính giá trị cho một key, giá trị được lưu đầu tiên được trả về cho tất cả.
+//
+// Params:
+//   - key: Tên key
+//   - fn: Hàm tính giá trị khi key chưa tồn tại
+//
+// Returns:
+//   - interface{}: Giá trị đã lưu hoặc vừa tính
+func (c *forkContext) GetOrSet(key string, fn func() interface{}) interface{} {
+	if value, exists := c.Get(key); exists {
+		return value
+	}
+
+	value := fn()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, exists := c.store[key]; exists {
+		return existing
+	}
+	c.store[key] = value
+	return value
+}
+
 // GetString lấy giá trị string từ context dựa theo key.
 //
 // Params:
@@ -398,6 +480,14 @@ func (c *forkContext) RawPath() string {
 // Returns:
 //   - string: Giá trị tham số, trả về "" nếu không tồn tại
 func (c *forkContext) Param(name string) string {
+	c.mu.RLock()
+	value, exists := c.params[name]
+	c.mu.RUnlock()
+	if exists {
+		return value
+	}
+
+	// Tương thích với tham số được đặt trực tiếp vào store với tiền tố "param:"
 	return c.GetString("param:" + name)
 }
 
@@ -406,7 +496,9 @@ func (c *forkContext) Param(name string) string {
 // Returns:
 //   - map[string]string: Map các tham số route
 func (c *forkContext) ParamMap() map[string]string {
-	params := make(map[string]string)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	params := make(map[string]string, len(c.params))
 	for key, value := range c.store {
 		if len(key) > 6 && key[:6] == "param:" {
 			paramName := key[6:]
@@ -415,9 +507,77 @@ func (c *forkContext) ParamMap() map[string]string {
 			}
 		}
 	}
+	for name, value := range c.params {
+		params[name] = value
+	}
 	return params
 }
 
+// SetParams thiết lập các tham số route của request, thay thế các tham số trước đó.
+//
+// Params:
+//   - params: Map tham số route, được giữ nguyên không sao chép
+func (c *forkContext) SetParams(params map[string]string) {
+	c.mu.Lock()
+	c.params = params
+	c.mu.Unlock()
+}
+
+// Params trả về map tham số route do router thiết lập.
+//
+// Returns:
+//   - map[string]string: Map tham số route, nil nếu chưa được thiết lập
+func (c *forkContext) Params() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.params
+}
+
+// SetRouteMeta thiết lập metadata của route đã khớp.
+//
+// Params:
+//   - meta: Metadata của route, được giữ nguyên không sao chép
+func (c *forkContext) SetRouteMeta(meta map[string]interface{}) {
+	c.mu.Lock()
+	c.routeMeta = meta
+	c.mu.Unlock()
+}
+
+// RouteMeta trả về metadata của route đã khớp theo key.
+//
+// Params:
+//   - key: Tên metadata
+//
+// Returns:
+//   - interface{}: Giá trị metadata
+//   - bool: true nếu tồn tại, false nếu không
+func (c *forkContext) RouteMeta(key string) (interface{}, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	value, exists := c.routeMeta[key]
+	return value, exists
+}
+
+// SetFullPath thiết lập pattern của route đã khớp.
+//
+// Params:
+//   - path: Pattern của route (ví dụ: "/users/:id")
+func (c *forkContext) SetFullPath(path string) {
+	c.mu.Lock()
+	c.fullPath = path
+	c.mu.Unlock()
+}
+
+// FullPath trả về pattern của route đã khớp.
+//
+// Returns:
+//   - string: Pattern của route, rỗng nếu request chưa khớp route nào
+func (c *forkContext) FullPath() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.fullPath
+}
+
 // ParamArray trả về mảng giá trị của tham số route theo tên (hiện chỉ hỗ trợ 1 giá trị).
 //
 // Params:
@@ -628,6 +788,66 @@ func (c *forkContext) BindXML(obj interface{}) error {
 	return xml.Unmarshal(body, obj)
 }
 
+// BindYAML đọc request body và chuyển đổi thành struct sử dụng YAML unmarshaling.
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: Lỗi nếu không thể đọc hoặc unmarshal YAML
+func (c *forkContext) BindYAML(obj interface{}) error {
+	body, err := c.GetRawData()
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(body, obj)
+}
+
+// BindTOML đọc request body và chuyển đổi thành struct sử dụng TOML unmarshaling.
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: Lỗi nếu không thể đọc hoặc unmarshal TOML
+func (c *forkContext) BindTOML(obj interface{}) error {
+	body, err := c.GetRawData()
+	if err != nil {
+		return err
+	}
+	return toml.Unmarshal(body, obj)
+}
+
+// BindMsgpack đọc request body và chuyển đổi thành struct sử dụng MessagePack unmarshaling.
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: Lỗi nếu không thể đọc hoặc unmarshal MessagePack
+func (c *forkContext) BindMsgpack(obj interface{}) error {
+	body, err := c.GetRawData()
+	if err != nil {
+		return err
+	}
+	return msgpack.Unmarshal(body, obj)
+}
+
+// BindCBOR đọc request body và chuyển đổi thành struct sử dụng CBOR unmarshaling.
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: Lỗi nếu không thể đọc hoặc unmarshal CBOR
+func (c *forkContext) BindCBOR(obj interface{}) error {
+	body, err := c.GetRawData()
+	if err != nil {
+		return err
+	}
+	return cbor.Unmarshal(body, obj)
+}
+
 // BindQuery liên kết các tham số truy vấn URL vào một struct sử dụng function bind.
 //
 // Params:
@@ -641,6 +861,8 @@ func (c *forkContext) BindQuery(obj interface{}) error {
 }
 
 // BindForm phân tích form trong request và liên kết các giá trị form vào một struct.
+// Với multipart/form-data, các trường kiểu *multipart.FileHeader hoặc []*multipart.FileHeader
+// nhận file upload có cùng tên field.
 //
 // Params:
 //   - obj: Con trỏ struct nhận dữ liệu
@@ -648,6 +870,17 @@ func (c *forkContext) BindQuery(obj interface{}) error {
 // Returns:
 //   - error: Lỗi nếu không thể bind
 func (c *forkContext) BindForm(obj interface{}) error {
+	if mediaType(c.ContentType()) == "multipart/form-data" {
+		form, err := c.request.MultipartForm()
+		if err != nil {
+			return err
+		}
+		if err := bind(c.request.Form(), obj); err != nil {
+			return err
+		}
+		return bindFiles(form.File, obj)
+	}
+
 	err := c.request.ParseForm()
 	if err != nil {
 		return err
@@ -655,7 +888,30 @@ func (c *forkContext) BindForm(obj interface{}) error {
 	return bind(c.request.Form(), obj)
 }
 
-// Bind tự động chọn phương thức binding dựa trên Content-Type của request.
+// BindHeader liên kết các header của request vào một struct sử dụng tag "header".
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: Lỗi nếu không thể bind
+func (c *forkContext) BindHeader(obj interface{}) error {
+	return bindHeader(c.request.Request().Header, obj)
+}
+
+// BindURI liên kết các tham số route vào một struct sử dụng tag "uri".
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: Lỗi nếu không thể bind
+func (c *forkContext) BindURI(obj interface{}) error {
+	return bindURI(c.ParamMap(), obj)
+}
+
+// Bind tự động chọn phương thức binding dựa trên Content-Type của request,
+// ưu tiên binder đã đăng ký bằng RegisterBinder.
 //
 // Params:
 //   - obj: Con trỏ struct nhận dữ liệu
@@ -666,14 +922,43 @@ func (c *forkContext) BindForm(obj interface{}) error {
 // Exceptions:
 //   - ErrUnsupportedBinding: Nếu Content-Type không được hỗ trợ
 func (c *forkContext) Bind(obj interface{}) error {
-	// Lấy Content-Type của request
-	contentType := c.ContentType()
+	return c.BindWithLimit(obj, DefaultBindLimit)
+}
+
+// bind chọn binder theo Content-Type và bind request body vào obj.
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: ErrUnsupportedBinding nếu Content-Type không được hỗ trợ, hoặc lỗi của binder
+func (c *forkContext) bind(obj interface{}) error {
+	// Lấy media type của request, bỏ qua tham số như charset hoặc boundary
+	contentType := mediaType(c.ContentType())
+	// Binder do ứng dụng đăng ký được ưu tiên hơn binder có sẵn
+	if binder := lookupBinder(contentType); binder != nil {
+		return binder.Bind(c, obj)
+	}
 	// Chọn phương thức binding phù hợp dựa vào Content-Type
 	switch contentType {
 	case "application/json":
 		return c.BindJSON(obj)
 	case "application/xml", "text/xml":
 		return c.BindXML(obj)
+	case "application/yaml", "application/x-yaml", "text/yaml":
+		return c.BindYAML(obj)
+	case "application/toml":
+		return c.BindTOML(obj)
+	case "application/msgpack", "application/x-msgpack", "application/vnd.msgpack":
+		return c.BindMsgpack(obj)
+	case "application/cbor":
+		return c.BindCBOR(obj)
+	case "application/protobuf", "application/x-protobuf":
+		message, ok := obj.(proto.Message)
+		if !ok {
+			return fmt.Errorf("%w: %T does not implement proto.Message", ErrUnsupportedBinding, obj)
+		}
+		return c.BindProtobuf(message)
 	case "application/x-www-form-urlencoded", "multipart/form-data":
 		return c.BindForm(obj)
 	}
@@ -709,15 +994,56 @@ func (c *forkContext) Header(key, value string) {
 	c.response.Header().Set(key, value)
 }
 
+// SetTrailer khai báo trailer trong header Trailer của response.
+//
+// Params:
+//   - key: Tên trailer
+func (c *forkContext) SetTrailer(key string) {
+	key = http.CanonicalHeaderKey(key)
+	if c.response.Written() || c.trailerDeclared(key) {
+		return
+	}
+	c.response.Header().Add("Trailer", key)
+}
+
+// WriteTrailer thiết lập giá trị trailer. Trailer không được khai báo trước khi ghi header
+// được gửi dưới dạng http.TrailerPrefix + key.
+//
+// Params:
+//   - key: Tên trailer
+//   - value: Giá trị trailer
+func (c *forkContext) WriteTrailer(key, value string) {
+	key = http.CanonicalHeaderKey(key)
+	if !c.trailerDeclared(key) {
+		key = http.TrailerPrefix + key
+	}
+	c.response.Header()[key] = []string{value}
+}
+
+// trailerDeclared kiểm tra trailer đã có trong header Trailer của response hay chưa.
+func (c *forkContext) trailerDeclared(key string) bool {
+	for _, value := range c.response.Header().Values("Trailer") {
+		for _, name := range strings.Split(value, ",") {
+			if http.CanonicalHeaderKey(strings.TrimSpace(name)) == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // GetHeader trả về giá trị của header request theo tên.
 //
 // Params:
-//   - key: Tên header
+//   - key: Tên header, không phân biệt hoa thường
 //
 // Returns:
 //   - string: Giá trị header, trả về "" nếu không tìm thấy
 func (c *forkContext) GetHeader(key string) string {
-	return c.request.Header().Get(key)
+	if values := c.headerValues(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
 }
 
 // Cookie trả về giá trị của cookie từ request dựa theo tên.
@@ -753,7 +1079,7 @@ func (c *forkContext) Cookie(name string) (string, error) {
 //   - httpOnly: Ngăn JavaScript truy cập cookie nếu true
 func (c *forkContext) SetCookie(name, value string, maxAge int, path, domain string, secure, httpOnly bool) {
 	// Tạo đối tượng cookie với các tham số đã cung cấp
-	cookie := &http.Cookie{
+	c.SetCookieWithOptions(http.Cookie{
 		Name:     name,
 		Value:    value,
 		MaxAge:   maxAge,
@@ -761,9 +1087,22 @@ func (c *forkContext) SetCookie(name, value string, maxAge int, path, domain str
 		Domain:   domain,
 		Secure:   secure,
 		HttpOnly: httpOnly,
+	})
+}
+
+// SetCookieWithOptions thiết lập cookie với đầy đủ thuộc tính của http.Cookie, bao gồm SameSite.
+// Cookie SameSite=None luôn được đánh dấu Secure vì trình duyệt từ chối cookie thiếu Secure.
+//
+// Params:
+//   - cookie: Cookie cần thiết lập
+func (c *forkContext) SetCookieWithOptions(cookie http.Cookie) {
+	if cookie.SameSite == http.SameSiteNoneMode {
+		cookie.Secure = true
+	}
+	// Cookie có tên không hợp lệ được http.Cookie.String bỏ qua (chuỗi rỗng)
+	if value := cookie.String(); value != "" {
+		c.response.Header().Add("Set-Cookie", value)
 	}
-	// Thêm cookie vào header response
-	c.response.Header().Add("Set-Cookie", cookie.String())
 }
 
 // Cookies trả về tất cả cookies từ request hiện tại.
@@ -783,6 +1122,9 @@ func (c *forkContext) Cookies() []*http.Cookie {
 //
 // Note: Requires templates middleware to be registered
 func (c *forkContext) Render(code int, name string, data interface{}) {
+	// Gộp dữ liệu view dùng chung (nếu có) vào dữ liệu template
+	data = c.viewData(data)
+
 	// Try to get template registry first for multi-engine support
 	if registry, exists := c.Get("template_registry"); exists {
 		if templateRegistry, ok := registry.(interface {
@@ -796,6 +1138,9 @@ func (c *forkContext) Render(code int, name string, data interface{}) {
 				}); ok {
 					// Render template using the detected engine
 					result, renderErr := templateEngine.Render(name, data)
+					if c.renderErrorPage(renderErr) {
+						return
+					}
 					if renderErr != nil {
 						// If template rendering fails, return error as JSON
 						c.JSON(http.StatusInternalServerError, map[string]string{
@@ -838,6 +1183,9 @@ func (c *forkContext) Render(code int, name string, data interface{}) {
 		}); ok {
 			// Render template using the legacy engine
 			result, err := templateEngine.Render(name, data)
+			if c.renderErrorPage(err) {
+				return
+			}
 			if err != nil {
 				// If template rendering fails, return error as JSON
 				c.JSON(http.StatusInternalServerError, map[string]string{
@@ -859,6 +1207,63 @@ func (c *forkContext) Render(code int, name string, data interface{}) {
 	c.HTML(code, fmt.Sprintf("<!-- Template '%s' not found or template engine not available -->", name))
 }
 
+// viewData gộp dữ liệu view dùng chung từ context store (khóa "view_globals",
+// thường được thiết lập bởi view.Globals hoặc WebApp.ViewGlobals) vào dữ liệu template.
+// Chỉ gộp khi data là nil hoặc map[string]interface{}; khóa trong data được ưu tiên.
+//
+// Params:
+//   - data: Dữ liệu truyền vào Render
+//
+// Returns:
+//   - interface{}: Dữ liệu đã gộp
+func (c *forkContext) viewData(data interface{}) interface{} {
+	value, exists := c.Get("view_globals")
+	if !exists {
+		return data
+	}
+	globalsFunc, ok := value.(func() map[string]interface{})
+	if !ok {
+		return data
+	}
+
+	var values map[string]interface{}
+	switch d := data.(type) {
+	case nil:
+	case map[string]interface{}:
+		values = d
+	default:
+		return data
+	}
+
+	merged := globalsFunc()
+	if merged == nil {
+		merged = make(map[string]interface{}, len(values))
+	}
+	for key, value := range values {
+		merged[key] = value
+	}
+	return merged
+}
+
+// renderErrorPage render trang lỗi HTML nếu lỗi template cung cấp phương thức ErrorPage
+// (ví dụ: view.RenderError ở chế độ Debug).
+//
+// Params:
+//   - err: Lỗi từ template engine
+//
+// Returns:
+//   - bool: true nếu trang lỗi đã được render
+func (c *forkContext) renderErrorPage(err error) bool {
+	var pageErr interface{ ErrorPage() []byte }
+	if err == nil || !errors.As(err, &pageErr) {
+		return false
+	}
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Status(http.StatusInternalServerError)
+	c.response.Write(pageErr.ErrorPage())
+	return true
+}
+
 // HTML render nội dung HTML với status code đã cho.
 //
 // Params:
@@ -1009,8 +1414,8 @@ func (c *forkContext) Stream(code int, contentType string, r io.Reader) {
 	c.Header("Content-Type", contentType)
 	// Thiết lập HTTP status code
 	c.Status(code)
-	// Sao chép dữ liệu từ reader vào response
-	io.Copy(c.response, r)
+	// Sao chép dữ liệu từ reader vào response, dừng khi client ngắt kết nối
+	c.copyUntilGone(r)
 }
 
 // Redirect thực hiện chuyển hướng HTTP đến địa chỉ được chỉ định.
@@ -1025,6 +1430,55 @@ func (c *forkContext) Redirect(code int, location string) {
 	c.Status(code)
 }
 
+// EarlyHints gửi interim response 103 Early Hints với các header Link.
+//
+// Params:
+//   - links: Giá trị các header Link
+//
+// Returns:
+//   - error: ErrEarlyHintsNotSupported nếu response đã được ghi, client dùng HTTP/1.0
+//     hoặc writer không hỗ trợ informational responses
+func (c *forkContext) EarlyHints(links []string) error {
+	if len(links) == 0 {
+		return nil
+	}
+	req := c.request.Request()
+	w := c.response.ResponseWriter()
+	if c.response.Written() || !req.ProtoAtLeast(1, 1) {
+		return ErrEarlyHintsNotSupported
+	}
+
+	iw, ok := w.(InformationalWriter)
+	if !ok && !isServerResponseWriter(w) {
+		return ErrEarlyHintsNotSupported
+	}
+
+	for _, link := range links {
+		c.response.Header().Add("Link", link)
+	}
+	if ok {
+		return iw.WriteInformational(http.StatusEarlyHints)
+	}
+	// net/http gửi ngay 1xx khi gọi WriteHeader và vẫn cho phép ghi response cuối cùng
+	w.WriteHeader(http.StatusEarlyHints)
+	return nil
+}
+
+// isServerResponseWriter kiểm tra writer có phải là writer gốc của net/http server
+// (HTTP/1.1 hoặc HTTP/2) hay không. Các writer wrapper như recorder hoặc buffer
+// coi mọi status code là response cuối cùng nên không được hỗ trợ.
+func isServerResponseWriter(w http.ResponseWriter) bool {
+	t := reflect.TypeOf(w)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.PkgPath() != "net/http" {
+		return false
+	}
+	name := strings.ToLower(t.Name())
+	return name == "response" || name == "http2responsewriter"
+}
+
 // Error trả về HTTP error với status code và thông báo từ error.
 //
 // Params:
@@ -1073,16 +1527,6 @@ func (c *forkContext) IsWebsocket() bool {
 	return false
 }
 
-// GetRawData đọc và trả về toàn bộ nội dung của request body.
-//
-// Returns:
-//   - []byte: Dữ liệu body
-//   - error: Lỗi nếu không thể đọc body
-func (c *forkContext) GetRawData() ([]byte, error) {
-	// Sử dụng io.ReadAll để đọc toàn bộ body của request
-	return io.ReadAll(c.request.Body())
-}
-
 // Handlers trả về danh sách các handlers đã đăng ký cho context này.
 //
 // Returns:
@@ -1107,15 +1551,11 @@ func (c *forkContext) SetHandlers(handlers []func(Context)) {
 // Returns:
 //   - error: Lỗi nếu không hợp lệ
 func (c *forkContext) ValidateStruct(obj interface{}) error {
-	// Khởi tạo validator nếu chưa có
-	if c.validator == nil {
-		c.validator = validator.New()
-	}
-	// Thực hiện validate struct
-	return c.validator.Struct(obj)
+	return c.structValidator().Struct(obj)
 }
 
-// ShouldBindAndValidate bind request data vào struct và validate nó, trả về lỗi nếu có.
+// ShouldBindAndValidate bind tham số route (tag "uri") và request data vào struct
+// rồi validate nó, trả về lỗi nếu có.
 //
 // Params:
 //   - obj: Struct nhận dữ liệu
@@ -1123,14 +1563,34 @@ func (c *forkContext) ValidateStruct(obj interface{}) error {
 // Returns:
 //   - error: Lỗi bind hoặc validate
 func (c *forkContext) ShouldBindAndValidate(obj interface{}) error {
-	// Thực hiện binding trước
+	// Bind tham số route trước, sau đó bind request body
+	if err := c.bindURIParams(obj); err != nil {
+		return err
+	}
 	if err := c.ShouldBind(obj); err != nil {
 		return err
 	}
 	return c.ValidateStruct(obj)
 }
 
-// BindAndValidate bind request data vào struct, validate, và tự động trả về HTTP error nếu thất bại.
+// bindURIParams bind tham số route vào obj cho ShouldBindAndValidate và BindAndValidate.
+// Bỏ qua obj không phải con trỏ struct, ví dụ map nhận JSON body.
+//
+// Params:
+//   - obj: Đối tượng nhận dữ liệu
+//
+// Returns:
+//   - error: Lỗi nếu không thể bind
+func (c *forkContext) bindURIParams(obj interface{}) error {
+	objValue := reflect.ValueOf(obj)
+	if objValue.Kind() != reflect.Ptr || objValue.IsNil() || objValue.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	return c.BindURI(obj)
+}
+
+// BindAndValidate bind tham số route (tag "uri") và request data vào struct, validate,
+// và tự động trả về HTTP error nếu thất bại.
 //
 // Params:
 //   - obj: Struct nhận dữ liệu
@@ -1138,14 +1598,21 @@ func (c *forkContext) ShouldBindAndValidate(obj interface{}) error {
 // Returns:
 //   - error: Lỗi bind hoặc validate, đồng thời trả về JSON error response
 func (c *forkContext) BindAndValidate(obj interface{}) error {
-	// Thực hiện binding trước
-	if err := c.Bind(obj); err != nil {
+	// Bind tham số route trước, sau đó bind request body
+	err := c.bindURIParams(obj)
+	if err == nil {
+		err = c.Bind(obj)
+	}
+	if err != nil {
 		// Trả về lỗi binding sử dụng fork/errors
 		details := map[string]interface{}{
 			"error": err.Error(),
 		}
-		// Tạo HTTP error với status code 400 Bad Request
+		// Tạo HTTP error với status code 400 Bad Request, hoặc 413 nếu body vượt giới hạn
 		httpError := forkerrors.NewBadRequest("Failed to bind request data", details, err)
+		if errors.Is(err, ErrBodyTooLarge) {
+			httpError = forkerrors.NewRequestEntityTooLarge("Request body too large", details, err)
+		}
 		// Tự động trả về response JSON với thông tin lỗi
 		c.JSON(httpError.StatusCode, httpError)
 		return httpError
@@ -1153,6 +1620,13 @@ func (c *forkContext) BindAndValidate(obj interface{}) error {
 
 	// Thực hiện validate sau khi binding thành công
 	if err := c.ValidateStruct(obj); err != nil {
+		// Validator tùy chỉnh có thể trả về HTTP error với status và chi tiết riêng
+		var httpErr *forkerrors.HttpError
+		if errors.As(err, &httpErr) {
+			c.JSON(httpErr.StatusCode, httpErr)
+			return httpErr
+		}
+
 		// Kiểm tra xem lỗi có phải là ValidationErrors không
 		validationErrors, ok := err.(validator.ValidationErrors)
 		if ok {
@@ -1193,27 +1667,59 @@ func (c *forkContext) BindAndValidate(obj interface{}) error {
 	return nil
 }
 
-// RegisterValidation đăng ký một hàm validation tùy chỉnh vào validator.
+// RegisterValidation không đăng ký validation: validator của context được dùng chung bởi mọi
+// request và đăng ký validation của go-playground không an toàn khi các request khác đang
+// validate. Validation phải được đăng ký khi khởi tạo ứng dụng qua WebApp.RegisterValidation.
 //
 // Params:
 //   - tag: Tên tag validation
 //   - fn: Hàm validation
 //
 // Returns:
-//   - error: Lỗi nếu đăng ký thất bại
+//   - error: ErrUnsupportedValidator nếu validator không phải go-playground, ngược lại ErrSharedValidator
 func (c *forkContext) RegisterValidation(tag string, fn validator.Func) error {
-	// Khởi tạo validator nếu chưa có
-	if c.validator == nil {
-		c.validator = validator.New()
+	if c.GetValidator() == nil {
+		return ErrUnsupportedValidator
 	}
-	// Đăng ký hàm validation với tag được chỉ định
-	return c.validator.RegisterValidation(tag, fn)
+	return ErrSharedValidator
 }
 
-// GetValidator trả về instance của validator để cho phép cấu hình nâng cao.
+// GetValidator trả về validator go-playground của context để cho phép cấu hình nâng cao.
 //
 // Returns:
-//   - *validator.Validate: Instance validator
+//   - *validator.Validate: Validator được gắn qua SetValidator hoặc validator dùng chung,
+//     nil nếu validator được gắn không phải go-playground
 func (c *forkContext) GetValidator() *validator.Validate {
-	return c.validator
+	validate, _ := c.structValidator().(*validator.Validate)
+	return validate
+}
+
+// SetValidator gắn validator của ứng dụng vào context.
+//
+// Params:
+//   - v: Validator dùng cho ValidateStruct, nil để dùng validator dùng chung
+func (c *forkContext) SetValidator(v Validator) {
+	if validate, ok := v.(*validator.Validate); ok && validate == nil {
+		v = nil
+	}
+	c.validator = v
+}
+
+// T dịch một message key theo ngôn ngữ của request hiện tại.
+//
+// Params:
+//   - key: Khóa của message
+//   - args: Tham số của message
+//
+// Returns:
+//   - string: Message đã dịch, hoặc key nếu không có translator
+func (c *forkContext) T(key string, args ...interface{}) string {
+	if value, exists := c.Get("translator"); exists {
+		if translator, ok := value.(interface {
+			T(string, ...interface{}) string
+		}); ok {
+			return translator.T(key, args...)
+		}
+	}
+	return key
 }
@@ -1,16 +1,27 @@
 package context
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
+	"mime/multipart"
+	"net/http"
 	"net/url"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // bind helper function
 // Hàm nội bộ để liên kết các giá trị từ url.Values vào một struct.
 // Sử dụng reflection để map các giá trị vào các trường struct dựa trên tag "form" hoặc "json".
+// Trường struct lồng nhau nhận các giá trị có tiền tố "<tên>." (ví dụ "filter.name"),
+// trường của struct nhúng không có tag được bind như trường của struct cha, và trường slice
+// nhận mọi giá trị của tham số lặp lại (ví dụ "ids=1&ids=2").
+// Trường có tag "default" nhận giá trị mặc định khi tham số không có trong values;
+// trường time.Time nhận tag "time_format" và "time_utc" (xem setTimeField).
 //
 // Parameters:
 //   - values: Các giá trị cần được liên kết vào struct
@@ -31,25 +42,264 @@ func bind(values url.Values, obj interface{}) error {
 
 	// Lấy giá trị thực của đối tượng
 	objValue = objValue.Elem()
-	objType := objValue.Type()
 
 	// Kiểm tra xem đối tượng có phải là struct hay không
+	if objValue.Kind() != reflect.Struct {
+		return errors.New("obj must be a struct")
+	}
+
+	_, err := bindStruct(values, objValue, "")
+	return err
+}
+
+// bindStruct liên kết các giá trị có tiền tố prefix vào các trường của structValue.
+//
+// Parameters:
+//   - values: Các giá trị cần được liên kết
+//   - structValue: Struct nhận các giá trị
+//   - prefix: Tiền tố tên tham số của struct, rỗng với struct gốc
+//
+// Returns:
+//   - bool: true nếu có ít nhất một trường được gán giá trị
+//   - error: Lỗi nếu không thể liên kết giá trị
+func bindStruct(values url.Values, structValue reflect.Value, prefix string) (bool, error) {
+	structType := structValue.Type()
+	bound := false
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		fieldValue := structValue.Field(i)
+		formTag := formFieldName(field)
+		if formTag == "-" {
+			continue
+		}
+
+		// Struct nhúng không có tag: các trường được bind như trường của struct cha
+		if field.Anonymous && formTag == "" {
+			ok, err := bindNested(values, fieldValue, prefix)
+			if err != nil {
+				return bound, err
+			}
+			bound = bound || ok
+			continue
+		}
+		if formTag == "" || !fieldValue.CanSet() {
+			continue
+		}
+		name := prefix + formTag
+
+		if isNestedStruct(field.Type) {
+			ok, err := bindNested(values, fieldValue, name+".")
+			if err != nil {
+				return bound, err
+			}
+			bound = bound || ok
+			continue
+		}
+
+		fieldValues, present := values[name]
+		if !present {
+			// Tham số không có trong request nhận giá trị từ tag "default" nếu có
+			if defaultValue := field.Tag.Get("default"); defaultValue != "" {
+				fieldValues = []string{defaultValue}
+				if fieldValue.Kind() == reflect.Slice {
+					fieldValues = strings.Split(defaultValue, ",")
+				}
+			}
+		}
+		if fieldValue.Kind() == reflect.Slice && !isTextUnmarshaler(fieldValue) {
+			// Bỏ qua giá trị rỗng như với trường đơn, ví dụ "ids=&ids=2"
+			fieldValues = slices.DeleteFunc(slices.Clone(fieldValues), func(value string) bool { return value == "" })
+			if len(fieldValues) == 0 {
+				continue
+			}
+			slice := reflect.MakeSlice(fieldValue.Type(), len(fieldValues), len(fieldValues))
+			for j, value := range fieldValues {
+				if err := setBindValue(slice.Index(j), field, value); err != nil {
+					return bound, fmt.Errorf("binding error for field %s: %w", field.Name, err)
+				}
+			}
+			fieldValue.Set(slice)
+			bound = true
+			continue
+		}
+
+		if len(fieldValues) == 0 || fieldValues[0] == "" {
+			continue
+		}
+		if err := setBindValue(fieldValue, field, fieldValues[0]); err != nil {
+			return bound, fmt.Errorf("binding error for field %s: %w", field.Name, err)
+		}
+		bound = true
+	}
+
+	return bound, nil
+}
+
+// bindNested liên kết các giá trị vào trường struct hoặc con trỏ struct. Con trỏ nil chỉ được
+// khởi tạo khi có ít nhất một trường của struct được gán giá trị.
+//
+// Parameters:
+//   - values: Các giá trị cần được liên kết
+//   - fieldValue: Trường kiểu struct hoặc con trỏ struct
+//   - prefix: Tiền tố tên tham số của các trường trong struct
+//
+// Returns:
+//   - bool: true nếu có ít nhất một trường được gán giá trị
+//   - error: Lỗi nếu không thể liên kết giá trị
+func bindNested(values url.Values, fieldValue reflect.Value, prefix string) (bool, error) {
+	switch {
+	case fieldValue.Kind() == reflect.Struct:
+		return bindStruct(values, fieldValue, prefix)
+	case fieldValue.Kind() == reflect.Ptr && fieldValue.Type().Elem().Kind() == reflect.Struct:
+		if !fieldValue.IsNil() {
+			return bindStruct(values, fieldValue.Elem(), prefix)
+		}
+		if !fieldValue.CanSet() {
+			return false, nil
+		}
+		nested := reflect.New(fieldValue.Type().Elem())
+		bound, err := bindStruct(values, nested.Elem(), prefix)
+		if bound {
+			fieldValue.Set(nested)
+		}
+		return bound, err
+	}
+	return false, nil
+}
+
+// isNestedStruct kiểm tra kiểu trường là struct (hoặc con trỏ struct) có các trường được bind
+// riêng, không phải kiểu được bind từ một chuỗi như time.Time hoặc file upload.
+func isNestedStruct(t reflect.Type) bool {
+	if t == fileHeaderType {
+		return false
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && !reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// formFieldName trả về tên tham số của trường từ tag "form", hoặc tag "json" nếu không có tag "form".
+func formFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("form")
+	if tag == "" {
+		tag = field.Tag.Get("json") // Fallback to json tag
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+// setBindValue đặt giá trị cho trường hoặc phần tử slice của trường, áp dụng tag thời gian
+// của trường cho giá trị kiểu time.Time.
+func setBindValue(fieldValue reflect.Value, field reflect.StructField, value string) error {
+	if fieldValue.Type() == timeType && (field.Tag.Get("time_format") != "" || field.Tag.Get("time_utc") != "") {
+		return setTimeField(fieldValue, field, value)
+	}
+	return setFieldValue(fieldValue, value)
+}
+
+// fileHeaderType và fileHeadersType là kiểu của các trường nhận file upload trong BindForm.
+var (
+	fileHeaderType  = reflect.TypeOf((*multipart.FileHeader)(nil))
+	fileHeadersType = reflect.TypeOf([]*multipart.FileHeader(nil))
+)
+
+// bindFiles liên kết các file upload của multipart form vào một struct.
+// Trường kiểu *multipart.FileHeader nhận file đầu tiên, trường kiểu []*multipart.FileHeader
+// nhận mọi file có cùng tên field; tên field lấy từ tag "form" hoặc "json" như bind.
+//
+// Parameters:
+//   - files: Các file upload theo tên field
+//   - obj: Con trỏ đến struct sẽ nhận các file
+//
+// Returns:
+//   - error: Lỗi nếu obj không phải con trỏ struct
+func bindFiles(files map[string][]*multipart.FileHeader, obj interface{}) error {
+	objValue := reflect.ValueOf(obj)
+	if objValue.Kind() != reflect.Ptr || objValue.IsNil() {
+		return errors.New("obj must be a non-nil pointer")
+	}
+
+	objValue = objValue.Elem()
+	objType := objValue.Type()
 	if objType.Kind() != reflect.Struct {
 		return errors.New("obj must be a struct")
 	}
 
 	for i := 0; i < objType.NumField(); i++ {
 		field := objType.Field(i)
-		formTag := field.Tag.Get("form")
-		if formTag == "" {
-			formTag = field.Tag.Get("json") // Fallback to json tag
-		}
+		formTag := formFieldName(field)
 		if formTag == "" || formTag == "-" {
 			continue
 		}
 
-		formValue := values.Get(formTag)
-		if formValue == "" {
+		headers := files[formTag]
+		fieldValue := objValue.Field(i)
+		if len(headers) == 0 || !fieldValue.CanSet() {
+			continue
+		}
+
+		switch field.Type {
+		case fileHeaderType:
+			fieldValue.Set(reflect.ValueOf(headers[0]))
+		case fileHeadersType:
+			fieldValue.Set(reflect.ValueOf(headers))
+		}
+	}
+
+	return nil
+}
+
+// mediaType trả về media type của header Content-Type ở dạng chữ thường,
+// bỏ qua các tham số như charset hoặc boundary.
+//
+// Parameters:
+//   - contentType: Giá trị header Content-Type
+//
+// Returns:
+//   - string: Media type, ví dụ "multipart/form-data"
+func mediaType(contentType string) string {
+	value, _, _ := strings.Cut(contentType, ";")
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// bindTagged liên kết các giá trị có tên vào một struct.
+// Sử dụng reflection để map giá trị vào các trường struct dựa trên tag chỉ định, ví dụ
+// "header" hoặc "uri". Trường kiểu slice nhận mọi giá trị, trường khác nhận giá trị đầu tiên.
+//
+// Parameters:
+//   - obj: Con trỏ đến struct sẽ nhận các giá trị
+//   - tag: Tên struct tag chứa tên giá trị
+//   - lookup: Hàm trả về các giá trị theo tên, rỗng nếu không có
+//
+// Returns:
+//   - error: Lỗi nếu không thể liên kết giá trị
+//
+// Errors:
+//   - "obj must be a non-nil pointer": Khi đối tượng không phải là con trỏ hoặc là nil
+//   - "obj must be a struct": Khi đối tượng không phải là struct
+func bindTagged(obj interface{}, tag string, lookup func(name string) []string) error {
+	objValue := reflect.ValueOf(obj)
+	if objValue.Kind() != reflect.Ptr || objValue.IsNil() {
+		return errors.New("obj must be a non-nil pointer")
+	}
+
+	objValue = objValue.Elem()
+	objType := objValue.Type()
+	if objType.Kind() != reflect.Struct {
+		return errors.New("obj must be a struct")
+	}
+
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		values := lookup(name)
+		if len(values) == 0 {
 			continue
 		}
 
@@ -58,8 +308,18 @@ func bind(values url.Values, obj interface{}) error {
 			continue
 		}
 
-		err := setFieldValue(fieldValue, formValue)
-		if err != nil {
+		if fieldValue.Kind() == reflect.Slice && !isTextUnmarshaler(fieldValue) {
+			slice := reflect.MakeSlice(fieldValue.Type(), len(values), len(values))
+			for j, value := range values {
+				if err := setFieldValue(slice.Index(j), value); err != nil {
+					return fmt.Errorf("binding error for field %s: %w", field.Name, err)
+				}
+			}
+			fieldValue.Set(slice)
+			continue
+		}
+
+		if err := setFieldValue(fieldValue, values[0]); err != nil {
 			return fmt.Errorf("binding error for field %s: %w", field.Name, err)
 		}
 	}
@@ -67,6 +327,89 @@ func bind(values url.Values, obj interface{}) error {
 	return nil
 }
 
+// bindHeader liên kết các header của request vào một struct dựa trên tag "header";
+// tên header không phân biệt hoa thường.
+//
+// Parameters:
+//   - header: Các header của request
+//   - obj: Con trỏ đến struct sẽ nhận các giá trị
+//
+// Returns:
+//   - error: Lỗi nếu không thể liên kết giá trị
+func bindHeader(header http.Header, obj interface{}) error {
+	return bindTagged(obj, "header", header.Values)
+}
+
+// bindURI liên kết các tham số route vào một struct dựa trên tag "uri".
+//
+// Parameters:
+//   - params: Các tham số route theo tên
+//   - obj: Con trỏ đến struct sẽ nhận các giá trị
+//
+// Returns:
+//   - error: Lỗi nếu không thể liên kết giá trị
+func bindURI(params map[string]string, obj interface{}) error {
+	return bindTagged(obj, "uri", func(name string) []string {
+		value, ok := params[name]
+		if !ok {
+			return nil
+		}
+		return []string{value}
+	})
+}
+
+// isTextUnmarshaler kiểm tra trường có tự chuyển đổi từ chuỗi qua encoding.TextUnmarshaler hay không.
+func isTextUnmarshaler(fieldValue reflect.Value) bool {
+	if !fieldValue.CanAddr() {
+		return false
+	}
+	_, ok := fieldValue.Addr().Interface().(encoding.TextUnmarshaler)
+	return ok
+}
+
+// timeType là kiểu time.Time, nhận tag "time_format" và "time_utc" trong bind.
+var timeType = reflect.TypeOf(time.Time{})
+
+// setTimeField đặt giá trị time.Time cho trường theo tag "time_format" (layout của time.Parse,
+// mặc định RFC3339) và "time_utc". Layout không chứa múi giờ được hiểu theo giờ địa phương,
+// hoặc UTC khi time_utc là true; khi đó kết quả cũng được chuyển về UTC.
+//
+// Parameters:
+//   - fieldValue: Giá trị trường kiểu time.Time cần đặt
+//   - field: Thông tin trường chứa các tag
+//   - value: Chuỗi thời gian cần parse
+//
+// Returns:
+//   - error: Lỗi nếu tag time_utc hoặc chuỗi thời gian không hợp lệ
+func setTimeField(fieldValue reflect.Value, field reflect.StructField, value string) error {
+	layout := field.Tag.Get("time_format")
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	utc := false
+	if tag := field.Tag.Get("time_utc"); tag != "" {
+		var err error
+		if utc, err = strconv.ParseBool(tag); err != nil {
+			return fmt.Errorf("invalid time_utc tag %q: %w", tag, err)
+		}
+	}
+
+	location := time.Local
+	if utc {
+		location = time.UTC
+	}
+	t, err := time.ParseInLocation(layout, value, location)
+	if err != nil {
+		return err
+	}
+	if utc {
+		t = t.UTC()
+	}
+	fieldValue.Set(reflect.ValueOf(t))
+	return nil
+}
+
 // setFieldValue đặt giá trị cho trường dựa trên đầu vào chuỗi.
 // Hàm này chuyển đổi giá trị chuỗi thành kiểu dữ liệu tương ứng của trường
 // và gán giá trị đã chuyển đổi vào trường đó sử dụng reflection.
@@ -80,8 +423,14 @@ func bind(values url.Values, obj interface{}) error {
 //
 // Errors:
 //   - strconv: Lỗi chuyển đổi chuỗi sang kiểu số
+//   - encoding.TextUnmarshaler: Lỗi từ UnmarshalText của trường
 //   - "unsupported field type": Kiểu dữ liệu không được hỗ trợ
 func setFieldValue(fieldValue reflect.Value, value string) error {
+	// Kiểu tự chuyển đổi từ chuỗi (ví dụ UUID, net.IP) được ưu tiên
+	if isTextUnmarshaler(fieldValue) {
+		return fieldValue.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	}
+
 	// Xử lý tùy theo kiểu dữ liệu của trường
 	switch fieldValue.Kind() {
 	case reflect.String:
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
 // Context đại diện cho một HTTP request/response context.
@@ -16,6 +18,12 @@ import (
 // một HTTP request và response, cung cấp các phương thức để truy cập và thao tác
 // với dữ liệu, xử lý middleware, quản lý session và thực hiện các chức năng khác.
 type Context interface {
+	// Context implement context.Context bằng cách ủy quyền Deadline, Done và Err cho Context(),
+	// nên có thể truyền trực tiếp cho database/sql, gRPC client và các API nhận context.Context.
+	// Value trả về giá trị của context store với khóa kiểu StoreKey, các khóa khác được
+	// ủy quyền cho Context().
+	context.Context
+
 	// Request trả về đối tượng Request.
 	//
 	// Returns:
@@ -43,6 +51,49 @@ type Context interface {
 	//   - Context: Context sau khi được cập nhật context.Context
 	WithContext(ctx context.Context) Context
 
+	// WithTimeout gắn deadline vào Context() (qua WithContext) để các thao tác nhận
+	// context.Context như truy vấn database bị hủy khi hết hạn. Khi deadline hết hạn,
+	// IsAborted trả về true và Next không thực thi các handlers còn lại; response không
+	// được ghi tự động. Gọi cancel trước khi hết hạn không abort context.
+	//
+	// Parameters:
+	//   - timeout: Thời gian tối đa tính từ lúc gọi
+	//
+	// Returns:
+	//   - context.CancelFunc: Hàm giải phóng timer, nên được defer ngay sau khi gọi
+	WithTimeout(timeout time.Duration) context.CancelFunc
+
+	// AddError ghi nhận một lỗi của request thay vì ghi response ngay, để middleware xử lý lỗi
+	// hoặc logging ở cuối chuỗi (sau Next) xử lý tập trung qua Errors. Lỗi nil bị bỏ qua.
+	//
+	// Parameters:
+	//   - err: Lỗi cần ghi nhận
+	AddError(err error)
+
+	// Errors trả về các lỗi đã ghi nhận bởi AddError theo thứ tự thêm vào.
+	//
+	// Returns:
+	//   - []error: Bản sao danh sách lỗi, nil nếu không có lỗi
+	Errors() []error
+
+	// Copy trả về bản sao chỉ đọc của context, an toàn để dùng trong goroutine nền sau khi
+	// handler trả về (ví dụ job bất đồng bộ). Bản sao giữ params, store (sao chép nông), lỗi đã ghi nhận,
+	// metadata của route và request không có body. context.Context của bản sao giữ các giá trị
+	// nhưng không bị hủy khi request kết thúc; ghi response từ bản sao trả về ErrDetachedResponse.
+	//
+	// Returns:
+	//   - Context: Bản sao tách khỏi request hiện tại
+	Copy() Context
+
+	// BridgeStore chia sẻ các giá trị của context store qua Context().Value với khóa StoreKey,
+	// cho phép code chỉ nhận context.Context (DB driver, HTTP client) đọc request ID,
+	// tenant hoặc danh tính người dùng. Giá trị được đọc tại thời điểm truy cập nên các lần
+	// Set sau đó vẫn được nhìn thấy; bridge được giữ lại khi gọi WithContext.
+	//
+	// Parameters:
+	//   - keys: Các khóa được chia sẻ, rỗng để chia sẻ toàn bộ store
+	BridgeStore(keys ...string)
+
 	// Next gọi middleware tiếp theo trong chuỗi.
 	// Phương thức này thực thi middleware tiếp theo trong pipeline.
 	Next()
@@ -51,12 +102,29 @@ type Context interface {
 	// Khi được gọi, các middleware còn lại trong chuỗi sẽ không được thực thi.
 	Abort()
 
-	// IsAborted kiểm tra xem context có bị abort không.
+	// IsAborted kiểm tra xem context có bị abort không. Context cũng được coi là đã abort
+	// khi deadline đặt bởi WithTimeout hết hạn.
 	//
 	// Returns:
 	//   - bool: true nếu context đã bị abort, ngược lại là false
 	IsAborted() bool
 
+	// Done trả về channel được đóng khi client ngắt kết nối hoặc context.Context hiện tại
+	// (Context()) bị hủy hay hết deadline. Handler dùng channel này trong select để dừng công việc dài.
+	//
+	// Returns:
+	//   - <-chan struct{}: Channel Done của Context(), nil nếu context không hỗ trợ hủy
+	Done() <-chan struct{}
+
+	// IsClientGone kiểm tra client đã ngắt kết nối hay chưa, dựa trên request context
+	// hoặc thông báo đóng kết nối của adapter. Với route streaming/SSE (Accept hoặc
+	// Content-Type là text/event-stream, hoặc response ghi bởi Stream), chuỗi handlers
+	// tự động bị abort khi client ngắt kết nối.
+	//
+	// Returns:
+	//   - bool: true nếu client đã ngắt kết nối
+	IsClientGone() bool
+
 	// Set thiết lập giá trị cho một khóa trong context.
 	//
 	// Parameters:
@@ -74,6 +142,31 @@ type Context interface {
 	//   - bool: true nếu khóa tồn tại, ngược lại là false
 	Get(key string) (interface{}, bool)
 
+	// MustGet lấy giá trị cho một khóa từ context, panic nếu khóa không tồn tại.
+	// Dùng cho giá trị mà middleware phía trước bắt buộc phải thiết lập.
+	//
+	// Parameters:
+	//   - key: Khóa cần truy xuất giá trị
+	//
+	// Returns:
+	//   - interface{}: Giá trị được lưu trữ
+	//
+	// Panics:
+	//   - Nếu khóa không tồn tại, với thông báo chứa tên khóa
+	MustGet(key string) interface{}
+
+	// GetOrSet lấy giá trị cho một khóa, tính và lưu giá trị bằng fn nếu khóa chưa tồn tại.
+	// Dùng cho giá trị tính lười trong phạm vi request, ví dụ auth token đã parse.
+	// fn được gọi ngoài lock nên có thể dùng Get/Set của context.
+	//
+	// Parameters:
+	//   - key: Khóa cần truy xuất giá trị
+	//   - fn: Hàm tính giá trị khi khóa chưa tồn tại
+	//
+	// Returns:
+	//   - interface{}: Giá trị đã lưu hoặc vừa tính
+	GetOrSet(key string, fn func() interface{}) interface{}
+
 	// GetString lấy giá trị string cho một khóa từ context.
 	//
 	// Parameters:
@@ -211,6 +304,133 @@ type Context interface {
 	//   - []string: Mảng các giá trị của tham số route
 	ParamArray(name string) []string
 
+	// ParamInt trả về tham số route dạng int.
+	// Bổ sung cho regex constraint của route (ví dụ ":id<\d+>") khi handler cần giá trị đã chuyển đổi.
+	//
+	// Parameters:
+	//   - name: Tên của tham số route
+	//
+	// Returns:
+	//   - int: Giá trị đã chuyển đổi, 0 nếu có lỗi
+	//   - error: Lỗi nếu tham số không có hoặc không hợp lệ
+	//
+	// Errors:
+	//   - ErrParamMissing: Tham số không có hoặc rỗng
+	//   - conversion: Lỗi chuyển đổi từ strconv.Atoi
+	ParamInt(name string) (int, error)
+
+	// MustParamInt trả về tham số route dạng int, trả lời 404 nếu không hợp lệ.
+	// Khi tham số không có hoặc không chuyển đổi được, ghi response 404 Not Found,
+	// gọi Abort và trả về false; handler chỉ cần return.
+	//
+	// Parameters:
+	//   - name: Tên của tham số route
+	//
+	// Returns:
+	//   - int: Giá trị đã chuyển đổi
+	//   - bool: false nếu response 404 đã được ghi
+	MustParamInt(name string) (int, bool)
+
+	// ParamInt64 trả về tham số route dạng int64.
+	// Bổ sung cho regex constraint của route (ví dụ ":id<\d+>") khi handler cần giá trị đã chuyển đổi.
+	//
+	// Parameters:
+	//   - name: Tên của tham số route
+	//
+	// Returns:
+	//   - int64: Giá trị đã chuyển đổi, 0 nếu có lỗi
+	//   - error: Lỗi nếu tham số không có hoặc không hợp lệ
+	//
+	// Errors:
+	//   - ErrParamMissing: Tham số không có hoặc rỗng
+	//   - conversion: Lỗi chuyển đổi từ strconv.ParseInt
+	ParamInt64(name string) (int64, error)
+
+	// MustParamInt64 trả về tham số route dạng int64, trả lời 404 nếu không hợp lệ.
+	// Khi tham số không có hoặc không chuyển đổi được, ghi response 404 Not Found,
+	// gọi Abort và trả về false; handler chỉ cần return.
+	//
+	// Parameters:
+	//   - name: Tên của tham số route
+	//
+	// Returns:
+	//   - int64: Giá trị đã chuyển đổi
+	//   - bool: false nếu response 404 đã được ghi
+	MustParamInt64(name string) (int64, bool)
+
+	// ParamUUID trả về tham số route dạng uuid.UUID.
+	// Bổ sung cho regex constraint của route (ví dụ ":id<\d+>") khi handler cần giá trị đã chuyển đổi.
+	//
+	// Parameters:
+	//   - name: Tên của tham số route
+	//
+	// Returns:
+	//   - uuid.UUID: Giá trị đã chuyển đổi, uuid.Nil nếu có lỗi
+	//   - error: Lỗi nếu tham số không có hoặc không hợp lệ
+	//
+	// Errors:
+	//   - ErrParamMissing: Tham số không có hoặc rỗng
+	//   - conversion: Lỗi chuyển đổi từ uuid.Parse
+	ParamUUID(name string) (uuid.UUID, error)
+
+	// MustParamUUID trả về tham số route dạng uuid.UUID, trả lời 404 nếu không hợp lệ.
+	// Khi tham số không có hoặc không chuyển đổi được, ghi response 404 Not Found,
+	// gọi Abort và trả về false; handler chỉ cần return.
+	//
+	// Parameters:
+	//   - name: Tên của tham số route
+	//
+	// Returns:
+	//   - uuid.UUID: Giá trị đã chuyển đổi
+	//   - bool: false nếu response 404 đã được ghi
+	MustParamUUID(name string) (uuid.UUID, bool)
+
+	// SetParams thiết lập các tham số route của request. Router gọi phương thức này
+	// sau khi tìm được route; map được giữ nguyên, không sao chép.
+	//
+	// Parameters:
+	//   - params: Map tham số route với key là tên tham số và value là giá trị
+	SetParams(params map[string]string)
+
+	// Params trả về map tham số route do router thiết lập.
+	// Map trả về không được sao chép và không nên bị sửa đổi; dùng ParamMap để có bản sao.
+	//
+	// Returns:
+	//   - map[string]string: Map tham số route, nil nếu request chưa khớp route nào
+	Params() map[string]string
+
+	// SetRouteMeta thiết lập metadata của route đã khớp. Router gọi phương thức này
+	// trước khi chạy chuỗi handlers của route.
+	//
+	// Parameters:
+	//   - meta: Metadata của route, được giữ nguyên không sao chép
+	SetRouteMeta(meta map[string]interface{})
+
+	// RouteMeta trả về metadata của route đã khớp theo key,
+	// ví dụ để policy middleware đọc quyền truy cập được khai báo trên route.
+	//
+	// Parameters:
+	//   - key: Tên metadata
+	//
+	// Returns:
+	//   - interface{}: Giá trị metadata
+	//   - bool: true nếu route có metadata với key này
+	RouteMeta(key string) (interface{}, bool)
+
+	// SetFullPath thiết lập pattern của route đã khớp. Router gọi phương thức này
+	// trước khi chạy chuỗi handlers của route.
+	//
+	// Parameters:
+	//   - path: Pattern của route (ví dụ: "/users/:id")
+	SetFullPath(path string)
+
+	// FullPath trả về pattern của route đã khớp thay vì path thực tế của request,
+	// ví dụ "/users/:id" cho request "/users/42", phù hợp làm nhãn cho metrics và logging.
+	//
+	// Returns:
+	//   - string: Pattern của route, rỗng nếu request chưa khớp route nào (404, 405...)
+	FullPath() string
+
 	// Query trả về giá trị tham số query.
 	// Tham số query là các tham số được truyền trong URL sau dấu "?".
 	//
@@ -252,6 +472,137 @@ type Context interface {
 	//   - map[string]string: Map các tham số query với key là phần còn lại sau prefix và value là giá trị
 	QueryMap(prefix string) map[string]string
 
+	// QueryInt trả về tham số query dạng int.
+	// Trả về defaultValue nếu tham số không có, rỗng hoặc không parse được; dùng ShouldQueryInt
+	// khi cần phân biệt các trường hợp này.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//   - defaultValue: Giá trị mặc định
+	//
+	// Returns:
+	//   - int: Giá trị đã parse hoặc defaultValue
+	QueryInt(name string, defaultValue int) int
+
+	// ShouldQueryInt trả về tham số query dạng int kèm lỗi.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//
+	// Returns:
+	//   - int: Giá trị đã parse, 0 nếu có lỗi
+	//   - error: Lỗi nếu tham số không có hoặc không hợp lệ
+	//
+	// Errors:
+	//   - ErrQueryMissing: Tham số không có hoặc rỗng
+	//   - strconv: Lỗi parse từ strconv.Atoi
+	ShouldQueryInt(name string) (int, error)
+
+	// QueryInt64 trả về tham số query dạng int64.
+	// Trả về defaultValue nếu tham số không có, rỗng hoặc không parse được; dùng ShouldQueryInt64
+	// khi cần phân biệt các trường hợp này.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//   - defaultValue: Giá trị mặc định
+	//
+	// Returns:
+	//   - int64: Giá trị đã parse hoặc defaultValue
+	QueryInt64(name string, defaultValue int64) int64
+
+	// ShouldQueryInt64 trả về tham số query dạng int64 kèm lỗi.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//
+	// Returns:
+	//   - int64: Giá trị đã parse, 0 nếu có lỗi
+	//   - error: Lỗi nếu tham số không có hoặc không hợp lệ
+	//
+	// Errors:
+	//   - ErrQueryMissing: Tham số không có hoặc rỗng
+	//   - strconv: Lỗi parse từ strconv.ParseInt
+	ShouldQueryInt64(name string) (int64, error)
+
+	// QueryBool trả về tham số query dạng bool.
+	// Trả về defaultValue nếu tham số không có, rỗng hoặc không parse được; dùng ShouldQueryBool
+	// khi cần phân biệt các trường hợp này.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//   - defaultValue: Giá trị mặc định
+	//
+	// Returns:
+	//   - bool: Giá trị đã parse hoặc defaultValue
+	QueryBool(name string, defaultValue bool) bool
+
+	// ShouldQueryBool trả về tham số query dạng bool kèm lỗi.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//
+	// Returns:
+	//   - bool: Giá trị đã parse, false nếu có lỗi
+	//   - error: Lỗi nếu tham số không có hoặc không hợp lệ
+	//
+	// Errors:
+	//   - ErrQueryMissing: Tham số không có hoặc rỗng
+	//   - strconv: Lỗi parse từ strconv.ParseBool
+	ShouldQueryBool(name string) (bool, error)
+
+	// QueryFloat64 trả về tham số query dạng float64.
+	// Trả về defaultValue nếu tham số không có, rỗng hoặc không parse được; dùng ShouldQueryFloat64
+	// khi cần phân biệt các trường hợp này.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//   - defaultValue: Giá trị mặc định
+	//
+	// Returns:
+	//   - float64: Giá trị đã parse hoặc defaultValue
+	QueryFloat64(name string, defaultValue float64) float64
+
+	// ShouldQueryFloat64 trả về tham số query dạng float64 kèm lỗi.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//
+	// Returns:
+	//   - float64: Giá trị đã parse, 0 nếu có lỗi
+	//   - error: Lỗi nếu tham số không có hoặc không hợp lệ
+	//
+	// Errors:
+	//   - ErrQueryMissing: Tham số không có hoặc rỗng
+	//   - strconv: Lỗi parse từ strconv.ParseFloat
+	ShouldQueryFloat64(name string) (float64, error)
+
+	// QueryTime trả về tham số query dạng time.Time theo layout.
+	// Trả về defaultValue nếu tham số không có, rỗng hoặc không parse được.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//   - layout: Layout của time.Parse, rỗng để dùng time.RFC3339
+	//   - defaultValue: Giá trị mặc định
+	//
+	// Returns:
+	//   - time.Time: Giá trị đã parse hoặc defaultValue
+	QueryTime(name, layout string, defaultValue time.Time) time.Time
+
+	// ShouldQueryTime trả về tham số query dạng time.Time theo layout kèm lỗi.
+	//
+	// Parameters:
+	//   - name: Tên của tham số query cần truy xuất
+	//   - layout: Layout của time.Parse, rỗng để dùng time.RFC3339
+	//
+	// Returns:
+	//   - time.Time: Giá trị đã parse, zero time nếu có lỗi
+	//   - error: Lỗi nếu tham số không có hoặc không hợp lệ
+	//
+	// Errors:
+	//   - ErrQueryMissing: Tham số không có hoặc rỗng
+	//   - time: Lỗi parse từ time.Parse
+	ShouldQueryTime(name, layout string) (time.Time, error)
+
 	// Form trả về giá trị form.
 	// Truy xuất giá trị từ form data, hỗ trợ cả application/x-www-form-urlencoded và multipart/form-data.
 	//
@@ -319,6 +670,19 @@ type Context interface {
 	//   - http: "Bad Request" nếu không tìm thấy file
 	FormFile(name string) (*multipart.FileHeader, error)
 
+	// MultipartReader trả về multipart.Reader để stream các part của request multipart.
+	// Khác với MultipartForm, các part không được buffer vào bộ nhớ hoặc file tạm,
+	// phù hợp cho upload lớn chuyển thẳng tới object storage.
+	//
+	// Returns:
+	//   - *multipart.Reader: Reader để duyệt các part qua NextPart
+	//   - error: Lỗi nếu request không phải multipart hoặc body đã được parse
+	//
+	// Errors:
+	//   - http.ErrNotMultipart nếu Content-Type không phải multipart/form-data hoặc multipart/mixed
+	//   - http: "multipart handled by ParseMultipartForm" nếu MultipartForm đã được gọi
+	MultipartReader() (*multipart.Reader, error)
+
 	// SaveUploadedFile lưu file tải lên vào đường dẫn.
 	// Lưu file đã được tải lên từ multipart form vào hệ thống tệp.
 	//
@@ -361,8 +725,84 @@ type Context interface {
 	//   - xml: Lỗi khi unmarshal dữ liệu XML
 	BindXML(obj interface{}) error
 
+	// BindYAML bind request body vào struct sử dụng YAML.
+	// Đọc dữ liệu từ request body và chuyển đổi thành struct thông qua YAML unmarshaling,
+	// sử dụng tag "yaml" trên struct fields.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu từ YAML
+	//
+	// Returns:
+	//   - error: Lỗi khi parse body hoặc unmarshal YAML
+	//
+	// Errors:
+	//   - io: Lỗi khi đọc request body
+	//   - yaml: Lỗi khi unmarshal dữ liệu YAML
+	BindYAML(obj interface{}) error
+
+	// BindTOML bind request body vào struct sử dụng TOML.
+	// Đọc dữ liệu từ request body và chuyển đổi thành struct thông qua TOML unmarshaling,
+	// sử dụng tag "toml" trên struct fields.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu từ TOML
+	//
+	// Returns:
+	//   - error: Lỗi khi parse body hoặc unmarshal TOML
+	//
+	// Errors:
+	//   - io: Lỗi khi đọc request body
+	//   - toml: Lỗi khi unmarshal dữ liệu TOML
+	BindTOML(obj interface{}) error
+
+	// BindMsgpack bind request body vào struct sử dụng MessagePack.
+	// Đọc dữ liệu từ request body và chuyển đổi thành struct thông qua MessagePack unmarshaling,
+	// sử dụng tag "msgpack" trên struct fields.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu từ MessagePack
+	//
+	// Returns:
+	//   - error: Lỗi khi parse body hoặc unmarshal MessagePack
+	//
+	// Errors:
+	//   - io: Lỗi khi đọc request body
+	//   - msgpack: Lỗi khi unmarshal dữ liệu MessagePack
+	BindMsgpack(obj interface{}) error
+
+	// BindCBOR bind request body vào struct sử dụng CBOR (RFC 8949).
+	// Đọc dữ liệu từ request body và chuyển đổi thành struct thông qua CBOR unmarshaling,
+	// sử dụng tag "cbor" hoặc "json" trên struct fields.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu từ CBOR
+	//
+	// Returns:
+	//   - error: Lỗi khi parse body hoặc unmarshal CBOR
+	//
+	// Errors:
+	//   - io: Lỗi khi đọc request body
+	//   - cbor: Lỗi khi unmarshal dữ liệu CBOR
+	BindCBOR(obj interface{}) error
+
+	// BindProtobuf bind request body vào protobuf message.
+	// Đọc tối đa MaxProtobufSize bytes từ request body và unmarshal thành protobuf message.
+	//
+	// Parameters:
+	//   - obj: Protobuf message nhận dữ liệu
+	//
+	// Returns:
+	//   - error: Lỗi khi đọc body hoặc unmarshal protobuf
+	//
+	// Errors:
+	//   - ErrBodyTooLarge: Request body vượt quá MaxProtobufSize
+	//   - proto: Lỗi khi unmarshal dữ liệu protobuf
+	BindProtobuf(obj proto.Message) error
+
 	// BindQuery bind query parameters vào struct.
 	// Map các query parameters từ URL vào struct sử dụng tag "form" hoặc "json" trên struct fields.
+	// Trường có tag "default" (ví dụ `default:"10"`) nhận giá trị mặc định khi tham số không được gửi.
+	// Trường time.Time được parse theo tag "time_format" (ví dụ `time_format:"2006-01-02"`) và "time_utc".
 	//
 	// Parameters:
 	//   - obj: Con trỏ đến struct nhận dữ liệu từ query parameters
@@ -376,6 +816,10 @@ type Context interface {
 
 	// BindForm bind form values vào struct.
 	// Map các giá trị form từ request vào struct sử dụng tag "form" hoặc "json" trên struct fields.
+	// Trường có tag "default" (ví dụ `default:"10"`) nhận giá trị mặc định khi tham số không được gửi.
+	// Trường time.Time được parse theo tag "time_format" (ví dụ `time_format:"2006-01-02"`) và "time_utc".
+	// Với multipart/form-data, trường kiểu *multipart.FileHeader hoặc []*multipart.FileHeader
+	// nhận file upload có cùng tên field.
 	//
 	// Parameters:
 	//   - obj: Con trỏ đến struct nhận dữ liệu từ form
@@ -388,9 +832,40 @@ type Context interface {
 	//   - binding: Lỗi khi chuyển đổi kiểu dữ liệu
 	BindForm(obj interface{}) error
 
+	// BindHeader bind request headers vào struct.
+	// Map các header của request vào struct sử dụng tag "header" trên struct fields,
+	// ví dụ `header:"X-Api-Key"`; tên header không phân biệt hoa thường và trường kiểu slice
+	// nhận mọi giá trị của header. Kết hợp với ValidateStruct để validate như các input khác.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu từ headers
+	//
+	// Returns:
+	//   - error: Lỗi khi bind headers vào struct
+	//
+	// Errors:
+	//   - binding: Lỗi khi chuyển đổi kiểu dữ liệu
+	BindHeader(obj interface{}) error
+
+	// BindURI bind route parameters vào struct.
+	// Map các tham số route vào struct sử dụng tag "uri" trên struct fields, ví dụ `uri:"id"`,
+	// chuyển đổi sang kiểu của trường (số, bool, hoặc kiểu implement encoding.TextUnmarshaler như UUID).
+	// ShouldBindAndValidate và BindAndValidate cũng tự động bind các trường này.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu từ route parameters
+	//
+	// Returns:
+	//   - error: Lỗi khi bind route parameters vào struct
+	//
+	// Errors:
+	//   - binding: Lỗi khi chuyển đổi kiểu dữ liệu
+	BindURI(obj interface{}) error
+
 	// Bind bind request body vào struct dựa vào Content-Type.
-	// Tự động chọn phương thức binding dựa vào Content-Type của request.
-	// Hỗ trợ các định dạng: JSON, XML, form data.
+	// Tự động chọn phương thức binding dựa vào media type của Content-Type (bỏ qua tham số như charset, boundary).
+	// Hỗ trợ các định dạng: JSON, XML, YAML, TOML, MessagePack, CBOR, Protobuf, form data.
+	// Content-Type khác được hỗ trợ qua RegisterBinder; binder đã đăng ký được ưu tiên.
 	//
 	// Parameters:
 	//   - obj: Con trỏ đến struct nhận dữ liệu
@@ -400,9 +875,25 @@ type Context interface {
 	//
 	// Errors:
 	//   - ErrUnsupportedBinding: Content-Type không được hỗ trợ
+	//   - ErrBodyTooLarge: Body vượt quá DefaultBindLimit
 	//   - binding: Lỗi từ phương thức binding tương ứng
 	Bind(obj interface{}) error
 
+	// BindWithLimit bind request body như Bind nhưng từ chối body lớn hơn maxBytes trước khi
+	// unmarshal. Body được đọc qua http.MaxBytesReader nên body lớn không bị đọc hết vào bộ nhớ.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu
+	//   - maxBytes: Số bytes tối đa của body, <= 0 để không giới hạn
+	//
+	// Returns:
+	//   - error: Lỗi khi bind dữ liệu vào struct
+	//
+	// Errors:
+	//   - ErrBodyTooLarge: Content-Length hoặc body thực tế vượt quá maxBytes
+	//   - ErrUnsupportedBinding: Content-Type không được hỗ trợ
+	BindWithLimit(obj interface{}, maxBytes int64) error
+
 	// ShouldBind bind request body vào struct và trả về lỗi.
 	// Hoạt động tương tự như Bind nhưng được thiết kế để sử dụng trong handler mà không tự động trả về lỗi HTTP.
 	//
@@ -417,6 +908,35 @@ type Context interface {
 	//   - binding: Lỗi từ phương thức binding tương ứng
 	ShouldBind(obj interface{}) error
 
+	// ShouldBindJSONStrict bind request body vào struct sử dụng JSON và từ chối payload có trường lạ.
+	// Hoạt động như BindJSON nhưng kiểm tra mọi trường của payload, kể cả trong object lồng nhau
+	// và phần tử của mảng; obj không bị thay đổi khi payload có trường không xác định.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu từ JSON
+	//
+	// Returns:
+	//   - error: Lỗi khi parse body, unmarshal JSON hoặc payload có trường không xác định
+	//
+	// Errors:
+	//   - *UnknownFieldsError: Payload chứa trường không tồn tại trong struct, liệt kê trong Fields
+	//   - json: Lỗi khi unmarshal dữ liệu JSON
+	ShouldBindJSONStrict(obj interface{}) error
+
+	// BindJSONStrict bind request body vào struct như ShouldBindJSONStrict.
+	// Tự động trả về lỗi 400 Bad Request khi thất bại; details của lỗi chứa
+	// "unknown_fields" liệt kê các trường không xác định.
+	//
+	// Parameters:
+	//   - obj: Con trỏ đến struct nhận dữ liệu từ JSON
+	//
+	// Returns:
+	//   - error: HTTPError object từ fork/errors nếu binding thất bại
+	//
+	// Errors:
+	//   - forkerrors.BadRequest: Payload không hợp lệ hoặc có trường không xác định
+	BindJSONStrict(obj interface{}) error
+
 	// Status thiết lập HTTP status code cho response.
 	// Đặt status code HTTP cho response được trả về.
 	//
@@ -432,6 +952,23 @@ type Context interface {
 	//   - value: Giá trị của header
 	Header(key, value string)
 
+	// SetTrailer khai báo một trailer trong header Trailer của response.
+	// Phải được gọi trước khi ghi body; trailer được gửi sau body qua WriteTrailer.
+	// Response có trailer không nên đặt Content-Length để HTTP/1.1 dùng chunked encoding.
+	//
+	// Parameters:
+	//   - key: Tên của trailer (ví dụ: "X-Checksum", "Grpc-Status")
+	SetTrailer(key string)
+
+	// WriteTrailer thiết lập giá trị của trailer, được gửi sau khi handler ghi xong body.
+	// Trailer chưa được khai báo bằng SetTrailer vẫn được gửi với net/http
+	// thông qua http.TrailerPrefix.
+	//
+	// Parameters:
+	//   - key: Tên của trailer
+	//   - value: Giá trị của trailer
+	WriteTrailer(key, value string)
+
 	// GetHeader trả về giá trị của header request theo tên.
 	//
 	// Phương thức này lấy giá trị của HTTP header từ request hiện tại dựa trên
@@ -444,6 +981,37 @@ type Context interface {
 	//   - string: Giá trị của header, hoặc chuỗi rỗng nếu không tìm thấy
 	GetHeader(key string) string
 
+	// GetHeaders trả về tất cả giá trị của header request theo tên.
+	// Tên header không phân biệt hoa thường, kể cả khi khóa trong http.Header không được chuẩn hóa.
+	//
+	// Parameters:
+	//   - key: Tên của HTTP header
+	//
+	// Returns:
+	//   - []string: Các giá trị của header, nil nếu không tìm thấy
+	GetHeaders(key string) []string
+
+	// GetHeaderInt trả về header request dạng int.
+	//
+	// Parameters:
+	//   - key: Tên của HTTP header
+	//
+	// Returns:
+	//   - int: Giá trị đã parse
+	//   - error: ErrHeaderMissing nếu header không có, hoặc lỗi parse
+	GetHeaderInt(key string) (int, error)
+
+	// GetHeaderTime trả về header request dạng time.Time theo định dạng HTTP date,
+	// ví dụ If-Modified-Since.
+	//
+	// Parameters:
+	//   - key: Tên của HTTP header
+	//
+	// Returns:
+	//   - time.Time: Giá trị đã parse
+	//   - error: ErrHeaderMissing nếu header không có, hoặc lỗi parse
+	GetHeaderTime(key string) (time.Time, error)
+
 	// Cookie trả về giá trị của cookie từ request dựa theo tên.
 	//
 	// Phương thức này tìm kiếm HTTP cookie trong request hiện tại bằng cách sử dụng tên
@@ -476,6 +1044,14 @@ type Context interface {
 	//   - httpOnly: Ngăn JavaScript truy cập cookie nếu là true
 	SetCookie(name, value string, maxAge int, path, domain string, secure, httpOnly bool)
 
+	// SetCookieWithOptions thiết lập cookie với đầy đủ thuộc tính của http.Cookie
+	// (SameSite, Expires, Partitioned...). Cookie SameSite=None luôn được đánh dấu Secure
+	// theo yêu cầu của trình duyệt. Cookie có tên không hợp lệ bị bỏ qua.
+	//
+	// Parameters:
+	//   - cookie: Cookie cần thiết lập
+	SetCookieWithOptions(cookie http.Cookie)
+
 	// Cookies trả về tất cả cookies từ request hiện tại.
 	//
 	// Phương thức này trích xuất tất cả HTTP cookies có trong request và
@@ -485,6 +1061,22 @@ type Context interface {
 	//   - []*http.Cookie: Mảng các đối tượng cookie, có thể rỗng nếu không có cookies
 	Cookies() []*http.Cookie
 
+	// Flash lưu một flash message vào session (khóa SessionKey trong context store) để hiển thị
+	// ở request tiếp theo, thường sau redirect. Không có tác dụng nếu session middleware
+	// chưa được đăng ký.
+	//
+	// Parameters:
+	//   - category: Loại thông báo, ví dụ "success", "error"
+	//   - message: Nội dung thông báo
+	Flash(category, message string)
+
+	// Flashes trả về các flash message được lưu bởi request trước và xóa chúng khỏi session,
+	// nên mỗi message chỉ hiển thị một lần. Các lần gọi sau trong cùng request trả về cùng kết quả.
+	//
+	// Returns:
+	//   - []FlashMessage: Các flash message theo thứ tự được thêm, nil nếu không có
+	Flashes() []FlashMessage
+
 	// Render renders một template với dữ liệu và thiết lập HTTP status code.
 	//
 	// Phương thức này được thiết kế để render template với tên và dữ liệu được cung cấp.
@@ -553,6 +1145,31 @@ type Context interface {
 	//   - Không trả về lỗi trực tiếp, nhưng gọi c.Error() nếu encoding thất bại
 	XML(code int, obj interface{})
 
+	// Accepts chọn MIME type phù hợp nhất với header Accept của request.
+	// Phân tích header Accept với q-values; media range cụ thể nhất ("type/subtype",
+	// rồi "type/*", rồi "*/*") quyết định q-value của mỗi offer. Khi nhiều offer có cùng
+	// q-value, offer đứng trước được chọn.
+	//
+	// Parameters:
+	//   - offers: Các MIME type server có thể trả về, theo thứ tự ưu tiên
+	//
+	// Returns:
+	//   - string: Offer được chọn, offer đầu tiên nếu request không có header Accept,
+	//     rỗng nếu không offer nào được chấp nhận
+	Accepts(offers ...string) string
+
+	// Negotiate ghi response theo định dạng client chấp nhận.
+	// Chọn định dạng từ config.Offered bằng Accepts rồi ghi response bằng JSON, XML,
+	// HTML (hoặc Render với config.HTMLName) hay String, kèm header "Vary: Accept".
+	//
+	// Parameters:
+	//   - code: HTTP status code
+	//   - config: Các định dạng và dữ liệu response
+	//
+	// Errors:
+	//   - forkerrors.NotAcceptable: Ghi response 406 nếu không định dạng nào được chấp nhận
+	Negotiate(code int, config Negotiate)
+
 	// File phục vụ một file từ filesystem.
 	// Đọc và trả về nội dung của file từ đường dẫn được chỉ định.
 	//
@@ -591,6 +1208,9 @@ type Context interface {
 	//   - contentType: Kiểu dữ liệu cho Content-Type header
 	//   - r: Reader chứa dữ liệu cần trả về
 	//
+	// Dữ liệu được flush sau mỗi chunk; việc sao chép dừng và chuỗi handlers bị abort
+	// khi client ngắt kết nối.
+	//
 	// Errors:
 	//   - io: Các lỗi từ Reader được truyền vào không được xử lý
 	Stream(code int, contentType string, r io.Reader)
@@ -603,6 +1223,21 @@ type Context interface {
 	//   - location: URL đích cho redirect
 	Redirect(code int, location string)
 
+	// EarlyHints gửi interim response 103 Early Hints kèm các header Link để trình duyệt
+	// preload/preconnect tài nguyên trong khi handler còn đang tạo response cuối cùng.
+	// Các header Link vẫn được giữ lại trong response cuối cùng theo RFC 8297.
+	//
+	// Parameters:
+	//   - links: Giá trị header Link (ví dụ: "</app.css>; rel=preload; as=style")
+	//
+	// Returns:
+	//   - error: Lỗi nếu không thể gửi early hints
+	//
+	// Errors:
+	//   - ErrEarlyHintsNotSupported: Response đã được ghi, client dùng HTTP/1.0
+	//     hoặc writer không hỗ trợ informational responses
+	EarlyHints(links []string) error
+
 	// Error trả về một HTTP error với status code và message.
 	// Trả về lỗi HTTP với status code 500 và message từ error.
 	//
@@ -633,14 +1268,50 @@ type Context interface {
 	//   - bool: true nếu request là websocket, ngược lại là false
 	IsWebsocket() bool
 
+	// IsAJAX kiểm tra request có header X-Requested-With: XMLHttpRequest không.
+	//
+	// Returns:
+	//   - bool: true nếu là AJAX request
+	IsAJAX() bool
+
+	// IsJSON kiểm tra Content-Type của request có phải JSON không
+	// ("application/json" hoặc "+json").
+	//
+	// Returns:
+	//   - bool: true nếu body của request là JSON
+	IsJSON() bool
+
+	// WantsJSON kiểm tra media range ưu tiên nhất trong header Accept có phải JSON không.
+	// Wildcard như "*/*" không được tính.
+	//
+	// Returns:
+	//   - bool: true nếu client ưu tiên response JSON
+	WantsJSON() bool
+
+	// Fresh kiểm tra cache của client còn mới không, so sánh If-None-Match/If-Modified-Since
+	// của request với ETag/Last-Modified đã thiết lập trên response. Chỉ áp dụng cho GET, HEAD.
+	//
+	// Returns:
+	//   - bool: true nếu có thể trả về 304 Not Modified
+	Fresh() bool
+
+	// Stale là phủ định của Fresh.
+	//
+	// Returns:
+	//   - bool: true nếu client cần nhận response đầy đủ
+	Stale() bool
+
 	// GetRawData trả về raw request body.
-	// Đọc và trả về toàn bộ nội dung của request body.
+	// Body được đệm lại (tối đa MaxBodyBufferSize) nên GetRawData và các phương thức Bind*
+	// có thể được gọi nhiều lần, ví dụ middleware xác thực chữ ký rồi handler bind body.
+	// Request().Body được phục hồi để đọc lại từ đầu sau lần đọc đầu tiên.
 	//
 	// Returns:
-	//   - []byte: Dữ liệu từ request body
+	//   - []byte: Dữ liệu từ request body, dùng chung giữa các lần gọi nên không được sửa đổi
 	//   - error: Lỗi nếu có khi đọc body
 	//
 	// Errors:
+	//   - ErrBodyTooLarge: Body vượt quá MaxBodyBufferSize; phần đã đọc được phục hồi vào Request().Body
 	//   - io: Lỗi khi đọc từ body
 	GetRawData() ([]byte, error)
 
@@ -672,7 +1343,8 @@ type Context interface {
 	ValidateStruct(obj interface{}) error
 
 	// ShouldBindAndValidate thực hiện bind và validate struct từ request.
-	// Thực hiện binding dữ liệu từ request vào struct và sau đó validate struct.
+	// Thực hiện binding tham số route (tag "uri") và dữ liệu từ request vào struct
+	// và sau đó validate struct.
 	// Không tự động trả về lỗi HTTP như BindAndValidate.
 	//
 	// Parameters:
@@ -698,29 +1370,126 @@ type Context interface {
 	//
 	// Errors:
 	//   - forkerrors.BadRequest: Lỗi khi binding request data
+	//   - forkerrors.RequestEntityTooLarge: Body vượt quá DefaultBindLimit hoặc MaxBodyBufferSize
 	//   - forkerrors.UnprocessableEntity: Lỗi khi validate dữ liệu
+	//   - *forkerrors.HttpError do Validator tùy chỉnh trả về: Được ghi nguyên vẹn
 	BindAndValidate(obj interface{}) error
 
-	// RegisterValidation đăng ký một hàm validation tùy chỉnh.
-	// Thêm một custom validation tag và hàm validation tương ứng vào validator.
+	// RegisterValidation luôn trả về lỗi: validator của context được dùng chung bởi mọi request
+	// và đăng ký validation không an toàn khi các request khác đang validate. Đăng ký validation
+	// khi khởi tạo ứng dụng qua WebApp.RegisterValidation.
 	//
 	// Parameters:
 	//   - tag: Tag name sẽ được sử dụng trong struct tag
 	//   - fn: Hàm validation tương ứng với tag
 	//
 	// Returns:
-	//   - error: Lỗi nếu không thể đăng ký validation
+	//   - error: Lỗi cho biết không thể đăng ký validation từ context
+	//
+	// Errors:
+	//   - ErrSharedValidator: Validator được dùng chung giữa các request
+	//   - ErrUnsupportedValidator: Validator được gắn không phải go-playground
 	RegisterValidation(tag string, fn validator.Func) error
 
 	// GetValidator trả về validator instance để cấu hình nâng cao.
-	// Cho phép truy cập trực tiếp đến validator instance để thực hiện cấu hình nâng cao.
+	// Validator được dùng chung giữa các request nên chỉ nên cấu hình khi khởi tạo ứng dụng.
 	//
 	// Returns:
-	//   - *validator.Validate: Instance của validator
+	//   - *validator.Validate: Validator của ứng dụng, hoặc validator dùng chung nếu chưa gắn;
+	//     nil nếu validator được gắn không phải go-playground
 	GetValidator() *validator.Validate
+
+	// SetValidator gắn validator của ứng dụng vào context. Router gọi phương thức này cho mỗi
+	// request với validator được cấu hình qua DefaultRouter.SetValidator hoặc WebApp.
+	//
+	// Parameters:
+	//   - v: Backend validation (*validator.Validate hoặc Validator tùy chỉnh) dùng cho
+	//     ValidateStruct và các phương thức *Validate, nil để dùng validator dùng chung
+	SetValidator(v Validator)
+
+	// RequestID trả về ID của request hiện tại: giá trị trong store (RequestIDKey), header
+	// X-Request-ID của request nếu hợp lệ, hoặc UUID mới. ID được lưu vào store và ghi vào
+	// header X-Request-ID của response ở lần gọi đầu tiên.
+	//
+	// Returns:
+	//   - string: Request ID
+	RequestID() string
+
+	// Logger trả về logger đã gắn sẵn request_id, method, path và route pattern của request.
+	// Request ID lấy theo RequestID.
+	//
+	// Returns:
+	//   - Logger: Logger của request, ghi qua logger của ứng dụng hoặc slog.Default nếu chưa gắn
+	Logger() Logger
+
+	// SetLogger gắn logger của ứng dụng vào context. Router gọi phương thức này cho mỗi
+	// request với logger được cấu hình qua DefaultRouter.SetLogger hoặc WebApp.SetLogger.
+	//
+	// Parameters:
+	//   - logger: Logger gốc (thường là log.Manager), nil để dùng slog.Default
+	SetLogger(logger Logger)
+
+	// T dịch một message key theo ngôn ngữ của request hiện tại.
+	// Translator được lấy từ context store với khóa "translator" (thường được thiết lập
+	// bởi i18n middleware). Nếu không có translator, key được trả về nguyên vẹn.
+	//
+	// Parameters:
+	//   - key: Khóa của message
+	//   - args: Tham số của message dạng cặp tên/giá trị; tham số "count" chọn dạng số nhiều
+	//
+	// Returns:
+	//   - string: Message đã dịch
+	T(key string, args ...interface{}) string
+
+	// Pagination phân tích tham số phân trang (page, limit, cursor) từ query string.
+	// Giới hạn và tên tham số được lấy từ PaginationConfig trong context store với khóa
+	// "pagination_config", hoặc DefaultPaginationConfig nếu không có.
+	// Page nhỏ hơn 1 hoặc không hợp lệ được đưa về 1; limit được giới hạn trong [1, MaxLimit].
+	//
+	// Returns:
+	//   - Pagination: Tham số phân trang đã chuẩn hóa
+	Pagination() Pagination
+
+	// Paginate gửi response danh sách theo số trang với envelope chuẩn
+	// {"data": ..., "pagination": {...}}, header Link (first, prev, next, last)
+	// và header X-Total-Count.
+	//
+	// Parameters:
+	//   - code: HTTP status code
+	//   - items: Các phần tử của trang hiện tại
+	//   - total: Tổng số phần tử
+	Paginate(code int, items interface{}, total int64)
+
+	// PaginateCursor gửi response danh sách theo cursor với envelope chuẩn
+	// và header Link rel="next" khi còn dữ liệu.
+	//
+	// Parameters:
+	//   - code: HTTP status code
+	//   - items: Các phần tử của trang hiện tại
+	//   - nextCursor: Cursor của trang tiếp theo, rỗng nếu đã hết dữ liệu
+	PaginateCursor(code int, items interface{}, nextCursor string)
 }
 
 // ErrUnsupportedBinding là lỗi được trả về khi Content-Type không được hỗ trợ.
 // Lỗi này được sử dụng trong phương thức Bind khi không thể xác định
 // phương thức binding phù hợp dựa vao Content-Type.
 var ErrUnsupportedBinding = errors.New("unsupported binding type")
+
+// ErrEarlyHintsNotSupported là lỗi được trả về bởi EarlyHints khi không thể gửi
+// interim response 103 cho request hiện tại. Handler có thể bỏ qua lỗi này một cách an toàn.
+var ErrEarlyHintsNotSupported = errors.New("early hints not supported")
+
+// InformationalWriter được implement bởi http.ResponseWriter có thể gửi informational
+// responses (1xx) trước response cuối cùng. Writer của net/http được hỗ trợ sẵn;
+// adapter hoặc writer wrapper khác implement interface này để bật EarlyHints.
+type InformationalWriter interface {
+	// WriteInformational gửi ngay một interim response với status code 1xx
+	// và các headers hiện tại của response.
+	//
+	// Parameters:
+	//   - code: HTTP status code 1xx
+	//
+	// Returns:
+	//   - error: Lỗi nếu không thể gửi interim response
+	WriteInformational(code int) error
+}
@@ -3,13 +3,25 @@ package context
 import (
 	"bytes"
 	gocontext "context"
+	"errors"
+	"fmt"
+	"io"
 	"mime/multipart"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/http/httptrace"
+	"net/textproto"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
 )
 
 func TestNewContext(t *testing.T) {
@@ -188,6 +200,63 @@ func TestContextSetGet(t *testing.T) {
 	}
 }
 
+func TestContextMustGet(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/test", nil))
+	ctx.Set("user", "alice")
+	if got := ctx.MustGet("user"); got != "alice" {
+		t.Errorf("Expected alice, got %v", got)
+	}
+
+	defer func() {
+		r := recover()
+		if r == nil || !strings.Contains(fmt.Sprint(r), `"missing"`) {
+			t.Errorf("Expected panic mentioning the missing key, got %v", r)
+		}
+	}()
+	ctx.MustGet("missing")
+}
+
+func TestContextGetOrSet(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/test", nil))
+	calls := 0
+	compute := func() interface{} {
+		calls++
+		ctx.Set("computed_at", calls) // fn có thể dùng context mà không deadlock
+		return "token"
+	}
+
+	for i := 0; i < 3; i++ {
+		if got := ctx.GetOrSet("auth", compute); got != "token" {
+			t.Errorf("Expected token, got %v", got)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected fn to run once, ran %d times", calls)
+	}
+
+	ctx.Set("preset", nil)
+	if got := ctx.GetOrSet("preset", func() interface{} { return "other" }); got != nil {
+		t.Errorf("Expected existing nil value to be kept, got %v", got)
+	}
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = ctx.GetOrSet("shared", func() interface{} { return i })
+		}(i)
+	}
+	wg.Wait()
+	for _, result := range results {
+		if result != results[0] {
+			t.Errorf("Expected all goroutines to get the same value, got %v", results)
+			break
+		}
+	}
+}
+
 func TestContextRequestMethods(t *testing.T) {
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest("POST", "/test?q=search&page=1", bytes.NewBufferString("name=test&age=25"))
@@ -284,6 +353,274 @@ func TestContextContentNegotiation(t *testing.T) {
 	}
 }
 
+func TestContextBindYAML(t *testing.T) {
+	type TestConfig struct {
+		Name    string   `yaml:"name"`
+		Port    int      `yaml:"port"`
+		Domains []string `yaml:"domains"`
+	}
+	body := "name: api\nport: 8080\ndomains:\n  - a.example.com\n  - b.example.com\n"
+
+	for _, contentType := range []string{"application/yaml", "application/x-yaml", "text/yaml"} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/config", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", contentType)
+		ctx := NewContext(w, req)
+
+		var config TestConfig
+		if err := ctx.Bind(&config); err != nil {
+			t.Errorf("%s: failed to bind YAML: %v", contentType, err)
+			continue
+		}
+		if config.Name != "api" || config.Port != 8080 || len(config.Domains) != 2 {
+			t.Errorf("%s: unexpected config %+v", contentType, config)
+		}
+	}
+
+	req := httptest.NewRequest("POST", "/config", bytes.NewBufferString("name: [unclosed"))
+	ctx := NewContext(httptest.NewRecorder(), req)
+	var config TestConfig
+	if err := ctx.BindYAML(&config); err == nil {
+		t.Error("Expected error for invalid YAML")
+	}
+}
+
+func TestContextBindTOML(t *testing.T) {
+	type TestConfig struct {
+		Name    string   `toml:"name"`
+		Port    int      `toml:"port"`
+		Domains []string `toml:"domains"`
+	}
+	body := "name = \"api\"\nport = 8080\ndomains = [\"a.example.com\", \"b.example.com\"]\n"
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/config", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/toml")
+	ctx := NewContext(w, req)
+
+	var config TestConfig
+	if err := ctx.Bind(&config); err != nil {
+		t.Fatalf("Failed to bind TOML: %v", err)
+	}
+	if config.Name != "api" || config.Port != 8080 || len(config.Domains) != 2 {
+		t.Errorf("Unexpected config %+v", config)
+	}
+
+	req = httptest.NewRequest("POST", "/config", bytes.NewBufferString("name = "))
+	ctx = NewContext(httptest.NewRecorder(), req)
+	if err := ctx.BindTOML(&config); err == nil {
+		t.Error("Expected error for invalid TOML")
+	}
+}
+
+func TestContextBindMsgpack(t *testing.T) {
+	type TestEvent struct {
+		Name  string   `msgpack:"name"`
+		Count int      `msgpack:"count"`
+		Tags  []string `msgpack:"tags"`
+	}
+	body, err := msgpack.Marshal(TestEvent{Name: "click", Count: 3, Tags: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Failed to marshal event: %v", err)
+	}
+
+	for _, contentType := range []string{"application/msgpack", "application/x-msgpack", "application/vnd.msgpack"} {
+		req := httptest.NewRequest("POST", "/events", bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		ctx := NewContext(httptest.NewRecorder(), req)
+
+		var event TestEvent
+		if err := ctx.Bind(&event); err != nil {
+			t.Errorf("%s: failed to bind MessagePack: %v", contentType, err)
+			continue
+		}
+		if event.Name != "click" || event.Count != 3 || len(event.Tags) != 2 {
+			t.Errorf("%s: unexpected event %+v", contentType, event)
+		}
+	}
+
+	req := httptest.NewRequest("POST", "/events", bytes.NewReader([]byte{0xc1}))
+	ctx := NewContext(httptest.NewRecorder(), req)
+	var event TestEvent
+	if err := ctx.BindMsgpack(&event); err == nil {
+		t.Error("Expected error for invalid MessagePack")
+	}
+}
+
+func TestContextBindCBOR(t *testing.T) {
+	type TestReading struct {
+		Sensor string  `cbor:"sensor"`
+		Value  float64 `cbor:"value"`
+	}
+	body, err := cbor.Marshal(TestReading{Sensor: "temp-1", Value: 21.5})
+	if err != nil {
+		t.Fatalf("Failed to marshal reading: %v", err)
+	}
+
+	req := httptest.NewRequest("POST", "/readings", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/cbor")
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	var reading TestReading
+	if err := ctx.Bind(&reading); err != nil {
+		t.Fatalf("Failed to bind CBOR: %v", err)
+	}
+	if reading.Sensor != "temp-1" || reading.Value != 21.5 {
+		t.Errorf("Unexpected reading %+v", reading)
+	}
+
+	req = httptest.NewRequest("POST", "/readings", bytes.NewReader([]byte{0xff}))
+	ctx = NewContext(httptest.NewRecorder(), req)
+	if err := ctx.BindCBOR(&reading); err == nil {
+		t.Error("Expected error for invalid CBOR")
+	}
+}
+
+func TestContextBindHeader(t *testing.T) {
+	type TestHeaders struct {
+		APIKey     string   `header:"X-Api-Key" validate:"required"`
+		Debug      bool     `header:"x-debug"`
+		Retries    int      `header:"X-Retries"`
+		Features   []string `header:"X-Feature"`
+		Untagged   string
+		Ignored    string `header:"-"`
+		unexported string `header:"X-Api-Key"`
+	}
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-API-KEY", "secret")
+	req.Header.Set("X-Debug", "true")
+	req.Header.Set("X-Retries", "3")
+	req.Header.Add("X-Feature", "beta")
+	req.Header.Add("X-Feature", "dark-mode")
+	req.Header.Set("Untagged", "x")
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	var headers TestHeaders
+	if err := ctx.BindHeader(&headers); err != nil {
+		t.Fatalf("Failed to bind headers: %v", err)
+	}
+	if headers.APIKey != "secret" || !headers.Debug || headers.Retries != 3 {
+		t.Errorf("Unexpected headers %+v", headers)
+	}
+	if len(headers.Features) != 2 || headers.Features[0] != "beta" || headers.Features[1] != "dark-mode" {
+		t.Errorf("Expected all X-Feature values, got %v", headers.Features)
+	}
+	if headers.Untagged != "" || headers.Ignored != "" || headers.unexported != "" {
+		t.Errorf("Expected untagged, ignored and unexported fields to be skipped, got %+v", headers)
+	}
+	if err := ctx.ValidateStruct(&headers); err != nil {
+		t.Errorf("Expected bound headers to validate, got %v", err)
+	}
+
+	req = httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Retries", "many")
+	ctx = NewContext(httptest.NewRecorder(), req)
+	headers = TestHeaders{}
+	if err := ctx.BindHeader(&headers); err == nil {
+		t.Error("Expected error for invalid integer header")
+	}
+	if err := ctx.ValidateStruct(&headers); err == nil {
+		t.Error("Expected validation error for missing X-Api-Key")
+	}
+}
+
+type testUUID [16]byte
+
+func (u *testUUID) UnmarshalText(text []byte) error {
+	hex := strings.ReplaceAll(string(text), "-", "")
+	if len(hex) != 32 {
+		return fmt.Errorf("invalid UUID %q", text)
+	}
+	for i := range u {
+		var b byte
+		if _, err := fmt.Sscanf(hex[i*2:i*2+2], "%02x", &b); err != nil {
+			return err
+		}
+		u[i] = b
+	}
+	return nil
+}
+
+func TestContextBindURI(t *testing.T) {
+	type TestURI struct {
+		ID      int      `uri:"id" validate:"min=1"`
+		Account testUUID `uri:"account"`
+		Addr    net.IP   `uri:"addr"`
+		Name    string   `uri:"name" json:"name"`
+		Missing string   `uri:"missing"`
+	}
+
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/test", nil))
+	ctx.SetParams(map[string]string{
+		"id":      "42",
+		"account": "123e4567-e89b-12d3-a456-426614174000",
+		"addr":    "10.0.0.1",
+		"name":    "alice",
+	})
+
+	var uri TestURI
+	if err := ctx.BindURI(&uri); err != nil {
+		t.Fatalf("Failed to bind URI: %v", err)
+	}
+	if uri.ID != 42 || uri.Name != "alice" || uri.Missing != "" {
+		t.Errorf("Unexpected URI params %+v", uri)
+	}
+	if uri.Account[0] != 0x12 || uri.Account[15] != 0x00 {
+		t.Errorf("Expected UUID to be parsed, got %x", uri.Account)
+	}
+	if !uri.Addr.Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("Expected IP 10.0.0.1, got %v", uri.Addr)
+	}
+
+	ctx.SetParams(map[string]string{"id": "abc"})
+	if err := ctx.BindURI(&TestURI{}); err == nil {
+		t.Error("Expected error for invalid integer param")
+	}
+	ctx.SetParams(map[string]string{"account": "not-a-uuid"})
+	if err := ctx.BindURI(&TestURI{}); err == nil {
+		t.Error("Expected error for invalid UUID param")
+	}
+}
+
+func TestContextShouldBindAndValidateURI(t *testing.T) {
+	type UpdateUser struct {
+		ID   int    `uri:"id" validate:"min=1"`
+		Name string `json:"name" validate:"required"`
+	}
+
+	req := httptest.NewRequest("PUT", "/users/7", bytes.NewBufferString(`{"name":"bob"}`))
+	req.Header.Set("Content-Type", "application/json")
+	ctx := NewContext(httptest.NewRecorder(), req)
+	ctx.SetParams(map[string]string{"id": "7"})
+
+	var user UpdateUser
+	if err := ctx.ShouldBindAndValidate(&user); err != nil {
+		t.Fatalf("Failed to bind and validate: %v", err)
+	}
+	if user.ID != 7 || user.Name != "bob" {
+		t.Errorf("Expected URI and body fields to be bound, got %+v", user)
+	}
+
+	req = httptest.NewRequest("PUT", "/users/0", bytes.NewBufferString(`{"name":"bob"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	ctx = NewContext(w, req)
+	ctx.SetParams(map[string]string{"id": "0"})
+	if err := ctx.BindAndValidate(&UpdateUser{}); err == nil || w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected 422 for invalid URI param, got %v (%d)", err, w.Code)
+	}
+
+	req = httptest.NewRequest("PUT", "/users/x", bytes.NewBufferString(`{"name":"bob"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	ctx = NewContext(w, req)
+	ctx.SetParams(map[string]string{"id": "x"})
+	if err := ctx.BindAndValidate(&UpdateUser{}); err == nil || w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unparsable URI param, got %v (%d)", err, w.Code)
+	}
+}
+
 func TestContextResponding(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -348,6 +685,30 @@ func TestContextResponding(t *testing.T) {
 	}
 }
 
+func TestContextSetCookieWithOptions(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx := NewContext(w, httptest.NewRequest("GET", "/", nil))
+
+	ctx.SetCookie("session", "abc", 3600, "/", "", false, true)
+	ctx.SetCookieWithOptions(http.Cookie{Name: "csrf", Value: "t", Path: "/", SameSite: http.SameSiteStrictMode})
+	ctx.SetCookieWithOptions(http.Cookie{Name: "embed", Value: "1", SameSite: http.SameSiteNoneMode})
+	ctx.SetCookieWithOptions(http.Cookie{Name: "bad name", Value: "x"})
+
+	cookies := w.Header().Values("Set-Cookie")
+	if len(cookies) != 3 {
+		t.Fatalf("Expected 3 cookies (invalid name skipped), got %v", cookies)
+	}
+	if !strings.Contains(cookies[0], "HttpOnly") || strings.Contains(cookies[0], "SameSite") {
+		t.Errorf("Expected SetCookie to keep its attributes, got %q", cookies[0])
+	}
+	if !strings.Contains(cookies[1], "SameSite=Strict") {
+		t.Errorf("Expected SameSite=Strict, got %q", cookies[1])
+	}
+	if !strings.Contains(cookies[2], "SameSite=None") || !strings.Contains(cookies[2], "Secure") {
+		t.Errorf("Expected SameSite=None to imply Secure, got %q", cookies[2])
+	}
+}
+
 func TestContextFile(t *testing.T) {
 	// Create a temporary file for testing
 	tmpfile, err := os.CreateTemp("", "test")
@@ -446,6 +807,234 @@ func TestContextMultipartForm(t *testing.T) {
 }
 
 // TestParamArrayAndMap checks the ParamArray and ParamMap methods
+func TestContextBindDefaults(t *testing.T) {
+	type ListQuery struct {
+		Page   int     `form:"page" default:"1" validate:"min=1"`
+		Limit  int     `form:"limit" default:"10" validate:"max=100"`
+		Sort   string  `form:"sort" default:"created_at"`
+		Active bool    `form:"active" default:"true"`
+		Ratio  float64 `form:"ratio"`
+		Search string  `form:"q" default:"all"`
+	}
+
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/items?limit=50&q=", nil))
+	var query ListQuery
+	if err := ctx.BindQuery(&query); err != nil {
+		t.Fatalf("Failed to bind query: %v", err)
+	}
+	expected := ListQuery{Page: 1, Limit: 50, Sort: "created_at", Active: true}
+	if query != expected {
+		t.Errorf("Expected %+v, got %+v", expected, query)
+	}
+	if err := ctx.ValidateStruct(&query); err != nil {
+		t.Errorf("Expected defaults to pass validation, got %v", err)
+	}
+
+	req := httptest.NewRequest("POST", "/items", strings.NewReader("page=3&active=false"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ctx = NewContext(httptest.NewRecorder(), req)
+	query = ListQuery{}
+	if err := ctx.Bind(&query); err != nil {
+		t.Fatalf("Failed to bind form: %v", err)
+	}
+	expected = ListQuery{Page: 3, Limit: 10, Sort: "created_at", Active: false, Search: "all"}
+	if query != expected {
+		t.Errorf("Expected %+v, got %+v", expected, query)
+	}
+
+	type BadDefault struct {
+		Limit int `form:"limit" default:"ten"`
+	}
+	ctx = NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/items", nil))
+	if err := ctx.BindQuery(&BadDefault{}); err == nil {
+		t.Error("Expected error for invalid default value")
+	}
+}
+
+func TestContextBindTimeFormat(t *testing.T) {
+	type ReportQuery struct {
+		From    time.Time `form:"from" time_format:"2006-01-02" time_utc:"true"`
+		To      time.Time `form:"to" time_format:"2006-01-02 15:04"`
+		Since   time.Time `form:"since"`
+		At      time.Time `form:"at" time_utc:"1"`
+		Default time.Time `form:"default" time_format:"2006-01-02" time_utc:"true" default:"2024-01-01"`
+	}
+
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET",
+		"/reports?from=2024-03-15&to=2024-03-16+08:30&since=2024-03-01T10:00:00%2B07:00&at=2024-03-01T10:00:00%2B07:00", nil))
+	var query ReportQuery
+	if err := ctx.BindQuery(&query); err != nil {
+		t.Fatalf("Failed to bind time fields: %v", err)
+	}
+
+	if want := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC); !query.From.Equal(want) || query.From.Location() != time.UTC {
+		t.Errorf("Expected from %v in UTC, got %v", want, query.From)
+	}
+	if want := time.Date(2024, 3, 16, 8, 30, 0, 0, time.Local); !query.To.Equal(want) {
+		t.Errorf("Expected to %v in local time, got %v", want, query.To)
+	}
+	if want := time.Date(2024, 3, 1, 3, 0, 0, 0, time.UTC); !query.Since.Equal(want) {
+		t.Errorf("Expected since %v, got %v", want, query.Since)
+	}
+	if query.At.Location() != time.UTC || query.At.Hour() != 3 {
+		t.Errorf("Expected at converted to UTC, got %v", query.At)
+	}
+	if want := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); !query.Default.Equal(want) {
+		t.Errorf("Expected default %v, got %v", want, query.Default)
+	}
+
+	ctx = NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/reports?from=15/03/2024", nil))
+	if err := ctx.BindQuery(&ReportQuery{}); err == nil {
+		t.Error("Expected error for date not matching time_format")
+	}
+
+	type BadTag struct {
+		From time.Time `form:"from" time_utc:"yes"`
+	}
+	ctx = NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/reports?from=2024-03-15T00:00:00Z", nil))
+	if err := ctx.BindQuery(&BadTag{}); err == nil {
+		t.Error("Expected error for invalid time_utc tag")
+	}
+}
+
+func TestContextBindNestedAndSlices(t *testing.T) {
+	type Pagination struct {
+		Page  int `form:"page" default:"1"`
+		Limit int `form:"limit"`
+	}
+	type Filter struct {
+		Name   string    `form:"name"`
+		Status []string  `form:"status"`
+		Since  time.Time `form:"since" time_format:"2006-01-02" time_utc:"true"`
+	}
+	type Range struct {
+		Min int `form:"min"`
+		Max int `form:"max"`
+	}
+	type SearchQuery struct {
+		Pagination
+		Filter Filter   `form:"filter"`
+		Price  *Range   `form:"price"`
+		Size   *Range   `form:"size"`
+		IDs    []int    `form:"ids"`
+		Sort   []string `form:"sort" default:"name,-created_at"`
+		Tags   []string `json:"tags,omitempty"`
+	}
+
+	target := "/search?limit=20&filter.name=x&filter.status=new&filter.status=open&filter.since=2024-03-15" +
+		"&price.max=100&ids=1&ids=&ids=2&tags=a&tags=b"
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", target, nil))
+	var query SearchQuery
+	if err := ctx.BindQuery(&query); err != nil {
+		t.Fatalf("Failed to bind query: %v", err)
+	}
+
+	if query.Page != 1 || query.Limit != 20 {
+		t.Errorf("Expected embedded pagination page=1 limit=20, got %+v", query.Pagination)
+	}
+	if query.Filter.Name != "x" || !reflect.DeepEqual(query.Filter.Status, []string{"new", "open"}) {
+		t.Errorf("Unexpected nested filter %+v", query.Filter)
+	}
+	if !query.Filter.Since.Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected nested time field to honor time_format, got %v", query.Filter.Since)
+	}
+	if query.Price == nil || query.Price.Max != 100 || query.Price.Min != 0 {
+		t.Errorf("Expected price range max=100, got %+v", query.Price)
+	}
+	if query.Size != nil {
+		t.Errorf("Expected nil pointer for absent nested struct, got %+v", query.Size)
+	}
+	if !reflect.DeepEqual(query.IDs, []int{1, 2}) {
+		t.Errorf("Expected ids [1 2], got %v", query.IDs)
+	}
+	if !reflect.DeepEqual(query.Sort, []string{"name", "-created_at"}) {
+		t.Errorf("Expected default sort, got %v", query.Sort)
+	}
+	if !reflect.DeepEqual(query.Tags, []string{"a", "b"}) {
+		t.Errorf("Expected json tag options to be ignored, got %v", query.Tags)
+	}
+
+	ctx = NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/search?ids=1&ids=two", nil))
+	if err := ctx.BindQuery(&SearchQuery{}); err == nil {
+		t.Error("Expected error for invalid slice element")
+	}
+	ctx = NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/search?price.min=low", nil))
+	if err := ctx.BindQuery(&SearchQuery{}); err == nil {
+		t.Error("Expected error for invalid nested field")
+	}
+}
+
+func TestContextBindMultipartFiles(t *testing.T) {
+	type UploadRequest struct {
+		Title       string                  `form:"title" validate:"required"`
+		Avatar      *multipart.FileHeader   `form:"avatar" validate:"required"`
+		Attachments []*multipart.FileHeader `form:"attachments"`
+		Missing     *multipart.FileHeader   `form:"missing"`
+	}
+
+	newRequest := func(withAvatar bool) *http.Request {
+		var b bytes.Buffer
+		w := multipart.NewWriter(&b)
+		if err := w.WriteField("title", "report"); err != nil {
+			t.Fatalf("Failed to write field: %v", err)
+		}
+		files := []struct{ field, name string }{{"attachments", "a.txt"}, {"attachments", "b.txt"}}
+		if withAvatar {
+			files = append(files, struct{ field, name string }{"avatar", "me.png"})
+		}
+		for _, file := range files {
+			fileWriter, err := w.CreateFormFile(file.field, file.name)
+			if err != nil {
+				t.Fatalf("Failed to create form file: %v", err)
+			}
+			if _, err := fileWriter.Write([]byte(file.name)); err != nil {
+				t.Fatalf("Failed to write file content: %v", err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Failed to close writer: %v", err)
+		}
+
+		req := httptest.NewRequest("POST", "/upload", &b)
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		return req
+	}
+
+	var upload UploadRequest
+	ctx := NewContext(httptest.NewRecorder(), newRequest(true))
+	if err := ctx.BindAndValidate(&upload); err != nil {
+		t.Fatalf("Failed to bind multipart form: %v", err)
+	}
+	if upload.Title != "report" || upload.Avatar == nil || upload.Avatar.Filename != "me.png" {
+		t.Errorf("Expected title and avatar to be bound, got %+v", upload)
+	}
+	if len(upload.Attachments) != 2 || upload.Attachments[1].Filename != "b.txt" {
+		t.Errorf("Expected two attachments, got %v", upload.Attachments)
+	}
+	if upload.Missing != nil {
+		t.Errorf("Expected missing file field to stay nil, got %v", upload.Missing)
+	}
+
+	rec := httptest.NewRecorder()
+	ctx = NewContext(rec, newRequest(false))
+	if err := ctx.BindAndValidate(&UploadRequest{}); err == nil || rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected 422 for missing required file, got %v (%d)", err, rec.Code)
+	}
+}
+
+func TestContextBindContentTypeParams(t *testing.T) {
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"name":"test"}`))
+	req.Header.Set("Content-Type", "Application/JSON; charset=utf-8")
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	var body struct {
+		Name string `json:"name"`
+	}
+	if err := ctx.Bind(&body); err != nil || body.Name != "test" {
+		t.Errorf("Expected JSON binding with charset parameter, got %+v (%v)", body, err)
+	}
+}
+
 func TestParamArrayAndMap(t *testing.T) {
 	// Create a request with no params
 	req, _ := http.NewRequest("GET", "/test", nil)
@@ -491,3 +1080,289 @@ func TestParamArrayAndMap(t *testing.T) {
 		t.Error("Expected nil ParamArray for non-existent param")
 	}
 }
+
+// TestSetParams checks the dedicated route params storage
+func TestSetParams(t *testing.T) {
+	req, _ := http.NewRequest("GET", "/users/7", nil)
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	if ctx.Params() != nil {
+		t.Errorf("Expected nil Params for new context, got %v", ctx.Params())
+	}
+
+	ctx.SetParams(map[string]string{"id": "7", "name": "route"})
+	if ctx.Param("id") != "7" {
+		t.Errorf("Expected id=7, got %s", ctx.Param("id"))
+	}
+	if _, exists := ctx.Get("param:id"); exists {
+		t.Error("Expected SetParams not to write to the store")
+	}
+
+	// Tham số đặt trực tiếp vào store vẫn được hỗ trợ; SetParams được ưu tiên khi trùng tên
+	ctx.Set("param:name", "store")
+	ctx.Set("param:legacy", "yes")
+	if ctx.Param("legacy") != "yes" {
+		t.Errorf("Expected legacy store param, got %s", ctx.Param("legacy"))
+	}
+	params := ctx.ParamMap()
+	if len(params) != 3 || params["name"] != "route" || params["legacy"] != "yes" {
+		t.Errorf("Unexpected ParamMap %v", params)
+	}
+
+	// ParamMap trả về bản sao
+	params["id"] = "changed"
+	if ctx.Param("id") != "7" {
+		t.Error("Expected ParamMap to return a copy")
+	}
+}
+
+// TestRouteMeta checks route metadata set by the router
+func TestRouteMeta(t *testing.T) {
+	req, _ := http.NewRequest("GET", "/", nil)
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	if _, ok := ctx.RouteMeta("auth"); ok {
+		t.Error("Expected no metadata for new context")
+	}
+
+	ctx.SetRouteMeta(map[string]interface{}{"auth": "admin"})
+	if value, ok := ctx.RouteMeta("auth"); !ok || value != "admin" {
+		t.Errorf("Expected auth=admin, got %v (%v)", value, ok)
+	}
+}
+
+// TestFullPath checks the matched route pattern set by the router
+func TestFullPath(t *testing.T) {
+	req, _ := http.NewRequest("GET", "/users/42", nil)
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	if ctx.FullPath() != "" {
+		t.Errorf("Expected empty full path for new context, got %q", ctx.FullPath())
+	}
+
+	ctx.SetFullPath("/users/:id")
+	if ctx.FullPath() != "/users/:id" {
+		t.Errorf("Expected /users/:id, got %q", ctx.FullPath())
+	}
+}
+
+// stubTranslator là translator đơn giản dùng cho test ctx.T
+type stubTranslator map[string]string
+
+func (s stubTranslator) T(key string, args ...interface{}) string {
+	if msg, ok := s[key]; ok {
+		return msg
+	}
+	return key
+}
+
+func TestContextT(t *testing.T) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/test", nil)
+	ctx := NewContext(w, req)
+
+	// Không có translator: trả về key
+	if got := ctx.T("greeting"); got != "greeting" {
+		t.Errorf("Expected key without translator, got %q", got)
+	}
+
+	ctx.Set("translator", stubTranslator{"greeting": "Xin chào"})
+	if got := ctx.T("greeting"); got != "Xin chào" {
+		t.Errorf("Expected translated message, got %q", got)
+	}
+}
+
+// pageError là lỗi template cung cấp trang lỗi HTML
+type pageError struct{}
+
+func (pageError) Error() string     { return "broken template" }
+func (pageError) ErrorPage() []byte { return []byte("<h1>broken</h1>") }
+
+// failingEngine là template engine luôn trả về lỗi
+type failingEngine struct {
+	err error
+}
+
+func (f failingEngine) Render(string, interface{}) ([]byte, error) {
+	return nil, f.err
+}
+
+func TestContextRenderErrorPage(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx := NewContext(w, httptest.NewRequest("GET", "/", nil))
+	ctx.Set("template_engine", failingEngine{err: fmt.Errorf("render: %w", pageError{})})
+	ctx.Render(200, "home", nil)
+
+	if w.Code != 500 {
+		t.Errorf("Expected status 500, got %d", w.Code)
+	}
+	if w.Body.String() != "<h1>broken</h1>" {
+		t.Errorf("Expected error page body, got %q", w.Body.String())
+	}
+
+	// Lỗi thông thường vẫn được trả về dưới dạng JSON
+	w = httptest.NewRecorder()
+	ctx = NewContext(w, httptest.NewRequest("GET", "/", nil))
+	ctx.Set("template_engine", failingEngine{err: fmt.Errorf("plain")})
+	ctx.Render(200, "home", nil)
+	if !strings.Contains(w.Header().Get("Content-Type"), "application/json") {
+		t.Errorf("Expected JSON error response, got %q", w.Header().Get("Content-Type"))
+	}
+}
+
+// informationalRecorder là writer hỗ trợ InformationalWriter, ghi lại các interim responses
+type informationalRecorder struct {
+	*httptest.ResponseRecorder
+	interim []int
+	links   []string
+}
+
+func (r *informationalRecorder) WriteInformational(code int) error {
+	r.interim = append(r.interim, code)
+	r.links = append([]string(nil), r.Header().Values("Link")...)
+	return nil
+}
+
+func TestContextEarlyHints(t *testing.T) {
+	links := []string{"</app.css>; rel=preload; as=style", "<https://cdn.example.com>; rel=preconnect"}
+
+	for _, http2 := range []bool{false, true} {
+		t.Run(fmt.Sprintf("server http2=%v", http2), func(t *testing.T) {
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctx := NewContext(w, r)
+				if err := ctx.EarlyHints(links); err != nil {
+					t.Errorf("Expected early hints to be sent, got %v", err)
+				}
+				ctx.String(http.StatusOK, "page")
+			}))
+			server.EnableHTTP2 = http2
+			server.StartTLS()
+			defer server.Close()
+
+			var interim []int
+			var hinted []string
+			trace := &httptrace.ClientTrace{
+				Got1xxResponse: func(code int, header textproto.MIMEHeader) error {
+					interim = append(interim, code)
+					hinted = header.Values("Link")
+					return nil
+				},
+			}
+			req, _ := http.NewRequestWithContext(httptrace.WithClientTrace(gocontext.Background(), trace), "GET", server.URL, nil)
+			resp, err := server.Client().Do(req)
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if len(interim) != 1 || interim[0] != http.StatusEarlyHints {
+				t.Errorf("Expected one 103 response, got %v", interim)
+			}
+			if strings.Join(hinted, ",") != strings.Join(links, ",") {
+				t.Errorf("Expected Link headers %v, got %v", links, hinted)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected final status 200, got %d", resp.StatusCode)
+			}
+		})
+	}
+
+	t.Run("InformationalWriter", func(t *testing.T) {
+		w := &informationalRecorder{ResponseRecorder: httptest.NewRecorder()}
+		ctx := NewContext(w, httptest.NewRequest("GET", "/", nil))
+		if err := ctx.EarlyHints(links); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(w.interim) != 1 || w.interim[0] != http.StatusEarlyHints || len(w.links) != 2 {
+			t.Errorf("Expected 103 with 2 links, got %v %v", w.interim, w.links)
+		}
+		if ctx.Response().Written() {
+			t.Error("Expected final response to remain unwritten")
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ctx := NewContext(w, httptest.NewRequest("GET", "/", nil))
+		if err := ctx.EarlyHints(links); !errors.Is(err, ErrEarlyHintsNotSupported) {
+			t.Errorf("Expected ErrEarlyHintsNotSupported for recorder, got %v", err)
+		}
+		if len(w.Header().Values("Link")) != 0 {
+			t.Error("Expected no Link headers when early hints are not supported")
+		}
+		if err := ctx.EarlyHints(nil); err != nil {
+			t.Errorf("Expected nil for empty links, got %v", err)
+		}
+
+		req := httptest.NewRequest("GET", "/", nil)
+		req.Proto, req.ProtoMinor = "HTTP/1.0", 0
+		iw := &informationalRecorder{ResponseRecorder: httptest.NewRecorder()}
+		if err := NewContext(iw, req).EarlyHints(links); !errors.Is(err, ErrEarlyHintsNotSupported) {
+			t.Errorf("Expected ErrEarlyHintsNotSupported for HTTP/1.0, got %v", err)
+		}
+
+		iw = &informationalRecorder{ResponseRecorder: httptest.NewRecorder()}
+		ctx = NewContext(iw, httptest.NewRequest("GET", "/", nil))
+		ctx.String(http.StatusOK, "done")
+		if err := ctx.EarlyHints(links); !errors.Is(err, ErrEarlyHintsNotSupported) {
+			t.Errorf("Expected ErrEarlyHintsNotSupported after write, got %v", err)
+		}
+	})
+}
+
+func TestContextTrailers(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := NewContext(w, r)
+		ctx.SetTrailer("x-checksum")
+		ctx.SetTrailer("X-Checksum")
+		ctx.Header("Content-Type", "text/plain")
+		ctx.Status(http.StatusOK)
+		ctx.Response().Write([]byte("chunk-1"))
+		ctx.Response().Flush()
+		ctx.Response().Write([]byte("chunk-2"))
+		ctx.WriteTrailer("X-Checksum", "abc123")
+		ctx.WriteTrailer("Grpc-Status", "0")
+	})
+
+	for _, http2 := range []bool{false, true} {
+		t.Run(fmt.Sprintf("server http2=%v", http2), func(t *testing.T) {
+			server := httptest.NewUnstartedServer(handler)
+			server.EnableHTTP2 = http2
+			server.StartTLS()
+			defer server.Close()
+
+			resp, err := server.Client().Get(server.URL)
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			// Client chuyển các trailer được khai báo trong header Trailer vào resp.Trailer trước khi đọc body
+			if _, announced := resp.Trailer["X-Checksum"]; !announced || len(resp.Trailer) != 1 {
+				t.Errorf("Expected X-Checksum to be announced, got %v", resp.Trailer)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			if string(body) != "chunk-1chunk-2" {
+				t.Errorf("Expected streamed body, got %q", body)
+			}
+			if got := resp.Trailer.Get("X-Checksum"); got != "abc123" {
+				t.Errorf("Expected declared trailer abc123, got %q", got)
+			}
+			if got := resp.Trailer.Get("Grpc-Status"); got != "0" {
+				t.Errorf("Expected undeclared trailer 0, got %q", got)
+			}
+		})
+	}
+
+	t.Run("recorder", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		result := w.Result()
+		if got := result.Trailer.Get("X-Checksum"); got != "abc123" {
+			t.Errorf("Expected recorded trailer abc123, got %q", got)
+		}
+		if got := result.Trailer.Get("Grpc-Status"); got != "0" {
+			t.Errorf("Expected recorded trailer 0, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package context
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net/http"
+	"slices"
+)
+
+// ErrDetachedResponse là lỗi được trả về khi ghi response từ context được tạo bởi Copy.
+// Response gốc có thể đã hoàn tất khi goroutine nền chạy nên bản sao không được ghi vào đó.
+var ErrDetachedResponse = errors.New("context: cannot write response from a copied context")
+
+// detachedWriter là http.ResponseWriter của bản sao context, bỏ qua mọi thao tác ghi.
+type detachedWriter struct {
+	header http.Header
+}
+
+// Header trả về header riêng của bản sao, không ảnh hưởng response gốc.
+func (w *detachedWriter) Header() http.Header {
+	return w.header
+}
+
+// Write không ghi dữ liệu và trả về ErrDetachedResponse.
+func (w *detachedWriter) Write([]byte) (int, error) {
+	return 0, ErrDetachedResponse
+}
+
+// WriteHeader không có tác dụng với bản sao.
+func (w *detachedWriter) WriteHeader(int) {}
+
+// Copy tạo bản sao chỉ đọc của context để dùng trong goroutine nền sau khi handler trả về.
+// Bản sao giữ params, store, lỗi đã ghi nhận, metadata của route và request (không có body); context.Context
+// của bản sao không bị hủy khi request kết thúc và response của bản sao không ghi được.
+//
+// Returns:
+//   - Context: Bản sao tách khỏi request hiện tại
+func (c *forkContext) Copy() Context {
+	c.mu.RLock()
+	store := maps.Clone(c.store)
+	errs := slices.Clone(c.errors)
+	var bridge *storeBridge
+	if c.bridge != nil {
+		bridge = &storeBridge{all: c.bridge.all, keys: maps.Clone(c.bridge.keys)}
+	}
+	c.mu.RUnlock()
+	if store == nil {
+		store = make(map[string]interface{})
+	}
+
+	// Bản sao không bị hủy khi request kết thúc nhưng vẫn giữ các giá trị của context gốc
+	ctx := context.WithoutCancel(c.ctx)
+	if bridge != nil {
+		ctx = bridge.wrap(ctx)
+	}
+
+	// Body của request gốc không còn đọc được sau khi handler trả về
+	req := c.request.Request().Clone(ctx)
+	req.Body = http.NoBody
+
+	cp := &forkContext{
+		request:   NewRequest(req),
+		response:  NewResponse(&detachedWriter{header: make(http.Header)}),
+		ctx:       ctx,
+		params:    maps.Clone(c.params),
+		routeMeta: maps.Clone(c.routeMeta),
+		fullPath:  c.fullPath,
+		index:     -1,
+		store:     store,
+		validator: c.validator,
+		logger:    c.logger,
+		bridge:    bridge,
+		errors:    errs,
+	}
+	if bridge != nil {
+		bridge.c = cp
+	}
+	return cp
+}
@@ -0,0 +1,89 @@
+package context
+
+import (
+	gocontext "context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContextCopy(t *testing.T) {
+	reqCtx, cancel := gocontext.WithCancel(gocontext.WithValue(gocontext.Background(), traceKey{}, "t-1"))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users/42?verbose=1", strings.NewReader(`{"name":"alice"}`)).WithContext(reqCtx)
+	req.Header.Set("X-Request-Id", "req-1")
+	ctx := NewContext(w, req)
+	ctx.SetParams(map[string]string{"id": "42"})
+	ctx.SetFullPath("/users/:id")
+	ctx.Set("user", "alice")
+	ctx.BridgeStore("user")
+
+	cp := ctx.Copy()
+
+	// Thay đổi trên context gốc sau khi copy không ảnh hưởng bản sao
+	ctx.Set("user", "bob")
+	ctx.Params()["id"] = "7"
+	cancel()
+
+	if got := cp.GetString("user"); got != "alice" {
+		t.Errorf("Expected store snapshot alice, got %q", got)
+	}
+	if got := cp.Param("id"); got != "42" {
+		t.Errorf("Expected param snapshot 42, got %q", got)
+	}
+	if cp.FullPath() != "/users/:id" || cp.Method() != "POST" || cp.Query("verbose") != "1" || cp.GetHeader("X-Request-Id") != "req-1" {
+		t.Errorf("Expected request metadata to be copied, got %s %s %q", cp.Method(), cp.FullPath(), cp.GetHeader("X-Request-Id"))
+	}
+
+	// context.Context của bản sao không bị hủy theo request nhưng giữ các giá trị
+	if cp.Err() != nil {
+		t.Errorf("Expected copy not to be canceled with the request, got %v", cp.Err())
+	}
+	if got := cp.Value(traceKey{}); got != "t-1" {
+		t.Errorf("Expected request context values to be kept, got %v", got)
+	}
+	if value, ok := StoreValue(cp.Context(), "user"); !ok || value != "alice" {
+		t.Errorf("Expected bridged store of the copy, got %v", value)
+	}
+
+	// Response của bản sao không ghi vào response gốc
+	if _, err := cp.Response().Write([]byte("late")); !errors.Is(err, ErrDetachedResponse) {
+		t.Errorf("Expected ErrDetachedResponse, got %v", err)
+	}
+	cp.Header("X-Late", "1")
+	if w.Body.Len() != 0 || w.Header().Get("X-Late") != "" {
+		t.Errorf("Expected original response untouched, got %q", w.Body.String())
+	}
+	if body, _ := cp.GetRawData(); len(body) != 0 {
+		t.Errorf("Expected copy to have no body, got %q", body)
+	}
+}
+
+func TestContextCopyAfterHandler(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	ctx.Set("job", "export")
+
+	done := make(chan string, 1)
+	ctx.SetHandlers([]func(Context){
+		func(c Context) {
+			cp := c.Copy()
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				done <- cp.GetString("job")
+			}()
+		},
+	})
+	ctx.Next()
+	ctx.Set("job", "changed")
+
+	select {
+	case got := <-done:
+		if got != "export" {
+			t.Errorf("Expected job export, got %q", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected background goroutine to finish")
+	}
+}
@@ -0,0 +1,74 @@
+package context
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+// eventStreamType là MIME type của server-sent events.
+const eventStreamType = "text/event-stream"
+
+// closeNotifier được implement bởi writer của adapter thông báo khi client đóng kết nối
+// (cùng chữ ký với http.CloseNotifier). Chỉ được dùng khi request context không hỗ trợ hủy.
+type closeNotifier interface {
+	CloseNotify() <-chan bool
+}
+
+// IsClientGone kiểm tra client đã ngắt kết nối hay chưa.
+//
+// Returns:
+//   - bool: true nếu request context bị hủy hoặc adapter báo kết nối đã đóng
+func (c *forkContext) IsClientGone() bool {
+	if c.clientGone {
+		return true
+	}
+
+	reqCtx := c.request.Request().Context()
+	if reqCtx.Done() != nil {
+		c.clientGone = errors.Is(reqCtx.Err(), context.Canceled)
+		return c.clientGone
+	}
+
+	if notifier, ok := c.response.ResponseWriter().(closeNotifier); ok {
+		select {
+		case <-notifier.CloseNotify():
+			c.clientGone = true
+		default:
+		}
+	}
+	return c.clientGone
+}
+
+// isStreaming kiểm tra request là streaming/SSE: client yêu cầu event stream,
+// response đã được đánh dấu event stream hoặc đang được ghi bởi Stream.
+func (c *forkContext) isStreaming() bool {
+	return c.streaming ||
+		strings.Contains(c.GetHeader("Accept"), eventStreamType) ||
+		strings.HasPrefix(c.response.Header().Get("Content-Type"), eventStreamType)
+}
+
+// copyUntilGone sao chép dữ liệu từ r vào response theo từng chunk, flush sau mỗi chunk
+// và dừng (abort chuỗi handlers) khi client ngắt kết nối.
+func (c *forkContext) copyUntilGone(r io.Reader) {
+	c.streaming = true
+	buf := make([]byte, 32*1024)
+	for {
+		if c.IsClientGone() {
+			c.Abort()
+			return
+		}
+		n, err := r.Read(buf)
+		if n > 0 {
+			if _, werr := c.response.Write(buf[:n]); werr != nil {
+				c.Abort()
+				return
+			}
+			c.response.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package context
+
+import (
+	gocontext "context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// notifyingRecorder mô phỏng writer của adapter có thông báo đóng kết nối
+type notifyingRecorder struct {
+	*httptest.ResponseRecorder
+	closed chan bool
+}
+
+func (r *notifyingRecorder) CloseNotify() <-chan bool { return r.closed }
+
+// goneReader trả về dữ liệu và hủy request context sau lần đọc đầu tiên
+type goneReader struct {
+	cancel func()
+	reads  int
+}
+
+func (r *goneReader) Read(p []byte) (int, error) {
+	r.reads++
+	if r.reads > 1 {
+		r.cancel()
+	}
+	return copy(p, "data: tick\n\n"), nil
+}
+
+func TestContextDone(t *testing.T) {
+	reqCtx, cancel := gocontext.WithCancel(gocontext.Background())
+	req := httptest.NewRequest("GET", "/", nil).WithContext(reqCtx)
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	if ctx.IsClientGone() {
+		t.Error("Expected client to be connected")
+	}
+	select {
+	case <-ctx.Done():
+		t.Fatal("Expected Done to block before disconnect")
+	default:
+	}
+
+	cancel()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Expected Done to be closed after disconnect")
+	}
+	if !ctx.IsClientGone() {
+		t.Error("Expected client to be gone after cancel")
+	}
+
+	// Hết deadline không có nghĩa là client ngắt kết nối
+	deadlineCtx, cancelDeadline := gocontext.WithDeadline(gocontext.Background(), time.Now().Add(-time.Second))
+	defer cancelDeadline()
+	ctx = NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil).WithContext(deadlineCtx))
+	if ctx.IsClientGone() {
+		t.Error("Expected deadline exceeded not to report client gone")
+	}
+}
+
+func TestContextIsClientGoneCloseNotify(t *testing.T) {
+	w := &notifyingRecorder{ResponseRecorder: httptest.NewRecorder(), closed: make(chan bool, 1)}
+	ctx := NewContext(w, httptest.NewRequest("GET", "/", nil).WithContext(gocontext.Background()))
+
+	if ctx.IsClientGone() {
+		t.Error("Expected client to be connected")
+	}
+	w.closed <- true
+	if !ctx.IsClientGone() || !ctx.IsClientGone() {
+		t.Error("Expected close notification to be remembered")
+	}
+}
+
+func TestContextAbortOnDisconnect(t *testing.T) {
+	run := func(accept string) bool {
+		reqCtx, cancel := gocontext.WithCancel(gocontext.Background())
+		req := httptest.NewRequest("GET", "/events", nil).WithContext(reqCtx)
+		req.Header.Set("Accept", accept)
+		ctx := NewContext(httptest.NewRecorder(), req)
+
+		called := false
+		ctx.SetHandlers([]func(Context){
+			func(c Context) {
+				cancel()
+				c.Next()
+			},
+			func(c Context) { called = true },
+		})
+		ctx.Next()
+		return called
+	}
+
+	if run("text/event-stream") {
+		t.Error("Expected SSE handler to be skipped after disconnect")
+	}
+	if !run("application/json") {
+		t.Error("Expected regular handler to keep running")
+	}
+}
+
+func TestContextStreamStopsOnDisconnect(t *testing.T) {
+	reqCtx, cancel := gocontext.WithCancel(gocontext.Background())
+	w := httptest.NewRecorder()
+	ctx := NewContext(w, httptest.NewRequest("GET", "/", nil).WithContext(reqCtx))
+
+	reader := &goneReader{cancel: cancel}
+	ctx.Stream(http.StatusOK, "text/event-stream", reader)
+
+	if !ctx.IsAborted() {
+		t.Error("Expected chain to be aborted after disconnect")
+	}
+	if got := strings.Count(w.Body.String(), "tick"); got != 2 {
+		t.Errorf("Expected 2 chunks before stop, got %d", got)
+	}
+	if !w.Flushed {
+		t.Error("Expected stream to be flushed")
+	}
+
+	// Reader kết thúc bình thường
+	w = httptest.NewRecorder()
+	ctx = NewContext(w, httptest.NewRequest("GET", "/", nil))
+	ctx.Stream(http.StatusOK, "text/plain", io.LimitReader(strings.NewReader(strings.Repeat("x", 100000)), 100000))
+	if w.Body.Len() != 100000 || ctx.IsAborted() {
+		t.Errorf("Expected full body without abort, got %d bytes", w.Body.Len())
+	}
+}
@@ -0,0 +1,27 @@
+package context
+
+import "slices"
+
+// AddError ghi nhận một lỗi của request để middleware xử lý lỗi hoặc logging ở cuối chuỗi
+// xử lý tập trung. Lỗi nil bị bỏ qua.
+//
+// Params:
+//   - err: Lỗi cần ghi nhận
+func (c *forkContext) AddError(err error) {
+	if err == nil {
+		return
+	}
+	c.mu.Lock()
+	c.errors = append(c.errors, err)
+	c.mu.Unlock()
+}
+
+// Errors trả về các lỗi đã ghi nhận bởi AddError theo thứ tự thêm vào.
+//
+// Returns:
+//   - []error: Bản sao danh sách lỗi, nil nếu không có lỗi
+func (c *forkContext) Errors() []error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Clone(c.errors)
+}
@@ -0,0 +1,61 @@
+package context
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContextErrors(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	if ctx.Errors() != nil {
+		t.Error("Expected no errors initially")
+	}
+
+	errDB := errors.New("db unavailable")
+	errCache := errors.New("cache miss")
+
+	var collected []error
+	ctx.SetHandlers([]func(Context){
+		// Middleware xử lý lỗi tập trung sau khi chuỗi handlers hoàn tất
+		func(c Context) {
+			c.Next()
+			collected = c.Errors()
+		},
+		func(c Context) {
+			c.AddError(errCache)
+			c.AddError(nil)
+			c.Next()
+		},
+		func(c Context) {
+			c.AddError(errDB)
+		},
+	})
+	ctx.Next()
+
+	if len(collected) != 2 || collected[0] != errCache || collected[1] != errDB {
+		t.Errorf("Expected errors in order, got %v", collected)
+	}
+	if !errors.Is(errors.Join(collected...), errDB) {
+		t.Error("Expected joined errors to match errDB")
+	}
+
+	// Errors trả về bản sao
+	collected[0] = nil
+	if ctx.Errors()[0] != errCache {
+		t.Error("Expected Errors to return a copy")
+	}
+
+	cp := ctx.Copy()
+	ctx.AddError(errors.New("late"))
+	if len(cp.Errors()) != 2 {
+		t.Errorf("Expected copy to keep its own errors, got %v", cp.Errors())
+	}
+
+	ReleaseContext(ctx)
+	ctx = AcquireContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	defer ReleaseContext(ctx)
+	if ctx.Errors() != nil {
+		t.Errorf("Expected pooled context to start without errors, got %v", ctx.Errors())
+	}
+}
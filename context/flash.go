@@ -0,0 +1,98 @@
+package context
+
+// Các khóa dùng bởi flash messages.
+const (
+	// SessionKey là khóa trong context store chứa session do session middleware thiết lập
+	SessionKey = "session"
+
+	// FlashSessionKey là khóa trong session chứa các flash message của request tiếp theo
+	FlashSessionKey = "_flashes"
+
+	// flashesStoreKey là khóa trong context store lưu các flash message đã đọc trong request
+	flashesStoreKey = "_flashes"
+)
+
+// FlashMessage là một thông báo chỉ hiển thị ở request tiếp theo, thường sau redirect.
+type FlashMessage struct {
+	// Category phân loại thông báo, ví dụ "success", "error"
+	Category string `json:"category"`
+
+	// Message là nội dung thông báo
+	Message string `json:"message"`
+}
+
+// flashSession là interface tối thiểu của session được session middleware lưu trong context store.
+type flashSession interface {
+	Get(key string) interface{}
+	Set(key string, value interface{})
+	Delete(key string)
+}
+
+// session trả về session trong context store, nil nếu session middleware chưa được đăng ký.
+func (c *forkContext) session() flashSession {
+	value, ok := c.Get(SessionKey)
+	if !ok {
+		return nil
+	}
+	session, _ := value.(flashSession)
+	return session
+}
+
+// Flash lưu một flash message vào session để hiển thị ở request tiếp theo.
+// Không có tác dụng nếu session middleware chưa được đăng ký.
+//
+// Params:
+//   - category: Loại thông báo, ví dụ "success", "error"
+//   - message: Nội dung thông báo
+func (c *forkContext) Flash(category, message string) {
+	session := c.session()
+	if session == nil {
+		return
+	}
+	existing := toFlashMessages(session.Get(FlashSessionKey))
+	flashes := make([]FlashMessage, 0, len(existing)+1)
+	flashes = append(append(flashes, existing...), FlashMessage{Category: category, Message: message})
+	session.Set(FlashSessionKey, flashes)
+}
+
+// Flashes trả về các flash message được lưu bởi request trước và xóa chúng khỏi session.
+// Các lần gọi sau trong cùng request trả về cùng kết quả.
+//
+// Returns:
+//   - []FlashMessage: Các flash message theo thứ tự được thêm, nil nếu không có
+func (c *forkContext) Flashes() []FlashMessage {
+	if value, ok := c.Get(flashesStoreKey); ok {
+		flashes, _ := value.([]FlashMessage)
+		return flashes
+	}
+
+	var flashes []FlashMessage
+	if session := c.session(); session != nil {
+		flashes = toFlashMessages(session.Get(FlashSessionKey))
+		session.Delete(FlashSessionKey)
+	}
+	c.Set(flashesStoreKey, flashes)
+	return flashes
+}
+
+// toFlashMessages chuyển dữ liệu flash trong session (có thể đã qua serialize) về []FlashMessage.
+func toFlashMessages(value interface{}) []FlashMessage {
+	switch v := value.(type) {
+	case []FlashMessage:
+		return v
+	case []interface{}:
+		flashes := make([]FlashMessage, 0, len(v))
+		for _, item := range v {
+			switch flash := item.(type) {
+			case FlashMessage:
+				flashes = append(flashes, flash)
+			case map[string]interface{}:
+				category, _ := flash["category"].(string)
+				message, _ := flash["message"].(string)
+				flashes = append(flashes, FlashMessage{Category: category, Message: message})
+			}
+		}
+		return flashes
+	}
+	return nil
+}
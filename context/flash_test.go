@@ -0,0 +1,72 @@
+package context
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// memorySession mô phỏng session của session middleware
+type memorySession map[string]interface{}
+
+func (s memorySession) Get(key string) interface{}        { return s[key] }
+func (s memorySession) Set(key string, value interface{}) { s[key] = value }
+func (s memorySession) Delete(key string)                 { delete(s, key) }
+
+func sessionContext(session memorySession) Context {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	ctx.Set(SessionKey, session)
+	return ctx
+}
+
+func TestContextFlash(t *testing.T) {
+	session := memorySession{}
+
+	// Request POST lưu flash rồi redirect
+	ctx := sessionContext(session)
+	ctx.Flash("success", "Saved")
+	ctx.Flash("warning", "Check your email")
+
+	// Request GET sau redirect đọc flash một lần
+	ctx = sessionContext(session)
+	expected := []FlashMessage{{"success", "Saved"}, {"warning", "Check your email"}}
+	if got := ctx.Flashes(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if got := ctx.Flashes(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected same flashes within the request, got %v", got)
+	}
+	if _, exists := session[FlashSessionKey]; exists {
+		t.Error("Expected flashes to be removed from the session")
+	}
+
+	// Request tiếp theo không còn flash
+	if got := sessionContext(session).Flashes(); got != nil {
+		t.Errorf("Expected no flashes, got %v", got)
+	}
+}
+
+func TestContextFlashSerializedSession(t *testing.T) {
+	// Session store serialize dữ liệu dạng JSON
+	data, _ := json.Marshal(map[string]interface{}{FlashSessionKey: []FlashMessage{{"error", "Failed"}}})
+	session := memorySession{}
+	if err := json.Unmarshal(data, &session); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := sessionContext(session)
+	ctx.Flash("info", "Retry")
+	expected := []FlashMessage{{"error", "Failed"}, {"info", "Retry"}}
+	if got := ctx.Flashes(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestContextFlashWithoutSession(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	ctx.Flash("success", "Saved")
+	if got := ctx.Flashes(); got != nil {
+		t.Errorf("Expected no flashes without session, got %v", got)
+	}
+}
@@ -0,0 +1,104 @@
+package context
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrHeaderMissing là lỗi được trả về bởi GetHeaderInt và GetHeaderTime khi request không có
+// header với tên đã cho hoặc header có giá trị rỗng.
+var ErrHeaderMissing = errors.New("request header missing")
+
+// headerValues trả về các giá trị của header request theo tên. Ngoài khóa đã chuẩn hóa
+// (textproto.CanonicalMIMEHeaderKey), các khóa không chuẩn hóa được gán trực tiếp vào
+// http.Header (ví dụ bởi proxy hoặc test) cũng được tìm theo so sánh không phân biệt hoa thường.
+//
+// Params:
+//   - key: Tên header
+//
+// Returns:
+//   - []string: Các giá trị của header, nil nếu không có
+func (c *forkContext) headerValues(key string) []string {
+	header := c.request.Header()
+	if values := header[textproto.CanonicalMIMEHeaderKey(key)]; len(values) > 0 {
+		return values
+	}
+	for name, values := range header {
+		if strings.EqualFold(name, key) && len(values) > 0 {
+			return values
+		}
+	}
+	return nil
+}
+
+// headerValue trả về giá trị đầu tiên của header, ErrHeaderMissing nếu không có hoặc rỗng.
+func (c *forkContext) headerValue(key string) (string, error) {
+	value := strings.TrimSpace(c.GetHeader(key))
+	if value == "" {
+		return "", fmt.Errorf("%w: %s", ErrHeaderMissing, key)
+	}
+	return value, nil
+}
+
+// headerError bọc lỗi parse header kèm tên header.
+func headerError(key string, err error) error {
+	return fmt.Errorf("invalid header %s: %w", key, err)
+}
+
+// GetHeaders trả về tất cả giá trị của header request theo tên, ví dụ khi header được gửi
+// nhiều lần.
+//
+// Params:
+//   - key: Tên header, không phân biệt hoa thường
+//
+// Returns:
+//   - []string: Các giá trị của header, nil nếu không có
+func (c *forkContext) GetHeaders(key string) []string {
+	return c.headerValues(key)
+}
+
+// GetHeaderInt trả về header request dạng int, ví dụ Content-Length hoặc X-RateLimit-Remaining.
+//
+// Params:
+//   - key: Tên header, không phân biệt hoa thường
+//
+// Returns:
+//   - int: Giá trị đã parse
+//   - error: ErrHeaderMissing nếu header không có, hoặc lỗi parse
+func (c *forkContext) GetHeaderInt(key string) (int, error) {
+	value, err := c.headerValue(key)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, headerError(key, err)
+	}
+	return n, nil
+}
+
+// GetHeaderTime trả về header request dạng time.Time theo định dạng HTTP date
+// (RFC 1123, RFC 850 hoặc ANSI C asctime), ví dụ If-Modified-Since.
+//
+// Params:
+//   - key: Tên header, không phân biệt hoa thường
+//
+// Returns:
+//   - time.Time: Giá trị đã parse
+//   - error: ErrHeaderMissing nếu header không có, hoặc lỗi parse
+func (c *forkContext) GetHeaderTime(key string) (time.Time, error) {
+	value, err := c.headerValue(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return time.Time{}, headerError(key, err)
+	}
+	return t, nil
+}
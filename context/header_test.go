@@ -0,0 +1,51 @@
+package context
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestContextTypedHeaders(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Retry-Count", "3")
+	req.Header.Set("If-Modified-Since", "Wed, 21 Oct 2015 07:28:00 GMT")
+	req.Header.Set("X-Bad", "abc")
+	req.Header.Add("X-Forwarded-For", "10.0.0.1")
+	req.Header.Add("X-Forwarded-For", "10.0.0.2")
+	req.Header["x-lowercase"] = []string{"raw"}
+	ctx := NewContext(httptest.NewRecorder(), req)
+
+	if n, err := ctx.GetHeaderInt("x-retry-count"); err != nil || n != 3 {
+		t.Errorf("Expected retry count 3, got %d (%v)", n, err)
+	}
+	expected := time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC)
+	if got, err := ctx.GetHeaderTime("If-Modified-Since"); err != nil || !got.Equal(expected) {
+		t.Errorf("Expected %v, got %v (%v)", expected, got, err)
+	}
+	if got := ctx.GetHeaders("x-forwarded-for"); len(got) != 2 || got[1] != "10.0.0.2" {
+		t.Errorf("Expected both X-Forwarded-For values, got %v", got)
+	}
+	if got := ctx.GetHeader("X-Lowercase"); got != "raw" {
+		t.Errorf("Expected non-canonical header to be found, got %q", got)
+	}
+	if got := ctx.GetHeaders("X-Missing"); got != nil {
+		t.Errorf("Expected nil for missing header, got %v", got)
+	}
+
+	if _, err := ctx.GetHeaderInt("X-Missing"); !errors.Is(err, ErrHeaderMissing) {
+		t.Errorf("Expected ErrHeaderMissing, got %v", err)
+	}
+	if _, err := ctx.GetHeaderTime("X-Missing"); !errors.Is(err, ErrHeaderMissing) {
+		t.Errorf("Expected ErrHeaderMissing, got %v", err)
+	}
+	var numErr *strconv.NumError
+	if _, err := ctx.GetHeaderInt("X-Bad"); !errors.As(err, &numErr) {
+		t.Errorf("Expected strconv.NumError for invalid int, got %v", err)
+	}
+	if _, err := ctx.GetHeaderTime("X-Bad"); err == nil || errors.Is(err, ErrHeaderMissing) {
+		t.Errorf("Expected parse error for invalid date, got %v", err)
+	}
+}
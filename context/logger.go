@@ -0,0 +1,84 @@
+package context
+
+import (
+	"log/slog"
+)
+
+// Logger là logger được Context.Logger sử dụng. log.Manager của go.fork.vn/log implement
+// Logger; args là các cặp key-value bổ sung vào bản ghi log.
+type Logger interface {
+	// Debug ghi log ở mức debug.
+	Debug(message string, args ...interface{})
+
+	// Info ghi log ở mức info.
+	Info(message string, args ...interface{})
+
+	// Warning ghi log ở mức warning.
+	Warning(message string, args ...interface{})
+
+	// Error ghi log ở mức error.
+	Error(message string, args ...interface{})
+}
+
+// slogLogger ghi log qua slog.Default, dùng khi context chưa được gắn logger.
+type slogLogger struct{}
+
+func (slogLogger) Debug(message string, args ...interface{})   { slog.Debug(message, args...) }
+func (slogLogger) Info(message string, args ...interface{})    { slog.Info(message, args...) }
+func (slogLogger) Warning(message string, args ...interface{}) { slog.Warn(message, args...) }
+func (slogLogger) Error(message string, args ...interface{})   { slog.Error(message, args...) }
+
+// requestLogger thêm các field của request vào trước args của mỗi bản ghi log.
+type requestLogger struct {
+	base   Logger
+	fields []interface{}
+}
+
+func (l *requestLogger) with(args []interface{}) []interface{} {
+	return append(l.fields[:len(l.fields):len(l.fields)], args...)
+}
+
+func (l *requestLogger) Debug(message string, args ...interface{}) {
+	l.base.Debug(message, l.with(args)...)
+}
+
+func (l *requestLogger) Info(message string, args ...interface{}) {
+	l.base.Info(message, l.with(args)...)
+}
+
+func (l *requestLogger) Warning(message string, args ...interface{}) {
+	l.base.Warning(message, l.with(args)...)
+}
+
+func (l *requestLogger) Error(message string, args ...interface{}) {
+	l.base.Error(message, l.with(args)...)
+}
+
+// Logger trả về logger đã gắn sẵn thông tin của request: request_id (theo RequestID), method,
+// path và route.
+// Logger gốc là logger của ứng dụng (log.Manager trong DI container khi dùng ServiceProvider),
+// hoặc slog.Default nếu context chưa được gắn logger.
+//
+// Returns:
+//   - Logger: Logger của request
+func (c *forkContext) Logger() Logger {
+	base := c.logger
+	if base == nil {
+		base = slogLogger{}
+	}
+
+	fields := make([]interface{}, 0, 8)
+	fields = append(fields, "request_id", c.RequestID(), "method", c.Method(), "path", c.Path())
+	if route := c.FullPath(); route != "" {
+		fields = append(fields, "route", route)
+	}
+	return &requestLogger{base: base, fields: fields}
+}
+
+// SetLogger gắn logger của ứng dụng vào context.
+//
+// Params:
+//   - logger: Logger gốc cho Logger, nil để dùng slog.Default
+func (c *forkContext) SetLogger(logger Logger) {
+	c.logger = logger
+}
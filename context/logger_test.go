@@ -0,0 +1,82 @@
+package context
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// recordingLogger ghi lại các bản ghi log để kiểm tra.
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (l *recordingLogger) record(level, message string, args []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, fmt.Sprintf("%s %s %v", level, message, args))
+}
+
+func (l *recordingLogger) Debug(message string, args ...interface{}) {
+	l.record("debug", message, args)
+}
+
+func (l *recordingLogger) Info(message string, args ...interface{}) {
+	l.record("info", message, args)
+}
+
+func (l *recordingLogger) Warning(message string, args ...interface{}) {
+	l.record("warning", message, args)
+}
+
+func (l *recordingLogger) Error(message string, args ...interface{}) {
+	l.record("error", message, args)
+}
+
+func TestContextLogger(t *testing.T) {
+	req := httptest.NewRequest("GET", "/users/42", nil)
+	req.Header.Set("X-Request-ID", "req-1")
+	ctx := NewContext(httptest.NewRecorder(), req)
+	ctx.SetFullPath("/users/:id")
+
+	logger := &recordingLogger{}
+	ctx.SetLogger(logger)
+
+	ctx.Logger().Info("user loaded", "user_id", 42)
+	ctx.Set(RequestIDKey, "req-from-store")
+	ctx.Logger().Error("failed")
+
+	expected := []string{
+		"info user loaded [request_id req-1 method GET path /users/42 route /users/:id user_id 42]",
+		"error failed [request_id req-from-store method GET path /users/42 route /users/:id]",
+	}
+	if len(logger.entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %v", len(expected), logger.entries)
+	}
+	for i, entry := range expected {
+		if logger.entries[i] != entry {
+			t.Errorf("Entry %d: expected %q, got %q", i, entry, logger.entries[i])
+		}
+	}
+}
+
+func TestContextLoggerDefaults(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("POST", "/items", nil))
+	ctx.Set(RequestIDKey, "generated")
+	if _, ok := ctx.Logger().(*requestLogger).base.(slogLogger); !ok {
+		t.Error("Expected slog fallback without an application logger")
+	}
+
+	logger := &recordingLogger{}
+	ctx.SetLogger(logger)
+	ctx.Logger().Warning("no route")
+	if len(logger.entries) != 1 || logger.entries[0] != "warning no route [request_id generated method POST path /items]" {
+		t.Errorf("Expected entry without route, got %v", logger.entries)
+	}
+
+	if copied := ctx.Copy(); copied.Logger().(*requestLogger).base != Logger(logger) {
+		t.Error("Expected Copy to keep the application logger")
+	}
+}
@@ -0,0 +1,20 @@
+package context
+
+import (
+	"mime/multipart"
+)
+
+// MultipartReader trả về multipart.Reader để đọc từng part của request multipart/form-data
+// theo dạng stream, không buffer toàn bộ form vào bộ nhớ hoặc file tạm như MultipartForm.
+// Phù hợp cho upload lớn cần chuyển thẳng tới object storage.
+//
+// Không thể dùng cùng MultipartForm, FormFile hoặc binding form trong cùng request
+// vì body chỉ được đọc một lần.
+//
+// Returns:
+//   - *multipart.Reader: Reader để duyệt các part qua NextPart
+//   - error: http.ErrNotMultipart nếu request không phải multipart, hoặc lỗi nếu
+//     body đã được parse bởi ParseMultipartForm
+func (c *forkContext) MultipartReader() (*multipart.Reader, error) {
+	return c.request.Request().MultipartReader()
+}
@@ -0,0 +1,76 @@
+package context
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func multipartContext(t *testing.T) Context {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	if err := mw.WriteField("title", "report"); err != nil {
+		t.Fatal(err)
+	}
+	fw, err := mw.CreateFormFile("file", "report.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(fw, "a,b\n1,2\n")
+	mw.Close()
+
+	req := httptest.NewRequest("POST", "/upload", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return NewContext(httptest.NewRecorder(), req)
+}
+
+func TestContextMultipartReader(t *testing.T) {
+	ctx := multipartContext(t)
+	reader, err := ctx.MultipartReader()
+	if err != nil {
+		t.Fatalf("Expected multipart reader, got %v", err)
+	}
+
+	parts := map[string]string{}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error reading part: %v", err)
+		}
+		data, _ := io.ReadAll(part)
+		parts[part.FormName()] = string(data)
+		if part.FormName() == "file" && part.FileName() != "report.csv" {
+			t.Errorf("Expected file name report.csv, got %q", part.FileName())
+		}
+	}
+
+	if parts["title"] != "report" || parts["file"] != "a,b\n1,2\n" {
+		t.Errorf("Unexpected parts: %v", parts)
+	}
+}
+
+func TestContextMultipartReaderErrors(t *testing.T) {
+	req := httptest.NewRequest("POST", "/upload", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	ctx := NewContext(httptest.NewRecorder(), req)
+	if _, err := ctx.MultipartReader(); !errors.Is(err, http.ErrNotMultipart) {
+		t.Errorf("Expected http.ErrNotMultipart, got %v", err)
+	}
+
+	ctx = multipartContext(t)
+	if _, err := ctx.MultipartForm(); err != nil {
+		t.Fatalf("Unexpected error parsing form: %v", err)
+	}
+	if _, err := ctx.MultipartReader(); err == nil {
+		t.Error("Expected error after MultipartForm consumed the body")
+	}
+}
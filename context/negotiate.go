@@ -0,0 +1,167 @@
+package context
+
+import (
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+
+	forkerrors "go.fork.vn/fork/errors"
+)
+
+// Negotiate cấu hình response cho Context.Negotiate.
+type Negotiate struct {
+	// Offered là các MIME type handler có thể trả về, theo thứ tự ưu tiên của server.
+	// Hỗ trợ "application/json", "application/xml", "text/xml", "text/html" và "text/plain".
+	Offered []string
+
+	// Data là dữ liệu response dùng cho mọi định dạng không có dữ liệu riêng
+	Data interface{}
+
+	// HTMLName là tên template render bằng Render khi chọn "text/html";
+	// rỗng để ghi Data (hoặc HTMLData) dưới dạng chuỗi HTML
+	HTMLName string
+
+	// HTMLData là dữ liệu riêng cho "text/html", nil để dùng Data
+	HTMLData interface{}
+
+	// JSONData là dữ liệu riêng cho "application/json", nil để dùng Data
+	JSONData interface{}
+
+	// XMLData là dữ liệu riêng cho XML, nil để dùng Data
+	XMLData interface{}
+}
+
+// acceptRange là một media range trong header Accept.
+type acceptRange struct {
+	// mediaType là media range dạng chữ thường, ví dụ "text/*"
+	mediaType string
+
+	// quality là giá trị q của media range, trong khoảng [0, 1]
+	quality float64
+}
+
+// Accepts chọn MIME type phù hợp nhất với header Accept của request theo q-values.
+//
+// Params:
+//   - offers: Các MIME type server có thể trả về, theo thứ tự ưu tiên
+//
+// Returns:
+//   - string: Offer được chọn, offer đầu tiên nếu request không có header Accept,
+//     rỗng nếu không offer nào được chấp nhận
+func (c *forkContext) Accepts(offers ...string) string {
+	if len(offers) == 0 {
+		return ""
+	}
+	ranges := parseAccept(c.GetHeader("Accept"))
+	if len(ranges) == 0 {
+		return offers[0]
+	}
+
+	best, bestQuality := "", 0.0
+	for _, offer := range offers {
+		if quality := acceptQuality(ranges, mediaType(offer)); quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+	return best
+}
+
+// Negotiate ghi response theo định dạng được chọn bởi Accepts từ config.Offered.
+// Response có header "Vary: Accept"; nếu không định dạng nào được chấp nhận,
+// trả về 406 Not Acceptable.
+//
+// Params:
+//   - code: HTTP status code
+//   - config: Các định dạng và dữ liệu response
+func (c *forkContext) Negotiate(code int, config Negotiate) {
+	c.response.Header().Add("Vary", "Accept")
+
+	switch mediaType(c.Accepts(config.Offered...)) {
+	case "application/json":
+		c.JSON(code, negotiatedData(config.JSONData, config.Data))
+	case "application/xml", "text/xml":
+		c.XML(code, negotiatedData(config.XMLData, config.Data))
+	case "text/html":
+		data := negotiatedData(config.HTMLData, config.Data)
+		if config.HTMLName != "" {
+			c.Render(code, config.HTMLName, data)
+			return
+		}
+		c.HTML(code, fmt.Sprint(data))
+	case "text/plain":
+		c.String(code, "%v", config.Data)
+	default:
+		details := map[string]interface{}{
+			"offered": config.Offered,
+		}
+		httpError := forkerrors.NewNotAcceptable("Not acceptable", details, nil)
+		c.JSON(httpError.StatusCode, httpError)
+	}
+}
+
+// negotiatedData trả về dữ liệu riêng của định dạng nếu có, ngược lại là dữ liệu chung.
+func negotiatedData(specific, data interface{}) interface{} {
+	if specific != nil {
+		return specific
+	}
+	return data
+}
+
+// parseAccept phân tích header Accept thành các media range, bỏ qua phần tử không hợp lệ.
+//
+// Parameters:
+//   - header: Giá trị header Accept
+//
+// Returns:
+//   - []acceptRange: Các media range theo thứ tự trong header
+func parseAccept(header string) []acceptRange {
+	var ranges []acceptRange
+	for _, part := range strings.Split(header, ",") {
+		value, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || !strings.Contains(value, "/") {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				continue
+			}
+			quality = parsed
+		}
+		ranges = append(ranges, acceptRange{mediaType: value, quality: quality})
+	}
+	return ranges
+}
+
+// acceptQuality trả về q-value của media range cụ thể nhất khớp với offer
+// ("type/subtype" hơn "type/*" hơn "*/*").
+//
+// Parameters:
+//   - ranges: Các media range của header Accept
+//   - offer: Media type đã chuẩn hóa của offer
+//
+// Returns:
+//   - float64: q-value áp dụng cho offer, 0 nếu không media range nào khớp
+func acceptQuality(ranges []acceptRange, offer string) float64 {
+	offerType, _, _ := strings.Cut(offer, "/")
+	quality, specificity := 0.0, 0
+	for _, r := range ranges {
+		rangeType, rangeSubtype, _ := strings.Cut(r.mediaType, "/")
+		level := 0
+		switch {
+		case r.mediaType == offer:
+			level = 3
+		case rangeSubtype == "*" && rangeType == offerType:
+			level = 2
+		case r.mediaType == "*/*":
+			level = 1
+		}
+		if level > specificity {
+			quality, specificity = r.quality, level
+		}
+	}
+	return quality
+}
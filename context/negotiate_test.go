@@ -0,0 +1,90 @@
+package context
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func acceptContext(accept string) (Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/users", nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	return NewContext(w, req), w
+}
+
+func TestContextAccepts(t *testing.T) {
+	offers := []string{"application/json", "application/xml", "text/html"}
+	tests := []struct {
+		accept   string
+		expected string
+	}{
+		{"", "application/json"},
+		{"application/xml", "application/xml"},
+		{"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", "text/html"},
+		{"application/json;q=0.5, application/xml", "application/xml"},
+		{"text/*", "text/html"},
+		{"*/*", "application/json"},
+		{"text/html;q=0, */*;q=0.1", "application/json"},
+		{"Application/XML", "application/xml"},
+		{"image/png", ""},
+		{"application/json;q=0", ""},
+		{"application/json;q=abc, text/html", "text/html"},
+		{"garbage", "application/json"},
+	}
+	for _, tt := range tests {
+		ctx, _ := acceptContext(tt.accept)
+		if got := ctx.Accepts(offers...); got != tt.expected {
+			t.Errorf("Accept %q: expected %q, got %q", tt.accept, tt.expected, got)
+		}
+	}
+
+	ctx, _ := acceptContext("application/json")
+	if got := ctx.Accepts(); got != "" {
+		t.Errorf("Expected empty result without offers, got %q", got)
+	}
+}
+
+func TestContextNegotiate(t *testing.T) {
+	type User struct {
+		Name string `json:"name" xml:"name"`
+	}
+	config := Negotiate{
+		Offered:  []string{"application/json", "application/xml", "text/html", "text/plain"},
+		Data:     User{Name: "alice"},
+		HTMLData: "<p>alice</p>",
+	}
+
+	tests := []struct {
+		accept      string
+		code        int
+		contentType string
+		body        string
+	}{
+		{"application/json", http.StatusOK, "application/json", `{"name":"alice"}`},
+		{"application/xml", http.StatusOK, "application/xml", "<User><name>alice</name></User>"},
+		{"text/html", http.StatusOK, "text/html", "<p>alice</p>"},
+		{"text/plain", http.StatusOK, "text/plain", "{alice}"},
+		{"image/png", http.StatusNotAcceptable, "application/json", `"status_code":406`},
+	}
+	for _, tt := range tests {
+		ctx, w := acceptContext(tt.accept)
+		ctx.Negotiate(http.StatusOK, config)
+
+		if w.Code != tt.code {
+			t.Errorf("Accept %q: expected status %d, got %d", tt.accept, tt.code, w.Code)
+		}
+		if !strings.HasPrefix(w.Header().Get("Content-Type"), tt.contentType) {
+			t.Errorf("Accept %q: expected Content-Type %q, got %q", tt.accept, tt.contentType, w.Header().Get("Content-Type"))
+		}
+		if !strings.Contains(w.Body.String(), tt.body) {
+			t.Errorf("Accept %q: expected body to contain %q, got %q", tt.accept, tt.body, w.Body.String())
+		}
+		if w.Header().Get("Vary") != "Accept" {
+			t.Errorf("Accept %q: expected Vary: Accept, got %q", tt.accept, w.Header().Get("Vary"))
+		}
+	}
+}
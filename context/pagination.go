@@ -0,0 +1,237 @@
+package context
+
+import (
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PaginationConfigKey là khóa trong context store chứa PaginationConfig của ứng dụng.
+const PaginationConfigKey = "pagination_config"
+
+// PaginationConfig chứa cấu hình phân trang cho ctx.Pagination và các response helper.
+type PaginationConfig struct {
+	// DefaultLimit là số phần tử mỗi trang khi request không chỉ định.
+	// Mặc định: 20
+	DefaultLimit int
+
+	// MaxLimit là số phần tử tối đa mỗi trang mà client được phép yêu cầu.
+	// Mặc định: 100
+	MaxLimit int
+
+	// PageParam là tên tham số query chứa số trang.
+	// Mặc định: "page"
+	PageParam string
+
+	// LimitParam là tên tham số query chứa số phần tử mỗi trang.
+	// Mặc định: "limit"
+	LimitParam string
+
+	// CursorParam là tên tham số query chứa cursor.
+	// Mặc định: "cursor"
+	CursorParam string
+}
+
+// DefaultPaginationConfig trả về cấu hình phân trang mặc định.
+//
+// Returns:
+//   - PaginationConfig: Cấu hình mặc định
+func DefaultPaginationConfig() PaginationConfig {
+	return PaginationConfig{
+		DefaultLimit: 20,
+		MaxLimit:     100,
+		PageParam:    "page",
+		LimitParam:   "limit",
+		CursorParam:  "cursor",
+	}
+}
+
+// withDefaults điền giá trị mặc định cho các trường chưa thiết lập.
+func (c PaginationConfig) withDefaults() PaginationConfig {
+	defaults := DefaultPaginationConfig()
+	if c.MaxLimit <= 0 {
+		c.MaxLimit = defaults.MaxLimit
+	}
+	if c.DefaultLimit <= 0 {
+		c.DefaultLimit = defaults.DefaultLimit
+	}
+	if c.DefaultLimit > c.MaxLimit {
+		c.DefaultLimit = c.MaxLimit
+	}
+	if c.PageParam == "" {
+		c.PageParam = defaults.PageParam
+	}
+	if c.LimitParam == "" {
+		c.LimitParam = defaults.LimitParam
+	}
+	if c.CursorParam == "" {
+		c.CursorParam = defaults.CursorParam
+	}
+	return c
+}
+
+// Pagination chứa tham số phân trang đã được chuẩn hóa của request.
+type Pagination struct {
+	// Page là số trang, bắt đầu từ 1
+	Page int
+
+	// Limit là số phần tử mỗi trang, nằm trong khoảng [1, MaxLimit]
+	Limit int
+
+	// Offset là số phần tử bỏ qua, tương ứng với (Page-1)*Limit
+	Offset int
+
+	// Cursor là cursor của request khi dùng phân trang theo cursor
+	Cursor string
+}
+
+// PageMeta là thông tin phân trang theo số trang trong envelope của response.
+type PageMeta struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+	HasMore    bool  `json:"has_more"`
+}
+
+// CursorMeta là thông tin phân trang theo cursor trong envelope của response.
+type CursorMeta struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// PaginatedResponse là envelope chuẩn cho response danh sách.
+type PaginatedResponse struct {
+	Data       interface{} `json:"data"`
+	Pagination interface{} `json:"pagination"`
+}
+
+// paginationConfig trả về cấu hình phân trang từ context store hoặc cấu hình mặc định.
+func (c *forkContext) paginationConfig() PaginationConfig {
+	if value, exists := c.Get(PaginationConfigKey); exists {
+		switch config := value.(type) {
+		case PaginationConfig:
+			return config.withDefaults()
+		case *PaginationConfig:
+			if config != nil {
+				return config.withDefaults()
+			}
+		}
+	}
+	return DefaultPaginationConfig()
+}
+
+// Pagination phân tích tham số page, limit và cursor từ query string.
+//
+// Returns:
+//   - Pagination: Tham số phân trang đã chuẩn hóa
+func (c *forkContext) Pagination() Pagination {
+	config := c.paginationConfig()
+
+	limit := config.DefaultLimit
+	if value, err := strconv.Atoi(c.Query(config.LimitParam)); err == nil && value > 0 {
+		limit = min(value, config.MaxLimit)
+	}
+
+	page := 1
+	if value, err := strconv.Atoi(c.Query(config.PageParam)); err == nil && value > 1 {
+		// Giới hạn page để offset không bị tràn số
+		page = min(value, math.MaxInt32/limit+1)
+	}
+
+	return Pagination{
+		Page:   page,
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+		Cursor: c.Query(config.CursorParam),
+	}
+}
+
+// Paginate gửi response danh sách phân trang theo số trang.
+//
+// Params:
+//   - code: HTTP status code
+//   - items: Các phần tử của trang hiện tại
+//   - total: Tổng số phần tử
+func (c *forkContext) Paginate(code int, items interface{}, total int64) {
+	config := c.paginationConfig()
+	p := c.Pagination()
+
+	totalPages := 0
+	if total > 0 {
+		totalPages = int((total + int64(p.Limit) - 1) / int64(p.Limit))
+	}
+
+	links := make([]string, 0, 4)
+	if totalPages > 0 {
+		links = append(links, c.pageLink(config, 1, p.Limit, "first"))
+	}
+	if p.Page > 1 && totalPages > 0 {
+		links = append(links, c.pageLink(config, min(p.Page-1, totalPages), p.Limit, "prev"))
+	}
+	if p.Page < totalPages {
+		links = append(links, c.pageLink(config, p.Page+1, p.Limit, "next"))
+	}
+	if totalPages > 0 {
+		links = append(links, c.pageLink(config, totalPages, p.Limit, "last"))
+	}
+	if len(links) > 0 {
+		c.Header("Link", strings.Join(links, ", "))
+	}
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+
+	c.JSON(code, PaginatedResponse{
+		Data: items,
+		Pagination: PageMeta{
+			Page:       p.Page,
+			Limit:      p.Limit,
+			Total:      total,
+			TotalPages: totalPages,
+			HasMore:    p.Page < totalPages,
+		},
+	})
+}
+
+// PaginateCursor gửi response danh sách phân trang theo cursor.
+//
+// Params:
+//   - code: HTTP status code
+//   - items: Các phần tử của trang hiện tại
+//   - nextCursor: Cursor của trang tiếp theo, rỗng nếu đã hết dữ liệu
+func (c *forkContext) PaginateCursor(code int, items interface{}, nextCursor string) {
+	config := c.paginationConfig()
+	p := c.Pagination()
+
+	if nextCursor != "" {
+		query := c.request.URL().Query()
+		query.Del(config.PageParam)
+		query.Set(config.CursorParam, nextCursor)
+		query.Set(config.LimitParam, strconv.Itoa(p.Limit))
+		c.Header("Link", formatLink(c.request.URL(), query, "next"))
+	}
+
+	c.JSON(code, PaginatedResponse{
+		Data: items,
+		Pagination: CursorMeta{
+			Limit:      p.Limit,
+			NextCursor: nextCursor,
+			HasMore:    nextCursor != "",
+		},
+	})
+}
+
+// pageLink tạo một phần tử của Link header trỏ tới trang đã cho.
+func (c *forkContext) pageLink(config PaginationConfig, page, limit int, rel string) string {
+	query := c.request.URL().Query()
+	query.Del(config.CursorParam)
+	query.Set(config.PageParam, strconv.Itoa(page))
+	query.Set(config.LimitParam, strconv.Itoa(limit))
+	return formatLink(c.request.URL(), query, rel)
+}
+
+// formatLink định dạng một phần tử Link header theo RFC 8288.
+func formatLink(u *url.URL, query url.Values, rel string) string {
+	return "<" + u.EscapedPath() + "?" + query.Encode() + `>; rel="` + rel + `"`
+}
@@ -0,0 +1,115 @@
+package context
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestContextPagination(t *testing.T) {
+	tests := []struct {
+		target string
+		config interface{}
+		page   int
+		limit  int
+		offset int
+		cursor string
+	}{
+		{"/items", nil, 1, 20, 0, ""},
+		{"/items?page=3&limit=10", nil, 3, 10, 20, ""},
+		{"/items?page=-2&limit=abc", nil, 1, 20, 0, ""},
+		{"/items?limit=1000", nil, 1, 100, 0, ""},
+		{"/items?cursor=abc", nil, 1, 20, 0, "abc"},
+		{"/items?p=2&size=500", PaginationConfig{DefaultLimit: 5, MaxLimit: 50, PageParam: "p", LimitParam: "size"}, 2, 50, 50, ""},
+		{"/items", &PaginationConfig{DefaultLimit: 200, MaxLimit: 30}, 1, 30, 0, ""},
+	}
+
+	for _, tt := range tests {
+		ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.target, nil))
+		if tt.config != nil {
+			ctx.Set(PaginationConfigKey, tt.config)
+		}
+		p := ctx.Pagination()
+		if p.Page != tt.page || p.Limit != tt.limit || p.Offset != tt.offset || p.Cursor != tt.cursor {
+			t.Errorf("%s: expected page=%d limit=%d offset=%d cursor=%q, got %+v",
+				tt.target, tt.page, tt.limit, tt.offset, tt.cursor, p)
+		}
+	}
+}
+
+func TestContextPaginate(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx := NewContext(w, httptest.NewRequest(http.MethodGet, "/users?page=2&limit=10&sort=name", nil))
+	ctx.Paginate(http.StatusOK, []string{"a", "b"}, 35)
+
+	if w.Header().Get("X-Total-Count") != "35" {
+		t.Errorf("Expected X-Total-Count 35, got %s", w.Header().Get("X-Total-Count"))
+	}
+	link := w.Header().Get("Link")
+	for _, expected := range []string{
+		`</users?limit=10&page=1&sort=name>; rel="first"`,
+		`</users?limit=10&page=1&sort=name>; rel="prev"`,
+		`</users?limit=10&page=3&sort=name>; rel="next"`,
+		`</users?limit=10&page=4&sort=name>; rel="last"`,
+	} {
+		if !strings.Contains(link, expected) {
+			t.Errorf("Expected Link to contain %s, got %s", expected, link)
+		}
+	}
+
+	var body struct {
+		Data       []string `json:"data"`
+		Pagination PageMeta `json:"pagination"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Failed to decode body: %v", err)
+	}
+	expected := PageMeta{Page: 2, Limit: 10, Total: 35, TotalPages: 4, HasMore: true}
+	if len(body.Data) != 2 || body.Pagination != expected {
+		t.Errorf("Expected %+v, got %+v", expected, body)
+	}
+}
+
+func TestContextPaginateLastAndEmpty(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx := NewContext(w, httptest.NewRequest(http.MethodGet, "/users?page=4&limit=10", nil))
+	ctx.Paginate(http.StatusOK, []string{}, 35)
+	if strings.Contains(w.Header().Get("Link"), `rel="next"`) {
+		t.Errorf("Expected no next link on last page, got %s", w.Header().Get("Link"))
+	}
+	if !strings.Contains(w.Body.String(), `"has_more":false`) {
+		t.Errorf("Expected has_more false, got %s", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	ctx = NewContext(w, httptest.NewRequest(http.MethodGet, "/users", nil))
+	ctx.Paginate(http.StatusOK, []string{}, 0)
+	if w.Header().Get("Link") != "" {
+		t.Errorf("Expected no Link header for empty result, got %s", w.Header().Get("Link"))
+	}
+	if !strings.Contains(w.Body.String(), `"total":0,"total_pages":0`) {
+		t.Errorf("Expected zero totals, got %s", w.Body.String())
+	}
+}
+
+func TestContextPaginateCursor(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx := NewContext(w, httptest.NewRequest(http.MethodGet, "/events?cursor=c1&limit=5", nil))
+	ctx.PaginateCursor(http.StatusOK, []int{1, 2}, "c2")
+
+	if link := w.Header().Get("Link"); link != `</events?cursor=c2&limit=5>; rel="next"` {
+		t.Errorf("Unexpected Link header: %s", link)
+	}
+	if body := w.Body.String(); !strings.Contains(body, `"pagination":{"limit":5,"next_cursor":"c2","has_more":true}`) {
+		t.Errorf("Unexpected body: %s", body)
+	}
+
+	w = httptest.NewRecorder()
+	ctx = NewContext(w, httptest.NewRequest(http.MethodGet, "/events?cursor=c2", nil))
+	ctx.PaginateCursor(http.StatusOK, []int{}, "")
+	if w.Header().Get("Link") != "" || !strings.Contains(w.Body.String(), `"has_more":false`) {
+		t.Errorf("Expected last page without next link, got %s %s", w.Header().Get("Link"), w.Body.String())
+	}
+}
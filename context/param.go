@@ -0,0 +1,149 @@
+package context
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	forkerrors "go.fork.vn/fork/errors"
+)
+
+// ErrParamMissing là lỗi được trả về bởi các phương thức Param* khi route không có tham số
+// với tên đã cho hoặc tham số có giá trị rỗng.
+var ErrParamMissing = errors.New("route parameter missing")
+
+// paramValue trả về giá trị của tham số route, ErrParamMissing nếu không có hoặc rỗng.
+func (c *forkContext) paramValue(name string) (string, error) {
+	value := c.Param(name)
+	if value == "" {
+		return "", fmt.Errorf("%w: %s", ErrParamMissing, name)
+	}
+	return value, nil
+}
+
+// paramError bọc lỗi chuyển đổi tham số route kèm tên tham số.
+func paramError(name string, err error) error {
+	return fmt.Errorf("invalid route parameter %s: %w", name, err)
+}
+
+// ParamInt trả về tham số route dạng int.
+//
+// Params:
+//   - name: Tên tham số
+//
+// Returns:
+//   - int: Giá trị đã chuyển đổi
+//   - error: ErrParamMissing nếu tham số không có, hoặc lỗi chuyển đổi
+func (c *forkContext) ParamInt(name string) (int, error) {
+	value, err := c.paramValue(name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, paramError(name, err)
+	}
+	return n, nil
+}
+
+// ParamInt64 trả về tham số route dạng int64.
+//
+// Params:
+//   - name: Tên tham số
+//
+// Returns:
+//   - int64: Giá trị đã chuyển đổi
+//   - error: ErrParamMissing nếu tham số không có, hoặc lỗi chuyển đổi
+func (c *forkContext) ParamInt64(name string) (int64, error) {
+	value, err := c.paramValue(name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, paramError(name, err)
+	}
+	return n, nil
+}
+
+// ParamUUID trả về tham số route dạng UUID.
+//
+// Params:
+//   - name: Tên tham số
+//
+// Returns:
+//   - uuid.UUID: Giá trị đã chuyển đổi
+//   - error: ErrParamMissing nếu tham số không có, hoặc lỗi chuyển đổi
+func (c *forkContext) ParamUUID(name string) (uuid.UUID, error) {
+	value, err := c.paramValue(name)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, paramError(name, err)
+	}
+	return id, nil
+}
+
+// MustParamInt trả về tham số route dạng int; nếu không hợp lệ, ghi 404 Not Found và dừng chuỗi handlers.
+//
+// Params:
+//   - name: Tên tham số
+//
+// Returns:
+//   - int: Giá trị đã chuyển đổi
+//   - bool: false nếu tham số không hợp lệ và response 404 đã được ghi
+func (c *forkContext) MustParamInt(name string) (int, bool) {
+	n, err := c.ParamInt(name)
+	return n, c.paramNotFound(name, err)
+}
+
+// MustParamInt64 trả về tham số route dạng int64; nếu không hợp lệ, ghi 404 Not Found và dừng chuỗi handlers.
+//
+// Params:
+//   - name: Tên tham số
+//
+// Returns:
+//   - int64: Giá trị đã chuyển đổi
+//   - bool: false nếu tham số không hợp lệ và response 404 đã được ghi
+func (c *forkContext) MustParamInt64(name string) (int64, bool) {
+	n, err := c.ParamInt64(name)
+	return n, c.paramNotFound(name, err)
+}
+
+// MustParamUUID trả về tham số route dạng UUID; nếu không hợp lệ, ghi 404 Not Found và dừng chuỗi handlers.
+//
+// Params:
+//   - name: Tên tham số
+//
+// Returns:
+//   - uuid.UUID: Giá trị đã chuyển đổi
+//   - bool: false nếu tham số không hợp lệ và response 404 đã được ghi
+func (c *forkContext) MustParamUUID(name string) (uuid.UUID, bool) {
+	id, err := c.ParamUUID(name)
+	return id, c.paramNotFound(name, err)
+}
+
+// paramNotFound ghi 404 Not Found và dừng chuỗi handlers nếu err khác nil.
+//
+// Params:
+//   - name: Tên tham số
+//   - err: Lỗi chuyển đổi tham số
+//
+// Returns:
+//   - bool: true nếu err là nil
+func (c *forkContext) paramNotFound(name string, err error) bool {
+	if err == nil {
+		return true
+	}
+
+	details := map[string]interface{}{
+		"param": name,
+	}
+	httpError := forkerrors.NewNotFound("Resource not found", details, err)
+	c.JSON(httpError.StatusCode, httpError)
+	c.Abort()
+	return false
+}
@@ -0,0 +1,86 @@
+package context
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func paramContext(params map[string]string) (Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	ctx := NewContext(w, httptest.NewRequest("GET", "/", nil))
+	ctx.SetParams(params)
+	return ctx, w
+}
+
+func TestContextTypedParams(t *testing.T) {
+	id := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	ctx, _ := paramContext(map[string]string{
+		"id":    "42",
+		"big":   "9007199254740993",
+		"uuid":  id.String(),
+		"bad":   "abc",
+		"empty": "",
+	})
+
+	if n, err := ctx.ParamInt("id"); err != nil || n != 42 {
+		t.Errorf("Expected id 42, got %d (%v)", n, err)
+	}
+	if n, err := ctx.ParamInt64("big"); err != nil || n != 9007199254740993 {
+		t.Errorf("Expected big 9007199254740993, got %d (%v)", n, err)
+	}
+	if got, err := ctx.ParamUUID("uuid"); err != nil || got != id {
+		t.Errorf("Expected uuid %s, got %s (%v)", id, got, err)
+	}
+
+	for _, name := range []string{"missing", "empty"} {
+		if _, err := ctx.ParamInt(name); !errors.Is(err, ErrParamMissing) {
+			t.Errorf("%s: expected ErrParamMissing, got %v", name, err)
+		}
+	}
+	var numErr *strconv.NumError
+	if _, err := ctx.ParamInt("bad"); !errors.As(err, &numErr) {
+		t.Errorf("Expected strconv.NumError for invalid int, got %v", err)
+	}
+	if _, err := ctx.ParamInt64("bad"); !errors.As(err, &numErr) {
+		t.Errorf("Expected strconv.NumError for invalid int64, got %v", err)
+	}
+	if got, err := ctx.ParamUUID("bad"); err == nil || got != uuid.Nil {
+		t.Errorf("Expected error and uuid.Nil for invalid UUID, got %s (%v)", got, err)
+	}
+}
+
+func TestContextMustParam(t *testing.T) {
+	ctx, w := paramContext(map[string]string{"id": "42"})
+	if n, ok := ctx.MustParamInt("id"); !ok || n != 42 {
+		t.Errorf("Expected id 42, got %d (%v)", n, ok)
+	}
+	if w.Body.Len() != 0 || ctx.IsAborted() {
+		t.Errorf("Expected no response for valid param, got %q (aborted=%v)", w.Body.String(), ctx.IsAborted())
+	}
+
+	tests := []struct {
+		name string
+		fn   func(Context) bool
+	}{
+		{"int", func(ctx Context) bool { _, ok := ctx.MustParamInt("id"); return ok }},
+		{"int64", func(ctx Context) bool { _, ok := ctx.MustParamInt64("id"); return ok }},
+		{"uuid", func(ctx Context) bool { _, ok := ctx.MustParamUUID("id"); return ok }},
+	}
+	for _, tt := range tests {
+		ctx, w := paramContext(map[string]string{"id": "not-valid"})
+		if tt.fn(ctx) {
+			t.Errorf("%s: expected ok=false for invalid param", tt.name)
+		}
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: expected status 404, got %d", tt.name, w.Code)
+		}
+		if !ctx.IsAborted() {
+			t.Errorf("%s: expected handler chain to be aborted", tt.name)
+		}
+	}
+}
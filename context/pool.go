@@ -0,0 +1,105 @@
+package context
+
+import (
+	"net/http"
+	"sync"
+)
+
+// contextPool tái sử dụng forkContext giữa các request để giảm cấp phát.
+var contextPool = sync.Pool{
+	New: func() any {
+		return newForkContext()
+	},
+}
+
+// AcquireContext lấy một context từ pool và khởi tạo nó cho request.
+// Router và adapter gọi ReleaseContext khi request kết thúc.
+//
+// Parameters:
+//   - w: http.ResponseWriter để ghi HTTP response
+//   - r: *http.Request chứa thông tin request
+//
+// Returns:
+//   - Context: Context đã được khởi tạo
+func AcquireContext(w http.ResponseWriter, r *http.Request) Context {
+	c := contextPool.Get().(*forkContext)
+	c.Reset(w, r)
+	return c
+}
+
+// ReleaseContext trả context về pool sau khi request kết thúc. Context không được dùng
+// sau khi release; goroutine nền phải dùng Copy. Context không được tạo bởi package này
+// bị bỏ qua.
+//
+// Parameters:
+//   - ctx: Context lấy từ AcquireContext hoặc NewContext
+func ReleaseContext(ctx Context) {
+	c, ok := ctx.(*forkContext)
+	if !ok {
+		return
+	}
+	c.clear()
+	contextPool.Put(c)
+}
+
+// Reset khởi tạo lại context cho một request mới, xóa params, store, handlers và trạng thái
+// abort/response của request trước. Được gọi bởi NewContext và AcquireContext.
+//
+// Params:
+//   - w: http.ResponseWriter của request mới
+//   - r: *http.Request của request mới
+func (c *forkContext) Reset(w http.ResponseWriter, r *http.Request) {
+	c.clear()
+
+	if req, ok := c.request.(*forkRequest); ok {
+		req.request = r
+	} else {
+		c.request = NewRequest(r)
+	}
+	if c.response != nil {
+		c.response.Reset(w)
+	} else {
+		c.response = NewResponse(w)
+	}
+	c.ctx = r.Context()
+}
+
+// clear xóa trạng thái của request trước đó và bỏ các tham chiếu tới request/response
+// để chúng được thu gom khi context nằm trong pool.
+func (c *forkContext) clear() {
+	c.mu.Lock()
+	clear(c.store)
+	c.errors = nil
+	if c.bridge != nil {
+		// context.Context đã bridge có thể còn được giữ ở nơi khác, không để nó đọc store
+		// của request tiếp theo
+		c.bridge.all = false
+		c.bridge.keys = nil
+		c.bridge = nil
+	}
+	c.mu.Unlock()
+
+	if req, ok := c.request.(*forkRequest); ok {
+		req.request = nil
+	}
+	if c.response != nil {
+		c.response.Reset(nil)
+	}
+	c.ctx = nil
+	c.params = nil
+	c.routeMeta = nil
+	c.fullPath = ""
+	c.handlers = nil
+	c.index = -1
+	c.aborted = false
+	c.validator = nil
+	c.logger = nil
+	c.clientGone = false
+	c.streaming = false
+	c.body = nil
+	if c.timeoutCancel != nil {
+		c.timeoutCancel()
+	}
+	c.timeout = nil
+	c.timeoutCancel = nil
+}
@@ -0,0 +1,113 @@
+package context
+
+import (
+	gocontext "context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestContextReset(t *testing.T) {
+	w1 := httptest.NewRecorder()
+	ctx := AcquireContext(w1, httptest.NewRequest("GET", "/first?x=1", nil))
+	ctx.SetParams(map[string]string{"id": "1"})
+	ctx.SetFullPath("/first")
+	ctx.SetHandlers([]func(Context){func(Context) {}})
+	ctx.Set("user", "alice")
+	ctx.BridgeStore()
+	bridged := ctx.Context()
+	ctx.Abort()
+	ctx.String(201, "first")
+	ReleaseContext(ctx)
+
+	// Context.Context đã bridge không đọc được store sau khi context được trả về pool
+	if _, ok := StoreValue(bridged, "user"); ok {
+		t.Error("Expected released bridge not to expose the store")
+	}
+
+	w2 := httptest.NewRecorder()
+	reqCtx, cancel := gocontext.WithCancel(gocontext.Background())
+	defer cancel()
+	forkCtx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil)).(*forkContext)
+	forkCtx.Set("user", "alice")
+	forkCtx.SetValidator(NewValidator())
+	forkCtx.Reset(w2, httptest.NewRequest("POST", "/second", nil).WithContext(reqCtx))
+
+	if _, exists := forkCtx.Get("user"); exists {
+		t.Error("Expected store to be cleared")
+	}
+	if forkCtx.GetValidator() != defaultValidator() {
+		t.Error("Expected validator to be reset")
+	}
+	if forkCtx.Param("id") != "" || forkCtx.FullPath() != "" || len(forkCtx.Handlers()) != 0 || forkCtx.IsAborted() {
+		t.Error("Expected params, full path, handlers and abort state to be cleared")
+	}
+	if forkCtx.Method() != "POST" || forkCtx.Path() != "/second" {
+		t.Errorf("Expected new request, got %s %s", forkCtx.Method(), forkCtx.Path())
+	}
+	if forkCtx.Context() != reqCtx {
+		t.Error("Expected context.Context of the new request")
+	}
+	if forkCtx.Response().Written() || forkCtx.Response().Status() != 200 {
+		t.Error("Expected response state to be reset")
+	}
+	forkCtx.String(200, "second")
+	if w2.Body.String() != "second" {
+		t.Errorf("Expected response to be written to the new writer, got %q", w2.Body.String())
+	}
+}
+
+func TestContextValidator(t *testing.T) {
+	type Input struct {
+		Name string `json:"name" validate:"required,never"`
+	}
+
+	appValidator := NewValidator()
+	ctx := AcquireContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	if ctx.GetValidator() != defaultValidator() {
+		t.Error("Expected the shared default validator before SetValidator")
+	}
+	ctx.SetValidator(appValidator)
+	if err := ctx.RegisterValidation("never", func(validator.FieldLevel) bool { return false }); !errors.Is(err, ErrSharedValidator) {
+		t.Errorf("Expected ErrSharedValidator from a request context, got %v", err)
+	}
+	ReleaseContext(ctx)
+
+	// Validation đăng ký khi khởi tạo có hiệu lực cho mọi request dùng cùng validator
+	if err := appValidator.RegisterValidation("never", func(validator.FieldLevel) bool { return false }); err != nil {
+		t.Fatal(err)
+	}
+	next := AcquireContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	defer ReleaseContext(next)
+	if next.GetValidator() != defaultValidator() {
+		t.Error("Expected pooled context to drop the previous validator")
+	}
+	next.SetValidator(appValidator)
+	if err := next.ValidateStruct(Input{Name: "a"}); err == nil {
+		t.Error("Expected app-wide custom validation to fail")
+	}
+	var validationErrors validator.ValidationErrors
+	if err := next.ValidateStruct(Input{}); !errors.As(err, &validationErrors) || validationErrors[0].Field() != "name" {
+		t.Errorf("Expected field name from json tag, got %v", err)
+	}
+}
+
+func BenchmarkNewContext(b *testing.B) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NewContext(w, req)
+	}
+}
+
+func BenchmarkAcquireContext(b *testing.B) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ReleaseContext(AcquireContext(w, req))
+	}
+}
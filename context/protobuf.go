@@ -0,0 +1,38 @@
+package context
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxProtobufSize là kích thước tối đa (bytes) của request body mà BindProtobuf chấp nhận,
+// mặc định 4MB như giới hạn nhận message của gRPC. Giá trị <= 0 tắt giới hạn.
+// Chỉ nên thay đổi khi khởi tạo ứng dụng.
+var MaxProtobufSize int64 = 4 << 20
+
+// ErrBodyTooLarge là lỗi được trả về khi request body vượt quá giới hạn kích thước của binding.
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// BindProtobuf đọc request body và chuyển đổi thành protobuf message.
+//
+// Params:
+//   - obj: Protobuf message nhận dữ liệu
+//
+// Returns:
+//   - error: ErrBodyTooLarge nếu body vượt quá MaxProtobufSize, hoặc lỗi đọc/unmarshal protobuf
+func (c *forkContext) BindProtobuf(obj proto.Message) error {
+	limit := MaxProtobufSize
+	if limit > 0 && c.request.Request().ContentLength > limit {
+		return ErrBodyTooLarge
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		return err
+	}
+	if limit > 0 && int64(len(body)) > limit {
+		return ErrBodyTooLarge
+	}
+	return proto.Unmarshal(body, obj)
+}
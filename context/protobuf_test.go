@@ -0,0 +1,75 @@
+package context
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func protobufContext(t *testing.T, body []byte, contentType string) Context {
+	t.Helper()
+	req := httptest.NewRequest("POST", "/messages", bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	return NewContext(httptest.NewRecorder(), req)
+}
+
+func TestContextBindProtobuf(t *testing.T) {
+	body, err := proto.Marshal(wrapperspb.String("hello"))
+	if err != nil {
+		t.Fatalf("Failed to marshal message: %v", err)
+	}
+
+	for _, contentType := range []string{"application/protobuf", "application/x-protobuf"} {
+		var message wrapperspb.StringValue
+		if err := protobufContext(t, body, contentType).Bind(&message); err != nil {
+			t.Errorf("%s: failed to bind protobuf: %v", contentType, err)
+			continue
+		}
+		if message.GetValue() != "hello" {
+			t.Errorf("%s: expected value %q, got %q", contentType, "hello", message.GetValue())
+		}
+	}
+
+	var plain struct{ Value string }
+	err = protobufContext(t, body, "application/protobuf").Bind(&plain)
+	if !errors.Is(err, ErrUnsupportedBinding) {
+		t.Errorf("Expected ErrUnsupportedBinding for non-proto obj, got %v", err)
+	}
+
+	var message wrapperspb.StringValue
+	if err := protobufContext(t, []byte{0xff}, "application/protobuf").BindProtobuf(&message); err == nil {
+		t.Error("Expected error for invalid protobuf")
+	}
+}
+
+func TestContextBindProtobufSizeLimit(t *testing.T) {
+	original := MaxProtobufSize
+	defer func() { MaxProtobufSize = original }()
+	MaxProtobufSize = 16
+
+	body, err := proto.Marshal(wrapperspb.String(strings.Repeat("x", 32)))
+	if err != nil {
+		t.Fatalf("Failed to marshal message: %v", err)
+	}
+
+	var message wrapperspb.StringValue
+	if err := protobufContext(t, body, "application/protobuf").BindProtobuf(&message); !errors.Is(err, ErrBodyTooLarge) {
+		t.Errorf("Expected ErrBodyTooLarge, got %v", err)
+	}
+
+	req := httptest.NewRequest("POST", "/messages", bytes.NewReader(body))
+	req.ContentLength = -1
+	if err := NewContext(httptest.NewRecorder(), req).BindProtobuf(&message); !errors.Is(err, ErrBodyTooLarge) {
+		t.Errorf("Expected ErrBodyTooLarge without Content-Length, got %v", err)
+	}
+
+	MaxProtobufSize = 0
+	if err := protobufContext(t, body, "application/protobuf").BindProtobuf(&message); err != nil {
+		t.Errorf("Expected no limit when MaxProtobufSize is 0, got %v", err)
+	}
+}
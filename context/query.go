@@ -0,0 +1,207 @@
+package context
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ErrQueryMissing là lỗi được trả về bởi các phương thức ShouldQuery* khi tham số query
+// không có trong request hoặc có giá trị rỗng.
+var ErrQueryMissing = errors.New("query parameter missing")
+
+// queryValue trả về giá trị của tham số query, ErrQueryMissing nếu không có hoặc rỗng.
+func (c *forkContext) queryValue(name string) (string, error) {
+	value := c.Query(name)
+	if value == "" {
+		return "", fmt.Errorf("%w: %s", ErrQueryMissing, name)
+	}
+	return value, nil
+}
+
+// queryError bọc lỗi parse tham số query kèm tên tham số.
+func queryError(name string, err error) error {
+	return fmt.Errorf("invalid query parameter %s: %w", name, err)
+}
+
+// ShouldQueryInt trả về tham số query dạng int.
+//
+// Params:
+//   - name: Tên tham số query
+//
+// Returns:
+//   - int: Giá trị đã parse
+//   - error: ErrQueryMissing nếu tham số không có, hoặc lỗi parse
+func (c *forkContext) ShouldQueryInt(name string) (int, error) {
+	value, err := c.queryValue(name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, queryError(name, err)
+	}
+	return n, nil
+}
+
+// QueryInt trả về tham số query dạng int, hoặc defaultValue nếu không có hoặc không hợp lệ.
+//
+// Params:
+//   - name: Tên tham số query
+//   - defaultValue: Giá trị mặc định
+//
+// Returns:
+//   - int: Giá trị đã parse hoặc defaultValue
+func (c *forkContext) QueryInt(name string, defaultValue int) int {
+	if n, err := c.ShouldQueryInt(name); err == nil {
+		return n
+	}
+	return defaultValue
+}
+
+// ShouldQueryInt64 trả về tham số query dạng int64.
+//
+// Params:
+//   - name: Tên tham số query
+//
+// Returns:
+//   - int64: Giá trị đã parse
+//   - error: ErrQueryMissing nếu tham số không có, hoặc lỗi parse
+func (c *forkContext) ShouldQueryInt64(name string) (int64, error) {
+	value, err := c.queryValue(name)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, queryError(name, err)
+	}
+	return n, nil
+}
+
+// QueryInt64 trả về tham số query dạng int64, hoặc defaultValue nếu không có hoặc không hợp lệ.
+//
+// Params:
+//   - name: Tên tham số query
+//   - defaultValue: Giá trị mặc định
+//
+// Returns:
+//   - int64: Giá trị đã parse hoặc defaultValue
+func (c *forkContext) QueryInt64(name string, defaultValue int64) int64 {
+	if n, err := c.ShouldQueryInt64(name); err == nil {
+		return n
+	}
+	return defaultValue
+}
+
+// ShouldQueryBool trả về tham số query dạng bool (theo strconv.ParseBool: "1", "true", "0", "false"...).
+//
+// Params:
+//   - name: Tên tham số query
+//
+// Returns:
+//   - bool: Giá trị đã parse
+//   - error: ErrQueryMissing nếu tham số không có, hoặc lỗi parse
+func (c *forkContext) ShouldQueryBool(name string) (bool, error) {
+	value, err := c.queryValue(name)
+	if err != nil {
+		return false, err
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, queryError(name, err)
+	}
+	return b, nil
+}
+
+// QueryBool trả về tham số query dạng bool, hoặc defaultValue nếu không có hoặc không hợp lệ.
+//
+// Params:
+//   - name: Tên tham số query
+//   - defaultValue: Giá trị mặc định
+//
+// Returns:
+//   - bool: Giá trị đã parse hoặc defaultValue
+func (c *forkContext) QueryBool(name string, defaultValue bool) bool {
+	if b, err := c.ShouldQueryBool(name); err == nil {
+		return b
+	}
+	return defaultValue
+}
+
+// ShouldQueryFloat64 trả về tham số query dạng float64.
+//
+// Params:
+//   - name: Tên tham số query
+//
+// Returns:
+//   - float64: Giá trị đã parse
+//   - error: ErrQueryMissing nếu tham số không có, hoặc lỗi parse
+func (c *forkContext) ShouldQueryFloat64(name string) (float64, error) {
+	value, err := c.queryValue(name)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, queryError(name, err)
+	}
+	return f, nil
+}
+
+// QueryFloat64 trả về tham số query dạng float64, hoặc defaultValue nếu không có hoặc không hợp lệ.
+//
+// Params:
+//   - name: Tên tham số query
+//   - defaultValue: Giá trị mặc định
+//
+// Returns:
+//   - float64: Giá trị đã parse hoặc defaultValue
+func (c *forkContext) QueryFloat64(name string, defaultValue float64) float64 {
+	if f, err := c.ShouldQueryFloat64(name); err == nil {
+		return f
+	}
+	return defaultValue
+}
+
+// ShouldQueryTime trả về tham số query dạng time.Time theo layout.
+//
+// Params:
+//   - name: Tên tham số query
+//   - layout: Layout của time.Parse, rỗng để dùng time.RFC3339
+//
+// Returns:
+//   - time.Time: Giá trị đã parse
+//   - error: ErrQueryMissing nếu tham số không có, hoặc lỗi parse
+func (c *forkContext) ShouldQueryTime(name, layout string) (time.Time, error) {
+	value, err := c.queryValue(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, queryError(name, err)
+	}
+	return t, nil
+}
+
+// QueryTime trả về tham số query dạng time.Time theo layout, hoặc defaultValue nếu không có
+// hoặc không hợp lệ.
+//
+// Params:
+//   - name: Tên tham số query
+//   - layout: Layout của time.Parse, rỗng để dùng time.RFC3339
+//   - defaultValue: Giá trị mặc định
+//
+// Returns:
+//   - time.Time: Giá trị đã parse hoặc defaultValue
+func (c *forkContext) QueryTime(name, layout string, defaultValue time.Time) time.Time {
+	if t, err := c.ShouldQueryTime(name, layout); err == nil {
+		return t
+	}
+	return defaultValue
+}
@@ -0,0 +1,87 @@
+package context
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func queryContext(target string) Context {
+	return NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", target, nil))
+}
+
+func TestContextTypedQuery(t *testing.T) {
+	ctx := queryContext("/items?page=3&id=9007199254740993&active=true&ratio=0.75&since=2024-03-15&bad=x&empty=")
+
+	if got := ctx.QueryInt("page", 1); got != 3 {
+		t.Errorf("Expected page 3, got %d", got)
+	}
+	if got := ctx.QueryInt64("id", 0); got != 9007199254740993 {
+		t.Errorf("Expected id 9007199254740993, got %d", got)
+	}
+	if got := ctx.QueryBool("active", false); !got {
+		t.Error("Expected active true")
+	}
+	if got := ctx.QueryFloat64("ratio", 0); got != 0.75 {
+		t.Errorf("Expected ratio 0.75, got %v", got)
+	}
+	if got := ctx.QueryTime("since", "2006-01-02", time.Time{}); !got.Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected since 2024-03-15, got %v", got)
+	}
+
+	fallback := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, name := range []string{"missing", "empty", "bad"} {
+		if got := ctx.QueryInt(name, 10); got != 10 {
+			t.Errorf("%s: expected default int, got %d", name, got)
+		}
+		if got := ctx.QueryInt64(name, 10); got != 10 {
+			t.Errorf("%s: expected default int64, got %d", name, got)
+		}
+		if got := ctx.QueryBool(name, true); !got {
+			t.Errorf("%s: expected default bool", name)
+		}
+		if got := ctx.QueryFloat64(name, 1.5); got != 1.5 {
+			t.Errorf("%s: expected default float64, got %v", name, got)
+		}
+		if got := ctx.QueryTime(name, "", fallback); !got.Equal(fallback) {
+			t.Errorf("%s: expected default time, got %v", name, got)
+		}
+	}
+}
+
+func TestContextShouldQuery(t *testing.T) {
+	ctx := queryContext("/items?page=3&bad=x&empty=&at=2024-03-15T10:00:00Z")
+
+	if n, err := ctx.ShouldQueryInt("page"); err != nil || n != 3 {
+		t.Errorf("Expected page 3, got %d (%v)", n, err)
+	}
+	if at, err := ctx.ShouldQueryTime("at", ""); err != nil || at.Hour() != 10 {
+		t.Errorf("Expected RFC3339 time, got %v (%v)", at, err)
+	}
+
+	for _, name := range []string{"missing", "empty"} {
+		if _, err := ctx.ShouldQueryInt(name); !errors.Is(err, ErrQueryMissing) {
+			t.Errorf("%s: expected ErrQueryMissing, got %v", name, err)
+		}
+	}
+
+	var numErr *strconv.NumError
+	if _, err := ctx.ShouldQueryInt("bad"); !errors.As(err, &numErr) {
+		t.Errorf("Expected strconv.NumError for invalid int, got %v", err)
+	}
+	if _, err := ctx.ShouldQueryInt64("bad"); !errors.As(err, &numErr) {
+		t.Errorf("Expected strconv.NumError for invalid int64, got %v", err)
+	}
+	if _, err := ctx.ShouldQueryBool("bad"); !errors.As(err, &numErr) {
+		t.Errorf("Expected strconv.NumError for invalid bool, got %v", err)
+	}
+	if _, err := ctx.ShouldQueryFloat64("bad"); !errors.As(err, &numErr) {
+		t.Errorf("Expected strconv.NumError for invalid float64, got %v", err)
+	}
+	var parseErr *time.ParseError
+	if _, err := ctx.ShouldQueryTime("bad", ""); !errors.As(err, &parseErr) {
+		t.Errorf("Expected time.ParseError for invalid time, got %v", err)
+	}
+}
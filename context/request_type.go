@@ -0,0 +1,121 @@
+package context
+
+import (
+	"net/http"
+	"strings"
+)
+
+// IsAJAX kiểm tra request có được gửi bằng XMLHttpRequest không (header
+// X-Requested-With: XMLHttpRequest, do jQuery và nhiều thư viện frontend thiết lập).
+//
+// Returns:
+//   - bool: true nếu là AJAX request
+func (c *forkContext) IsAJAX() bool {
+	return strings.EqualFold(c.GetHeader("X-Requested-With"), "XMLHttpRequest")
+}
+
+// IsJSON kiểm tra body của request có phải JSON không theo Content-Type
+// ("application/json" hoặc "+json" như "application/problem+json").
+//
+// Returns:
+//   - bool: true nếu Content-Type là JSON
+func (c *forkContext) IsJSON() bool {
+	return isJSONMediaType(mediaType(c.ContentType()))
+}
+
+// WantsJSON kiểm tra client có ưu tiên response JSON không: media range có q-value cao nhất
+// trong header Accept (range xuất hiện trước khi bằng nhau) là JSON. Wildcard như "*/*"
+// không được tính là yêu cầu JSON.
+//
+// Returns:
+//   - bool: true nếu client ưu tiên JSON
+func (c *forkContext) WantsJSON() bool {
+	ranges := parseAccept(c.GetHeader("Accept"))
+	best := -1
+	for i, r := range ranges {
+		if r.quality > 0 && (best < 0 || r.quality > ranges[best].quality) {
+			best = i
+		}
+	}
+	return best >= 0 && isJSONMediaType(ranges[best].mediaType)
+}
+
+// Fresh kiểm tra response trong cache của client còn mới không, theo các header điều kiện
+// của request (If-None-Match, If-Modified-Since) và header ETag, Last-Modified đã thiết lập
+// trên response. Chỉ áp dụng cho GET và HEAD với status 2xx hoặc 304; handler có thể trả về
+// 304 Not Modified khi Fresh trả về true.
+//
+// Returns:
+//   - bool: true nếu cache của client còn mới
+func (c *forkContext) Fresh() bool {
+	method := c.Method()
+	if method != http.MethodGet && method != http.MethodHead {
+		return false
+	}
+	status := c.response.Status()
+	if (status < 200 || status >= 300) && status != http.StatusNotModified {
+		return false
+	}
+
+	noneMatch := c.GetHeader("If-None-Match")
+	modifiedSince := c.GetHeader("If-Modified-Since")
+	if noneMatch == "" && modifiedSince == "" {
+		return false
+	}
+	if strings.Contains(strings.ToLower(c.GetHeader("Cache-Control")), "no-cache") {
+		return false
+	}
+
+	header := c.response.Header()
+	// If-None-Match được ưu tiên hơn If-Modified-Since (RFC 9110, mục 13.1.3)
+	if noneMatch != "" {
+		return etagMatches(noneMatch, header.Get("ETag"))
+	}
+
+	lastModified, err := http.ParseTime(header.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+	since, err := http.ParseTime(modifiedSince)
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(since)
+}
+
+// Stale là phủ định của Fresh: client cần nhận response đầy đủ.
+//
+// Returns:
+//   - bool: true nếu cache của client đã cũ hoặc request không có header điều kiện
+func (c *forkContext) Stale() bool {
+	return !c.Fresh()
+}
+
+// isJSONMediaType kiểm tra media type đã chuẩn hóa có phải JSON không.
+func isJSONMediaType(value string) bool {
+	return value == "application/json" || strings.HasSuffix(value, "+json")
+}
+
+// etagMatches so sánh yếu (weak comparison) danh sách ETag của If-None-Match với ETag của response.
+//
+// Parameters:
+//   - noneMatch: Giá trị header If-None-Match
+//   - etag: ETag của response
+//
+// Returns:
+//   - bool: true nếu If-None-Match là "*" hoặc chứa ETag của response
+func etagMatches(noneMatch, etag string) bool {
+	if strings.TrimSpace(noneMatch) == "*" {
+		return true
+	}
+	if etag == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(noneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
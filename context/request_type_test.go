@@ -0,0 +1,89 @@
+package context
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func headerContext(method string, headers map[string]string) Context {
+	req := httptest.NewRequest(method, "/", nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	return NewContext(httptest.NewRecorder(), req)
+}
+
+func TestContextRequestType(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		ajax    bool
+		json    bool
+		wants   bool
+	}{
+		{"empty", nil, false, false, false},
+		{"ajax", map[string]string{"X-Requested-With": "xmlhttprequest"}, true, false, false},
+		{"json body", map[string]string{"Content-Type": "application/json; charset=utf-8"}, false, true, false},
+		{"problem json body", map[string]string{"Content-Type": "application/problem+json"}, false, true, false},
+		{"form body", map[string]string{"Content-Type": "application/x-www-form-urlencoded"}, false, false, false},
+		{"accept json", map[string]string{"Accept": "application/json"}, false, false, true},
+		{"accept json over html", map[string]string{"Accept": "text/html;q=0.8, application/vnd.api+json"}, false, false, true},
+		{"browser", map[string]string{"Accept": "text/html,application/xhtml+xml,*/*;q=0.8"}, false, false, false},
+		{"wildcard", map[string]string{"Accept": "*/*"}, false, false, false},
+		{"json refused", map[string]string{"Accept": "application/json;q=0"}, false, false, false},
+	}
+	for _, tt := range tests {
+		ctx := headerContext("POST", tt.headers)
+		if got := ctx.IsAJAX(); got != tt.ajax {
+			t.Errorf("%s: IsAJAX expected %v, got %v", tt.name, tt.ajax, got)
+		}
+		if got := ctx.IsJSON(); got != tt.json {
+			t.Errorf("%s: IsJSON expected %v, got %v", tt.name, tt.json, got)
+		}
+		if got := ctx.WantsJSON(); got != tt.wants {
+			t.Errorf("%s: WantsJSON expected %v, got %v", tt.name, tt.wants, got)
+		}
+	}
+}
+
+func TestContextFresh(t *testing.T) {
+	const lastModified = "Wed, 21 Oct 2015 07:28:00 GMT"
+	tests := []struct {
+		name     string
+		method   string
+		headers  map[string]string
+		etag     string
+		status   int
+		expected bool
+	}{
+		{"no conditional headers", "GET", nil, `"v1"`, 0, false},
+		{"etag match", "GET", map[string]string{"If-None-Match": `"v1"`}, `"v1"`, 0, true},
+		{"etag list weak match", "GET", map[string]string{"If-None-Match": `"v0", W/"v1"`}, `"v1"`, 0, true},
+		{"etag mismatch", "GET", map[string]string{"If-None-Match": `"v0"`}, `"v1"`, 0, false},
+		{"etag star", "HEAD", map[string]string{"If-None-Match": "*"}, "", 0, true},
+		{"etag precedes modified since", "GET", map[string]string{"If-None-Match": `"v0"`, "If-Modified-Since": lastModified}, `"v1"`, 0, false},
+		{"not modified since", "GET", map[string]string{"If-Modified-Since": lastModified}, "", 0, true},
+		{"modified since", "GET", map[string]string{"If-Modified-Since": "Tue, 20 Oct 2015 07:28:00 GMT"}, "", 0, false},
+		{"no-cache", "GET", map[string]string{"If-None-Match": `"v1"`, "Cache-Control": "no-cache"}, `"v1"`, 0, false},
+		{"post", "POST", map[string]string{"If-None-Match": `"v1"`}, `"v1"`, 0, false},
+		{"error status", "GET", map[string]string{"If-None-Match": `"v1"`}, `"v1"`, http.StatusNotFound, false},
+		{"not modified status", "GET", map[string]string{"If-None-Match": `"v1"`}, `"v1"`, http.StatusNotModified, true},
+	}
+	for _, tt := range tests {
+		ctx := headerContext(tt.method, tt.headers)
+		ctx.Header("Last-Modified", lastModified)
+		if tt.etag != "" {
+			ctx.Header("ETag", tt.etag)
+		}
+		if tt.status != 0 {
+			ctx.Status(tt.status)
+		}
+		if got := ctx.Fresh(); got != tt.expected {
+			t.Errorf("%s: Fresh expected %v, got %v", tt.name, tt.expected, got)
+		}
+		if got := ctx.Stale(); got == tt.expected {
+			t.Errorf("%s: Stale expected %v, got %v", tt.name, !tt.expected, got)
+		}
+	}
+}
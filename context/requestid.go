@@ -0,0 +1,53 @@
+package context
+
+import (
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader là header chứa request ID, được đọc từ request và ghi vào response.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestIDKey là khóa trong store chứa request ID của request hiện tại, do RequestID thiết lập
+// hoặc middleware tùy chỉnh ghi đè.
+const RequestIDKey = "request_id"
+
+// maxRequestIDLength là độ dài tối đa của request ID nhận từ client.
+const maxRequestIDLength = 128
+
+// RequestID trả về ID của request hiện tại để dùng cho logging và tracing. ID được lấy từ
+// store (RequestIDKey), sau đó là header X-Request-ID của request nếu hợp lệ, nếu không có
+// thì sinh UUID mới. Lần gọi đầu tiên lưu ID vào store và ghi header X-Request-ID của response.
+// ID chỉ được sinh khi cần (handler, Logger hoặc client gọi RequestID); bật
+// router.DefaultRouter.SetEagerRequestID để router gán ID cho mọi request.
+//
+// Returns:
+//   - string: Request ID
+func (c *forkContext) RequestID() string {
+	if value, ok := c.Get(RequestIDKey); ok {
+		if id, ok := value.(string); ok && id != "" {
+			return id
+		}
+	}
+
+	id := c.GetHeader(RequestIDHeader)
+	if !validRequestID(id) {
+		id = uuid.NewString()
+	}
+	c.Set(RequestIDKey, id)
+	c.Header(RequestIDHeader, id)
+	return id
+}
+
+// validRequestID kiểm tra request ID nhận từ client: không rỗng, không quá dài và chỉ gồm
+// ký tự ASCII hiển thị được để tránh chèn dữ liệu vào log và header.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package context
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestContextRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{"from header", "abc-123", "abc-123"},
+		{"missing", "", ""},
+		{"too long", strings.Repeat("a", maxRequestIDLength+1), ""},
+		{"control characters", "abc\nforged=1", ""},
+		{"spaces", "abc 123", ""},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/", nil)
+		if tt.header != "" {
+			req.Header.Set(RequestIDHeader, tt.header)
+		}
+		w := httptest.NewRecorder()
+		ctx := NewContext(w, req)
+
+		id := ctx.RequestID()
+		if tt.expected != "" && id != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, id)
+		}
+		if tt.expected == "" {
+			if _, err := uuid.Parse(id); err != nil {
+				t.Errorf("%s: expected generated UUID, got %q", tt.name, id)
+			}
+		}
+		if again := ctx.RequestID(); again != id {
+			t.Errorf("%s: expected stable ID %q, got %q", tt.name, id, again)
+		}
+		if got := w.Header().Get(RequestIDHeader); got != id {
+			t.Errorf("%s: expected response header %q, got %q", tt.name, id, got)
+		}
+		if value, _ := ctx.Get(RequestIDKey); value != id {
+			t.Errorf("%s: expected store value %q, got %v", tt.name, id, value)
+		}
+	}
+}
+
+func TestContextRequestIDFromStore(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(RequestIDHeader, "from-header")
+	ctx := NewContext(httptest.NewRecorder(), req)
+	ctx.Set(RequestIDKey, "from-store")
+
+	if got := ctx.RequestID(); got != "from-store" {
+		t.Errorf("Expected store value to take precedence, got %q", got)
+	}
+}
@@ -0,0 +1,218 @@
+package context
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	forkerrors "go.fork.vn/fork/errors"
+)
+
+// UnknownFieldsError là lỗi được trả về bởi ShouldBindJSONStrict khi JSON payload chứa
+// các trường không tồn tại trong struct đích.
+type UnknownFieldsError struct {
+	// Fields là đường dẫn của các trường không xác định, ví dụ "nickname",
+	// "address.zipcode" hoặc "items[0].sku"
+	Fields []string
+}
+
+// Error trả về mô tả lỗi kèm danh sách trường không xác định.
+func (e *UnknownFieldsError) Error() string {
+	return "json: unknown fields " + strings.Join(e.Fields, ", ")
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// ShouldBindJSONStrict đọc request body và chuyển đổi thành struct như BindJSON,
+// nhưng từ chối payload chứa trường không tồn tại trong struct đích.
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: *UnknownFieldsError liệt kê mọi trường không xác định, hoặc lỗi đọc/unmarshal JSON
+func (c *forkContext) ShouldBindJSONStrict(obj interface{}) error {
+	body, err := c.GetRawData()
+	if err != nil {
+		return err
+	}
+
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return err
+	}
+	if fields := unknownJSONFields(payload, reflect.TypeOf(obj), "", nil); len(fields) > 0 {
+		return &UnknownFieldsError{Fields: fields}
+	}
+	return json.Unmarshal(body, obj)
+}
+
+// BindJSONStrict bind request body như ShouldBindJSONStrict và tự động trả về
+// 400 Bad Request nếu thất bại; details của lỗi chứa "unknown_fields" khi payload
+// có trường không xác định.
+//
+// Params:
+//   - obj: Con trỏ struct nhận dữ liệu
+//
+// Returns:
+//   - error: HttpError đã được ghi vào response, nil nếu bind thành công
+func (c *forkContext) BindJSONStrict(obj interface{}) error {
+	err := c.ShouldBindJSONStrict(obj)
+	if err == nil {
+		return nil
+	}
+
+	details := map[string]interface{}{
+		"error": err.Error(),
+	}
+	if unknown, ok := err.(*UnknownFieldsError); ok {
+		details["unknown_fields"] = unknown.Fields
+	}
+	httpError := forkerrors.NewBadRequest("Failed to bind request data", details, err)
+	c.JSON(httpError.StatusCode, httpError)
+	return httpError
+}
+
+// unknownJSONFields duyệt payload đã decode theo kiểu đích và thu thập đường dẫn
+// của các key không khớp trường nào, theo cùng quy tắc so khớp của encoding/json
+// (tag json, tên trường, không phân biệt hoa thường, trường của struct nhúng).
+//
+// Parameters:
+//   - value: Giá trị JSON đã decode vào interface{}
+//   - t: Kiểu đích tương ứng
+//   - path: Đường dẫn của value trong payload
+//   - fields: Danh sách trường không xác định đã thu thập
+//
+// Returns:
+//   - []string: Danh sách trường không xác định
+func unknownJSONFields(value interface{}, t reflect.Type, path string, fields []string) []string {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || customUnmarshaler(t) {
+		return fields
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fields
+		}
+		known := jsonFieldTypes(t, nil)
+		for _, key := range sortedKeys(object) {
+			fieldType, ok := lookupJSONField(known, key)
+			if !ok {
+				fields = append(fields, joinJSONPath(path, key))
+				continue
+			}
+			fields = unknownJSONFields(object[key], fieldType, joinJSONPath(path, key), fields)
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fields
+		}
+		for _, key := range sortedKeys(object) {
+			fields = unknownJSONFields(object[key], t.Elem(), joinJSONPath(path, key), fields)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			return fields
+		}
+		for i, item := range items {
+			fields = unknownJSONFields(item, t.Elem(), path+"["+strconv.Itoa(i)+"]", fields)
+		}
+	}
+	return fields
+}
+
+// customUnmarshaler kiểm tra kiểu tự xử lý JSON; các trường bên trong kiểu này không được kiểm tra.
+func customUnmarshaler(t reflect.Type) bool {
+	ptr := reflect.PointerTo(t)
+	return ptr.Implements(jsonUnmarshalerType) || (t.Kind() != reflect.Map && ptr.Implements(textUnmarshalerType))
+}
+
+// jsonFieldTypes trả về tên JSON và kiểu của các trường mà encoding/json decode được,
+// kể cả trường được promote từ struct nhúng. Trường ở cấp nông hơn được ưu tiên.
+func jsonFieldTypes(t reflect.Type, known map[string]reflect.Type) map[string]reflect.Type {
+	if known == nil {
+		known = make(map[string]reflect.Type)
+	}
+
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldType)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		known[name] = field.Type
+	}
+
+	for _, fieldType := range embedded {
+		promoted := jsonFieldTypes(fieldType, nil)
+		for name, promotedType := range promoted {
+			if _, exists := known[name]; !exists {
+				known[name] = promotedType
+			}
+		}
+	}
+	return known
+}
+
+// lookupJSONField tìm trường cho key JSON: khớp chính xác trước, sau đó không phân biệt hoa thường.
+func lookupJSONField(known map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if fieldType, ok := known[key]; ok {
+		return fieldType, true
+	}
+	for name, fieldType := range known {
+		if strings.EqualFold(name, key) {
+			return fieldType, true
+		}
+	}
+	return nil, false
+}
+
+// sortedKeys trả về các key của JSON object theo thứ tự tăng dần để kết quả ổn định.
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// joinJSONPath nối key vào đường dẫn trường.
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
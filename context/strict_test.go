@@ -0,0 +1,121 @@
+package context
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type strictAddress struct {
+	City string `json:"city"`
+}
+
+type strictAudit struct {
+	CreatedBy string `json:"created_by"`
+}
+
+type strictUser struct {
+	strictAudit
+	Name     string            `json:"name"`
+	Age      int               `json:"age,omitempty"`
+	Password string            `json:"-"`
+	Address  *strictAddress    `json:"address"`
+	Tags     []strictAddress   `json:"tags"`
+	Labels   map[string]string `json:"labels"`
+	Extra    interface{}       `json:"extra"`
+	Born     time.Time         `json:"born"`
+	Raw      json.RawMessage   `json:"raw"`
+	internal string
+}
+
+func strictContext(body string) (Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/users", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	return NewContext(w, req), w
+}
+
+func TestShouldBindJSONStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		unknown []string
+	}{
+		{"known fields", `{"name":"a","age":1,"created_by":"admin","address":{"city":"x"},"tags":[{"city":"y"}]}`, nil},
+		{"case insensitive", `{"NAME":"a","Created_By":"admin"}`, nil},
+		{"free-form values", `{"labels":{"any":"x"},"extra":{"any":1},"raw":{"any":1},"born":"2024-01-01T00:00:00Z"}`, nil},
+		{"unknown top-level", `{"name":"a","nickname":"b","admin":true}`, []string{"admin", "nickname"}},
+		{"ignored field", `{"name":"a","Password":"secret"}`, []string{"Password"}},
+		{"unexported field", `{"internal":"x"}`, []string{"internal"}},
+		{"nested", `{"address":{"city":"x","zip":"1"},"tags":[{"city":"y"},{"color":"red"}]}`, []string{"address.zip", "tags[1].color"}},
+	}
+	for _, tt := range tests {
+		ctx, _ := strictContext(tt.body)
+		var user strictUser
+		err := ctx.ShouldBindJSONStrict(&user)
+
+		var unknown *UnknownFieldsError
+		if tt.unknown == nil {
+			if err != nil {
+				t.Errorf("%s: expected no error, got %v", tt.name, err)
+			}
+			continue
+		}
+		if !errors.As(err, &unknown) {
+			t.Errorf("%s: expected UnknownFieldsError, got %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(unknown.Fields, tt.unknown) {
+			t.Errorf("%s: expected unknown fields %v, got %v", tt.name, tt.unknown, unknown.Fields)
+		}
+		if user.Name != "" {
+			t.Errorf("%s: expected obj to be left unchanged, got name %q", tt.name, user.Name)
+		}
+	}
+}
+
+func TestShouldBindJSONStrictInvalidJSON(t *testing.T) {
+	ctx, _ := strictContext(`{"name":`)
+	var user strictUser
+	err := ctx.ShouldBindJSONStrict(&user)
+	var unknown *UnknownFieldsError
+	if err == nil || errors.As(err, &unknown) {
+		t.Errorf("Expected JSON syntax error, got %v", err)
+	}
+}
+
+func TestBindJSONStrict(t *testing.T) {
+	ctx, w := strictContext(`{"name":"a","nickname":"b"}`)
+	var user strictUser
+	if err := ctx.BindJSONStrict(&user); err == nil {
+		t.Fatal("Expected error for unknown field")
+	}
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	var body struct {
+		Details struct {
+			UnknownFields []string `json:"unknown_fields"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Failed to decode error response: %v", err)
+	}
+	if !reflect.DeepEqual(body.Details.UnknownFields, []string{"nickname"}) {
+		t.Errorf("Expected unknown_fields [nickname], got %v (%s)", body.Details.UnknownFields, w.Body.String())
+	}
+
+	ctx, w = strictContext(`{"name":"a"}`)
+	if err := ctx.BindJSONStrict(&user); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if user.Name != "a" || w.Body.Len() != 0 {
+		t.Errorf("Expected bound obj without response, got name %q body %q", user.Name, w.Body.String())
+	}
+}
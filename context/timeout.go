@@ -0,0 +1,44 @@
+package context
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// WithTimeout gắn deadline vào context.Context của request. Khi hết hạn, IsAborted trả về
+// true và Next không thực thi các handlers còn lại.
+//
+// Params:
+//   - timeout: Thời gian tối đa tính từ lúc gọi
+//
+// Returns:
+//   - context.CancelFunc: Hàm giải phóng timer, nên được gọi khi không cần deadline nữa
+func (c *forkContext) WithTimeout(timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(c.ctx, timeout)
+	c.WithContext(ctx)
+	c.timeout = ctx
+
+	// Giữ cancel của các lần gọi trước để clear giải phóng toàn bộ timers
+	if prev := c.timeoutCancel; prev != nil {
+		c.timeoutCancel = func() {
+			cancel()
+			prev()
+		}
+	} else {
+		c.timeoutCancel = cancel
+	}
+	return cancel
+}
+
+// timedOut kiểm tra deadline đặt bởi WithTimeout đã hết hạn hay chưa và abort context nếu đã hết.
+//
+// Returns:
+//   - bool: true nếu deadline đã hết hạn
+func (c *forkContext) timedOut() bool {
+	if c.timeout == nil || !errors.Is(c.timeout.Err(), context.DeadlineExceeded) {
+		return false
+	}
+	c.aborted = true
+	return true
+}
@@ -0,0 +1,67 @@
+package context
+
+import (
+	gocontext "context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestContextWithTimeout(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	ctx.BridgeStore("user")
+	ctx.Set("user", "alice")
+
+	var ran []int
+	ctx.SetHandlers([]func(Context){
+		func(c Context) {
+			ran = append(ran, 0)
+			cancel := c.WithTimeout(10 * time.Millisecond)
+			defer cancel()
+			if _, ok := c.Context().Deadline(); !ok {
+				t.Error("Expected deadline on Context()")
+			}
+			c.Next()
+		},
+		func(c Context) {
+			ran = append(ran, 1)
+			<-c.Context().Done() // công việc chậm hơn deadline
+		},
+		func(c Context) {
+			ran = append(ran, 2)
+		},
+	})
+	ctx.Next()
+
+	if len(ran) != 2 {
+		t.Errorf("Expected handlers after the deadline to be skipped, ran %v", ran)
+	}
+	if !ctx.IsAborted() {
+		t.Error("Expected IsAborted after deadline")
+	}
+	if !errors.Is(ctx.Err(), gocontext.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded, got %v", ctx.Err())
+	}
+	if value, ok := StoreValue(ctx.Context(), "user"); !ok || value != "alice" {
+		t.Errorf("Expected bridge to be kept on the deadline context, got %v", value)
+	}
+}
+
+func TestContextWithTimeoutCanceledEarly(t *testing.T) {
+	ctx := NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	cancel := ctx.WithTimeout(time.Hour)
+	cancel()
+
+	if ctx.IsAborted() {
+		t.Error("Expected cancel before the deadline not to abort")
+	}
+
+	ctx = AcquireContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	ctx.WithTimeout(time.Hour)
+	timeoutCtx := ctx.Context()
+	ReleaseContext(ctx)
+	if timeoutCtx.Err() == nil {
+		t.Error("Expected ReleaseContext to release the timeout")
+	}
+}
@@ -0,0 +1,77 @@
+package context
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Validator là backend validation dùng bởi ValidateStruct, ShouldBindAndValidate và
+// BindAndValidate. *validator.Validate của go-playground implement Validator; ứng dụng dùng
+// thư viện khác (ozzo-validation, JSON schema...) implement Validator và gắn qua
+// WebApp.SetValidator.
+type Validator interface {
+	// Struct kiểm tra tính hợp lệ của obj.
+	//
+	// Parameters:
+	//   - obj: Đối tượng cần validate, thường là con trỏ struct đã bind
+	//
+	// Returns:
+	//   - error: Lỗi nếu không hợp lệ; *forkerrors.HttpError được BindAndValidate trả về nguyên vẹn
+	Struct(obj interface{}) error
+}
+
+// ValidatorFunc cho phép dùng một hàm thông thường như Validator.
+type ValidatorFunc func(obj interface{}) error
+
+// Struct gọi f(obj).
+func (f ValidatorFunc) Struct(obj interface{}) error {
+	return f(obj)
+}
+
+// ErrUnsupportedValidator là lỗi được trả về bởi RegisterValidation khi validator đang dùng
+// không phải *validator.Validate của go-playground.
+var ErrUnsupportedValidator = errors.New("validator does not support RegisterValidation")
+
+// ErrSharedValidator là lỗi được trả về bởi Context.RegisterValidation: validator được dùng
+// chung bởi mọi request nên đăng ký validation trong lúc xử lý request gây data race với các
+// request đang validate. Đăng ký khi khởi tạo ứng dụng qua WebApp.RegisterValidation.
+var ErrSharedValidator = errors.New("validator is shared across requests; register validations at startup with WebApp.RegisterValidation")
+
+// NewValidator tạo validator với cấu hình mặc định của context: tên field trong lỗi
+// validation lấy từ tag json, sau đó là form, cuối cùng là tên field. Dùng để tạo validator
+// của ứng dụng và gắn vào context qua SetValidator.
+//
+// Returns:
+//   - *validator.Validate: Validator mới
+func NewValidator() *validator.Validate {
+	validate := validator.New()
+
+	// Đăng ký hàm định dạng lỗi tùy chỉnh
+	// Ưu tiên sử dụng tên từ tag json, sau đó là form, cuối cùng là tên trường
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := fld.Tag.Get("json")
+		if name == "" {
+			name = fld.Tag.Get("form")
+		}
+		if name == "" {
+			name = fld.Name
+		}
+		return name
+	})
+	return validate
+}
+
+// defaultValidator là validator dùng chung cho các context chưa được gắn validator của ứng dụng.
+// Khởi tạo validator tốn nhiều cấp phát nên không tạo lại cho mỗi request.
+var defaultValidator = sync.OnceValue(NewValidator)
+
+// structValidator trả về validator được gắn qua SetValidator, ngược lại là validator dùng chung.
+func (c *forkContext) structValidator() Validator {
+	if c.validator != nil {
+		return c.validator
+	}
+	return defaultValidator()
+}
@@ -0,0 +1,98 @@
+package context
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	forkerrors "go.fork.vn/fork/errors"
+)
+
+// validatable mô phỏng struct tự validate như ozzo-validation
+type validatable interface {
+	Validate() error
+}
+
+type signupRequest struct {
+	Email string `json:"email"`
+}
+
+func (r signupRequest) Validate() error {
+	if !strings.Contains(r.Email, "@") {
+		return errors.New("email: must be a valid email address")
+	}
+	return nil
+}
+
+// validatableEngine là backend validation gọi Validate của struct
+var validatableEngine = ValidatorFunc(func(obj interface{}) error {
+	if v, ok := obj.(validatable); ok {
+		return v.Validate()
+	}
+	return nil
+})
+
+func jsonContext(body string) (Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return NewContext(w, req), w
+}
+
+func TestContextCustomValidator(t *testing.T) {
+	ctx, _ := jsonContext(`{"email":"alice@example.com"}`)
+	ctx.SetValidator(validatableEngine)
+
+	var req signupRequest
+	if err := ctx.ShouldBindAndValidate(&req); err != nil {
+		t.Errorf("Expected valid request, got %v", err)
+	}
+	if ctx.GetValidator() != nil {
+		t.Error("Expected GetValidator to be nil for a custom engine")
+	}
+	if err := ctx.RegisterValidation("x", func(validator.FieldLevel) bool { return true }); !errors.Is(err, ErrUnsupportedValidator) {
+		t.Errorf("Expected ErrUnsupportedValidator, got %v", err)
+	}
+
+	ctx, w := jsonContext(`{"email":"invalid"}`)
+	ctx.SetValidator(validatableEngine)
+	if err := ctx.BindAndValidate(&req); err == nil {
+		t.Fatal("Expected validation error")
+	}
+	if w.Code != http.StatusUnprocessableEntity || !strings.Contains(w.Body.String(), "must be a valid email address") {
+		t.Errorf("Expected 422 with engine message, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestContextCustomValidatorHTTPError(t *testing.T) {
+	ctx, w := jsonContext(`{"email":"taken@example.com"}`)
+	ctx.SetValidator(ValidatorFunc(func(obj interface{}) error {
+		return forkerrors.NewConflict("Email already registered", map[string]interface{}{"field": "email"}, nil)
+	}))
+
+	var req signupRequest
+	err := ctx.BindAndValidate(&req)
+	var httpErr *forkerrors.HttpError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusConflict {
+		t.Errorf("Expected HTTP error from validator, got %v", err)
+	}
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status 409, got %d", w.Code)
+	}
+}
+
+func TestContextSetValidatorNil(t *testing.T) {
+	ctx, _ := jsonContext(`{}`)
+	ctx.SetValidator((*validator.Validate)(nil))
+	if ctx.GetValidator() != defaultValidator() {
+		t.Error("Expected typed nil validator to fall back to the shared validator")
+	}
+	if err := ctx.ValidateStruct(struct {
+		Name string `validate:"required"`
+	}{}); err == nil {
+		t.Error("Expected shared validator to validate")
+	}
+}
@@ -138,3 +138,5 @@
 //
 // Xem thêm ví dụ trong /examples/infra/http/error_handling
 package fork
+
+//go:generate mockery --config .mockery.yaml
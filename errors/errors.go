@@ -338,6 +338,38 @@ func Gone(message string) *HttpError {
 	return SimpleHttpError(http.StatusGone, message)
 }
 
+// NewRequestEntityTooLarge tạo một HttpError với mã trạng thái 413 Request Entity Too Large.
+// Phương thức này được sử dụng khi body của request vượt quá kích thước server cho phép.
+//
+// Parameters:
+//   - message: Thông báo mô tả lỗi, nếu rỗng sẽ sử dụng "Request Entity Too Large"
+//   - details: Map chứa thông tin chi tiết về lỗi, có thể là nil
+//   - err: Lỗi gốc gây ra HttpError, có thể là nil
+//
+// Returns:
+//   - *HttpError: Một instance mới của HttpError với StatusCode là 413
+func NewRequestEntityTooLarge(message string, details map[string]interface{}, err error) *HttpError {
+	if message == "" {
+		message = "Request Entity Too Large"
+	}
+	return NewHttpError(http.StatusRequestEntityTooLarge, message, details, err)
+}
+
+// RequestEntityTooLarge tạo một HttpError 413 đơn giản chỉ với thông báo.
+// Phương thức này là cách nhanh để tạo lỗi Request Entity Too Large khi không cần chi tiết và lỗi gốc.
+//
+// Parameters:
+//   - message: Thông báo mô tả lỗi, nếu rỗng sẽ sử dụng "Request Entity Too Large"
+//
+// Returns:
+//   - *HttpError: Một instance mới của HttpError với StatusCode là 413
+func RequestEntityTooLarge(message string) *HttpError {
+	if message == "" {
+		message = "Request Entity Too Large"
+	}
+	return SimpleHttpError(http.StatusRequestEntityTooLarge, message)
+}
+
 // NewUnsupportedMediaType tạo một HttpError với mã trạng thái 415 Unsupported Media Type.
 // Phương thức này được sử dụng khi server không hỗ trợ định dạng media yêu cầu.
 //
@@ -0,0 +1,237 @@
+package forktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// ContextOption cấu hình context được tạo bởi NewTestContext.
+type ContextOption func(*contextConfig)
+
+type formFile struct {
+	field    string
+	filename string
+	content  []byte
+}
+
+type contextConfig struct {
+	params      map[string]string
+	fullPath    string
+	headers     map[string]string
+	store       map[string]interface{}
+	body        []byte
+	contentType string
+	formValues  url.Values
+	formFiles   []formFile
+}
+
+// WithParams thiết lập các route parameter cho context (ví dụ: ":id" của "/users/:id").
+//
+// Parameters:
+//   - params: Tên và giá trị của các route parameter
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+func WithParams(params map[string]string) ContextOption {
+	return func(c *contextConfig) {
+		for name, value := range params {
+			c.params[name] = value
+		}
+	}
+}
+
+// WithParam thiết lập một route parameter cho context.
+//
+// Parameters:
+//   - name: Tên parameter
+//   - value: Giá trị parameter
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+func WithParam(name, value string) ContextOption {
+	return func(c *contextConfig) {
+		c.params[name] = value
+	}
+}
+
+// WithFullPath thiết lập pattern của route đã khớp, đọc bằng ctx.FullPath().
+//
+// Parameters:
+//   - path: Pattern của route (ví dụ: "/users/:id")
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+func WithFullPath(path string) ContextOption {
+	return func(c *contextConfig) {
+		c.fullPath = path
+	}
+}
+
+// WithRequestHeader thiết lập header cho request của context.
+//
+// Parameters:
+//   - key: Tên header
+//   - value: Giá trị header
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+func WithRequestHeader(key, value string) ContextOption {
+	return func(c *contextConfig) {
+		c.headers[key] = value
+	}
+}
+
+// WithValue lưu giá trị vào context store (ví dụ: user đã xác thực do middleware trước gắn vào).
+//
+// Parameters:
+//   - key: Khóa trong context store
+//   - value: Giá trị
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+func WithValue(key string, value interface{}) ContextOption {
+	return func(c *contextConfig) {
+		c.store[key] = value
+	}
+}
+
+// WithJSONBody mã hóa v thành JSON làm body của request.
+//
+// Parameters:
+//   - v: Giá trị cần mã hóa
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+//
+// Panics:
+//   - Nếu v không thể mã hóa thành JSON
+func WithJSONBody(v interface{}) ContextOption {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic("forktest: cannot encode JSON body: " + err.Error())
+	}
+	return WithRawBody("application/json", data)
+}
+
+// WithRawBody thiết lập body thô và Content-Type của request.
+//
+// Parameters:
+//   - contentType: Giá trị header Content-Type
+//   - body: Nội dung body
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+func WithRawBody(contentType string, body []byte) ContextOption {
+	return func(c *contextConfig) {
+		c.contentType = contentType
+		c.body = body
+	}
+}
+
+// WithFormValue thêm trường form. Khi có WithFormFile, trường được gửi trong multipart
+// form; ngược lại body có dạng application/x-www-form-urlencoded.
+//
+// Parameters:
+//   - field: Tên trường
+//   - value: Giá trị trường
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+func WithFormValue(field, value string) ContextOption {
+	return func(c *contextConfig) {
+		c.formValues.Add(field, value)
+	}
+}
+
+// WithFormFile thêm file upload vào multipart form của request.
+//
+// Parameters:
+//   - field: Tên trường file
+//   - filename: Tên file
+//   - content: Nội dung file
+//
+// Returns:
+//   - ContextOption: Option cho NewTestContext
+func WithFormFile(field, filename string, content []byte) ContextOption {
+	return func(c *contextConfig) {
+		c.formFiles = append(c.formFiles, formFile{field: field, filename: filename, content: content})
+	}
+}
+
+// NewTestContext tạo Context cho unit test của handler và middleware mà không cần router.
+// Route parameter, body JSON và multipart form được thiết lập qua các ContextOption.
+//
+// Parameters:
+//   - method: HTTP method
+//   - target: Đường dẫn, có thể kèm query string
+//   - opts: Các option cấu hình context
+//
+// Returns:
+//   - forkCtx.Context: Context đã khởi tạo
+//   - *httptest.ResponseRecorder: Recorder ghi lại response
+func NewTestContext(method, target string, opts ...ContextOption) (forkCtx.Context, *httptest.ResponseRecorder) {
+	config := &contextConfig{
+		params:     make(map[string]string),
+		headers:    make(map[string]string),
+		store:      make(map[string]interface{}),
+		formValues: url.Values{},
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	body, contentType := config.requestBody()
+	req := httptest.NewRequest(method, target, body)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for key, value := range config.headers {
+		req.Header.Set(key, value)
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(recorder, req)
+	if len(config.params) > 0 {
+		ctx.SetParams(config.params)
+	}
+	if config.fullPath != "" {
+		ctx.SetFullPath(config.fullPath)
+	}
+	for key, value := range config.store {
+		ctx.Set(key, value)
+	}
+	return ctx, recorder
+}
+
+// requestBody tạo body và Content-Type của request từ cấu hình.
+func (c *contextConfig) requestBody() (io.Reader, string) {
+	if len(c.formFiles) > 0 {
+		var buf bytes.Buffer
+		writer := multipart.NewWriter(&buf)
+		for field, values := range c.formValues {
+			for _, value := range values {
+				writer.WriteField(field, value)
+			}
+		}
+		for _, file := range c.formFiles {
+			part, _ := writer.CreateFormFile(file.field, file.filename)
+			part.Write(file.content)
+		}
+		writer.Close()
+		return &buf, writer.FormDataContentType()
+	}
+	if len(c.formValues) > 0 {
+		return strings.NewReader(c.formValues.Encode()), "application/x-www-form-urlencoded"
+	}
+	if c.body != nil {
+		return bytes.NewReader(c.body), c.contentType
+	}
+	return nil, ""
+}
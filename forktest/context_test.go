@@ -0,0 +1,77 @@
+package forktest
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestNewTestContextParamsAndStore(t *testing.T) {
+	ctx, w := NewTestContext(http.MethodGet, "/users/42?tab=posts",
+		WithParams(map[string]string{"id": "42"}),
+		WithParam("section", "posts"),
+		WithFullPath("/users/:id"),
+		WithRequestHeader("X-Request-ID", "abc"),
+		WithValue("user", "admin"),
+	)
+
+	if ctx.Param("id") != "42" || ctx.Param("section") != "posts" {
+		t.Errorf("Expected route params, got %v", ctx.ParamMap())
+	}
+	if ctx.FullPath() != "/users/:id" {
+		t.Errorf("Expected full path to be set, got %q", ctx.FullPath())
+	}
+	if ctx.Query("tab") != "posts" || ctx.GetHeader("X-Request-ID") != "abc" {
+		t.Error("Expected query and header to be set")
+	}
+	if ctx.GetString("user") != "admin" {
+		t.Errorf("Expected store value, got %q", ctx.GetString("user"))
+	}
+
+	ctx.String(http.StatusOK, "ok")
+	if w.Code != http.StatusOK || w.Body.String() != "ok" {
+		t.Errorf("Expected recorder to capture response, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestNewTestContextJSONBody(t *testing.T) {
+	ctx, _ := NewTestContext(http.MethodPost, "/users", WithJSONBody(map[string]string{"name": "fork"}))
+
+	var body struct {
+		Name string `json:"name"`
+	}
+	if err := ctx.BindJSON(&body); err != nil || body.Name != "fork" {
+		t.Errorf("Expected JSON body to bind, got %+v, %v", body, err)
+	}
+	if ctx.ContentType() != "application/json" {
+		t.Errorf("Expected JSON content type, got %q", ctx.ContentType())
+	}
+}
+
+func TestNewTestContextFormFile(t *testing.T) {
+	ctx, _ := NewTestContext(http.MethodPost, "/upload",
+		WithFormValue("title", "report"),
+		WithFormFile("file", "report.txt", []byte("hello")),
+	)
+
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		t.Fatalf("Expected form file, got %v", err)
+	}
+	file, _ := header.Open()
+	defer file.Close()
+	content, _ := io.ReadAll(file)
+	if header.Filename != "report.txt" || string(content) != "hello" {
+		t.Errorf("Unexpected file %q with content %q", header.Filename, content)
+	}
+	if ctx.Form("title") != "report" {
+		t.Errorf("Expected multipart form value, got %q", ctx.Form("title"))
+	}
+}
+
+func TestNewTestContextFormValues(t *testing.T) {
+	ctx, _ := NewTestContext(http.MethodPost, "/login", WithFormValue("email", "a@b.c"))
+	if ctx.Form("email") != "a@b.c" {
+		t.Errorf("Expected urlencoded form value, got %q", ctx.Form("email"))
+	}
+}
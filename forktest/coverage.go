@@ -0,0 +1,204 @@
+package forktest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// observableRouter là router hỗ trợ đăng ký MatchObserver, ví dụ router.DefaultRouter.
+type observableRouter interface {
+	router.Router
+	Observe(observer router.MatchObserver)
+}
+
+// RouteHit là số lần một route đã được gọi trong quá trình test.
+type RouteHit struct {
+	Method string
+	Path   string
+	Hits   int
+}
+
+// RouteCoverage ghi lại các routes đã được gọi trong quá trình test để tìm endpoints chưa được kiểm thử.
+//
+// Ví dụ với TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		coverage := forktest.TrackRoutes(app.Router())
+//		code := m.Run()
+//		fmt.Print(coverage.Report())
+//		if err := coverage.Check(80); err != nil && code == 0 {
+//			fmt.Println(err)
+//			code = 1
+//		}
+//		os.Exit(code)
+//	}
+type RouteCoverage struct {
+	router router.Router
+	mu     sync.Mutex
+	hits   map[string]int
+}
+
+// TrackRoutes bắt đầu ghi lại các routes được gọi trên router.
+// Routes đăng ký sau khi gọi TrackRoutes vẫn được tính vào coverage.
+//
+// Parameters:
+//   - r: Router cần theo dõi, thường là app.Router()
+//
+// Returns:
+//   - *RouteCoverage: Bộ ghi route coverage
+//
+// Panics:
+//   - Nếu router không hỗ trợ Observe
+func TrackRoutes(r router.Router) *RouteCoverage {
+	observable, ok := r.(observableRouter)
+	if !ok {
+		panic(fmt.Sprintf("forktest: router %T does not support route observation", r))
+	}
+
+	c := &RouteCoverage{router: r, hits: make(map[string]int)}
+	observable.Observe(func(_ forkCtx.Context, route router.Route) {
+		c.mu.Lock()
+		c.hits[routeKey(route.Method, route.Path)]++
+		c.mu.Unlock()
+	})
+	return c
+}
+
+// Hits trả về số lần route với method và path pattern đã cho được gọi.
+//
+// Parameters:
+//   - method: HTTP method của route
+//   - path: Path pattern như khi đăng ký (ví dụ: "/users/:id")
+//
+// Returns:
+//   - int: Số lần route được gọi
+func (c *RouteCoverage) Hits(method, path string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits[routeKey(method, path)]
+}
+
+// Routes trả về số lần gọi của mọi route đã đăng ký, sắp xếp theo path và method.
+//
+// Returns:
+//   - []RouteHit: Danh sách routes kèm số lần gọi
+func (c *RouteCoverage) Routes() []RouteHit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	seen := make(map[string]bool)
+	result := make([]RouteHit, 0)
+	for _, route := range c.router.Routes() {
+		key := routeKey(route.Method, route.Path)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, RouteHit{Method: route.Method, Path: route.Path, Hits: c.hits[key]})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Method < result[j].Method
+	})
+	return result
+}
+
+// Untested trả về các routes chưa được gọi lần nào.
+//
+// Returns:
+//   - []RouteHit: Danh sách routes chưa được kiểm thử
+func (c *RouteCoverage) Untested() []RouteHit {
+	untested := make([]RouteHit, 0)
+	for _, hit := range c.Routes() {
+		if hit.Hits == 0 {
+			untested = append(untested, hit)
+		}
+	}
+	return untested
+}
+
+// Percent trả về tỷ lệ phần trăm routes đã được gọi; router không có route nào được tính là 100.
+//
+// Returns:
+//   - float64: Tỷ lệ coverage trong khoảng [0, 100]
+func (c *RouteCoverage) Percent() float64 {
+	routes := c.Routes()
+	if len(routes) == 0 {
+		return 100
+	}
+
+	covered := 0
+	for _, hit := range routes {
+		if hit.Hits > 0 {
+			covered++
+		}
+	}
+	return float64(covered) * 100 / float64(len(routes))
+}
+
+// Report trả về báo cáo dạng văn bản gồm tỷ lệ coverage và số lần gọi của từng route.
+//
+// Returns:
+//   - string: Báo cáo route coverage
+func (c *RouteCoverage) Report() string {
+	routes := c.Routes()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "route coverage: %.1f%% of %d routes\n", c.Percent(), len(routes))
+	for _, hit := range routes {
+		marker := " "
+		if hit.Hits == 0 {
+			marker = "!"
+		}
+		fmt.Fprintf(&b, "%s %-7s %s (%d)\n", marker, hit.Method, hit.Path, hit.Hits)
+	}
+	return b.String()
+}
+
+// Check trả về lỗi liệt kê các routes chưa được kiểm thử nếu coverage thấp hơn ngưỡng.
+//
+// Parameters:
+//   - minPercent: Ngưỡng coverage tối thiểu, tính theo phần trăm
+//
+// Returns:
+//   - error: Lỗi nếu coverage thấp hơn ngưỡng, nil nếu đạt
+func (c *RouteCoverage) Check(minPercent float64) error {
+	percent := c.Percent()
+	if percent >= minPercent {
+		return nil
+	}
+
+	untested := c.Untested()
+	names := make([]string, len(untested))
+	for i, hit := range untested {
+		names[i] = routeKey(hit.Method, hit.Path)
+	}
+	return fmt.Errorf("route coverage %.1f%% is below %.1f%%; untested routes: %s",
+		percent, minPercent, strings.Join(names, ", "))
+}
+
+// AssertMinimum báo lỗi test nếu route coverage thấp hơn ngưỡng.
+//
+// Parameters:
+//   - t: Test đang chạy
+//   - minPercent: Ngưỡng coverage tối thiểu, tính theo phần trăm
+func (c *RouteCoverage) AssertMinimum(t testing.TB, minPercent float64) {
+	t.Helper()
+	if err := c.Check(minPercent); err != nil {
+		t.Errorf("%v", err)
+	}
+}
+
+// routeKey tạo khóa định danh route từ method và path pattern.
+func routeKey(method, path string) string {
+	return method + " " + path
+}
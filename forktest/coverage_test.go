@@ -0,0 +1,75 @@
+package forktest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+func TestRouteCoverage(t *testing.T) {
+	r := router.NewRouter()
+	coverage := TrackRoutes(r)
+
+	ok := func(ctx forkCtx.Context) { ctx.String(http.StatusOK, "ok") }
+	r.Handle(http.MethodGet, "/users", ok)
+	r.Handle(http.MethodGet, "/users/:id", ok)
+	r.Group("/admin").Handle(http.MethodDelete, "/users/:id", ok)
+	r.Handle(http.MethodPost, "/users", ok)
+
+	Get("/users/1").Run(r)
+	Get("/users/2").Run(r)
+	Post("/users").Run(r)
+	Get("/missing").Run(r)
+
+	if hits := coverage.Hits(http.MethodGet, "/users/:id"); hits != 2 {
+		t.Errorf("Expected 2 hits, got %d", hits)
+	}
+	if percent := coverage.Percent(); percent != 50 {
+		t.Errorf("Expected 50%% coverage, got %v", percent)
+	}
+
+	untested := coverage.Untested()
+	if len(untested) != 2 || untested[0].Path != "/admin/users/:id" || untested[1].Method != http.MethodGet || untested[1].Path != "/users" {
+		t.Errorf("Unexpected untested routes: %+v", untested)
+	}
+
+	report := coverage.Report()
+	for _, expected := range []string{"route coverage: 50.0% of 4 routes", "! DELETE  /admin/users/:id (0)", "  GET     /users/:id (2)"} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("Expected report to contain %q, got:\n%s", expected, report)
+		}
+	}
+
+	if err := coverage.Check(50); err != nil {
+		t.Errorf("Expected threshold to pass, got %v", err)
+	}
+	err := coverage.Check(75)
+	if err == nil || !strings.Contains(err.Error(), "DELETE /admin/users/:id, GET /users") {
+		t.Errorf("Expected error listing untested routes, got %v", err)
+	}
+
+	rt := &recordingT{}
+	coverage.AssertMinimum(rt, 75)
+	if len(rt.errors) != 1 {
+		t.Errorf("Expected AssertMinimum to fail, got %v", rt.errors)
+	}
+}
+
+func TestRouteCoverageEmptyRouter(t *testing.T) {
+	coverage := TrackRoutes(router.NewRouter())
+	if coverage.Percent() != 100 || coverage.Check(100) != nil {
+		t.Errorf("Expected empty router to be fully covered, got %v", coverage.Percent())
+	}
+}
+
+func TestTrackRoutesUnsupportedRouter(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for router without Observe")
+		}
+	}()
+	TrackRoutes(struct{ router.Router }{})
+}
@@ -0,0 +1,221 @@
+// Package forktest cung cấp request builder dạng fluent và các assertion cho response,
+// giúp giảm boilerplate httptest trong test suite của ứng dụng.
+//
+// Ví dụ:
+//
+//	forktest.Post("/users").
+//		WithHeader("Authorization", "Bearer token").
+//		WithJSON(map[string]string{"name": "fork"}).
+//		Run(app).
+//		AssertStatus(t, http.StatusCreated).
+//		AssertJSONPath(t, "data.name", "fork")
+package forktest
+
+import (
+	"bytes"
+	gocontext "context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+)
+
+// RequestBuilder xây dựng HTTP request cho test.
+type RequestBuilder struct {
+	method  string
+	path    string
+	query   url.Values
+	header  http.Header
+	cookies []*http.Cookie
+	body    []byte
+	ctx     gocontext.Context
+}
+
+// NewRequest tạo RequestBuilder với method và path đã cho.
+//
+// Parameters:
+//   - method: HTTP method
+//   - path: Đường dẫn, có thể kèm query string (ví dụ: "/users?page=2")
+//
+// Returns:
+//   - *RequestBuilder: Builder mới
+func NewRequest(method, path string) *RequestBuilder {
+	return &RequestBuilder{
+		method: method,
+		path:   path,
+		query:  url.Values{},
+		header: http.Header{},
+	}
+}
+
+// Get tạo RequestBuilder cho request GET.
+func Get(path string) *RequestBuilder { return NewRequest(http.MethodGet, path) }
+
+// Post tạo RequestBuilder cho request POST.
+func Post(path string) *RequestBuilder { return NewRequest(http.MethodPost, path) }
+
+// Put tạo RequestBuilder cho request PUT.
+func Put(path string) *RequestBuilder { return NewRequest(http.MethodPut, path) }
+
+// Patch tạo RequestBuilder cho request PATCH.
+func Patch(path string) *RequestBuilder { return NewRequest(http.MethodPatch, path) }
+
+// Delete tạo RequestBuilder cho request DELETE.
+func Delete(path string) *RequestBuilder { return NewRequest(http.MethodDelete, path) }
+
+// Head tạo RequestBuilder cho request HEAD.
+func Head(path string) *RequestBuilder { return NewRequest(http.MethodHead, path) }
+
+// Options tạo RequestBuilder cho request OPTIONS.
+func Options(path string) *RequestBuilder { return NewRequest(http.MethodOptions, path) }
+
+// WithHeader thêm header vào request.
+//
+// Parameters:
+//   - key: Tên header
+//   - value: Giá trị header
+//
+// Returns:
+//   - *RequestBuilder: Builder để tiếp tục chuỗi gọi
+func (b *RequestBuilder) WithHeader(key, value string) *RequestBuilder {
+	b.header.Add(key, value)
+	return b
+}
+
+// WithQuery thêm tham số query vào request.
+//
+// Parameters:
+//   - key: Tên tham số
+//   - value: Giá trị tham số
+//
+// Returns:
+//   - *RequestBuilder: Builder để tiếp tục chuỗi gọi
+func (b *RequestBuilder) WithQuery(key, value string) *RequestBuilder {
+	b.query.Add(key, value)
+	return b
+}
+
+// WithCookie thêm cookie vào request.
+//
+// Parameters:
+//   - cookie: Cookie cần gửi
+//
+// Returns:
+//   - *RequestBuilder: Builder để tiếp tục chuỗi gọi
+func (b *RequestBuilder) WithCookie(cookie *http.Cookie) *RequestBuilder {
+	b.cookies = append(b.cookies, cookie)
+	return b
+}
+
+// WithBearerToken thiết lập header Authorization dạng Bearer.
+//
+// Parameters:
+//   - token: Access token
+//
+// Returns:
+//   - *RequestBuilder: Builder để tiếp tục chuỗi gọi
+func (b *RequestBuilder) WithBearerToken(token string) *RequestBuilder {
+	b.header.Set("Authorization", "Bearer "+token)
+	return b
+}
+
+// WithBody thiết lập body thô và Content-Type của request.
+//
+// Parameters:
+//   - contentType: Giá trị header Content-Type
+//   - body: Nội dung body
+//
+// Returns:
+//   - *RequestBuilder: Builder để tiếp tục chuỗi gọi
+func (b *RequestBuilder) WithBody(contentType string, body []byte) *RequestBuilder {
+	b.header.Set("Content-Type", contentType)
+	b.body = body
+	return b
+}
+
+// WithJSON mã hóa v thành JSON làm body của request.
+//
+// Parameters:
+//   - v: Giá trị cần mã hóa
+//
+// Returns:
+//   - *RequestBuilder: Builder để tiếp tục chuỗi gọi
+//
+// Panics:
+//   - Nếu v không thể mã hóa thành JSON
+func (b *RequestBuilder) WithJSON(v interface{}) *RequestBuilder {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic("forktest: cannot encode JSON body: " + err.Error())
+	}
+	return b.WithBody("application/json", data)
+}
+
+// WithForm thiết lập body dạng application/x-www-form-urlencoded.
+//
+// Parameters:
+//   - values: Các trường của form
+//
+// Returns:
+//   - *RequestBuilder: Builder để tiếp tục chuỗi gọi
+func (b *RequestBuilder) WithForm(values url.Values) *RequestBuilder {
+	return b.WithBody("application/x-www-form-urlencoded", []byte(values.Encode()))
+}
+
+// WithContext thiết lập context.Context cho request.
+//
+// Parameters:
+//   - ctx: Context của request
+//
+// Returns:
+//   - *RequestBuilder: Builder để tiếp tục chuỗi gọi
+func (b *RequestBuilder) WithContext(ctx gocontext.Context) *RequestBuilder {
+	b.ctx = ctx
+	return b
+}
+
+// Build tạo *http.Request từ builder.
+//
+// Returns:
+//   - *http.Request: Request đã xây dựng
+func (b *RequestBuilder) Build() *http.Request {
+	target := b.path
+	if len(b.query) > 0 {
+		separator := "?"
+		if strings.Contains(target, "?") {
+			separator = "&"
+		}
+		target += separator + b.query.Encode()
+	}
+
+	var body io.Reader
+	if b.body != nil {
+		body = bytes.NewReader(b.body)
+	}
+	req := httptest.NewRequest(b.method, target, body)
+	for key, values := range b.header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	for _, cookie := range b.cookies {
+		req.AddCookie(cookie)
+	}
+	if b.ctx != nil {
+		req = req.WithContext(b.ctx)
+	}
+	return req
+}
+
+// Run thực thi request trên handler (ví dụ: *fork.WebApp hoặc router) trong bộ nhớ.
+//
+// Parameters:
+//   - handler: http.Handler xử lý request
+//
+// Returns:
+//   - *Result: Kết quả của request
+func (b *RequestBuilder) Run(handler http.Handler) *Result {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, b.Build())
+	return newResult(recorder.Result())
+}
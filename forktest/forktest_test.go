@@ -0,0 +1,70 @@
+package forktest
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+type ctxKey struct{}
+
+func echoHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var session string
+		if cookie, err := r.Cookie("session"); err == nil {
+			session = cookie.Value
+		}
+		value, _ := r.Context().Value(ctxKey{}).(string)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"method":       r.Method,
+			"path":         r.URL.Path,
+			"query":        r.URL.RawQuery,
+			"content_type": r.Header.Get("Content-Type"),
+			"auth":         r.Header.Get("Authorization"),
+			"cookie":       session,
+			"body":         string(body),
+			"ctx":          value,
+		})
+	})
+}
+
+func TestBuilderRun(t *testing.T) {
+	result := Post("/users?a=1").
+		WithQuery("b", "2").
+		WithBearerToken("secret").
+		WithCookie(&http.Cookie{Name: "session", Value: "abc"}).
+		WithJSON(map[string]string{"name": "fork"}).
+		WithContext(gocontext.WithValue(gocontext.Background(), ctxKey{}, "value")).
+		Run(echoHandler())
+
+	result.AssertStatus(t, http.StatusCreated).
+		AssertHeader(t, "Content-Type", "application/json").
+		AssertJSONPath(t, "method", "POST").
+		AssertJSONPath(t, "path", "/users").
+		AssertJSONPath(t, "query", "a=1&b=2").
+		AssertJSONPath(t, "auth", "Bearer secret").
+		AssertJSONPath(t, "cookie", "abc").
+		AssertJSONPath(t, "content_type", "application/json").
+		AssertJSONPath(t, "body", `{"name":"fork"}`).
+		AssertJSONPath(t, "ctx", "value")
+
+	// Body có thể được đọc lại từ Response
+	body, _ := io.ReadAll(result.Response.Body)
+	if string(body) != result.String() {
+		t.Errorf("Expected Response.Body to be readable, got %q", body)
+	}
+}
+
+func TestBuilderWithForm(t *testing.T) {
+	Put("/profile").
+		WithForm(url.Values{"name": {"fork"}}).
+		Run(echoHandler()).
+		AssertJSONPath(t, "body", "name=fork").
+		AssertJSONPath(t, "content_type", "application/x-www-form-urlencoded")
+}
@@ -0,0 +1,261 @@
+package forktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// UpdateGoldenEnv là biến môi trường bật chế độ ghi lại golden file ("1" hoặc "true").
+const UpdateGoldenEnv = "FORKTEST_UPDATE_GOLDEN"
+
+// redactedValue thay thế giá trị bị ẩn trong snapshot.
+const redactedValue = "<redacted>"
+
+var (
+	timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+	uuidPattern      = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+)
+
+// GoldenOption cấu hình việc tạo và so sánh snapshot.
+type GoldenOption func(*goldenConfig)
+
+type goldenRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+type goldenConfig struct {
+	dir     string
+	headers []string
+	fields  map[string]bool
+	rules   []goldenRule
+	update  bool
+}
+
+// WithGoldenDir thiết lập thư mục chứa golden file.
+// Mặc định: "testdata"
+func WithGoldenDir(dir string) GoldenOption {
+	return func(c *goldenConfig) {
+		c.dir = dir
+	}
+}
+
+// WithGoldenHeaders chọn các header được đưa vào snapshot.
+// Mặc định: Content-Type
+func WithGoldenHeaders(names ...string) GoldenOption {
+	return func(c *goldenConfig) {
+		c.headers = names
+	}
+}
+
+// RedactFields ẩn giá trị của các khóa JSON (ở mọi cấp) trong snapshot, ví dụ "id" hay "created_at".
+func RedactFields(names ...string) GoldenOption {
+	return func(c *goldenConfig) {
+		for _, name := range names {
+			c.fields[name] = true
+		}
+	}
+}
+
+// RedactPattern thay thế các đoạn khớp pattern trong body và header của snapshot.
+func RedactPattern(pattern *regexp.Regexp, replacement string) GoldenOption {
+	return func(c *goldenConfig) {
+		c.rules = append(c.rules, goldenRule{pattern: pattern, replacement: replacement})
+	}
+}
+
+// RedactTimestamps thay thế các thời điểm dạng RFC 3339 bằng "<timestamp>".
+func RedactTimestamps() GoldenOption {
+	return RedactPattern(timestampPattern, "<timestamp>")
+}
+
+// RedactUUIDs thay thế các UUID bằng "<uuid>".
+func RedactUUIDs() GoldenOption {
+	return RedactPattern(uuidPattern, "<uuid>")
+}
+
+// UpdateGolden bật hoặc tắt chế độ ghi lại golden file, bất kể biến môi trường UpdateGoldenEnv.
+func UpdateGolden(update bool) GoldenOption {
+	return func(c *goldenConfig) {
+		c.update = update
+	}
+}
+
+func newGoldenConfig(opts []GoldenOption) *goldenConfig {
+	env := os.Getenv(UpdateGoldenEnv)
+	config := &goldenConfig{
+		dir:     "testdata",
+		headers: []string{"Content-Type"},
+		fields:  make(map[string]bool),
+		update:  env == "1" || env == "true",
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// Snapshot trả về biểu diễn ổn định của response: status, các header đã chọn và body
+// đã chuẩn hóa (JSON được định dạng lại với khóa sắp xếp) sau khi ẩn các giá trị thay đổi.
+//
+// Parameters:
+//   - opts: Các option chọn header và ẩn giá trị
+//
+// Returns:
+//   - string: Nội dung snapshot
+func (r *Result) Snapshot(opts ...GoldenOption) string {
+	return r.snapshot(newGoldenConfig(opts))
+}
+
+func (r *Result) snapshot(config *goldenConfig) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "HTTP %d\n", r.Response.StatusCode)
+
+	headers := append([]string(nil), config.headers...)
+	sort.Strings(headers)
+	for _, name := range headers {
+		for _, value := range r.Response.Header.Values(name) {
+			fmt.Fprintf(&b, "%s: %s\n", http.CanonicalHeaderKey(name), value)
+		}
+	}
+	b.WriteString("\n")
+	b.Write(normalizeBody(r.body, config.fields))
+
+	snapshot := b.String()
+	for _, rule := range config.rules {
+		snapshot = rule.pattern.ReplaceAllString(snapshot, rule.replacement)
+	}
+	if !strings.HasSuffix(snapshot, "\n") {
+		snapshot += "\n"
+	}
+	return snapshot
+}
+
+// AssertGolden so sánh snapshot của response với golden file "<dir>/<name>.golden".
+// Khi chế độ ghi lại được bật (UpdateGoldenEnv=1 hoặc UpdateGolden(true)), golden file
+// được ghi mới thay vì so sánh.
+//
+// Parameters:
+//   - t: Test hiện tại
+//   - name: Tên golden file, không gồm phần mở rộng
+//   - opts: Các option chọn header và ẩn giá trị
+//
+// Returns:
+//   - *Result: Result để tiếp tục chuỗi gọi
+func (r *Result) AssertGolden(t testing.TB, name string, opts ...GoldenOption) *Result {
+	t.Helper()
+	config := newGoldenConfig(opts)
+	actual := r.snapshot(config)
+	path := filepath.Join(config.dir, name+".golden")
+
+	if config.update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("Failed to create golden directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(actual), 0o644); err != nil {
+			t.Fatalf("Failed to write golden file %s: %v", path, err)
+		}
+		return r
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("Failed to read golden file %s (run with %s=1 to create it): %v", path, UpdateGoldenEnv, err)
+		return r
+	}
+	if string(expected) != actual {
+		t.Errorf("Response does not match golden file %s (run with %s=1 to update):\n%s",
+			path, UpdateGoldenEnv, diffLines(string(expected), actual))
+	}
+	return r
+}
+
+// normalizeBody định dạng lại body JSON với khóa sắp xếp và ẩn các trường được chỉ định.
+// Body không phải JSON được giữ nguyên.
+func normalizeBody(body []byte, fields map[string]bool) []byte {
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if len(bytes.TrimSpace(body)) == 0 || decoder.Decode(&document) != nil || decoder.More() {
+		return body
+	}
+	document = redactFields(document, fields)
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return body
+	}
+	return buf.Bytes()
+}
+
+func redactFields(value interface{}, fields map[string]bool) interface{} {
+	if len(fields) == 0 {
+		return value
+	}
+	switch node := value.(type) {
+	case map[string]interface{}:
+		for key, child := range node {
+			if fields[key] && child != nil {
+				node[key] = redactedValue
+			} else {
+				node[key] = redactFields(child, fields)
+			}
+		}
+	case []interface{}:
+		for i, child := range node {
+			node[i] = redactFields(child, fields)
+		}
+	}
+	return value
+}
+
+// diffLines trả về khác biệt theo dòng giữa expected và actual, dòng bị xóa có tiền tố "-"
+// và dòng được thêm có tiền tố "+".
+func diffLines(expected, actual string) string {
+	a := strings.Split(strings.TrimSuffix(expected, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(actual, "\n"), "\n")
+
+	// Bảng độ dài chuỗi con chung dài nhất (LCS)
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			out.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			out.WriteString("- " + a[i] + "\n")
+			i++
+		default:
+			out.WriteString("+ " + b[j] + "\n")
+			j++
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,89 @@
+package forktest
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func userHandler(name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-ID", "c3b1f1b2-7a0e-4d4f-9b39-2f1c9a9d5e11")
+		w.Write([]byte(`{"name":"` + name + `","id":17,"created_at":"2024-05-01T10:00:00Z","meta":{"trace":"c3b1f1b2-7a0e-4d4f-9b39-2f1c9a9d5e11"}}`))
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	snapshot := Get("/users/17").Run(userHandler("fork")).Snapshot(
+		WithGoldenHeaders("Content-Type", "X-Request-ID"),
+		RedactFields("id"),
+		RedactTimestamps(),
+		RedactUUIDs(),
+	)
+
+	expected := `HTTP 200
+Content-Type: application/json
+X-Request-Id: <uuid>
+
+{
+  "created_at": "<timestamp>",
+  "id": "<redacted>",
+  "meta": {
+    "trace": "<uuid>"
+  },
+  "name": "fork"
+}
+`
+	if snapshot != expected {
+		t.Errorf("Unexpected snapshot:\n%s", snapshot)
+	}
+}
+
+func TestSnapshotNonJSONBody(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("hello"))
+	})
+	if snapshot := Get("/").Run(handler).Snapshot(); snapshot != "HTTP 200\nContent-Type: text/plain\n\nhello\n" {
+		t.Errorf("Unexpected snapshot: %q", snapshot)
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	dir := t.TempDir()
+	opts := []GoldenOption{WithGoldenDir(dir), RedactFields("id", "created_at")}
+
+	// Chưa có golden file
+	rt := &recordingT{}
+	Get("/").Run(userHandler("fork")).AssertGolden(rt, "user", opts...)
+	if len(rt.errors) != 1 {
+		t.Fatalf("Expected missing golden file to fail, got %v", rt.errors)
+	}
+
+	Get("/").Run(userHandler("fork")).AssertGolden(t, "user", append(opts, UpdateGolden(true))...)
+	if _, err := os.Stat(filepath.Join(dir, "user.golden")); err != nil {
+		t.Fatalf("Expected golden file to be written: %v", err)
+	}
+
+	Get("/").Run(userHandler("fork")).AssertGolden(t, "user", opts...)
+
+	rt = &recordingT{}
+	Get("/").Run(userHandler("spoon")).AssertGolden(rt, "user", opts...)
+	if len(rt.errors) != 1 {
+		t.Errorf("Expected changed response to fail, got %v", rt.errors)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	diff := diffLines("a\nb\nc\n", "a\nx\nc\n")
+	expected := "  a\n- b\n+ x\n  c\n"
+	if diff != expected {
+		t.Errorf("Expected diff %q, got %q", expected, diff)
+	}
+	if !strings.Contains(diffLines("a\n", "a\nb\n"), "+ b") {
+		t.Error("Expected added line in diff")
+	}
+}
@@ -0,0 +1,184 @@
+package forktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Result là response của request đã thực thi, kèm các assertion.
+// Mỗi assertion trả về chính Result để có thể nối chuỗi.
+type Result struct {
+	// Response là response gốc; Body đã được đọc và có thể đọc lại
+	Response *http.Response
+
+	body []byte
+}
+
+func newResult(resp *http.Response) *Result {
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return &Result{Response: resp, body: body}
+}
+
+// StatusCode trả về HTTP status code của response.
+func (r *Result) StatusCode() int {
+	return r.Response.StatusCode
+}
+
+// Header trả về header của response.
+func (r *Result) Header() http.Header {
+	return r.Response.Header
+}
+
+// Body trả về nội dung body của response.
+func (r *Result) Body() []byte {
+	return r.body
+}
+
+// String trả về nội dung body dạng chuỗi.
+func (r *Result) String() string {
+	return string(r.body)
+}
+
+// JSON giải mã body JSON vào v.
+//
+// Parameters:
+//   - v: Con trỏ tới giá trị nhận dữ liệu
+//
+// Returns:
+//   - error: Lỗi nếu body không phải JSON hợp lệ
+func (r *Result) JSON(v interface{}) error {
+	return json.Unmarshal(r.body, v)
+}
+
+// AssertStatus kiểm tra HTTP status code.
+//
+// Parameters:
+//   - t: Test hiện tại
+//   - expected: Status code mong đợi
+//
+// Returns:
+//   - *Result: Result để tiếp tục chuỗi gọi
+func (r *Result) AssertStatus(t testing.TB, expected int) *Result {
+	t.Helper()
+	if r.Response.StatusCode != expected {
+		t.Errorf("Expected status %d, got %d; body: %s", expected, r.Response.StatusCode, r.body)
+	}
+	return r
+}
+
+// AssertHeader kiểm tra giá trị header của response.
+//
+// Parameters:
+//   - t: Test hiện tại
+//   - key: Tên header
+//   - expected: Giá trị mong đợi
+//
+// Returns:
+//   - *Result: Result để tiếp tục chuỗi gọi
+func (r *Result) AssertHeader(t testing.TB, key, expected string) *Result {
+	t.Helper()
+	if actual := r.Response.Header.Get(key); actual != expected {
+		t.Errorf("Expected header %s to be %q, got %q", key, expected, actual)
+	}
+	return r
+}
+
+// AssertBodyContains kiểm tra body có chứa chuỗi con.
+//
+// Parameters:
+//   - t: Test hiện tại
+//   - substr: Chuỗi con mong đợi
+//
+// Returns:
+//   - *Result: Result để tiếp tục chuỗi gọi
+func (r *Result) AssertBodyContains(t testing.TB, substr string) *Result {
+	t.Helper()
+	if !strings.Contains(string(r.body), substr) {
+		t.Errorf("Expected body to contain %q, got %s", substr, r.body)
+	}
+	return r
+}
+
+// AssertJSONPath kiểm tra giá trị tại đường dẫn trong body JSON.
+// Đường dẫn gồm các khóa phân tách bởi dấu chấm; phần tử mảng được truy cập bằng chỉ số
+// (ví dụ: "data.0.name"). Đường dẫn rỗng trỏ tới toàn bộ body. Giá trị mong đợi được so
+// sánh sau khi mã hóa qua JSON, vì vậy 1 và 1.0 được xem là bằng nhau.
+//
+// Parameters:
+//   - t: Test hiện tại
+//   - path: Đường dẫn tới giá trị
+//   - expected: Giá trị mong đợi
+//
+// Returns:
+//   - *Result: Result để tiếp tục chuỗi gọi
+func (r *Result) AssertJSONPath(t testing.TB, path string, expected interface{}) *Result {
+	t.Helper()
+	var document interface{}
+	if err := json.Unmarshal(r.body, &document); err != nil {
+		t.Errorf("Expected JSON body, got %s: %v", r.body, err)
+		return r
+	}
+
+	actual, err := lookup(document, path)
+	if err != nil {
+		t.Errorf("JSON path %q: %v", path, err)
+		return r
+	}
+
+	want, err := normalize(expected)
+	if err != nil {
+		t.Errorf("JSON path %q: cannot encode expected value: %v", path, err)
+		return r
+	}
+	if !reflect.DeepEqual(actual, want) {
+		t.Errorf("JSON path %q: expected %v, got %v", path, want, actual)
+	}
+	return r
+}
+
+// lookup duyệt document theo đường dẫn phân tách bởi dấu chấm.
+func lookup(document interface{}, path string) (interface{}, error) {
+	if path == "" {
+		return document, nil
+	}
+	current := document
+	for _, segment := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[segment]
+			if !ok {
+				return nil, fmt.Errorf("key %q not found", segment)
+			}
+			current = value
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("index %q out of range (length %d)", segment, len(node))
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("cannot descend into %T at %q", current, segment)
+		}
+	}
+	return current, nil
+}
+
+// normalize chuyển giá trị về dạng tương ứng sau khi giải mã JSON.
+func normalize(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var result interface{}
+	err = json.Unmarshal(data, &result)
+	return result, err
+}
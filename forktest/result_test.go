@@ -0,0 +1,47 @@
+package forktest
+
+import (
+	"net/http"
+	"testing"
+)
+
+// recordingT ghi lại các lỗi assertion thay vì làm fail test
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, format)
+}
+
+func TestAssertJSONPath(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"id":1,"tags":["a","b"]}],"meta":{"total":1,"ok":true}}`))
+	})
+	result := Get("/").Run(handler)
+
+	result.AssertJSONPath(t, "data.0.id", 1).
+		AssertJSONPath(t, "data.0.tags", []string{"a", "b"}).
+		AssertJSONPath(t, "meta", map[string]interface{}{"total": 1, "ok": true}).
+		AssertJSONPath(t, "meta.ok", true)
+
+	for _, path := range []string{"data.1.id", "data.x", "meta.missing", "meta.total.value"} {
+		rt := &recordingT{}
+		result.AssertJSONPath(rt, path, 1)
+		if len(rt.errors) != 1 {
+			t.Errorf("Expected path %q to fail, got %v", path, rt.errors)
+		}
+	}
+
+	rt := &recordingT{}
+	result.AssertJSONPath(rt, "data.0.id", 2).
+		AssertStatus(rt, http.StatusNotFound).
+		AssertHeader(rt, "X-Missing", "value").
+		AssertBodyContains(rt, "nothing")
+	if len(rt.errors) != 4 {
+		t.Errorf("Expected 4 failed assertions, got %d", len(rt.errors))
+	}
+}
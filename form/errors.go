@@ -0,0 +1,60 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldErrors chuyển lỗi validate thành thông báo lỗi theo field.
+// Khóa là tên field do validator báo cáo (tên struct field, hoặc tên tag nếu validator
+// đã đăng ký RegisterTagNameFunc). Lỗi không phải validator.ValidationErrors được
+// trả về dưới khóa rỗng "".
+//
+// Parameters:
+//   - err: Lỗi từ ValidateStruct/ShouldBindAndValidate
+//
+// Returns:
+//   - map[string][]string: Thông báo lỗi theo field, nil nếu err là nil
+func FieldErrors(err error) map[string][]string {
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return map[string][]string{"": {err.Error()}}
+	}
+
+	result := make(map[string][]string, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		result[fieldErr.Field()] = append(result[fieldErr.Field()], message(fieldErr))
+	}
+	return result
+}
+
+// message tạo thông báo lỗi dễ đọc cho một lỗi validate.
+func message(fieldErr validator.FieldError) string {
+	field := fieldErr.Field()
+	switch fieldErr.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", field)
+	case "email":
+		return fmt.Sprintf("%s must be a valid email address", field)
+	case "url":
+		return fmt.Sprintf("%s must be a valid URL", field)
+	case "min":
+		return fmt.Sprintf("%s must be at least %s", field, fieldErr.Param())
+	case "max":
+		return fmt.Sprintf("%s must be at most %s", field, fieldErr.Param())
+	case "len":
+		return fmt.Sprintf("%s must be exactly %s", field, fieldErr.Param())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", field, fieldErr.Param())
+	case "eqfield":
+		return fmt.Sprintf("%s must match %s", field, fieldErr.Param())
+	default:
+		return fmt.Sprintf("%s is invalid", field)
+	}
+}
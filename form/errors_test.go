@@ -0,0 +1,39 @@
+package form
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type signup struct {
+	Email    string `validate:"required,email"`
+	Password string `validate:"min=8"`
+	Role     string `validate:"oneof=admin user"`
+}
+
+func TestFieldErrors(t *testing.T) {
+	err := validator.New().Struct(signup{Email: "bad", Password: "short", Role: "root"})
+
+	got := FieldErrors(err)
+	expected := map[string][]string{
+		"Email":    {"Email must be a valid email address"},
+		"Password": {"Password must be at least 8"},
+		"Role":     {"Role must be one of: admin user"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestFieldErrorsOtherErrors(t *testing.T) {
+	if FieldErrors(nil) != nil {
+		t.Error("Expected nil for nil error")
+	}
+	got := FieldErrors(errors.New("malformed body"))
+	if got[""][0] != "malformed body" {
+		t.Errorf("Expected generic error under empty key, got %v", got)
+	}
+}
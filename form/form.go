@@ -0,0 +1,325 @@
+// Package form cung cấp helpers cho HTML forms: hidden field CSRF, khôi phục giá trị
+// đã nhập (old input) và thông báo lỗi theo field sau khi validate thất bại.
+//
+// Package sử dụng token do CSRF middleware lưu trong context store và session do session
+// middleware lưu trong context store để truyền old input và lỗi qua redirect (flash).
+//
+// Luồng sử dụng điển hình:
+//
+//	app.Use(form.New(form.Config{}))
+//	app.ViewGlobals(form.Globals)
+//
+//	app.POST("/register", func(c forkCtx.Context) {
+//		var req RegisterRequest
+//		if err := c.ShouldBindAndValidate(&req); err != nil {
+//			form.RedirectBack(c, "/register", form.FieldErrors(err))
+//			return
+//		}
+//		...
+//	})
+//
+// Trong template:
+//
+//	<form method="post">
+//	  {{ .form.CSRFField }}
+//	  <input name="Email" value="{{ .form.Old "Email" }}">
+//	  {{ if .form.HasError "Email" }}<p>{{ .form.Error "Email" }}</p>{{ end }}
+//	</form>
+package form
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// Các khóa mặc định.
+const (
+	// ContextKey là khóa trong context store chứa *Form của request
+	ContextKey = "form"
+
+	// OldInputKey là khóa flash trong session chứa old input
+	OldInputKey = "_old_input"
+
+	// ErrorsKey là khóa flash trong session chứa lỗi theo field
+	ErrorsKey = "_errors"
+)
+
+// Session là interface tối thiểu của session được session middleware lưu trong context.
+type Session interface {
+	Get(key string) interface{}
+	Set(key string, value interface{})
+	Delete(key string)
+}
+
+// Config chứa cấu hình cho form middleware.
+type Config struct {
+	// CSRFContextKey là khóa trong context store chứa CSRF token.
+	// Mặc định: "csrf"
+	CSRFContextKey string
+
+	// CSRFFieldName là tên hidden field chứa CSRF token.
+	// Mặc định: "_csrf"
+	CSRFFieldName string
+
+	// SessionContextKey là khóa trong context store chứa Session.
+	// Mặc định: "session"
+	SessionContextKey string
+
+	// ExceptFields là các fields không được lưu vào old input.
+	// Mặc định: "password", "password_confirmation" cùng CSRFFieldName
+	ExceptFields []string
+}
+
+// Form chứa dữ liệu form của request hiện tại.
+type Form struct {
+	ctx    forkCtx.Context
+	config *Config
+	old    url.Values
+	errors map[string][]string
+}
+
+// New tạo form middleware: đọc old input và lỗi từ session flash (rồi xóa khỏi session)
+// và gắn *Form vào context với khóa ContextKey.
+//
+// Parameters:
+//   - config: Cấu hình middleware
+//
+// Returns:
+//   - router.HandlerFunc: Middleware form
+func New(config Config) router.HandlerFunc {
+	applyDefaults(&config)
+
+	return func(ctx forkCtx.Context) {
+		f := &Form{ctx: ctx, config: &config}
+		if session := sessionFrom(ctx, &config); session != nil {
+			f.old = url.Values(toStringSlices(session.Get(OldInputKey)))
+			f.errors = toStringSlices(session.Get(ErrorsKey))
+			session.Delete(OldInputKey)
+			session.Delete(ErrorsKey)
+		}
+		ctx.Set(ContextKey, f)
+		ctx.Next()
+	}
+}
+
+// From trả về *Form của request. Nếu form middleware chưa được đăng ký,
+// một Form rỗng với cấu hình mặc định được trả về.
+//
+// Parameters:
+//   - ctx: Context của request
+//
+// Returns:
+//   - *Form: Form của request
+func From(ctx forkCtx.Context) *Form {
+	if value, ok := ctx.Get(ContextKey); ok {
+		if f, ok := value.(*Form); ok {
+			return f
+		}
+	}
+	config := Config{}
+	applyDefaults(&config)
+	return &Form{ctx: ctx, config: &config}
+}
+
+// Globals cung cấp *Form cho mọi template với khóa "form",
+// dùng với WebApp.ViewGlobals hoặc view.Globals.
+//
+// Parameters:
+//   - ctx: Context của request
+//
+// Returns:
+//   - map[string]interface{}: Dữ liệu view dùng chung
+func Globals(ctx forkCtx.Context) map[string]interface{} {
+	return map[string]interface{}{ContextKey: From(ctx)}
+}
+
+// CSRFToken trả về CSRF token do CSRF middleware thiết lập, hoặc chuỗi rỗng.
+func (f *Form) CSRFToken() string {
+	return f.ctx.GetString(f.config.CSRFContextKey)
+}
+
+// CSRFField trả về hidden input chứa CSRF token để nhúng vào form.
+//
+// Returns:
+//   - template.HTML: Thẻ input đã escape an toàn
+func (f *Form) CSRFField() template.HTML {
+	return template.HTML(`<input type="hidden" name="` + template.HTMLEscapeString(f.config.CSRFFieldName) +
+		`" value="` + template.HTMLEscapeString(f.CSRFToken()) + `">`)
+}
+
+// Old trả về giá trị đã nhập của field từ lần submit thất bại trước đó.
+//
+// Parameters:
+//   - name: Tên field
+//   - defaultValue: Giá trị mặc định (tùy chọn) nếu không có old input
+//
+// Returns:
+//   - string: Giá trị đã nhập hoặc giá trị mặc định
+func (f *Form) Old(name string, defaultValue ...string) string {
+	if values, ok := f.old[name]; ok && len(values) > 0 {
+		return values[0]
+	}
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
+	return ""
+}
+
+// OldValues trả về tất cả giá trị đã nhập của field (cho checkbox, select multiple).
+func (f *Form) OldValues(name string) []string {
+	return f.old[name]
+}
+
+// HasOld kiểm tra có old input hay không (tức request là redirect sau submit thất bại).
+func (f *Form) HasOld() bool {
+	return len(f.old) > 0
+}
+
+// Error trả về thông báo lỗi đầu tiên của field, hoặc chuỗi rỗng.
+func (f *Form) Error(name string) string {
+	if messages := f.errors[name]; len(messages) > 0 {
+		return messages[0]
+	}
+	return ""
+}
+
+// Errors trả về tất cả thông báo lỗi của field.
+func (f *Form) Errors(name string) []string {
+	return f.errors[name]
+}
+
+// HasError kiểm tra field có lỗi hay không.
+func (f *Form) HasError(name string) bool {
+	return len(f.errors[name]) > 0
+}
+
+// HasErrors kiểm tra form có lỗi nào hay không.
+func (f *Form) HasErrors() bool {
+	return len(f.errors) > 0
+}
+
+// AllErrors trả về toàn bộ lỗi theo field.
+func (f *Form) AllErrors() map[string][]string {
+	return f.errors
+}
+
+// WithErrors gắn lỗi vào form của request hiện tại, dùng khi render lại form trực tiếp
+// thay vì redirect. Giá trị đã submit cũng được dùng làm old input.
+//
+// Parameters:
+//   - ctx: Context của request
+//   - errors: Lỗi theo field (ví dụ: từ FieldErrors)
+//
+// Returns:
+//   - *Form: Form của request
+func WithErrors(ctx forkCtx.Context, errors map[string][]string) *Form {
+	f := From(ctx)
+	f.errors = errors
+	f.old = submittedInput(ctx, f.config)
+	ctx.Set(ContextKey, f)
+	return f
+}
+
+// RedirectBack lưu old input và lỗi vào session flash rồi redirect (303 See Other).
+// Request tiếp theo đọc lại dữ liệu này qua form middleware.
+//
+// Parameters:
+//   - ctx: Context của request
+//   - location: URL redirect tới (thường là trang chứa form)
+//   - errors: Lỗi theo field (ví dụ: từ FieldErrors)
+func RedirectBack(ctx forkCtx.Context, location string, errors map[string][]string) {
+	f := From(ctx)
+	if session := sessionFrom(ctx, f.config); session != nil {
+		session.Set(OldInputKey, map[string][]string(submittedInput(ctx, f.config)))
+		session.Set(ErrorsKey, errors)
+	}
+	ctx.Redirect(http.StatusSeeOther, location)
+}
+
+// FuncMap trả về template functions nhận *Form làm tham số đầu, cho các template
+// không dùng cú pháp method ({{ csrfField .form }}, {{ old .form "Email" }}).
+//
+// Returns:
+//   - template.FuncMap: Các template functions
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"csrfField":  func(f *Form) template.HTML { return f.CSRFField() },
+		"old":        func(f *Form, name string, def ...string) string { return f.Old(name, def...) },
+		"fieldError": func(f *Form, name string) string { return f.Error(name) },
+		"hasError":   func(f *Form, name string) bool { return f.HasError(name) },
+	}
+}
+
+// submittedInput trả về form values của request, loại bỏ các fields nhạy cảm.
+func submittedInput(ctx forkCtx.Context, config *Config) url.Values {
+	req := ctx.Request().Request()
+	if err := req.ParseForm(); err != nil {
+		return url.Values{}
+	}
+	input := make(url.Values, len(req.PostForm))
+	for name, values := range req.PostForm {
+		input[name] = values
+	}
+	for _, name := range config.ExceptFields {
+		delete(input, name)
+	}
+	return input
+}
+
+// sessionFrom lấy Session từ context store.
+func sessionFrom(ctx forkCtx.Context, config *Config) Session {
+	value, ok := ctx.Get(config.SessionContextKey)
+	if !ok {
+		return nil
+	}
+	session, _ := value.(Session)
+	return session
+}
+
+// toStringSlices chuyển dữ liệu flash (có thể đã qua serialize) về map[string][]string.
+func toStringSlices(value interface{}) map[string][]string {
+	switch v := value.(type) {
+	case map[string][]string:
+		return v
+	case url.Values:
+		return v
+	case map[string]interface{}:
+		result := make(map[string][]string, len(v))
+		for key, item := range v {
+			switch values := item.(type) {
+			case []string:
+				result[key] = values
+			case string:
+				result[key] = []string{values}
+			case []interface{}:
+				for _, value := range values {
+					if s, ok := value.(string); ok {
+						result[key] = append(result[key], s)
+					}
+				}
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+func applyDefaults(config *Config) {
+	if config.CSRFContextKey == "" {
+		config.CSRFContextKey = "csrf"
+	}
+	if config.CSRFFieldName == "" {
+		config.CSRFFieldName = "_csrf"
+	}
+	if config.SessionContextKey == "" {
+		config.SessionContextKey = "session"
+	}
+	if config.ExceptFields == nil {
+		config.ExceptFields = []string{"password", "password_confirmation"}
+	}
+	config.ExceptFields = append(append([]string(nil), config.ExceptFields...), config.CSRFFieldName)
+}
@@ -0,0 +1,128 @@
+package form
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// memorySession là Session đơn giản dùng cho test
+type memorySession map[string]interface{}
+
+func (s memorySession) Get(key string) interface{}        { return s[key] }
+func (s memorySession) Set(key string, value interface{}) { s[key] = value }
+func (s memorySession) Delete(key string)                 { delete(s, key) }
+
+// run thực thi chuỗi handlers với session và CSRF token cho trước.
+func run(req *http.Request, session memorySession, handlers ...func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.Set("session", session)
+	ctx.Set("csrf", `tok"en`)
+	ctx.SetHandlers(handlers)
+	ctx.Next()
+	return w
+}
+
+func TestRedirectBackRoundTrip(t *testing.T) {
+	session := memorySession{}
+	mw := New(Config{})
+
+	body := url.Values{"email": {"an@example.com"}, "password": {"secret"}, "_csrf": {"x"}, "tags": {"a", "b"}}
+	req := httptest.NewRequest(http.MethodPost, "/register", strings.NewReader(body.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	w := run(req, session, mw, func(ctx forkCtx.Context) {
+		RedirectBack(ctx, "/register", map[string][]string{"email": {"email is taken"}})
+	})
+	if w.Code != http.StatusSeeOther || w.Header().Get("Location") != "/register" {
+		t.Fatalf("Expected 303 redirect to /register, got %d %q", w.Code, w.Header().Get("Location"))
+	}
+
+	var f *Form
+	run(httptest.NewRequest(http.MethodGet, "/register", nil), session, mw, func(ctx forkCtx.Context) {
+		f = From(ctx)
+	})
+
+	if f.Old("email") != "an@example.com" {
+		t.Errorf("Expected old email, got %q", f.Old("email"))
+	}
+	if f.Old("password", "none") != "none" {
+		t.Error("Expected password to be excluded from old input")
+	}
+	if f.Old("_csrf") != "" {
+		t.Error("Expected CSRF field to be excluded from old input")
+	}
+	if !reflect.DeepEqual(f.OldValues("tags"), []string{"a", "b"}) {
+		t.Errorf("Expected multiple old values, got %v", f.OldValues("tags"))
+	}
+	if !f.HasError("email") || f.Error("email") != "email is taken" {
+		t.Errorf("Expected email error, got %v", f.AllErrors())
+	}
+	if len(session) != 0 {
+		t.Errorf("Expected flash data to be removed from session, got %v", session)
+	}
+}
+
+func TestFormCSRFField(t *testing.T) {
+	var field template.HTML
+	run(httptest.NewRequest(http.MethodGet, "/", nil), memorySession{}, New(Config{CSRFFieldName: "token"}), func(ctx forkCtx.Context) {
+		field = From(ctx).CSRFField()
+	})
+
+	expected := template.HTML(`<input type="hidden" name="token" value="tok&#34;en">`)
+	if field != expected {
+		t.Errorf("Expected %s, got %s", expected, field)
+	}
+}
+
+func TestWithErrorsAndTemplate(t *testing.T) {
+	body := url.Values{"email": {"bad"}}
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	tmpl := template.Must(template.New("form").Funcs(FuncMap()).Parse(
+		`{{ csrfField .form }}|{{ old .form "email" }}|{{ if hasError .form "email" }}{{ fieldError .form "email" }}{{ end }}|{{ .form.Old "name" "anon" }}`))
+
+	var out strings.Builder
+	run(req, memorySession{}, func(ctx forkCtx.Context) {
+		WithErrors(ctx, map[string][]string{"email": {"invalid"}})
+		if err := tmpl.Execute(&out, Globals(ctx)); err != nil {
+			t.Fatalf("Failed to execute template: %v", err)
+		}
+	})
+
+	expected := `<input type="hidden" name="_csrf" value="tok&#34;en">|bad|invalid|anon`
+	if out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+}
+
+func TestFromWithoutMiddleware(t *testing.T) {
+	ctx := forkCtx.NewContext(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	f := From(ctx)
+	if f.HasErrors() || f.HasOld() || f.CSRFToken() != "" {
+		t.Error("Expected empty form without middleware")
+	}
+}
+
+func TestToStringSlices(t *testing.T) {
+	// Dữ liệu flash sau khi session serialize qua JSON
+	got := toStringSlices(map[string]interface{}{
+		"a": []interface{}{"1", "2"},
+		"b": "3",
+	})
+	expected := map[string][]string{"a": {"1", "2"}, "b": {"3"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if toStringSlices(42) != nil {
+		t.Error("Expected nil for unsupported value")
+	}
+}
@@ -0,0 +1,299 @@
+// Package i18n cung cấp hệ thống đa ngôn ngữ: nạp catalog JSON/TOML, quy tắc số nhiều,
+// xác định locale từ query/cookie/Accept-Language và middleware gắn translator vào
+// context để sử dụng qua ctx.T(key, args...) hoặc trong template.
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// message là một message đã nạp, có thể có nhiều dạng số nhiều.
+type message struct {
+	forms map[Plural]string
+}
+
+// Bundle chứa các catalog message của tất cả locales.
+type Bundle struct {
+	mu            sync.RWMutex
+	defaultLocale string
+	messages      map[string]map[string]*message
+}
+
+// NewBundle tạo Bundle mới.
+//
+// Parameters:
+//   - defaultLocale: Locale mặc định, được dùng khi không tìm thấy message ở locale yêu cầu
+//
+// Returns:
+//   - *Bundle: Bundle đã khởi tạo
+func NewBundle(defaultLocale string) *Bundle {
+	return &Bundle{
+		defaultLocale: normalizeLocale(defaultLocale),
+		messages:      make(map[string]map[string]*message),
+	}
+}
+
+// DefaultLocale trả về locale mặc định của bundle.
+func (b *Bundle) DefaultLocale() string {
+	return b.defaultLocale
+}
+
+// Locales trả về danh sách locales đã nạp, đã sắp xếp.
+func (b *Bundle) Locales() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	locales := make([]string, 0, len(b.messages))
+	for locale := range b.messages {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// AddMessages thêm messages cho một locale.
+//
+// Các khóa lồng nhau được làm phẳng bằng dấu chấm ({"cart": {"title": "..."}} -> "cart.title").
+// Một map chỉ chứa các khóa dạng số nhiều (zero, one, two, few, many, other) được coi là
+// một message số nhiều.
+//
+// Parameters:
+//   - locale: Locale của messages (ví dụ: "vi", "en-US")
+//   - messages: Map các messages
+//
+// Returns:
+//   - error: Lỗi nếu có giá trị không hợp lệ
+func (b *Bundle) AddMessages(locale string, messages map[string]interface{}) error {
+	flat := make(map[string]*message)
+	if err := flatten("", messages, flat); err != nil {
+		return fmt.Errorf("i18n: locale %s: %w", locale, err)
+	}
+
+	locale = normalizeLocale(locale)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	catalog, ok := b.messages[locale]
+	if !ok {
+		catalog = make(map[string]*message)
+		b.messages[locale] = catalog
+	}
+	for key, msg := range flat {
+		catalog[key] = msg
+	}
+	return nil
+}
+
+// LoadFile nạp một catalog từ file JSON hoặc TOML.
+// Locale được lấy từ tên file: "vi.json", "en-US.toml" hoặc "messages.vi.json".
+//
+// Parameters:
+//   - filename: Đường dẫn tới file catalog
+//
+// Returns:
+//   - error: Lỗi nếu không đọc hoặc không parse được file
+func (b *Bundle) LoadFile(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return b.LoadBytes(filepath.Base(filename), data)
+}
+
+// LoadFS nạp tất cả catalog khớp pattern trong một fs.FS (ví dụ: embed.FS).
+//
+// Parameters:
+//   - fsys: File system chứa catalog
+//   - pattern: Glob pattern (ví dụ: "locales/*.json")
+//
+// Returns:
+//   - error: Lỗi nếu pattern không hợp lệ hoặc không nạp được file
+func (b *Bundle) LoadFS(fsys fs.FS, pattern string) error {
+	matches, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		data, err := fs.ReadFile(fsys, match)
+		if err != nil {
+			return err
+		}
+		if err := b.LoadBytes(path.Base(match), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadBytes nạp catalog từ dữ liệu, định dạng và locale được xác định từ tên file.
+//
+// Parameters:
+//   - filename: Tên file (ví dụ: "vi.json")
+//   - data: Nội dung catalog
+//
+// Returns:
+//   - error: Lỗi nếu định dạng không được hỗ trợ hoặc không parse được
+func (b *Bundle) LoadBytes(filename string, data []byte) error {
+	ext := strings.ToLower(path.Ext(filename))
+	name := strings.TrimSuffix(filename, path.Ext(filename))
+	locale := name
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		locale = name[idx+1:]
+	}
+
+	messages := make(map[string]interface{})
+	switch ext {
+	case ".json":
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return fmt.Errorf("i18n: parse %s: %w", filename, err)
+		}
+	case ".toml":
+		if err := toml.Unmarshal(data, &messages); err != nil {
+			return fmt.Errorf("i18n: parse %s: %w", filename, err)
+		}
+	default:
+		return fmt.Errorf("i18n: unsupported catalog format %q", ext)
+	}
+	return b.AddMessages(locale, messages)
+}
+
+// Localizer tạo Localizer cho danh sách locales theo thứ tự ưu tiên.
+// Locale mặc định của bundle luôn được thêm vào cuối làm fallback.
+//
+// Parameters:
+//   - locales: Các locales theo thứ tự ưu tiên
+//
+// Returns:
+//   - *Localizer: Localizer đã khởi tạo
+func (b *Bundle) Localizer(locales ...string) *Localizer {
+	chain := make([]string, 0, len(locales)*2+1)
+	seen := make(map[string]bool)
+	add := func(locale string) {
+		if locale != "" && !seen[locale] {
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+	for _, locale := range locales {
+		locale = normalizeLocale(locale)
+		add(locale)
+		add(baseLanguage(locale))
+	}
+	add(b.defaultLocale)
+	return &Localizer{bundle: b, locales: chain}
+}
+
+// Match tìm locale được hỗ trợ phù hợp nhất với locale yêu cầu.
+// Thứ tự: khớp chính xác, khớp ngôn ngữ cơ sở, locale cùng ngôn ngữ cơ sở.
+//
+// Parameters:
+//   - locale: Locale yêu cầu
+//
+// Returns:
+//   - string: Locale được hỗ trợ
+//   - bool: false nếu không có locale phù hợp
+func (b *Bundle) Match(locale string) (string, bool) {
+	locale = normalizeLocale(locale)
+	base := baseLanguage(locale)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if _, ok := b.messages[locale]; ok {
+		return locale, true
+	}
+	if _, ok := b.messages[base]; ok {
+		return base, true
+	}
+	candidates := make([]string, 0)
+	for supported := range b.messages {
+		if baseLanguage(supported) == base {
+			candidates = append(candidates, supported)
+		}
+	}
+	if len(candidates) > 0 {
+		sort.Strings(candidates)
+		return candidates[0], true
+	}
+	return "", false
+}
+
+// lookup tìm message theo locale và key.
+func (b *Bundle) lookup(locale, key string) (*message, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	msg, ok := b.messages[locale][key]
+	return msg, ok
+}
+
+// flatten làm phẳng map lồng nhau thành các message.
+func flatten(prefix string, values map[string]interface{}, out map[string]*message) error {
+	for key, value := range values {
+		fullKey := key
+		if prefix != "" {
+			fullKey = prefix + "." + key
+		}
+
+		switch v := value.(type) {
+		case string:
+			out[fullKey] = &message{forms: map[Plural]string{PluralOther: v}}
+		case map[string]interface{}:
+			if forms, ok := pluralForms(v); ok {
+				out[fullKey] = &message{forms: forms}
+				continue
+			}
+			if err := flatten(fullKey, v, out); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invalid value for key %q: %T", fullKey, value)
+		}
+	}
+	return nil
+}
+
+// pluralForms kiểm tra map có phải là message số nhiều hay không.
+func pluralForms(values map[string]interface{}) (map[Plural]string, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+	forms := make(map[Plural]string, len(values))
+	for key, value := range values {
+		switch Plural(key) {
+		case PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther:
+		default:
+			return nil, false
+		}
+		text, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+		forms[Plural(key)] = text
+	}
+	if _, ok := forms[PluralOther]; !ok {
+		return nil, false
+	}
+	return forms, true
+}
+
+// normalizeLocale chuẩn hóa locale về dạng "en-US".
+func normalizeLocale(locale string) string {
+	locale = strings.TrimSpace(strings.ReplaceAll(locale, "_", "-"))
+	parts := strings.Split(locale, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) == 2 {
+			parts[i] = strings.ToUpper(parts[i])
+		} else if len(parts[i]) == 4 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:])
+		}
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,118 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestBundleAddMessagesFlattens(t *testing.T) {
+	b := NewBundle("en")
+	err := b.AddMessages("en", map[string]interface{}{
+		"cart": map[string]interface{}{
+			"title": "Cart",
+			"items": map[string]interface{}{"one": "{count} item", "other": "{count} items"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, ok := b.lookup("en", "cart.title"); !ok {
+		t.Error("Expected nested key to be flattened to cart.title")
+	}
+	msg, ok := b.lookup("en", "cart.items")
+	if !ok {
+		t.Fatal("Expected plural message cart.items")
+	}
+	if msg.forms[PluralOne] != "{count} item" {
+		t.Errorf("Expected plural form one, got %q", msg.forms[PluralOne])
+	}
+}
+
+func TestBundleAddMessagesInvalidValue(t *testing.T) {
+	b := NewBundle("en")
+	if err := b.AddMessages("en", map[string]interface{}{"count": 42}); err == nil {
+		t.Error("Expected error for non-string value")
+	}
+}
+
+func TestBundleLoadFileFormats(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"vi.json":          `{"greeting": "Xin chào {name}"}`,
+		"messages.fr.toml": "greeting = \"Bonjour {name}\"\n\n[items]\none = \"{count} article\"\nother = \"{count} articles\"\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	b := NewBundle("en")
+	for name := range files {
+		if err := b.LoadFile(filepath.Join(dir, name)); err != nil {
+			t.Fatalf("Failed to load %s: %v", name, err)
+		}
+	}
+
+	if got := b.Localizer("vi").T("greeting", "name", "An"); got != "Xin chào An" {
+		t.Errorf("Expected Vietnamese greeting, got %q", got)
+	}
+	if got := b.Localizer("fr").T("items", "count", 0); got != "0 article" {
+		t.Errorf("Expected French singular for 0, got %q", got)
+	}
+}
+
+func TestBundleLoadFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/en.json":    {Data: []byte(`{"hello": "Hello"}`)},
+		"locales/vi-VN.json": {Data: []byte(`{"hello": "Xin chào"}`)},
+	}
+
+	b := NewBundle("en")
+	if err := b.LoadFS(fsys, "locales/*.json"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	locales := b.Locales()
+	if len(locales) != 2 || locales[0] != "en" || locales[1] != "vi-VN" {
+		t.Errorf("Expected [en vi-VN], got %v", locales)
+	}
+}
+
+func TestBundleLoadBytesUnsupported(t *testing.T) {
+	b := NewBundle("en")
+	if err := b.LoadBytes("en.ini", []byte("hello=Hello")); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+	if err := b.LoadBytes("en.json", []byte("{")); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestBundleMatch(t *testing.T) {
+	b := NewBundle("en")
+	b.AddMessages("en", map[string]interface{}{"a": "a"})
+	b.AddMessages("pt-BR", map[string]interface{}{"a": "a"})
+
+	tests := []struct {
+		locale   string
+		expected string
+		ok       bool
+	}{
+		{"en", "en", true},
+		{"en-GB", "en", true},
+		{"pt_br", "pt-BR", true},
+		{"pt-PT", "pt-BR", true},
+		{"de", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := b.Match(tt.locale)
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("Match(%q) = (%q, %v), expected (%q, %v)", tt.locale, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
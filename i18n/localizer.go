@@ -0,0 +1,180 @@
+package i18n
+
+import (
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+)
+
+// Localizer dịch messages theo danh sách locales ưu tiên.
+type Localizer struct {
+	bundle  *Bundle
+	locales []string
+}
+
+// Locale trả về locale ưu tiên cao nhất của localizer.
+func (l *Localizer) Locale() string {
+	if len(l.locales) == 0 {
+		return ""
+	}
+	return l.locales[0]
+}
+
+// T dịch một message key.
+//
+// Tham số được truyền dưới dạng các cặp tên/giá trị và thay thế các placeholder {name}
+// trong message. Tham số "count" (kiểu số nguyên) chọn dạng số nhiều phù hợp với locale.
+// Nếu args không phải các cặp tên/giá trị, message được định dạng bằng fmt.Sprintf.
+//
+// Ví dụ:
+//
+//	l.T("cart.items", "count", 3) // "3 sản phẩm"
+//	l.T("greeting", "name", "An")  // "Xin chào An"
+//
+// Parameters:
+//   - key: Khóa của message
+//   - args: Tham số của message
+//
+// Returns:
+//   - string: Message đã dịch, hoặc key nếu không tìm thấy ở bất kỳ locale nào
+func (l *Localizer) T(key string, args ...interface{}) string {
+	params, named := namedParams(args)
+
+	for _, locale := range l.locales {
+		msg, ok := l.bundle.lookup(locale, key)
+		if !ok {
+			continue
+		}
+
+		text := msg.forms[PluralOther]
+		if count, ok := params["count"]; ok {
+			if n, ok := toInt(count); ok {
+				plural := PluralFor(locale, n)
+				// Dạng "zero" được ưu tiên cho n == 0 nếu catalog khai báo
+				if n == 0 {
+					if zero, ok := msg.forms[PluralZero]; ok {
+						plural = PluralZero
+						text = zero
+					}
+				}
+				if form, ok := msg.forms[plural]; ok {
+					text = form
+				}
+			}
+		}
+
+		if named {
+			return interpolate(text, params)
+		}
+		if len(args) > 0 {
+			return fmt.Sprintf(text, args...)
+		}
+		return text
+	}
+	return key
+}
+
+// Has kiểm tra message key có tồn tại ở một trong các locales hay không.
+//
+// Parameters:
+//   - key: Khóa của message
+//
+// Returns:
+//   - bool: true nếu message tồn tại
+func (l *Localizer) Has(key string) bool {
+	for _, locale := range l.locales {
+		if _, ok := l.bundle.lookup(locale, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// FuncMap trả về các template functions gắn với localizer:
+//   - t: dịch message, ví dụ {{ t "cart.items" "count" .Count }}
+//   - locale: trả về locale hiện tại
+//
+// Returns:
+//   - template.FuncMap: Các template functions
+func (l *Localizer) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"t":      l.T,
+		"locale": l.Locale,
+	}
+}
+
+// FuncMap trả về template functions không phụ thuộc request, nhận locale là tham số đầu:
+//   - t: ví dụ {{ t .Locale "cart.items" "count" .Count }}
+//
+// Returns:
+//   - template.FuncMap: Các template functions
+func (b *Bundle) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"t": func(locale, key string, args ...interface{}) string {
+			return b.Localizer(locale).T(key, args...)
+		},
+	}
+}
+
+// namedParams chuyển args dạng cặp tên/giá trị thành map.
+func namedParams(args []interface{}) (map[string]interface{}, bool) {
+	if len(args) == 0 || len(args)%2 != 0 {
+		return nil, false
+	}
+	params := make(map[string]interface{}, len(args)/2)
+	for i := 0; i < len(args); i += 2 {
+		name, ok := args[i].(string)
+		if !ok {
+			return nil, false
+		}
+		params[name] = args[i+1]
+	}
+	return params, true
+}
+
+// interpolate thay thế các placeholder {name} bằng giá trị tham số.
+func interpolate(text string, params map[string]interface{}) string {
+	if !strings.Contains(text, "{") {
+		return text
+	}
+	pairs := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// toInt chuyển giá trị số sang int.
+func toInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case uint:
+		return int(v), true
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		return int(v), true
+	case uint64:
+		return int(v), true
+	case float32:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case string:
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	}
+	return 0, false
+}
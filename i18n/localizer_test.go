@@ -0,0 +1,106 @@
+package i18n
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+)
+
+func newTestBundle(t *testing.T) *Bundle {
+	t.Helper()
+	b := NewBundle("en")
+	if err := b.AddMessages("en", map[string]interface{}{
+		"greeting": "Hello {name}",
+		"farewell": "Goodbye",
+		"format":   "%d new messages",
+		"cart": map[string]interface{}{
+			"items": map[string]interface{}{
+				"zero":  "Your cart is empty",
+				"one":   "{count} item",
+				"other": "{count} items",
+			},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to add en messages: %v", err)
+	}
+	if err := b.AddMessages("vi", map[string]interface{}{
+		"greeting": "Xin chào {name}",
+		"cart": map[string]interface{}{
+			"items": map[string]interface{}{"other": "{count} sản phẩm"},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to add vi messages: %v", err)
+	}
+	return b
+}
+
+func TestLocalizerT(t *testing.T) {
+	b := newTestBundle(t)
+	en := b.Localizer("en")
+	vi := b.Localizer("vi-VN")
+
+	tests := []struct {
+		name      string
+		localizer *Localizer
+		key       string
+		args      []interface{}
+		expected  string
+	}{
+		{"named params", en, "greeting", []interface{}{"name", "An"}, "Hello An"},
+		{"base language fallback", vi, "greeting", []interface{}{"name", "An"}, "Xin chào An"},
+		{"default locale fallback", vi, "farewell", nil, "Goodbye"},
+		{"missing key", vi, "missing.key", nil, "missing.key"},
+		{"plural one", en, "cart.items", []interface{}{"count", 1}, "1 item"},
+		{"plural other", en, "cart.items", []interface{}{"count", 5}, "5 items"},
+		{"plural zero", en, "cart.items", []interface{}{"count", 0}, "Your cart is empty"},
+		{"plural without forms", vi, "cart.items", []interface{}{"count", 1}, "1 sản phẩm"},
+		{"printf args", en, "format", []interface{}{3}, "3 new messages"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.localizer.T(tt.key, tt.args...); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLocalizerHasAndLocale(t *testing.T) {
+	b := newTestBundle(t)
+	l := b.Localizer("vi")
+
+	if l.Locale() != "vi" {
+		t.Errorf("Expected locale vi, got %q", l.Locale())
+	}
+	if !l.Has("farewell") {
+		t.Error("Expected Has to find key via default locale")
+	}
+	if l.Has("missing") {
+		t.Error("Expected Has to return false for missing key")
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	b := newTestBundle(t)
+
+	tmpl := template.Must(template.New("page").Funcs(b.Localizer("vi").FuncMap()).
+		Parse(`{{ locale }}: {{ t "cart.items" "count" 2 }}`))
+	var out strings.Builder
+	if err := tmpl.Execute(&out, nil); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+	if out.String() != "vi: 2 sản phẩm" {
+		t.Errorf("Unexpected localizer template output %q", out.String())
+	}
+
+	tmpl = template.Must(template.New("page").Funcs(b.FuncMap()).
+		Parse(`{{ t .Locale "greeting" "name" .Name }}`))
+	out.Reset()
+	if err := tmpl.Execute(&out, map[string]string{"Locale": "en", "Name": "An"}); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+	if out.String() != "Hello An" {
+		t.Errorf("Unexpected bundle template output %q", out.String())
+	}
+}
@@ -0,0 +1,145 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// Các khóa trong context store được middleware thiết lập.
+const (
+	// TranslatorKey chứa *Localizer của request, được ctx.T sử dụng
+	TranslatorKey = "translator"
+
+	// LocaleKey chứa locale đã được xác định cho request
+	LocaleKey = "locale"
+)
+
+// Config chứa cấu hình cho i18n middleware.
+type Config struct {
+	// Bundle chứa các catalog message (bắt buộc)
+	Bundle *Bundle
+
+	// QueryParam là tên query param chọn locale (ví dụ: ?lang=vi).
+	// Mặc định: "lang". Đặt "-" để tắt.
+	QueryParam string
+
+	// CookieName là tên cookie chọn locale.
+	// Mặc định: "lang". Đặt "-" để tắt.
+	CookieName string
+
+	// Resolver cho phép xác định locale theo cách riêng (ví dụ: từ hồ sơ người dùng).
+	// Được gọi trước query, cookie và Accept-Language; trả về chuỗi rỗng để bỏ qua.
+	Resolver func(ctx forkCtx.Context) string
+}
+
+// New tạo i18n middleware xác định locale của request và gắn Localizer vào context.
+//
+// Thứ tự xác định locale: Resolver, query param, cookie, header Accept-Language,
+// cuối cùng là locale mặc định của bundle.
+//
+// Parameters:
+//   - config: Cấu hình middleware
+//
+// Returns:
+//   - router.HandlerFunc: Middleware i18n
+//
+// Panics:
+//   - Nếu config.Bundle là nil
+func New(config Config) router.HandlerFunc {
+	if config.Bundle == nil {
+		panic("i18n: Bundle is required")
+	}
+	if config.QueryParam == "" {
+		config.QueryParam = "lang"
+	}
+	if config.CookieName == "" {
+		config.CookieName = "lang"
+	}
+
+	return func(ctx forkCtx.Context) {
+		locale := resolveLocale(ctx, &config)
+		localizer := config.Bundle.Localizer(locale)
+		ctx.Set(TranslatorKey, localizer)
+		ctx.Set(LocaleKey, localizer.Locale())
+		ctx.Header("Content-Language", localizer.Locale())
+		ctx.Next()
+	}
+}
+
+// resolveLocale xác định locale được hỗ trợ cho request.
+func resolveLocale(ctx forkCtx.Context, config *Config) string {
+	var candidates []string
+	if config.Resolver != nil {
+		candidates = append(candidates, config.Resolver(ctx))
+	}
+	if config.QueryParam != "-" {
+		candidates = append(candidates, ctx.Query(config.QueryParam))
+	}
+	if config.CookieName != "-" {
+		if value, err := ctx.Cookie(config.CookieName); err == nil {
+			candidates = append(candidates, value)
+		}
+	}
+	candidates = append(candidates, ParseAcceptLanguage(ctx.GetHeader("Accept-Language"))...)
+
+	for _, candidate := range candidates {
+		if candidate == "" || candidate == "*" {
+			continue
+		}
+		if locale, ok := config.Bundle.Match(candidate); ok {
+			return locale
+		}
+	}
+	return config.Bundle.DefaultLocale()
+}
+
+// ParseAcceptLanguage phân tích header Accept-Language và trả về các locales
+// theo thứ tự giảm dần của trọng số q.
+//
+// Parameters:
+//   - header: Giá trị header Accept-Language (ví dụ: "vi-VN,vi;q=0.9,en;q=0.8")
+//
+// Returns:
+//   - []string: Các locales theo thứ tự ưu tiên
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var items []weighted
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		locale, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if ok && name == "q" {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		items = append(items, weighted{locale: strings.TrimSpace(locale), q: q})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].q > items[j].q
+	})
+
+	locales := make([]string, len(items))
+	for i, item := range items {
+		locales[i] = item.locale
+	}
+	return locales
+}
@@ -0,0 +1,102 @@
+package i18n
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+func serve(mw func(forkCtx.Context), req *http.Request, handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+func TestMiddlewareResolvesLocale(t *testing.T) {
+	b := newTestBundle(t)
+	mw := New(Config{Bundle: b})
+
+	tests := []struct {
+		name     string
+		target   string
+		cookie   string
+		accept   string
+		expected string
+	}{
+		{"default", "/", "", "", "en"},
+		{"accept language", "/", "", "fr;q=0.9, vi-VN;q=0.8", "vi"},
+		{"cookie beats header", "/", "en", "vi", "en"},
+		{"query beats cookie", "/?lang=vi", "en", "", "vi"},
+		{"unsupported query", "/?lang=de", "", "vi", "vi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "lang", Value: tt.cookie})
+			}
+			if tt.accept != "" {
+				req.Header.Set("Accept-Language", tt.accept)
+			}
+
+			var locale, greeting string
+			w := serve(mw, req, func(ctx forkCtx.Context) {
+				locale = ctx.GetString(LocaleKey)
+				greeting = ctx.T("greeting", "name", "An")
+			})
+
+			if locale != tt.expected {
+				t.Errorf("Expected locale %q, got %q", tt.expected, locale)
+			}
+			if w.Header().Get("Content-Language") != tt.expected {
+				t.Errorf("Expected Content-Language %q, got %q", tt.expected, w.Header().Get("Content-Language"))
+			}
+			if greeting == "greeting" {
+				t.Error("Expected ctx.T to use translator set by middleware")
+			}
+		})
+	}
+}
+
+func TestMiddlewareResolver(t *testing.T) {
+	b := newTestBundle(t)
+	mw := New(Config{
+		Bundle:     b,
+		QueryParam: "-",
+		Resolver:   func(forkCtx.Context) string { return "vi" },
+	})
+
+	var greeting string
+	serve(mw, httptest.NewRequest(http.MethodGet, "/?lang=en", nil), func(ctx forkCtx.Context) {
+		greeting = ctx.T("greeting", "name", "An")
+	})
+	if greeting != "Xin chào An" {
+		t.Errorf("Expected resolver locale to win, got %q", greeting)
+	}
+}
+
+func TestNewPanicsWithoutBundle(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic without Bundle")
+		}
+	}()
+	New(Config{})
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	got := ParseAcceptLanguage("en;q=0.5, vi-VN, fr;q=0.8, de;q=0")
+	expected := []string{"vi-VN", "fr", "en"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+	if len(ParseAcceptLanguage("")) != 0 {
+		t.Error("Expected no locales for empty header")
+	}
+}
@@ -0,0 +1,167 @@
+package i18n
+
+import (
+	"strings"
+	"sync"
+)
+
+// Plural là dạng số nhiều theo CLDR.
+type Plural string
+
+// Các dạng số nhiều theo CLDR.
+const (
+	PluralZero  Plural = "zero"
+	PluralOne   Plural = "one"
+	PluralTwo   Plural = "two"
+	PluralFew   Plural = "few"
+	PluralMany  Plural = "many"
+	PluralOther Plural = "other"
+)
+
+// PluralRule trả về dạng số nhiều cho một số lượng.
+type PluralRule func(n int) Plural
+
+var (
+	pluralMu    sync.RWMutex
+	pluralRules = map[string]PluralRule{}
+)
+
+func init() {
+	// Ngôn ngữ không phân biệt số nhiều
+	for _, lang := range []string{"vi", "ja", "zh", "ko", "th", "id", "ms", "lo", "km", "my"} {
+		pluralRules[lang] = ruleOther
+	}
+	// Ngôn ngữ phân biệt one/other với n == 1
+	for _, lang := range []string{"en", "de", "nl", "sv", "da", "no", "nb", "fi", "et", "it", "es", "el", "hu", "tr", "bg"} {
+		pluralRules[lang] = ruleOneOther
+	}
+	// Ngôn ngữ coi 0 và 1 là số ít
+	for _, lang := range []string{"fr", "pt", "hi"} {
+		pluralRules[lang] = ruleZeroOneOther
+	}
+	// Ngôn ngữ Slavic với one/few/many
+	for _, lang := range []string{"ru", "uk", "be", "sr", "hr", "bs"} {
+		pluralRules[lang] = ruleSlavic
+	}
+	pluralRules["pl"] = rulePolish
+	pluralRules["cs"] = ruleCzech
+	pluralRules["sk"] = ruleCzech
+	pluralRules["ar"] = ruleArabic
+}
+
+// RegisterPluralRule đăng ký (hoặc ghi đè) quy tắc số nhiều cho một ngôn ngữ.
+//
+// Parameters:
+//   - lang: Mã ngôn ngữ cơ sở (ví dụ: "en", "vi")
+//   - rule: Quy tắc số nhiều
+func RegisterPluralRule(lang string, rule PluralRule) {
+	pluralMu.Lock()
+	defer pluralMu.Unlock()
+	pluralRules[strings.ToLower(lang)] = rule
+}
+
+// PluralFor trả về dạng số nhiều của n theo ngôn ngữ của locale.
+// Ngôn ngữ chưa có quy tắc dùng quy tắc one/other.
+//
+// Parameters:
+//   - locale: Locale (ví dụ: "en-US", "vi")
+//   - n: Số lượng
+//
+// Returns:
+//   - Plural: Dạng số nhiều
+func PluralFor(locale string, n int) Plural {
+	pluralMu.RLock()
+	rule, ok := pluralRules[baseLanguage(locale)]
+	pluralMu.RUnlock()
+	if !ok {
+		rule = ruleOneOther
+	}
+	return rule(n)
+}
+
+func ruleOther(int) Plural {
+	return PluralOther
+}
+
+func ruleOneOther(n int) Plural {
+	if n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func ruleZeroOneOther(n int) Plural {
+	if n == 0 || n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+func ruleSlavic(n int) Plural {
+	n = abs(n)
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return PluralOne
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+func rulePolish(n int) Plural {
+	n = abs(n)
+	switch {
+	case n == 1:
+		return PluralOne
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+func ruleCzech(n int) Plural {
+	switch {
+	case n == 1:
+		return PluralOne
+	case n >= 2 && n <= 4:
+		return PluralFew
+	default:
+		return PluralOther
+	}
+}
+
+func ruleArabic(n int) Plural {
+	n = abs(n)
+	switch {
+	case n == 0:
+		return PluralZero
+	case n == 1:
+		return PluralOne
+	case n == 2:
+		return PluralTwo
+	case n%100 >= 3 && n%100 <= 10:
+		return PluralFew
+	case n%100 >= 11:
+		return PluralMany
+	default:
+		return PluralOther
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// baseLanguage trả về mã ngôn ngữ cơ sở của locale ("en-US" -> "en").
+func baseLanguage(locale string) string {
+	locale = strings.ToLower(locale)
+	if idx := strings.IndexAny(locale, "-_"); idx > 0 {
+		return locale[:idx]
+	}
+	return locale
+}
@@ -0,0 +1,46 @@
+package i18n
+
+import "testing"
+
+func TestPluralFor(t *testing.T) {
+	tests := []struct {
+		locale   string
+		n        int
+		expected Plural
+	}{
+		{"en", 1, PluralOne},
+		{"en-US", 2, PluralOther},
+		{"en", 0, PluralOther},
+		{"vi", 1, PluralOther},
+		{"fr", 0, PluralOne},
+		{"fr", 2, PluralOther},
+		{"ru", 1, PluralOne},
+		{"ru", 3, PluralFew},
+		{"ru", 5, PluralMany},
+		{"ru", 11, PluralMany},
+		{"ru", 21, PluralOne},
+		{"pl", 22, PluralFew},
+		{"pl", 21, PluralMany},
+		{"ar", 2, PluralTwo},
+		{"xx", 1, PluralOne},
+	}
+
+	for _, tt := range tests {
+		if got := PluralFor(tt.locale, tt.n); got != tt.expected {
+			t.Errorf("PluralFor(%q, %d) = %s, expected %s", tt.locale, tt.n, got, tt.expected)
+		}
+	}
+}
+
+func TestRegisterPluralRule(t *testing.T) {
+	RegisterPluralRule("tlh", func(n int) Plural {
+		if n == 2 {
+			return PluralTwo
+		}
+		return PluralOther
+	})
+
+	if got := PluralFor("tlh", 2); got != PluralTwo {
+		t.Errorf("Expected custom rule to return two, got %s", got)
+	}
+}
@@ -0,0 +1,447 @@
+// Package cache cung cấp middleware cache response với nhiều backend lưu trữ
+// (bộ nhớ, Redis, Memcached), khóa cache có thể vary theo headers/query,
+// stale-while-revalidate và các API purge theo khóa hoặc tag.
+//
+// Cache là cache dùng chung: response cho request có header Authorization chỉ được lưu khi
+// response có Cache-Control: public hoặc s-maxage, và header Vary của response được tôn trọng
+// (mỗi tổ hợp giá trị header được lưu riêng, Vary: * không được lưu).
+package cache
+
+import (
+	"bytes"
+	gocontext "context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// Các giá trị của header trạng thái cache.
+const (
+	StatusHit   = "HIT"
+	StatusMiss  = "MISS"
+	StatusStale = "STALE"
+)
+
+// Config chứa cấu hình cho cache middleware.
+type Config struct {
+	// Store là backend lưu trữ. Mặc định: MemoryStore không giới hạn
+	Store Store
+
+	// TTL là thời gian response được coi là fresh.
+	// Mặc định: 1 phút
+	TTL time.Duration
+
+	// StaleWhileRevalidate là khoảng thời gian sau TTL mà response stale vẫn được trả về
+	// trong khi cache được làm mới sau khi đã gửi response. Giá trị 0 tắt tính năng này.
+	StaleWhileRevalidate time.Duration
+
+	// KeyFunc trả về khóa cache cho request hiện tại.
+	// Trả về chuỗi rỗng để bỏ qua cache. Mặc định: KeyBuilder{}.Build
+	KeyFunc func(ctx forkCtx.Context) string
+
+	// Tags trả về danh sách tag gắn với response của request hiện tại (dùng cho PurgeTag).
+	Tags func(ctx forkCtx.Context) []string
+
+	// Methods là danh sách HTTP methods được cache. Mặc định: GET, HEAD
+	// Response của HEAD không có body nên không bao giờ được lưu; request HEAD chỉ được
+	// phục vụ từ entry của GET khi KeyFunc bỏ qua method (KeyBuilder.IgnoreMethod).
+	Methods []string
+
+	// StatusCodes là danh sách status codes được cache. Mặc định: 200
+	StatusCodes []int
+
+	// MaxBodySize là kích thước response tối đa (bytes) được cache. Mặc định: 1MB
+	MaxBodySize int
+
+	// StatusHeader là tên header báo trạng thái cache (HIT, MISS, STALE).
+	// Mặc định: "X-Cache"
+	StatusHeader string
+
+	// OnError được gọi khi store trả về lỗi. Lỗi store không bao giờ làm request thất bại.
+	OnError func(err error)
+
+	// Clock là nguồn thời gian xác định độ fresh và header Age.
+	// Mặc định: clock.New()
+	Clock clock.Clock
+}
+
+// Cache là response cache có thể purge theo khóa hoặc tag.
+type Cache struct {
+	config       Config
+	methods      map[string]bool
+	statusCodes  map[int]bool
+	revalidating sync.Map
+}
+
+// NewCache tạo Cache mới với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình cache
+//
+// Returns:
+//   - *Cache: Cache đã khởi tạo
+func NewCache(config Config) *Cache {
+	if config.Clock == nil {
+		config.Clock = clock.New()
+	}
+	if config.Store == nil {
+		store := NewMemoryStore(0)
+		store.SetClock(config.Clock)
+		config.Store = store
+	}
+	if config.TTL <= 0 {
+		config.TTL = time.Minute
+	}
+	if config.KeyFunc == nil {
+		builder := KeyBuilder{}
+		config.KeyFunc = func(ctx forkCtx.Context) string {
+			return builder.Build(ctx.Request().Request())
+		}
+	}
+	if len(config.Methods) == 0 {
+		config.Methods = []string{http.MethodGet, http.MethodHead}
+	}
+	if len(config.StatusCodes) == 0 {
+		config.StatusCodes = []int{http.StatusOK}
+	}
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = 1 << 20
+	}
+	if config.StatusHeader == "" {
+		config.StatusHeader = "X-Cache"
+	}
+
+	c := &Cache{
+		config:      config,
+		methods:     make(map[string]bool, len(config.Methods)),
+		statusCodes: make(map[int]bool, len(config.StatusCodes)),
+	}
+	for _, method := range config.Methods {
+		c.methods[method] = true
+	}
+	for _, code := range config.StatusCodes {
+		c.statusCodes[code] = true
+	}
+	return c
+}
+
+// New tạo cache middleware với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình cache
+//
+// Returns:
+//   - router.HandlerFunc: Middleware cache response
+func New(config Config) router.HandlerFunc {
+	return NewCache(config).Middleware()
+}
+
+// Purge xóa response đã cache theo khóa.
+//
+// Parameters:
+//   - ctx: Go context
+//   - key: Khóa cache (được tạo bởi KeyFunc hoặc KeyBuilder.Build)
+//
+// Returns:
+//   - error: Lỗi từ store
+func (c *Cache) Purge(ctx gocontext.Context, key string) error {
+	return c.config.Store.Delete(ctx, key)
+}
+
+// PurgeTag xóa tất cả responses đã cache được gắn tag.
+//
+// Parameters:
+//   - ctx: Go context
+//   - tag: Tên tag
+//
+// Returns:
+//   - error: Lỗi từ store
+func (c *Cache) PurgeTag(ctx gocontext.Context, tag string) error {
+	return c.config.Store.DeleteTag(ctx, tag)
+}
+
+// Middleware trả về middleware cache response.
+//
+// Returns:
+//   - router.HandlerFunc: Middleware cache response
+func (c *Cache) Middleware() router.HandlerFunc {
+	return func(ctx forkCtx.Context) {
+		if !c.methods[ctx.Method()] || bypass(ctx.Request().Header()) {
+			ctx.Next()
+			return
+		}
+		key := c.config.KeyFunc(ctx)
+		if key == "" {
+			ctx.Next()
+			return
+		}
+
+		entry := c.lookup(ctx, key)
+
+		now := c.config.Clock.Now()
+		if entry != nil {
+			if now.Before(entry.FreshUntil) {
+				c.serve(ctx, entry, StatusHit, now)
+				ctx.Abort()
+				return
+			}
+			if now.Before(entry.FreshUntil.Add(c.config.StaleWhileRevalidate)) {
+				c.serve(ctx, entry, StatusStale, now)
+				ctx.Response().Flush()
+				c.revalidate(ctx, key)
+				ctx.Abort()
+				return
+			}
+		}
+
+		ctx.Header(c.config.StatusHeader, StatusMiss)
+		original := ctx.Response().ResponseWriter()
+		capture := &captureWriter{target: original, header: original.Header(), status: http.StatusOK, limit: c.config.MaxBodySize}
+		ctx.Response().Reset(capture)
+		ctx.Next()
+
+		c.store(ctx, key, capture)
+	}
+}
+
+// lookup trả về entry đã cache cho request, theo entry chỉ mục Vary nếu có.
+func (c *Cache) lookup(ctx forkCtx.Context, key string) *Entry {
+	entry, err := c.config.Store.Get(ctx.Context(), key)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		c.reportError(err)
+	}
+	if entry == nil || len(entry.Vary) == 0 {
+		return entry
+	}
+
+	entry, err = c.config.Store.Get(ctx.Context(), variantKey(key, entry.Vary, ctx.Request().Header()))
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		c.reportError(err)
+	}
+	return entry
+}
+
+// serve ghi response đã cache về client.
+func (c *Cache) serve(ctx forkCtx.Context, entry *Entry, status string, now time.Time) {
+	header := ctx.Response().Header()
+	for name, values := range entry.Header {
+		header[name] = append([]string(nil), values...)
+	}
+	header.Set(c.config.StatusHeader, status)
+	header.Set("Age", strconv.Itoa(int(now.Sub(entry.StoredAt).Seconds())))
+	header.Set("Content-Length", strconv.Itoa(len(entry.Body)))
+
+	ctx.Response().WriteHeader(entry.Status)
+	if ctx.Method() != http.MethodHead {
+		ctx.Response().Write(entry.Body)
+	}
+}
+
+// revalidate chạy lại handler sau khi response stale đã được gửi để làm mới cache.
+// Chỉ một lần làm mới cho mỗi khóa được thực hiện đồng thời trong một instance.
+func (c *Cache) revalidate(ctx forkCtx.Context, key string) {
+	if _, busy := c.revalidating.LoadOrStore(key, struct{}{}); busy {
+		return
+	}
+	defer c.revalidating.Delete(key)
+
+	capture := &captureWriter{header: http.Header{}, status: http.StatusOK, limit: c.config.MaxBodySize}
+	ctx.Response().Reset(capture)
+	ctx.Next()
+
+	c.store(ctx, key, capture)
+}
+
+// store lưu response đã ghi lại nếu nó có thể cache.
+func (c *Cache) store(ctx forkCtx.Context, key string, capture *captureWriter) {
+	if !capture.wroteHeader || capture.overflow || !c.statusCodes[capture.status] {
+		return
+	}
+	// Response HEAD có body rỗng, lưu lại sẽ khiến GET dùng chung khóa nhận body rỗng
+	if ctx.Method() == http.MethodHead {
+		return
+	}
+	reqHeader := ctx.Request().Header()
+	if !cacheable(reqHeader, capture.header) {
+		return
+	}
+	vary, ok := varyHeaders(capture.header)
+	if !ok {
+		return
+	}
+
+	header := capture.header.Clone()
+	header.Del(c.config.StatusHeader)
+	header.Del("Age")
+
+	now := c.config.Clock.Now()
+	entry := &Entry{
+		Status:     capture.status,
+		Header:     header,
+		Body:       capture.body.Bytes(),
+		StoredAt:   now,
+		FreshUntil: now.Add(c.config.TTL),
+	}
+	if c.config.Tags != nil {
+		entry.Tags = c.config.Tags(ctx)
+	}
+
+	ttl := c.config.TTL + c.config.StaleWhileRevalidate
+	if len(vary) > 0 {
+		// Lưu entry chỉ mục ở khóa gốc, response thực tế ở khóa theo giá trị các header Vary
+		index := &Entry{Tags: entry.Tags, StoredAt: now, FreshUntil: entry.FreshUntil, Vary: vary}
+		if err := c.config.Store.Set(gocontext.Background(), key, index, ttl); err != nil {
+			c.reportError(err)
+			return
+		}
+		key = variantKey(key, vary, reqHeader)
+	}
+	if err := c.config.Store.Set(gocontext.Background(), key, entry, ttl); err != nil {
+		c.reportError(err)
+	}
+}
+
+// reportError chuyển lỗi store tới OnError nếu được cấu hình.
+func (c *Cache) reportError(err error) {
+	if c.config.OnError != nil {
+		c.config.OnError(err)
+	}
+}
+
+// bypass kiểm tra request có yêu cầu bỏ qua cache hay không.
+func bypass(header http.Header) bool {
+	cacheControl := strings.ToLower(header.Get("Cache-Control"))
+	return strings.Contains(cacheControl, "no-cache") || strings.Contains(cacheControl, "no-store")
+}
+
+// cacheable kiểm tra response có được phép lưu vào cache dùng chung hay không.
+// Response cho request có header Authorization chỉ được lưu khi response cho phép rõ ràng
+// qua Cache-Control: public hoặc s-maxage (RFC 9111, mục 3.5).
+//
+// Parameters:
+//   - reqHeader: Headers của request
+//   - header: Headers của response
+//
+// Returns:
+//   - bool: true nếu response có thể lưu vào cache dùng chung
+func cacheable(reqHeader, header http.Header) bool {
+	if header.Get("Set-Cookie") != "" {
+		return false
+	}
+	cacheControl := strings.ToLower(header.Get("Cache-Control"))
+	if strings.Contains(cacheControl, "no-store") || strings.Contains(cacheControl, "private") {
+		return false
+	}
+	if reqHeader.Get("Authorization") != "" {
+		return strings.Contains(cacheControl, "public") || strings.Contains(cacheControl, "s-maxage")
+	}
+	return true
+}
+
+// varyHeaders trả về danh sách header đã chuẩn hóa và sắp xếp trong header Vary của response.
+//
+// Parameters:
+//   - header: Headers của response
+//
+// Returns:
+//   - []string: Các header mà response vary theo, nil nếu không có Vary
+//   - bool: false nếu Vary chứa "*" (response không thể cache)
+func varyHeaders(header http.Header) ([]string, bool) {
+	var names []string
+	for _, value := range header.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name == "*" {
+				return nil, false
+			}
+			if name != "" && !slices.Contains(names, http.CanonicalHeaderKey(name)) {
+				names = append(names, http.CanonicalHeaderKey(name))
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, true
+}
+
+// variantKey tạo khóa của response thực tế từ khóa gốc và giá trị các header Vary của request.
+//
+// Parameters:
+//   - key: Khóa gốc
+//   - vary: Các header mà response vary theo
+//   - reqHeader: Headers của request
+//
+// Returns:
+//   - string: Khóa của biến thể response
+func variantKey(key string, vary []string, reqHeader http.Header) string {
+	hash := sha256.New()
+	for _, name := range vary {
+		hash.Write([]byte(name))
+		hash.Write([]byte{0})
+		hash.Write([]byte(strings.Join(reqHeader.Values(name), ",")))
+		hash.Write([]byte{0})
+	}
+	return key + ":vary:" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// captureWriter ghi lại status, headers và body của response.
+// Nếu target khác nil, dữ liệu đồng thời được chuyển tiếp tới client.
+type captureWriter struct {
+	target      http.ResponseWriter
+	header      http.Header
+	status      int
+	body        bytes.Buffer
+	limit       int
+	overflow    bool
+	wroteHeader bool
+}
+
+// Header trả về headers của response.
+func (w *captureWriter) Header() http.Header {
+	return w.header
+}
+
+// WriteHeader lưu status code và chuyển tiếp tới client nếu có.
+func (w *captureWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.status = code
+		w.wroteHeader = true
+	}
+	if w.target != nil {
+		w.target.WriteHeader(code)
+	}
+}
+
+// Write lưu body (tối đa limit bytes) và chuyển tiếp tới client nếu có.
+func (w *captureWriter) Write(data []byte) (int, error) {
+	w.wroteHeader = true
+	if !w.overflow {
+		if w.body.Len()+len(data) > w.limit {
+			w.overflow = true
+			w.body.Reset()
+		} else {
+			w.body.Write(data)
+		}
+	}
+	if w.target != nil {
+		return w.target.Write(data)
+	}
+	return len(data), nil
+}
+
+// Flush chuyển tiếp tới client nếu writer hỗ trợ.
+func (w *captureWriter) Flush() {
+	if flusher, ok := w.target.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
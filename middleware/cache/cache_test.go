@@ -0,0 +1,350 @@
+package cache
+
+import (
+	gocontext "context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// serve chạy middleware và handler cuối trên một request mới.
+func serve(mw func(forkCtx.Context), req *http.Request, handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+func TestCacheHitAndMiss(t *testing.T) {
+	c := NewCache(Config{TTL: time.Minute})
+	mw := c.Middleware()
+
+	executions := 0
+	handler := func(ctx forkCtx.Context) {
+		executions++
+		ctx.Header("X-Version", strconv.Itoa(executions))
+		ctx.String(http.StatusOK, "body %d", executions)
+	}
+
+	first := serve(mw, httptest.NewRequest(http.MethodGet, "/items?a=1&b=2", nil), handler)
+	if first.Header().Get("X-Cache") != StatusMiss || first.Body.String() != "body 1" {
+		t.Fatalf("Expected MISS body 1, got %s %q", first.Header().Get("X-Cache"), first.Body.String())
+	}
+
+	second := serve(mw, httptest.NewRequest(http.MethodGet, "/items?b=2&a=1", nil), handler)
+	if second.Header().Get("X-Cache") != StatusHit || second.Body.String() != "body 1" {
+		t.Errorf("Expected HIT body 1, got %s %q", second.Header().Get("X-Cache"), second.Body.String())
+	}
+	if second.Header().Get("X-Version") != "1" {
+		t.Errorf("Expected cached headers to be replayed, got %q", second.Header().Get("X-Version"))
+	}
+	if executions != 1 {
+		t.Errorf("Expected handler to run once, got %d", executions)
+	}
+
+	// Request yêu cầu no-cache luôn bỏ qua cache
+	req := httptest.NewRequest(http.MethodGet, "/items?a=1&b=2", nil)
+	req.Header.Set("Cache-Control", "no-cache")
+	serve(mw, req, handler)
+	if executions != 2 {
+		t.Errorf("Expected no-cache request to bypass cache, got %d executions", executions)
+	}
+}
+
+func TestCacheSkipsUncacheableResponses(t *testing.T) {
+	mw := New(Config{})
+
+	executions := 0
+	cases := []func(ctx forkCtx.Context){
+		func(ctx forkCtx.Context) { ctx.String(http.StatusInternalServerError, "error") },
+		func(ctx forkCtx.Context) {
+			ctx.Header("Cache-Control", "private")
+			ctx.String(http.StatusOK, "private")
+		},
+		func(ctx forkCtx.Context) {
+			ctx.SetCookie("session", "1", 0, "/", "", false, true)
+			ctx.String(http.StatusOK, "cookie")
+		},
+	}
+
+	for i, handler := range cases {
+		path := "/case" + strconv.Itoa(i)
+		wrapped := func(ctx forkCtx.Context) {
+			executions++
+			handler(ctx)
+		}
+		serve(mw, httptest.NewRequest(http.MethodGet, path, nil), wrapped)
+		serve(mw, httptest.NewRequest(http.MethodGet, path, nil), wrapped)
+	}
+
+	if executions != 6 {
+		t.Errorf("Expected uncacheable responses not to be cached, got %d executions", executions)
+	}
+
+	// POST không được cache
+	post := 0
+	for i := 0; i < 2; i++ {
+		serve(mw, httptest.NewRequest(http.MethodPost, "/post", nil), func(ctx forkCtx.Context) {
+			post++
+			ctx.String(http.StatusOK, "ok")
+		})
+	}
+	if post != 2 {
+		t.Errorf("Expected POST not to be cached, got %d executions", post)
+	}
+}
+
+func TestCacheAuthorizedRequests(t *testing.T) {
+	mw := New(Config{})
+
+	tests := []struct {
+		cacheControl string
+		cached       bool
+	}{
+		{"", false},
+		{"max-age=60", false},
+		{"public, max-age=60", true},
+		{"s-maxage=60", true},
+	}
+	for i, tt := range tests {
+		path := "/auth" + strconv.Itoa(i)
+		executions := 0
+		handler := func(ctx forkCtx.Context) {
+			executions++
+			if tt.cacheControl != "" {
+				ctx.Header("Cache-Control", tt.cacheControl)
+			}
+			ctx.String(http.StatusOK, "user %s", ctx.GetHeader("Authorization"))
+		}
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer alice")
+		serve(mw, req, handler)
+
+		// Client khác không có Authorization
+		w := serve(mw, httptest.NewRequest(http.MethodGet, path, nil), handler)
+		if cached := executions == 1; cached != tt.cached {
+			t.Errorf("Cache-Control %q: expected cached=%v, got %d executions (body %q)", tt.cacheControl, tt.cached, executions, w.Body.String())
+		}
+	}
+}
+
+func TestCacheVary(t *testing.T) {
+	mw := New(Config{})
+
+	executions := 0
+	handler := func(ctx forkCtx.Context) {
+		executions++
+		ctx.Header("Vary", "Accept-Language")
+		ctx.String(http.StatusOK, "lang %s", ctx.GetHeader("Accept-Language"))
+	}
+	request := func(lang string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/greeting", nil)
+		req.Header.Set("Accept-Language", lang)
+		return serve(mw, req, handler)
+	}
+
+	request("en")
+	request("vi")
+	if w := request("en"); w.Header().Get("X-Cache") != StatusHit || w.Body.String() != "lang en" {
+		t.Errorf("Expected HIT for en, got %s %q", w.Header().Get("X-Cache"), w.Body.String())
+	}
+	if w := request("vi"); w.Header().Get("X-Cache") != StatusHit || w.Body.String() != "lang vi" {
+		t.Errorf("Expected HIT for vi, got %s %q", w.Header().Get("X-Cache"), w.Body.String())
+	}
+	if executions != 2 {
+		t.Errorf("Expected one execution per Accept-Language, got %d", executions)
+	}
+
+	star := 0
+	for i := 0; i < 2; i++ {
+		serve(mw, httptest.NewRequest(http.MethodGet, "/star", nil), func(ctx forkCtx.Context) {
+			star++
+			ctx.Header("Vary", "*")
+			ctx.String(http.StatusOK, "ok")
+		})
+	}
+	if star != 2 {
+		t.Errorf("Expected Vary: * not to be cached, got %d executions", star)
+	}
+}
+
+func TestCacheHosts(t *testing.T) {
+	mw := New(Config{})
+	handler := func(ctx forkCtx.Context) {
+		ctx.String(http.StatusOK, "tenant %s", ctx.Request().Request().Host)
+	}
+
+	serve(mw, httptest.NewRequest(http.MethodGet, "http://tenant-a.example.com/", nil), handler)
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "http://tenant-b.example.com/", nil), handler)
+	if w.Header().Get("X-Cache") != StatusMiss || w.Body.String() != "tenant tenant-b.example.com" {
+		t.Errorf("Expected MISS for another host, got %s %q", w.Header().Get("X-Cache"), w.Body.String())
+	}
+	w = serve(mw, httptest.NewRequest(http.MethodGet, "http://tenant-a.example.com/", nil), handler)
+	if w.Header().Get("X-Cache") != StatusHit || w.Body.String() != "tenant tenant-a.example.com" {
+		t.Errorf("Expected HIT with the host's own body, got %s %q", w.Header().Get("X-Cache"), w.Body.String())
+	}
+}
+
+func TestCacheHead(t *testing.T) {
+	builder := KeyBuilder{IgnoreMethod: true}
+	mw := New(Config{KeyFunc: func(ctx forkCtx.Context) string {
+		return builder.Build(ctx.Request().Request())
+	}})
+
+	executions := 0
+	handler := func(ctx forkCtx.Context) {
+		executions++
+		if ctx.Method() == http.MethodHead {
+			ctx.Status(http.StatusOK)
+			return
+		}
+		ctx.String(http.StatusOK, "full body")
+	}
+
+	serve(mw, httptest.NewRequest(http.MethodHead, "/doc", nil), handler)
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "/doc", nil), handler)
+	if w.Header().Get("X-Cache") != StatusMiss || w.Body.String() != "full body" {
+		t.Errorf("Expected HEAD response not to be cached for GET, got %s %q", w.Header().Get("X-Cache"), w.Body.String())
+	}
+
+	w = serve(mw, httptest.NewRequest(http.MethodHead, "/doc", nil), handler)
+	if w.Header().Get("X-Cache") != StatusHit || w.Body.Len() != 0 {
+		t.Errorf("Expected HEAD to be served from the GET entry without body, got %s %q", w.Header().Get("X-Cache"), w.Body.String())
+	}
+	if executions != 2 {
+		t.Errorf("Expected 2 executions, got %d", executions)
+	}
+}
+
+func TestCacheStaleWhileRevalidate(t *testing.T) {
+	store := NewMemoryStore(0)
+	c := NewCache(Config{Store: store, TTL: time.Minute, StaleWhileRevalidate: time.Minute})
+	mw := c.Middleware()
+
+	key := KeyBuilder{}.Build(httptest.NewRequest(http.MethodGet, "/report", nil))
+	store.Set(gocontext.Background(), key, &Entry{
+		Status:     http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/plain"}},
+		Body:       []byte("old"),
+		StoredAt:   time.Now().Add(-2 * time.Minute),
+		FreshUntil: time.Now().Add(-time.Second),
+	}, time.Minute)
+
+	executions := 0
+	handler := func(ctx forkCtx.Context) {
+		executions++
+		ctx.String(http.StatusOK, "new")
+	}
+
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "/report", nil), handler)
+	if w.Header().Get("X-Cache") != StatusStale || w.Body.String() != "old" {
+		t.Fatalf("Expected STALE old, got %s %q", w.Header().Get("X-Cache"), w.Body.String())
+	}
+	if executions != 1 {
+		t.Errorf("Expected revalidation to run handler once, got %d", executions)
+	}
+
+	w = serve(mw, httptest.NewRequest(http.MethodGet, "/report", nil), handler)
+	if w.Header().Get("X-Cache") != StatusHit || w.Body.String() != "new" {
+		t.Errorf("Expected HIT new after revalidation, got %s %q", w.Header().Get("X-Cache"), w.Body.String())
+	}
+}
+
+func TestCacheExpiryWithMockClock(t *testing.T) {
+	mock := clock.NewMock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	mw := New(Config{TTL: time.Minute, StaleWhileRevalidate: time.Minute, Clock: mock})
+
+	executions := 0
+	handler := func(ctx forkCtx.Context) {
+		executions++
+		ctx.String(http.StatusOK, "body %d", executions)
+	}
+	request := func() *httptest.ResponseRecorder {
+		return serve(mw, httptest.NewRequest(http.MethodGet, "/report", nil), handler)
+	}
+
+	request()
+	mock.Add(30 * time.Second)
+	if w := request(); w.Header().Get("X-Cache") != StatusHit || w.Header().Get("Age") != "30" {
+		t.Errorf("Expected HIT with Age 30, got %s %s", w.Header().Get("X-Cache"), w.Header().Get("Age"))
+	}
+
+	mock.Add(45 * time.Second)
+	if w := request(); w.Header().Get("X-Cache") != StatusStale {
+		t.Errorf("Expected STALE after TTL, got %s", w.Header().Get("X-Cache"))
+	}
+
+	mock.Add(3 * time.Minute)
+	if w := request(); w.Header().Get("X-Cache") != StatusMiss {
+		t.Errorf("Expected MISS after store expiry, got %s", w.Header().Get("X-Cache"))
+	}
+}
+
+func TestCachePurge(t *testing.T) {
+	c := NewCache(Config{
+		Tags: func(ctx forkCtx.Context) []string { return []string{"products"} },
+	})
+	mw := c.Middleware()
+
+	executions := 0
+	handler := func(ctx forkCtx.Context) {
+		executions++
+		ctx.String(http.StatusOK, "ok")
+	}
+
+	serve(mw, httptest.NewRequest(http.MethodGet, "/products/1", nil), handler)
+	serve(mw, httptest.NewRequest(http.MethodGet, "/products/2", nil), handler)
+
+	key := KeyBuilder{}.Build(httptest.NewRequest(http.MethodGet, "/products/1", nil))
+	if err := c.Purge(gocontext.Background(), key); err != nil {
+		t.Fatalf("Unexpected purge error: %v", err)
+	}
+	serve(mw, httptest.NewRequest(http.MethodGet, "/products/1", nil), handler)
+	serve(mw, httptest.NewRequest(http.MethodGet, "/products/2", nil), handler)
+	if executions != 3 {
+		t.Errorf("Expected only purged key to be re-executed, got %d executions", executions)
+	}
+
+	if err := c.PurgeTag(gocontext.Background(), "products"); err != nil {
+		t.Fatalf("Unexpected purge tag error: %v", err)
+	}
+	serve(mw, httptest.NewRequest(http.MethodGet, "/products/1", nil), handler)
+	serve(mw, httptest.NewRequest(http.MethodGet, "/products/2", nil), handler)
+	if executions != 5 {
+		t.Errorf("Expected tag purge to invalidate both entries, got %d executions", executions)
+	}
+}
+
+// failingStore luôn trả về lỗi.
+type failingStore struct{}
+
+func (failingStore) Get(gocontext.Context, string) (*Entry, error) { return nil, errors.New("down") }
+func (failingStore) Set(gocontext.Context, string, *Entry, time.Duration) error {
+	return errors.New("down")
+}
+func (failingStore) Delete(gocontext.Context, string) error    { return errors.New("down") }
+func (failingStore) DeleteTag(gocontext.Context, string) error { return errors.New("down") }
+
+func TestCacheStoreErrorsDoNotFailRequests(t *testing.T) {
+	errorsSeen := 0
+	mw := New(Config{Store: failingStore{}, OnError: func(err error) { errorsSeen++ }})
+
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), func(ctx forkCtx.Context) {
+		ctx.String(http.StatusOK, "ok")
+	})
+
+	if w.Code != http.StatusOK || w.Body.String() != "ok" {
+		t.Errorf("Expected request to succeed, got %d %q", w.Code, w.Body.String())
+	}
+	if errorsSeen != 2 {
+		t.Errorf("Expected 2 store errors to be reported, got %d", errorsSeen)
+	}
+}
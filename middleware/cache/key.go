@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// KeyBuilder tạo khóa cache từ request, cho phép vary theo headers và một tập con query params.
+type KeyBuilder struct {
+	// Prefix được thêm vào trước khóa (ví dụ: tên service hoặc phiên bản)
+	Prefix string
+
+	// VaryHeaders là danh sách header có giá trị được đưa vào khóa (ví dụ: Accept-Language)
+	VaryHeaders []string
+
+	// QueryParams là tập con query params được đưa vào khóa.
+	// Nếu nil, tất cả query params được sử dụng.
+	QueryParams []string
+
+	// IgnoreQuery bỏ qua toàn bộ query string khi tạo khóa.
+	IgnoreQuery bool
+
+	// IgnoreMethod bỏ qua HTTP method khi tạo khóa (ví dụ: để HEAD được phục vụ từ entry của GET).
+	IgnoreMethod bool
+
+	// IgnoreHost bỏ qua host của request khi tạo khóa. Chỉ nên bật khi mọi host phục vụ
+	// cùng nội dung; mặc định host được đưa vào khóa để các host/subdomain không dùng chung entry.
+	IgnoreHost bool
+}
+
+// Build tạo khóa cache cho request.
+// Khóa được chuẩn hóa (sắp xếp query params) rồi băm SHA-256 để có độ dài cố định,
+// phù hợp với giới hạn khóa của các backend như Memcached.
+//
+// Parameters:
+//   - req: HTTP request
+//
+// Returns:
+//   - string: Khóa cache
+func (b KeyBuilder) Build(req *http.Request) string {
+	var sb strings.Builder
+	if !b.IgnoreMethod {
+		sb.WriteString(req.Method)
+	}
+	sb.WriteByte(' ')
+	if !b.IgnoreHost {
+		sb.WriteString(strings.ToLower(req.Host))
+	}
+	sb.WriteString(req.URL.Path)
+
+	if !b.IgnoreQuery {
+		sb.WriteByte('?')
+		sb.WriteString(b.canonicalQuery(req.URL.Query()))
+	}
+
+	for _, name := range b.VaryHeaders {
+		sb.WriteByte('\n')
+		sb.WriteString(http.CanonicalHeaderKey(name))
+		sb.WriteByte(':')
+		sb.WriteString(strings.Join(req.Header.Values(name), ","))
+	}
+
+	sum := sha256.Sum256([]byte(sb.String()))
+	return b.Prefix + hex.EncodeToString(sum[:])
+}
+
+// canonicalQuery trả về query string đã được lọc và sắp xếp.
+func (b KeyBuilder) canonicalQuery(query url.Values) string {
+	if b.QueryParams != nil {
+		filtered := url.Values{}
+		for _, name := range b.QueryParams {
+			if values, ok := query[name]; ok {
+				filtered[name] = values
+			}
+		}
+		query = filtered
+	}
+	// url.Values.Encode sắp xếp theo tên; sắp xếp thêm giá trị để thứ tự không ảnh hưởng khóa
+	for name, values := range query {
+		sorted := append([]string(nil), values...)
+		sort.Strings(sorted)
+		query[name] = sorted
+	}
+	return query.Encode()
+}
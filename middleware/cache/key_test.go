@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestKeyBuilder(t *testing.T) {
+	req := func(method, target string, headers ...string) *http.Request {
+		r := httptest.NewRequest(method, target, nil)
+		for i := 0; i+1 < len(headers); i += 2 {
+			r.Header.Add(headers[i], headers[i+1])
+		}
+		return r
+	}
+
+	tests := []struct {
+		name    string
+		builder KeyBuilder
+		a, b    *http.Request
+		same    bool
+	}{
+		{"query order", KeyBuilder{}, req("GET", "/p?a=1&b=2"), req("GET", "/p?b=2&a=1"), true},
+		{"query value order", KeyBuilder{}, req("GET", "/p?a=1&a=2"), req("GET", "/p?a=2&a=1"), true},
+		{"different query", KeyBuilder{}, req("GET", "/p?a=1"), req("GET", "/p?a=2"), false},
+		{"different method", KeyBuilder{}, req("GET", "/p"), req("HEAD", "/p"), false},
+		{"ignore method", KeyBuilder{IgnoreMethod: true}, req("GET", "/p"), req("HEAD", "/p"), true},
+		{"ignore query", KeyBuilder{IgnoreQuery: true}, req("GET", "/p?a=1"), req("GET", "/p?a=2"), true},
+		{"query subset", KeyBuilder{QueryParams: []string{"page"}}, req("GET", "/p?page=1&utm=x"), req("GET", "/p?page=1&utm=y"), true},
+		{"query subset differs", KeyBuilder{QueryParams: []string{"page"}}, req("GET", "/p?page=1"), req("GET", "/p?page=2"), false},
+		{"vary header", KeyBuilder{VaryHeaders: []string{"Accept-Language"}}, req("GET", "/p", "Accept-Language", "vi"), req("GET", "/p", "Accept-Language", "en"), false},
+		{"different host", KeyBuilder{}, req("GET", "http://tenant-a.example.com/p"), req("GET", "http://tenant-b.example.com/p"), false},
+		{"host case", KeyBuilder{}, req("GET", "http://Tenant-A.example.com/p"), req("GET", "http://tenant-a.example.com/p"), true},
+		{"ignore host", KeyBuilder{IgnoreHost: true}, req("GET", "http://tenant-a.example.com/p"), req("GET", "http://tenant-b.example.com/p"), true},
+		{"non vary header", KeyBuilder{}, req("GET", "/p", "Accept-Language", "vi"), req("GET", "/p", "Accept-Language", "en"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			same := tt.builder.Build(tt.a) == tt.builder.Build(tt.b)
+			if same != tt.same {
+				t.Errorf("Expected same=%v, got %v", tt.same, same)
+			}
+		})
+	}
+
+	key := KeyBuilder{Prefix: "v1:"}.Build(req("GET", "/p"))
+	if !strings.HasPrefix(key, "v1:") || len(key) != len("v1:")+64 {
+		t.Errorf("Expected prefixed sha256 key, got %q", key)
+	}
+}
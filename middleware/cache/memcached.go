@@ -0,0 +1,139 @@
+package cache
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// MemcachedClient là tập lệnh Memcached tối thiểu mà MemcachedStore cần.
+// Người dùng bọc client Memcached đang sử dụng (ví dụ: gomemcache) để triển khai interface này.
+type MemcachedClient interface {
+	// Get trả về giá trị của key, hoặc ErrNotFound nếu key không tồn tại.
+	Get(key string) ([]byte, error)
+
+	// Set lưu giá trị với thời gian sống ttl.
+	Set(key string, value []byte, ttl time.Duration) error
+
+	// Delete xóa key. Trả về ErrNotFound hoặc nil nếu key không tồn tại.
+	Delete(key string) error
+}
+
+// MemcachedStore là Store dùng Memcached, cho phép chia sẻ cache giữa nhiều instances.
+//
+// Memcached không hỗ trợ set, vì vậy mỗi tag được lưu dưới dạng danh sách khóa JSON.
+// Việc cập nhật danh sách không nguyên tử; khi nhiều instances ghi cùng một tag đồng thời,
+// một vài khóa có thể không được ghi nhận và sẽ hết hạn theo TTL thay vì bị purge.
+type MemcachedStore struct {
+	client MemcachedClient
+	prefix string
+}
+
+// NewMemcachedStore tạo MemcachedStore mới.
+//
+// Parameters:
+//   - client: Memcached client
+//   - prefix: Tiền tố cho tất cả keys (ví dụ: "fork:cache:")
+//
+// Returns:
+//   - *MemcachedStore: Store đã khởi tạo
+func NewMemcachedStore(client MemcachedClient, prefix string) *MemcachedStore {
+	return &MemcachedStore{client: client, prefix: prefix}
+}
+
+// Get trả về entry theo khóa.
+func (s *MemcachedStore) Get(_ gocontext.Context, key string) (*Entry, error) {
+	data, err := s.client.Get(s.prefix + key)
+	if err != nil {
+		return nil, err
+	}
+	return decodeEntry(data)
+}
+
+// Set lưu entry và thêm khóa vào danh sách của từng tag.
+func (s *MemcachedStore) Set(_ gocontext.Context, key string, entry *Entry, ttl time.Duration) error {
+	data, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Set(s.prefix+key, data, ttl); err != nil {
+		return err
+	}
+
+	for _, tag := range entry.Tags {
+		keys, err := s.tagMembers(tag)
+		if err != nil {
+			return err
+		}
+		if containsString(keys, key) {
+			continue
+		}
+		encoded, err := json.Marshal(append(keys, key))
+		if err != nil {
+			return err
+		}
+		if err := s.client.Set(s.tagKey(tag), encoded, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete xóa entry theo khóa.
+func (s *MemcachedStore) Delete(_ gocontext.Context, key string) error {
+	return ignoreNotFound(s.client.Delete(s.prefix + key))
+}
+
+// DeleteTag xóa tất cả entries trong danh sách của tag và chính danh sách đó.
+func (s *MemcachedStore) DeleteTag(_ gocontext.Context, tag string) error {
+	keys, err := s.tagMembers(tag)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := ignoreNotFound(s.client.Delete(s.prefix + key)); err != nil {
+			return err
+		}
+	}
+	return ignoreNotFound(s.client.Delete(s.tagKey(tag)))
+}
+
+// tagMembers đọc danh sách khóa của tag.
+func (s *MemcachedStore) tagMembers(tag string) ([]string, error) {
+	data, err := s.client.Get(s.tagKey(tag))
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// tagKey trả về key của danh sách khóa thuộc tag.
+func (s *MemcachedStore) tagKey(tag string) string {
+	return s.prefix + "tag:" + tag
+}
+
+// ignoreNotFound bỏ qua ErrNotFound khi xóa.
+func ignoreNotFound(err error) error {
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// containsString kiểm tra slice có chứa chuỗi hay không.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
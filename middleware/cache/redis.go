@@ -0,0 +1,106 @@
+package cache
+
+import (
+	gocontext "context"
+	"errors"
+	"time"
+)
+
+// RedisClient là tập lệnh Redis tối thiểu mà RedisStore cần.
+// Người dùng bọc client Redis đang sử dụng (ví dụ: go-redis) để triển khai interface này,
+// giúp package không phụ thuộc vào một thư viện Redis cụ thể.
+type RedisClient interface {
+	// Get trả về giá trị của key, hoặc ErrNotFound nếu key không tồn tại.
+	Get(ctx gocontext.Context, key string) ([]byte, error)
+
+	// Set lưu giá trị với thời gian sống ttl (SET key value PX ttl).
+	Set(ctx gocontext.Context, key string, value []byte, ttl time.Duration) error
+
+	// Del xóa các keys.
+	Del(ctx gocontext.Context, keys ...string) error
+
+	// SAdd thêm members vào set.
+	SAdd(ctx gocontext.Context, key string, members ...string) error
+
+	// SMembers trả về tất cả members của set.
+	SMembers(ctx gocontext.Context, key string) ([]string, error)
+
+	// Expire đặt thời gian sống cho key.
+	Expire(ctx gocontext.Context, key string, ttl time.Duration) error
+}
+
+// RedisStore là Store dùng Redis, cho phép chia sẻ cache giữa nhiều instances.
+// Mỗi tag được lưu dưới dạng một Redis set chứa các khóa của entries.
+type RedisStore struct {
+	client RedisClient
+	prefix string
+}
+
+// NewRedisStore tạo RedisStore mới.
+//
+// Parameters:
+//   - client: Redis client
+//   - prefix: Tiền tố cho tất cả keys (ví dụ: "fork:cache:")
+//
+// Returns:
+//   - *RedisStore: Store đã khởi tạo
+func NewRedisStore(client RedisClient, prefix string) *RedisStore {
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+// Get trả về entry theo khóa.
+func (s *RedisStore) Get(ctx gocontext.Context, key string) (*Entry, error) {
+	data, err := s.client.Get(ctx, s.prefix+key)
+	if err != nil {
+		return nil, err
+	}
+	return decodeEntry(data)
+}
+
+// Set lưu entry và thêm khóa vào set của từng tag.
+func (s *RedisStore) Set(ctx gocontext.Context, key string, entry *Entry, ttl time.Duration) error {
+	data, err := encodeEntry(entry)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Set(ctx, s.prefix+key, data, ttl); err != nil {
+		return err
+	}
+	for _, tag := range entry.Tags {
+		tagKey := s.tagKey(tag)
+		if err := s.client.SAdd(ctx, tagKey, key); err != nil {
+			return err
+		}
+		// Tag set sống ít nhất bằng entry mới nhất của nó
+		if err := s.client.Expire(ctx, tagKey, ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete xóa entry theo khóa.
+func (s *RedisStore) Delete(ctx gocontext.Context, key string) error {
+	return s.client.Del(ctx, s.prefix+key)
+}
+
+// DeleteTag xóa tất cả entries trong set của tag và chính set đó.
+func (s *RedisStore) DeleteTag(ctx gocontext.Context, tag string) error {
+	tagKey := s.tagKey(tag)
+	members, err := s.client.SMembers(ctx, tagKey)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	keys := make([]string, 0, len(members)+1)
+	for _, member := range members {
+		keys = append(keys, s.prefix+member)
+	}
+	keys = append(keys, tagKey)
+	return s.client.Del(ctx, keys...)
+}
+
+// tagKey trả về key của set chứa các khóa thuộc tag.
+func (s *RedisStore) tagKey(tag string) string {
+	return s.prefix + "tag:" + tag
+}
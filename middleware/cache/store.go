@@ -0,0 +1,209 @@
+package cache
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.fork.vn/fork/clock"
+)
+
+// ErrNotFound được trả về bởi Store và các client khi khóa không tồn tại hoặc đã hết hạn.
+var ErrNotFound = errors.New("cache: entry not found")
+
+// Entry là một response đã được cache.
+type Entry struct {
+	// Status là HTTP status code của response
+	Status int `json:"status"`
+
+	// Header là HTTP headers của response
+	Header http.Header `json:"header"`
+
+	// Body là nội dung của response
+	Body []byte `json:"body"`
+
+	// Tags là danh sách tag dùng để purge theo nhóm
+	Tags []string `json:"tags,omitempty"`
+
+	// StoredAt là thời điểm response được cache
+	StoredAt time.Time `json:"stored_at"`
+
+	// FreshUntil là thời điểm response hết fresh và bắt đầu stale
+	FreshUntil time.Time `json:"fresh_until"`
+
+	// Vary là danh sách header trong header Vary của response. Entry có Vary là entry chỉ mục:
+	// response thực tế được lưu ở khóa tính từ khóa gốc và giá trị các header này của request
+	Vary []string `json:"vary,omitempty"`
+}
+
+// Store là interface cho backend lưu trữ response cache.
+// Các triển khai phải an toàn khi sử dụng đồng thời từ nhiều goroutines.
+type Store interface {
+	// Get trả về entry theo khóa, hoặc ErrNotFound nếu không tồn tại.
+	Get(ctx gocontext.Context, key string) (*Entry, error)
+
+	// Set lưu entry với thời gian sống ttl và gắn entry vào các tag của nó.
+	Set(ctx gocontext.Context, key string, entry *Entry, ttl time.Duration) error
+
+	// Delete xóa entry theo khóa.
+	Delete(ctx gocontext.Context, key string) error
+
+	// DeleteTag xóa tất cả entries được gắn tag.
+	DeleteTag(ctx gocontext.Context, tag string) error
+}
+
+// encodeEntry tuần tự hóa entry để lưu vào các backend phân tán.
+func encodeEntry(entry *Entry) ([]byte, error) {
+	return json.Marshal(entry)
+}
+
+// decodeEntry giải tuần tự hóa entry từ backend phân tán.
+func decodeEntry(data []byte) (*Entry, error) {
+	entry := &Entry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// memoryItem là một entry trong MemoryStore.
+type memoryItem struct {
+	entry     *Entry
+	expiresAt time.Time
+}
+
+// MemoryStore là Store lưu trong bộ nhớ của tiến trình, phù hợp cho một instance.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	items      map[string]memoryItem
+	tags       map[string]map[string]struct{}
+	maxEntries int
+	now        func() time.Time
+}
+
+// NewMemoryStore tạo MemoryStore mới.
+//
+// Parameters:
+//   - maxEntries: Số entries tối đa, 0 để không giới hạn
+//
+// Returns:
+//   - *MemoryStore: Store đã khởi tạo
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{
+		items:      make(map[string]memoryItem),
+		tags:       make(map[string]map[string]struct{}),
+		maxEntries: maxEntries,
+		now:        time.Now,
+	}
+}
+
+// SetClock thiết lập nguồn thời gian dùng để xác định entry hết hạn.
+//
+// Parameters:
+//   - c: Clock (ví dụ: clock.NewMock trong test)
+func (s *MemoryStore) SetClock(c clock.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = c.Now
+}
+
+// Get trả về entry theo khóa nếu còn hạn.
+func (s *MemoryStore) Get(_ gocontext.Context, key string) (*Entry, error) {
+	s.mu.RLock()
+	item, ok := s.items[key]
+	now := s.now()
+	s.mu.RUnlock()
+
+	if !ok || now.After(item.expiresAt) {
+		return nil, ErrNotFound
+	}
+	return item.entry, nil
+}
+
+// Set lưu entry với thời gian sống ttl.
+func (s *MemoryStore) Set(_ gocontext.Context, key string, entry *Entry, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.items[key]; !exists && s.maxEntries > 0 && len(s.items) >= s.maxEntries {
+		s.evictLocked()
+	}
+
+	s.deleteLocked(key)
+	s.items[key] = memoryItem{entry: entry, expiresAt: s.now().Add(ttl)}
+	for _, tag := range entry.Tags {
+		keys, ok := s.tags[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			s.tags[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+	return nil
+}
+
+// Delete xóa entry theo khóa.
+func (s *MemoryStore) Delete(_ gocontext.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleteLocked(key)
+	return nil
+}
+
+// DeleteTag xóa tất cả entries được gắn tag.
+func (s *MemoryStore) DeleteTag(_ gocontext.Context, tag string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.tags[tag] {
+		s.deleteLocked(key)
+	}
+	delete(s.tags, tag)
+	return nil
+}
+
+// Len trả về số entries hiện có (bao gồm cả entries đã hết hạn nhưng chưa bị dọn).
+func (s *MemoryStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.items)
+}
+
+// deleteLocked xóa entry và gỡ khỏi các tag index. Phải được gọi khi đang giữ s.mu.
+func (s *MemoryStore) deleteLocked(key string) {
+	item, ok := s.items[key]
+	if !ok {
+		return
+	}
+	delete(s.items, key)
+	for _, tag := range item.entry.Tags {
+		if keys, ok := s.tags[tag]; ok {
+			delete(keys, key)
+			if len(keys) == 0 {
+				delete(s.tags, tag)
+			}
+		}
+	}
+}
+
+// evictLocked dọn các entries hết hạn, nếu vẫn đầy thì bỏ entry sắp hết hạn nhất.
+// Phải được gọi khi đang giữ s.mu.
+func (s *MemoryStore) evictLocked() {
+	now := s.now()
+	oldestKey := ""
+	var oldest time.Time
+	for key, item := range s.items {
+		if now.After(item.expiresAt) {
+			s.deleteLocked(key)
+			continue
+		}
+		if oldestKey == "" || item.expiresAt.Before(oldest) {
+			oldestKey, oldest = key, item.expiresAt
+		}
+	}
+	if len(s.items) >= s.maxEntries && oldestKey != "" {
+		s.deleteLocked(oldestKey)
+	}
+}
@@ -0,0 +1,182 @@
+package cache
+
+import (
+	gocontext "context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis là RedisClient trong bộ nhớ dùng cho test.
+type fakeRedis struct {
+	mu     sync.Mutex
+	values map[string][]byte
+	sets   map[string]map[string]struct{}
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{values: map[string][]byte{}, sets: map[string]map[string]struct{}{}}
+}
+
+func (r *fakeRedis) Get(_ gocontext.Context, key string) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if v, ok := r.values[key]; ok {
+		return v, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (r *fakeRedis) Set(_ gocontext.Context, key string, value []byte, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[key] = value
+	return nil
+}
+
+func (r *fakeRedis) Del(_ gocontext.Context, keys ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range keys {
+		delete(r.values, key)
+		delete(r.sets, key)
+	}
+	return nil
+}
+
+func (r *fakeRedis) SAdd(_ gocontext.Context, key string, members ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sets[key] == nil {
+		r.sets[key] = map[string]struct{}{}
+	}
+	for _, m := range members {
+		r.sets[key][m] = struct{}{}
+	}
+	return nil
+}
+
+func (r *fakeRedis) SMembers(_ gocontext.Context, key string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var members []string
+	for m := range r.sets[key] {
+		members = append(members, m)
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+func (r *fakeRedis) Expire(gocontext.Context, string, time.Duration) error { return nil }
+
+// fakeMemcached là MemcachedClient trong bộ nhớ dùng cho test.
+type fakeMemcached struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func (m *fakeMemcached) Get(key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.values[key]; ok {
+		return v, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (m *fakeMemcached) Set(key string, value []byte, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[key] = value
+	return nil
+}
+
+func (m *fakeMemcached) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.values[key]; !ok {
+		return ErrNotFound
+	}
+	delete(m.values, key)
+	return nil
+}
+
+// testStore kiểm tra hành vi chung của một Store.
+func testStore(t *testing.T, store Store) {
+	ctx := gocontext.Background()
+	entry := func(body string, tags ...string) *Entry {
+		return &Entry{Status: 200, Body: []byte(body), Tags: tags, StoredAt: time.Now(), FreshUntil: time.Now().Add(time.Minute)}
+	}
+
+	if _, err := store.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	store.Set(ctx, "a", entry("A", "t1"), time.Minute)
+	store.Set(ctx, "b", entry("B", "t1", "t2"), time.Minute)
+	store.Set(ctx, "c", entry("C", "t2"), time.Minute)
+
+	got, err := store.Get(ctx, "a")
+	if err != nil || string(got.Body) != "A" || got.Status != 200 {
+		t.Fatalf("Expected entry A, got %+v %v", got, err)
+	}
+
+	if err := store.Delete(ctx, "a"); err != nil {
+		t.Fatalf("Unexpected delete error: %v", err)
+	}
+	if _, err := store.Get(ctx, "a"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a to be deleted, got %v", err)
+	}
+
+	if err := store.DeleteTag(ctx, "t2"); err != nil {
+		t.Fatalf("Unexpected delete tag error: %v", err)
+	}
+	for _, key := range []string{"b", "c"} {
+		if _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected %s to be purged by tag, got %v", key, err)
+		}
+	}
+	if err := store.DeleteTag(ctx, "unknown"); err != nil {
+		t.Errorf("Expected purging unknown tag to succeed, got %v", err)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore(0))
+}
+
+func TestMemoryStoreExpiryAndEviction(t *testing.T) {
+	ctx := gocontext.Background()
+	store := NewMemoryStore(2)
+
+	store.Set(ctx, "short", &Entry{Body: []byte("s")}, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	if _, err := store.Get(ctx, "short"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected expired entry to be missing, got %v", err)
+	}
+
+	store.Set(ctx, "a", &Entry{Body: []byte("a")}, time.Minute)
+	store.Set(ctx, "b", &Entry{Body: []byte("b")}, 2*time.Minute)
+	store.Set(ctx, "c", &Entry{Body: []byte("c")}, 3*time.Minute)
+	if store.Len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", store.Len())
+	}
+	if _, err := store.Get(ctx, "a"); !errors.Is(err, ErrNotFound) {
+		t.Error("Expected entry closest to expiry to be evicted")
+	}
+}
+
+func TestRedisStore(t *testing.T) {
+	client := newFakeRedis()
+	testStore(t, NewRedisStore(client, "fork:"))
+
+	if _, ok := client.values["fork:c"]; ok {
+		t.Error("Expected prefixed keys to be deleted")
+	}
+}
+
+func TestMemcachedStore(t *testing.T) {
+	testStore(t, NewMemcachedStore(&fakeMemcached{values: map[string][]byte{}}, "fork:"))
+}
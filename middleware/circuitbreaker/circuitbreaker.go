@@ -0,0 +1,433 @@
+// Package circuitbreaker cung cấp circuit breaker theo khóa (route hoặc upstream)
+// với ngưỡng tỷ lệ lỗi, trạng thái half-open để thăm dò và fallback handler.
+//
+// Khi circuit mở, middleware trả về HttpError 503 (hoặc gọi Fallback) thay vì
+// chuyển request tới handler, giúp upstream đang gặp sự cố có thời gian phục hồi.
+package circuitbreaker
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+	"go.fork.vn/fork/router"
+)
+
+// ErrOpen được trả về khi circuit đang mở hoặc half-open đã đủ số request thăm dò.
+var ErrOpen = errors.New("circuitbreaker: circuit is open")
+
+// State là trạng thái của một circuit.
+type State int
+
+const (
+	// StateClosed cho phép tất cả request đi qua và đếm tỷ lệ lỗi.
+	StateClosed State = iota
+	// StateOpen từ chối tất cả request cho đến khi hết OpenTimeout.
+	StateOpen
+	// StateHalfOpen cho phép một số request thăm dò để kiểm tra upstream đã phục hồi chưa.
+	StateHalfOpen
+)
+
+// String trả về tên của trạng thái.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Metrics là interface nhận các sự kiện của circuit breaker để xuất ra hệ thống giám sát.
+type Metrics interface {
+	// OnStateChange được gọi khi một circuit chuyển trạng thái.
+	OnStateChange(key string, from, to State)
+
+	// IncRejected được gọi khi một request bị từ chối do circuit đang mở.
+	IncRejected(key string)
+}
+
+// Config chứa cấu hình cho circuit breaker.
+type Config struct {
+	// KeyFunc trả về khóa circuit cho request hiện tại.
+	// Mặc định: method + pattern của route đã khớp (ctx.FullPath), nên /users/:id dùng một
+	// circuit cho mọi id; method + path của request khi không có route nào khớp
+	KeyFunc func(ctx forkCtx.Context) string
+
+	// MaxCircuits giới hạn số circuit được lưu. Khi đầy, các circuit closed không còn request
+	// trong window hiện tại bị xóa trước, sau đó là các circuit closed khác; circuit đang open
+	// hoặc half-open được giữ lại. Mặc định: 10000
+	MaxCircuits int
+
+	// FailureRateThreshold là tỷ lệ lỗi (0-1) để mở circuit.
+	// Mặc định: 0.5
+	FailureRateThreshold float64
+
+	// MinRequests là số request tối thiểu trong một window trước khi xét tỷ lệ lỗi.
+	// Mặc định: 10
+	MinRequests int
+
+	// Window là khoảng thời gian đếm request và lỗi ở trạng thái closed.
+	// Mặc định: 10 giây
+	Window time.Duration
+
+	// OpenTimeout là thời gian circuit giữ trạng thái open trước khi chuyển sang half-open.
+	// Mặc định: 30 giây
+	OpenTimeout time.Duration
+
+	// HalfOpenRequests là số request thăm dò được phép ở trạng thái half-open.
+	// Circuit đóng lại khi tất cả request thăm dò thành công. Mặc định: 1
+	HalfOpenRequests int
+
+	// IsFailure xác định request đã xử lý có được coi là lỗi hay không.
+	// Mặc định: response status >= 500
+	IsFailure func(ctx forkCtx.Context) bool
+
+	// Fallback được gọi thay cho handler khi circuit đang mở.
+	// Mặc định: trả về HttpError 503 kèm header Retry-After
+	Fallback func(ctx forkCtx.Context, err error)
+
+	// Metrics nhận các sự kiện chuyển trạng thái và từ chối request.
+	Metrics Metrics
+
+	// Clock là nguồn thời gian cho cửa sổ thống kê và OpenTimeout.
+	// Mặc định: clock.New()
+	Clock clock.Clock
+}
+
+// Breaker quản lý tập các circuit theo khóa.
+type Breaker struct {
+	config   Config
+	mu       sync.RWMutex
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+// circuit lưu trạng thái của một khóa.
+type circuit struct {
+	mu          sync.Mutex
+	state       State
+	generation  uint64
+	requests    int
+	failures    int
+	windowStart time.Time
+	openedAt    time.Time
+	inFlight    int
+	successes   int
+}
+
+// NewBreaker tạo Breaker mới với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình circuit breaker
+//
+// Returns:
+//   - *Breaker: Breaker đã khởi tạo
+func NewBreaker(config Config) *Breaker {
+	if config.KeyFunc == nil {
+		config.KeyFunc = defaultKey
+	}
+	if config.MaxCircuits <= 0 {
+		config.MaxCircuits = 10000
+	}
+	if config.FailureRateThreshold <= 0 || config.FailureRateThreshold > 1 {
+		config.FailureRateThreshold = 0.5
+	}
+	if config.MinRequests <= 0 {
+		config.MinRequests = 10
+	}
+	if config.Window <= 0 {
+		config.Window = 10 * time.Second
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = 30 * time.Second
+	}
+	if config.HalfOpenRequests <= 0 {
+		config.HalfOpenRequests = 1
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = func(ctx forkCtx.Context) bool {
+			return ctx.Response().Status() >= 500
+		}
+	}
+	if config.Clock == nil {
+		config.Clock = clock.New()
+	}
+
+	b := &Breaker{
+		config:   config,
+		circuits: make(map[string]*circuit),
+		now:      config.Clock.Now,
+	}
+	if b.config.Fallback == nil {
+		b.config.Fallback = b.defaultFallback
+	}
+	return b
+}
+
+// New tạo circuit breaker middleware với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình circuit breaker
+//
+// Returns:
+//   - router.HandlerFunc: Middleware circuit breaker
+func New(config Config) router.HandlerFunc {
+	return NewBreaker(config).Middleware()
+}
+
+// Middleware trả về middleware áp dụng breaker cho các request.
+//
+// Returns:
+//   - router.HandlerFunc: Middleware circuit breaker
+func (b *Breaker) Middleware() router.HandlerFunc {
+	return func(ctx forkCtx.Context) {
+		key := b.config.KeyFunc(ctx)
+		done, err := b.Allow(key)
+		if err != nil {
+			b.config.Fallback(ctx, err)
+			ctx.Abort()
+			return
+		}
+
+		success := false
+		defer func() {
+			// Panic trong handler được tính là lỗi
+			done(success)
+		}()
+
+		ctx.Next()
+		success = !b.config.IsFailure(ctx)
+	}
+}
+
+// Allow kiểm tra circuit của key có cho phép request hay không.
+// Có thể dùng trực tiếp để bảo vệ các lời gọi upstream ngoài middleware.
+//
+// Parameters:
+//   - key: Khóa circuit (ví dụ: tên upstream)
+//
+// Returns:
+//   - func(success bool): Hàm phải được gọi đúng một lần với kết quả của request
+//   - error: ErrOpen nếu request bị từ chối
+func (b *Breaker) Allow(key string) (func(success bool), error) {
+	c := b.circuit(key)
+
+	c.mu.Lock()
+	now := b.now()
+	from, changed := b.refresh(c, now)
+
+	switch c.state {
+	case StateOpen:
+		c.mu.Unlock()
+		b.notify(key, from, StateOpen, changed)
+		b.rejected(key)
+		return nil, ErrOpen
+	case StateHalfOpen:
+		if c.inFlight >= b.config.HalfOpenRequests {
+			c.mu.Unlock()
+			b.notify(key, from, StateHalfOpen, changed)
+			b.rejected(key)
+			return nil, ErrOpen
+		}
+		c.inFlight++
+	}
+
+	state := c.state
+	generation := c.generation
+	c.mu.Unlock()
+	b.notify(key, from, state, changed)
+
+	var once sync.Once
+	return func(success bool) {
+		once.Do(func() {
+			b.record(key, c, generation, success)
+		})
+	}, nil
+}
+
+// State trả về trạng thái hiện tại của circuit theo key.
+//
+// Parameters:
+//   - key: Khóa circuit
+//
+// Returns:
+//   - State: Trạng thái hiện tại (StateClosed nếu key chưa được sử dụng)
+func (b *Breaker) State(key string) State {
+	b.mu.RLock()
+	c, ok := b.circuits[key]
+	b.mu.RUnlock()
+	if !ok {
+		return StateClosed
+	}
+
+	c.mu.Lock()
+	from, changed := b.refresh(c, b.now())
+	state := c.state
+	c.mu.Unlock()
+	b.notify(key, from, state, changed)
+	return state
+}
+
+// Reset đưa circuit của key về trạng thái closed.
+//
+// Parameters:
+//   - key: Khóa circuit
+func (b *Breaker) Reset(key string) {
+	b.mu.Lock()
+	delete(b.circuits, key)
+	b.mu.Unlock()
+}
+
+// circuit trả về circuit của key, tạo mới nếu chưa tồn tại.
+func (b *Breaker) circuit(key string) *circuit {
+	b.mu.RLock()
+	c, ok := b.circuits[key]
+	b.mu.RUnlock()
+	if ok {
+		return c
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c, ok = b.circuits[key]; !ok {
+		now := b.now()
+		if len(b.circuits) >= b.config.MaxCircuits {
+			b.evict(now)
+		}
+		c = &circuit{windowStart: now}
+		b.circuits[key] = c
+	}
+	return c
+}
+
+// evict giải phóng chỗ trong b.circuits khi đã đầy: xóa các circuit closed không có request
+// đang chạy và đã hết window (tương đương circuit mới), sau đó nếu vẫn đầy thì xóa các
+// circuit closed khác. Circuit open và half-open được giữ lại để không mất trạng thái bảo vệ.
+// Phải được gọi khi đang giữ b.mu.
+func (b *Breaker) evict(now time.Time) {
+	for _, idleOnly := range []bool{true, false} {
+		for key, c := range b.circuits {
+			if len(b.circuits) < b.config.MaxCircuits {
+				return
+			}
+			c.mu.Lock()
+			evictable := c.state == StateClosed && c.inFlight == 0 &&
+				(!idleOnly || now.Sub(c.windowStart) >= b.config.Window)
+			c.mu.Unlock()
+			if evictable {
+				delete(b.circuits, key)
+			}
+		}
+	}
+}
+
+// defaultKey trả về method + pattern của route đã khớp, hoặc method + path nếu không có
+// route nào khớp.
+func defaultKey(ctx forkCtx.Context) string {
+	if route := ctx.FullPath(); route != "" {
+		return ctx.Method() + " " + route
+	}
+	return ctx.Method() + " " + ctx.Path()
+}
+
+// refresh cập nhật trạng thái theo thời gian: hết window ở closed hoặc hết OpenTimeout ở open.
+// Phải được gọi khi đang giữ c.mu.
+func (b *Breaker) refresh(c *circuit, now time.Time) (State, bool) {
+	switch c.state {
+	case StateClosed:
+		if now.Sub(c.windowStart) >= b.config.Window {
+			c.requests, c.failures = 0, 0
+			c.windowStart = now
+		}
+	case StateOpen:
+		if now.Sub(c.openedAt) >= b.config.OpenTimeout {
+			b.transition(c, StateHalfOpen, now)
+			return StateOpen, true
+		}
+	}
+	return c.state, false
+}
+
+// record ghi nhận kết quả của một request đã được cho phép.
+func (b *Breaker) record(key string, c *circuit, generation uint64, success bool) {
+	c.mu.Lock()
+	if generation != c.generation {
+		// Trạng thái đã thay đổi kể từ khi request bắt đầu: bỏ qua kết quả cũ
+		c.mu.Unlock()
+		return
+	}
+
+	now := b.now()
+	from := c.state
+	switch c.state {
+	case StateClosed:
+		c.requests++
+		if !success {
+			c.failures++
+		}
+		if c.requests >= b.config.MinRequests &&
+			float64(c.failures)/float64(c.requests) >= b.config.FailureRateThreshold {
+			b.transition(c, StateOpen, now)
+		}
+	case StateHalfOpen:
+		c.inFlight--
+		if !success {
+			b.transition(c, StateOpen, now)
+		} else {
+			c.successes++
+			if c.successes >= b.config.HalfOpenRequests {
+				b.transition(c, StateClosed, now)
+			}
+		}
+	}
+	to := c.state
+	c.mu.Unlock()
+
+	b.notify(key, from, to, from != to)
+}
+
+// transition chuyển circuit sang trạng thái mới và đặt lại các bộ đếm.
+// Phải được gọi khi đang giữ c.mu.
+func (b *Breaker) transition(c *circuit, to State, now time.Time) {
+	c.state = to
+	c.generation++
+	c.requests, c.failures = 0, 0
+	c.inFlight, c.successes = 0, 0
+	c.windowStart = now
+	if to == StateOpen {
+		c.openedAt = now
+	}
+}
+
+// notify gửi sự kiện chuyển trạng thái tới Metrics.
+func (b *Breaker) notify(key string, from, to State, changed bool) {
+	if changed && from != to && b.config.Metrics != nil {
+		b.config.Metrics.OnStateChange(key, from, to)
+	}
+}
+
+// rejected gửi sự kiện từ chối request tới Metrics.
+func (b *Breaker) rejected(key string) {
+	if b.config.Metrics != nil {
+		b.config.Metrics.IncRejected(key)
+	}
+}
+
+// defaultFallback trả về HttpError 503 kèm header Retry-After.
+func (b *Breaker) defaultFallback(ctx forkCtx.Context, err error) {
+	retryAfter := int(math.Ceil(b.config.OpenTimeout.Seconds()))
+	ctx.Header("Retry-After", strconv.Itoa(retryAfter))
+
+	httpError := forkerrors.NewServiceUnavailable("Service temporarily unavailable", nil, err)
+	ctx.JSON(httpError.StatusCode, httpError)
+}
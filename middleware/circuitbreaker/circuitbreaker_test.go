@@ -0,0 +1,249 @@
+package circuitbreaker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// recordingMetrics ghi lại các sự kiện để kiểm tra.
+type recordingMetrics struct {
+	mu          sync.Mutex
+	transitions []string
+	rejected    int
+}
+
+func (m *recordingMetrics) OnStateChange(key string, from, to State) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.transitions = append(m.transitions, from.String()+"->"+to.String())
+}
+
+func (m *recordingMetrics) IncRejected(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rejected++
+}
+
+// serve chạy middleware và handler cuối trên một request mới.
+func serve(mw func(forkCtx.Context), handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, httptest.NewRequest(http.MethodGet, "/orders", nil))
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+func failing(c forkCtx.Context) { c.String(http.StatusInternalServerError, "fail") }
+func healthy(c forkCtx.Context) { c.String(http.StatusOK, "ok") }
+
+func TestBreakerOpensOnFailureRate(t *testing.T) {
+	metrics := &recordingMetrics{}
+	b := NewBreaker(Config{MinRequests: 4, FailureRateThreshold: 0.5, Metrics: metrics})
+	mw := b.Middleware()
+
+	serve(mw, healthy)
+	serve(mw, failing)
+	serve(mw, healthy)
+	if b.State("GET /orders") != StateClosed {
+		t.Fatal("Expected circuit to stay closed below MinRequests")
+	}
+	serve(mw, failing)
+
+	if b.State("GET /orders") != StateOpen {
+		t.Fatalf("Expected circuit to open, got %s", b.State("GET /orders"))
+	}
+
+	called := false
+	w := serve(mw, func(c forkCtx.Context) { called = true })
+	if called {
+		t.Error("Expected handler not to be called while open")
+	}
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status 503, got %d", w.Code)
+	}
+	if w.Header().Get("Retry-After") != "30" {
+		t.Errorf("Expected Retry-After 30, got %q", w.Header().Get("Retry-After"))
+	}
+	if metrics.rejected != 1 {
+		t.Errorf("Expected 1 rejected request, got %d", metrics.rejected)
+	}
+	if len(metrics.transitions) != 1 || metrics.transitions[0] != "closed->open" {
+		t.Errorf("Unexpected transitions: %v", metrics.transitions)
+	}
+}
+
+func TestBreakerHalfOpenProbing(t *testing.T) {
+	now := time.Now()
+	metrics := &recordingMetrics{}
+	b := NewBreaker(Config{MinRequests: 1, OpenTimeout: time.Second, HalfOpenRequests: 2, Metrics: metrics})
+	b.now = func() time.Time { return now }
+
+	done, err := b.Allow("upstream")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	done(false)
+	if b.State("upstream") != StateOpen {
+		t.Fatal("Expected circuit to open")
+	}
+
+	now = now.Add(time.Second)
+	probe1, err := b.Allow("upstream")
+	if err != nil {
+		t.Fatalf("Expected first probe to be allowed: %v", err)
+	}
+	probe2, err := b.Allow("upstream")
+	if err != nil {
+		t.Fatalf("Expected second probe to be allowed: %v", err)
+	}
+	if _, err := b.Allow("upstream"); err != ErrOpen {
+		t.Errorf("Expected third probe to be rejected, got %v", err)
+	}
+
+	probe1(true)
+	if b.State("upstream") != StateHalfOpen {
+		t.Errorf("Expected circuit to stay half-open, got %s", b.State("upstream"))
+	}
+	probe2(true)
+	if b.State("upstream") != StateClosed {
+		t.Errorf("Expected circuit to close, got %s", b.State("upstream"))
+	}
+
+	expected := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if len(metrics.transitions) != len(expected) {
+		t.Fatalf("Expected transitions %v, got %v", expected, metrics.transitions)
+	}
+	for i := range expected {
+		if metrics.transitions[i] != expected[i] {
+			t.Errorf("Expected transitions %v, got %v", expected, metrics.transitions)
+		}
+	}
+}
+
+func TestBreakerHalfOpenFailureReopens(t *testing.T) {
+	mock := clock.NewMock(time.Time{})
+	b := NewBreaker(Config{MinRequests: 1, OpenTimeout: time.Second, Clock: mock})
+
+	done, _ := b.Allow("k")
+	done(false)
+	mock.Add(time.Second - time.Millisecond)
+	if _, err := b.Allow("k"); err != ErrOpen {
+		t.Errorf("Expected circuit to stay open before OpenTimeout, got %v", err)
+	}
+	mock.Add(time.Millisecond)
+
+	probe, err := b.Allow("k")
+	if err != nil {
+		t.Fatalf("Expected probe to be allowed: %v", err)
+	}
+	probe(false)
+	if b.State("k") != StateOpen {
+		t.Errorf("Expected circuit to reopen, got %s", b.State("k"))
+	}
+}
+
+func TestBreakerWindowReset(t *testing.T) {
+	now := time.Now()
+	b := NewBreaker(Config{MinRequests: 2, Window: time.Second})
+	b.now = func() time.Time { return now }
+
+	done, _ := b.Allow("k")
+	done(false)
+	now = now.Add(2 * time.Second)
+	done, _ = b.Allow("k")
+	done(true)
+
+	if b.State("k") != StateClosed {
+		t.Errorf("Expected failures from previous window to be discarded, got %s", b.State("k"))
+	}
+}
+
+func TestBreakerFallbackAndPanic(t *testing.T) {
+	fallbackCalled := false
+	b := NewBreaker(Config{
+		MinRequests: 1,
+		Fallback: func(c forkCtx.Context, err error) {
+			fallbackCalled = true
+			c.String(http.StatusOK, "cached")
+		},
+	})
+	mw := b.Middleware()
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic to propagate")
+			}
+		}()
+		serve(mw, func(c forkCtx.Context) { panic("boom") })
+	}()
+
+	if b.State("GET /orders") != StateOpen {
+		t.Fatal("Expected panic to be counted as failure")
+	}
+
+	w := serve(mw, healthy)
+	if !fallbackCalled || w.Body.String() != "cached" {
+		t.Errorf("Expected fallback response, got %q", w.Body.String())
+	}
+}
+
+func TestStateString(t *testing.T) {
+	if StateClosed.String() != "closed" || StateOpen.String() != "open" || StateHalfOpen.String() != "half-open" {
+		t.Error("Unexpected state names")
+	}
+	if State(99).String() != "unknown" {
+		t.Error("Expected unknown for invalid state")
+	}
+}
+
+func TestDefaultKeyUsesRoutePattern(t *testing.T) {
+	ctx := forkCtx.NewContext(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/42", nil))
+	if got := defaultKey(ctx); got != "GET /users/42" {
+		t.Errorf("Expected path fallback without matched route, got %q", got)
+	}
+	ctx.SetFullPath("/users/:id")
+	if got := defaultKey(ctx); got != "GET /users/:id" {
+		t.Errorf("Expected route pattern key, got %q", got)
+	}
+}
+
+func TestBreakerMaxCircuits(t *testing.T) {
+	mock := clock.NewMock(time.Unix(0, 0))
+	b := NewBreaker(Config{MaxCircuits: 3, MinRequests: 1, Window: time.Second, Clock: mock})
+
+	// Circuit "down" mở và phải được giữ lại khi map đầy
+	done, _ := b.Allow("down")
+	done(false)
+	for _, key := range []string{"a", "b"} {
+		done, _ := b.Allow(key)
+		done(true)
+	}
+
+	// Chưa hết window: các circuit closed vẫn bị xóa để giữ giới hạn
+	for _, key := range []string{"c", "d", "e"} {
+		done, _ := b.Allow(key)
+		done(true)
+	}
+	if len(b.circuits) > 3 {
+		t.Errorf("Expected at most 3 circuits, got %d", len(b.circuits))
+	}
+	if b.State("down") != StateOpen {
+		t.Error("Expected open circuit to survive eviction")
+	}
+
+	// Hết window: circuit idle được xóa trước
+	mock.Add(2 * time.Second)
+	if _, err := b.Allow("f"); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.circuits) > 3 {
+		t.Errorf("Expected at most 3 circuits after idle eviction, got %d", len(b.circuits))
+	}
+}
@@ -0,0 +1,480 @@
+// Package debugrecorder cung cấp middleware dành cho môi trường phát triển, ghi lại toàn bộ
+// request và response (headers và body tới giới hạn, sau khi ẩn dữ liệu nhạy cảm) vào một
+// ring buffer có thể xem qua debug endpoint được bảo vệ.
+//
+// Middleware giúp tái hiện các lỗi khó từ phía client mà không cần proxy bên ngoài.
+// Không nên bật trên production vì body request và response được giữ trong bộ nhớ.
+//
+// Ví dụ:
+//
+//	recorder := debugrecorder.NewRecorder(debugrecorder.Config{
+//		RedactFields: []string{"password", "token"},
+//	})
+//	app.Use(recorder.Middleware())
+//	recorder.Register(app.Router()) // GET /_debug/requests, GET /_debug/requests/:id
+package debugrecorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+	"go.fork.vn/fork/router"
+)
+
+// Redacted là giá trị thay thế cho dữ liệu nhạy cảm.
+const Redacted = "[REDACTED]"
+
+// DefaultRedactHeaders là các header được ẩn mặc định.
+var DefaultRedactHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// Config chứa cấu hình cho debug recorder.
+type Config struct {
+	// Capacity là số exchange tối đa được giữ trong ring buffer; exchange cũ nhất bị ghi đè.
+	// Mặc định: 100
+	Capacity int
+
+	// MaxBodySize là số bytes tối đa của mỗi body được ghi lại; phần vượt quá bị cắt bỏ.
+	// Mặc định: 64KB
+	MaxBodySize int
+
+	// RedactHeaders là các header (không phân biệt hoa thường) có giá trị bị ẩn.
+	// Mặc định: DefaultRedactHeaders
+	RedactHeaders []string
+
+	// RedactFields là các trường (không phân biệt hoa thường) bị ẩn trong query string,
+	// body JSON (ở mọi cấp) và body form.
+	RedactFields []string
+
+	// Path là đường dẫn của debug endpoint khi dùng Register. Request tới Path không được ghi lại.
+	// Mặc định: "/_debug/requests"
+	Path string
+
+	// Authorize quyết định request có được xem debug endpoint hay không.
+	// Mặc định: chỉ cho phép kết nối từ loopback (dựa trên RemoteAddr, không tin header proxy)
+	Authorize func(ctx forkCtx.Context) bool
+
+	// Skipper cho phép bỏ qua việc ghi lại một số request.
+	Skipper func(ctx forkCtx.Context) bool
+
+	// Clock là nguồn thời gian cho thời điểm và thời lượng của exchange.
+	// Mặc định: clock.New()
+	Clock clock.Clock
+}
+
+// RecordedRequest là request đã được ghi lại.
+type RecordedRequest struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Proto      string      `json:"proto"`
+	RemoteAddr string      `json:"remote_addr"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+	Truncated  bool        `json:"truncated"`
+}
+
+// RecordedResponse là response đã được ghi lại.
+type RecordedResponse struct {
+	Status    int         `json:"status"`
+	Header    http.Header `json:"header"`
+	Body      string      `json:"body"`
+	Size      int         `json:"size"`
+	Truncated bool        `json:"truncated"`
+}
+
+// Exchange là một cặp request/response đã được ghi lại.
+type Exchange struct {
+	ID       uint64           `json:"id"`
+	Time     time.Time        `json:"time"`
+	Duration time.Duration    `json:"duration"`
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Recorder ghi lại các exchange vào ring buffer.
+type Recorder struct {
+	config        Config
+	redactHeaders map[string]bool
+	redactFields  map[string]bool
+
+	mu        sync.RWMutex
+	exchanges []Exchange
+	next      int
+	lastID    uint64
+}
+
+// NewRecorder tạo Recorder với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình recorder
+//
+// Returns:
+//   - *Recorder: Recorder mới
+func NewRecorder(config Config) *Recorder {
+	if config.Capacity <= 0 {
+		config.Capacity = 100
+	}
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = 64 << 10
+	}
+	if config.RedactHeaders == nil {
+		config.RedactHeaders = DefaultRedactHeaders
+	}
+	if config.Path == "" {
+		config.Path = "/_debug/requests"
+	}
+	if config.Authorize == nil {
+		config.Authorize = isLoopback
+	}
+	if config.Clock == nil {
+		config.Clock = clock.New()
+	}
+
+	r := &Recorder{
+		config:        config,
+		redactHeaders: make(map[string]bool),
+		redactFields:  make(map[string]bool),
+		exchanges:     make([]Exchange, 0, config.Capacity),
+	}
+	for _, name := range config.RedactHeaders {
+		r.redactHeaders[http.CanonicalHeaderKey(name)] = true
+	}
+	for _, name := range config.RedactFields {
+		r.redactFields[strings.ToLower(name)] = true
+	}
+	return r
+}
+
+// Middleware trả về middleware ghi lại request và response.
+//
+// Returns:
+//   - router.HandlerFunc: Middleware ghi lại exchange
+func (r *Recorder) Middleware() router.HandlerFunc {
+	return func(ctx forkCtx.Context) {
+		if r.isDebugPath(ctx.Path()) || (r.config.Skipper != nil && r.config.Skipper(ctx)) {
+			ctx.Next()
+			return
+		}
+
+		start := r.config.Clock.Now()
+		req := ctx.Request().Request()
+		body, truncated := captureBody(req, r.config.MaxBodySize)
+		recorded := RecordedRequest{
+			Method:     req.Method,
+			URL:        r.redactURL(req.URL),
+			Proto:      req.Proto,
+			RemoteAddr: req.RemoteAddr,
+			Header:     r.redactHeader(req.Header),
+			Body:       r.redactBody(req.Header.Get("Content-Type"), body, truncated),
+			Truncated:  truncated,
+		}
+
+		original := ctx.Response().ResponseWriter()
+		capture := &captureWriter{target: original, status: http.StatusOK, limit: r.config.MaxBodySize}
+		ctx.Response().Reset(capture)
+		ctx.Next()
+
+		header := original.Header()
+		r.add(Exchange{
+			Time:     start,
+			Duration: r.config.Clock.Since(start),
+			Request:  recorded,
+			Response: RecordedResponse{
+				Status:    capture.status,
+				Header:    r.redactHeader(header),
+				Body:      r.redactBody(header.Get("Content-Type"), capture.body.Bytes(), capture.truncated),
+				Size:      capture.size,
+				Truncated: capture.truncated,
+			},
+		})
+	}
+}
+
+// Register đăng ký debug endpoint trên router:
+// GET Path liệt kê exchange mới nhất trước, GET Path/:id trả về một exchange
+// và DELETE Path xóa toàn bộ buffer. Mọi endpoint đều được bảo vệ bởi Config.Authorize.
+//
+// Parameters:
+//   - rt: Router cần đăng ký endpoint
+func (r *Recorder) Register(rt router.Router) {
+	rt.Handle(http.MethodGet, r.config.Path, r.authorize(r.list))
+	rt.Handle(http.MethodGet, r.config.Path+"/:id", r.authorize(r.show))
+	rt.Handle(http.MethodDelete, r.config.Path, r.authorize(r.clear))
+}
+
+// Exchanges trả về các exchange trong buffer, mới nhất trước.
+//
+// Returns:
+//   - []Exchange: Danh sách exchange
+func (r *Recorder) Exchanges() []Exchange {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]Exchange, 0, len(r.exchanges))
+	for i := 1; i <= len(r.exchanges); i++ {
+		result = append(result, r.exchanges[(r.next-i+len(r.exchanges))%len(r.exchanges)])
+	}
+	return result
+}
+
+// Get trả về exchange theo ID.
+//
+// Parameters:
+//   - id: ID của exchange
+//
+// Returns:
+//   - Exchange: Exchange tìm thấy
+//   - bool: false nếu exchange không còn trong buffer
+func (r *Recorder) Get(id uint64) (Exchange, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, exchange := range r.exchanges {
+		if exchange.ID == id {
+			return exchange, true
+		}
+	}
+	return Exchange{}, false
+}
+
+// Clear xóa toàn bộ exchange trong buffer.
+func (r *Recorder) Clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exchanges = r.exchanges[:0]
+	r.next = 0
+}
+
+// add thêm exchange vào ring buffer và gán ID tăng dần.
+func (r *Recorder) add(exchange Exchange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastID++
+	exchange.ID = r.lastID
+	if len(r.exchanges) < r.config.Capacity {
+		r.exchanges = append(r.exchanges, exchange)
+	} else {
+		r.exchanges[r.next] = exchange
+	}
+	r.next = (r.next + 1) % r.config.Capacity
+}
+
+// isDebugPath kiểm tra path có thuộc debug endpoint hay không.
+func (r *Recorder) isDebugPath(path string) bool {
+	return path == r.config.Path || strings.HasPrefix(path, r.config.Path+"/")
+}
+
+// authorize bọc handler của debug endpoint bằng Config.Authorize.
+func (r *Recorder) authorize(next router.HandlerFunc) router.HandlerFunc {
+	return func(ctx forkCtx.Context) {
+		if !r.config.Authorize(ctx) {
+			httpError := forkerrors.Forbidden("Debug endpoint is not accessible")
+			ctx.JSON(httpError.StatusCode, httpError)
+			ctx.Abort()
+			return
+		}
+		next(ctx)
+	}
+}
+
+// list trả về danh sách exchange.
+func (r *Recorder) list(ctx forkCtx.Context) {
+	ctx.JSON(http.StatusOK, r.Exchanges())
+}
+
+// show trả về một exchange theo ID.
+func (r *Recorder) show(ctx forkCtx.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		httpError := forkerrors.NewBadRequest("Invalid exchange id", nil, err)
+		ctx.JSON(httpError.StatusCode, httpError)
+		return
+	}
+	exchange, ok := r.Get(id)
+	if !ok {
+		httpError := forkerrors.NotFound("Exchange not found")
+		ctx.JSON(httpError.StatusCode, httpError)
+		return
+	}
+	ctx.JSON(http.StatusOK, exchange)
+}
+
+// clear xóa buffer và trả về 204.
+func (r *Recorder) clear(ctx forkCtx.Context) {
+	r.Clear()
+	ctx.Status(http.StatusNoContent)
+}
+
+// redactHeader sao chép header và ẩn giá trị của các header nhạy cảm.
+func (r *Recorder) redactHeader(header http.Header) http.Header {
+	result := make(http.Header, len(header))
+	for name, values := range header {
+		if r.redactHeaders[http.CanonicalHeaderKey(name)] {
+			result[name] = []string{Redacted}
+			continue
+		}
+		result[name] = append([]string(nil), values...)
+	}
+	return result
+}
+
+// redactURL trả về URL của request với các tham số query nhạy cảm đã được ẩn.
+func (r *Recorder) redactURL(u *url.URL) string {
+	if u.RawQuery == "" || len(r.redactFields) == 0 {
+		return u.RequestURI()
+	}
+	copied := *u
+	copied.RawQuery = r.redactValues(u.Query()).Encode()
+	return copied.RequestURI()
+}
+
+// redactValues ẩn các giá trị nhạy cảm trong query string hoặc body form.
+func (r *Recorder) redactValues(values url.Values) url.Values {
+	for name := range values {
+		if r.redactFields[strings.ToLower(name)] {
+			values[name] = []string{Redacted}
+		}
+	}
+	return values
+}
+
+// redactBody ẩn các trường nhạy cảm trong body JSON hoặc form.
+// Body bị cắt bỏ hoặc không phân tích được được giữ nguyên.
+func (r *Recorder) redactBody(contentType string, body []byte, truncated bool) string {
+	if len(r.redactFields) == 0 || truncated || len(body) == 0 {
+		return string(body)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var data interface{}
+		if err := json.Unmarshal(body, &data); err != nil {
+			return string(body)
+		}
+		redacted, err := json.Marshal(r.redactJSON(data))
+		if err != nil {
+			return string(body)
+		}
+		return string(redacted)
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return string(body)
+		}
+		return r.redactValues(values).Encode()
+	}
+	return string(body)
+}
+
+// redactJSON ẩn các trường nhạy cảm ở mọi cấp của dữ liệu JSON.
+func (r *Recorder) redactJSON(data interface{}) interface{} {
+	switch value := data.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if r.redactFields[strings.ToLower(key)] {
+				value[key] = Redacted
+			} else {
+				value[key] = r.redactJSON(child)
+			}
+		}
+	case []interface{}:
+		for i, child := range value {
+			value[i] = r.redactJSON(child)
+		}
+	}
+	return data
+}
+
+// captureBody đọc tối đa limit bytes của body request và khôi phục body cho handler.
+// Trả về true nếu body dài hơn limit và đã bị cắt bỏ.
+func captureBody(req *http.Request, limit int) ([]byte, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, false
+	}
+
+	buf, _ := io.ReadAll(io.LimitReader(req.Body, int64(limit)+1))
+	req.Body = &restoredBody{Reader: io.MultiReader(bytes.NewReader(buf), req.Body), closer: req.Body}
+	if len(buf) > limit {
+		return buf[:limit], true
+	}
+	return buf, false
+}
+
+// restoredBody ghép phần body đã đọc với body gốc mà vẫn đóng được body gốc.
+type restoredBody struct {
+	io.Reader
+	closer io.Closer
+}
+
+// Close đóng body gốc.
+func (b *restoredBody) Close() error {
+	return b.closer.Close()
+}
+
+// captureWriter chuyển tiếp response tới client và ghi lại status cùng tối đa limit bytes của body.
+type captureWriter struct {
+	target      http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	size        int
+	limit       int
+	truncated   bool
+	wroteHeader bool
+}
+
+// Header trả về headers của response gốc.
+func (w *captureWriter) Header() http.Header {
+	return w.target.Header()
+}
+
+// WriteHeader lưu status code và chuyển tiếp tới client.
+func (w *captureWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.status = code
+		w.wroteHeader = true
+	}
+	w.target.WriteHeader(code)
+}
+
+// Write lưu body (tối đa limit bytes) và chuyển tiếp tới client.
+func (w *captureWriter) Write(data []byte) (int, error) {
+	w.wroteHeader = true
+	w.size += len(data)
+	if remaining := w.limit - w.body.Len(); remaining < len(data) {
+		w.body.Write(data[:max(remaining, 0)])
+		w.truncated = true
+	} else {
+		w.body.Write(data)
+	}
+	return w.target.Write(data)
+}
+
+// Flush chuyển tiếp tới client nếu writer hỗ trợ.
+func (w *captureWriter) Flush() {
+	if flusher, ok := w.target.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// isLoopback kiểm tra kết nối có đến từ địa chỉ loopback hay không.
+func isLoopback(ctx forkCtx.Context) bool {
+	host, _, err := net.SplitHostPort(ctx.Request().Request().RemoteAddr)
+	if err != nil {
+		host = ctx.Request().Request().RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
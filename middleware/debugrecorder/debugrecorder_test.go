@@ -0,0 +1,193 @@
+package debugrecorder
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+func serve(mw func(forkCtx.Context), req *http.Request, handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+func TestRecorderCapturesExchange(t *testing.T) {
+	mock := clock.NewMock(time.Time{})
+	recorder := NewRecorder(Config{RedactFields: []string{"password", "token"}, Clock: mock})
+
+	req := httptest.NewRequest(http.MethodPost, "/login?token=abc&next=/home", strings.NewReader(`{"user":"an","password":"secret","meta":[{"Token":"t"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer abc")
+	req.Header.Set("X-Request-Id", "42")
+
+	var handlerBody string
+	w := serve(recorder.Middleware(), req, func(ctx forkCtx.Context) {
+		data, _ := io.ReadAll(ctx.Request().Body())
+		handlerBody = string(data)
+		mock.Add(15 * time.Millisecond)
+		ctx.Header("Set-Cookie", "session=xyz")
+		ctx.JSON(http.StatusCreated, map[string]string{"token": "new", "status": "ok"})
+	})
+
+	if !strings.Contains(handlerBody, `"password":"secret"`) {
+		t.Errorf("Expected handler to receive the original body, got %s", handlerBody)
+	}
+	if w.Code != http.StatusCreated || !strings.Contains(w.Body.String(), `"token":"new"`) {
+		t.Errorf("Expected response to reach the client unchanged, got %d %s", w.Code, w.Body.String())
+	}
+
+	exchanges := recorder.Exchanges()
+	if len(exchanges) != 1 {
+		t.Fatalf("Expected 1 exchange, got %d", len(exchanges))
+	}
+	ex := exchanges[0]
+	if ex.ID != 1 || ex.Duration != 15*time.Millisecond || !ex.Time.Equal(clock.NewMock(time.Time{}).Now()) {
+		t.Errorf("Unexpected exchange metadata: id=%d duration=%s time=%s", ex.ID, ex.Duration, ex.Time)
+	}
+	if ex.Request.URL != "/login?next=%2Fhome&token=%5BREDACTED%5D" {
+		t.Errorf("Expected token query to be redacted, got %s", ex.Request.URL)
+	}
+	if ex.Request.Header.Get("Authorization") != Redacted || ex.Request.Header.Get("X-Request-Id") != "42" {
+		t.Errorf("Unexpected request headers: %v", ex.Request.Header)
+	}
+	if ex.Request.Body != `{"meta":[{"Token":"[REDACTED]"}],"password":"[REDACTED]","user":"an"}` {
+		t.Errorf("Unexpected request body: %s", ex.Request.Body)
+	}
+	if ex.Response.Status != http.StatusCreated || ex.Response.Header.Get("Set-Cookie") != Redacted {
+		t.Errorf("Unexpected response: %d %v", ex.Response.Status, ex.Response.Header)
+	}
+	if ex.Response.Body != `{"status":"ok","token":"[REDACTED]"}` {
+		t.Errorf("Unexpected response body: %s", ex.Response.Body)
+	}
+}
+
+func TestRecorderTruncatesBodies(t *testing.T) {
+	recorder := NewRecorder(Config{MaxBodySize: 4, RedactFields: []string{"password"}})
+
+	req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("password=0123456789"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var received string
+	w := serve(recorder.Middleware(), req, func(ctx forkCtx.Context) {
+		received = ctx.Form("password")
+		ctx.String(http.StatusOK, "abcdefgh")
+	})
+
+	if received != "0123456789" || w.Body.String() != "abcdefgh" {
+		t.Errorf("Expected full bodies to pass through, got %q and %q", received, w.Body.String())
+	}
+	ex := recorder.Exchanges()[0]
+	if ex.Request.Body != "pass" || !ex.Request.Truncated {
+		t.Errorf("Expected truncated request body, got %q truncated=%v", ex.Request.Body, ex.Request.Truncated)
+	}
+	if ex.Response.Body != "abcd" || !ex.Response.Truncated || ex.Response.Size != 8 {
+		t.Errorf("Expected truncated response body, got %+v", ex.Response)
+	}
+}
+
+func TestRecorderFormRedaction(t *testing.T) {
+	recorder := NewRecorder(Config{RedactFields: []string{"password"}})
+	req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader("user=an&password=secret"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	serve(recorder.Middleware(), req, func(ctx forkCtx.Context) { ctx.Status(http.StatusNoContent) })
+
+	if body := recorder.Exchanges()[0].Request.Body; body != "password=%5BREDACTED%5D&user=an" {
+		t.Errorf("Expected password to be redacted, got %s", body)
+	}
+}
+
+func TestRecorderRingBuffer(t *testing.T) {
+	recorder := NewRecorder(Config{Capacity: 3})
+	handler := func(ctx forkCtx.Context) { ctx.String(http.StatusOK, ctx.Path()) }
+	for _, path := range []string{"/1", "/2", "/3", "/4", "/5"} {
+		serve(recorder.Middleware(), httptest.NewRequest(http.MethodGet, path, nil), handler)
+	}
+
+	exchanges := recorder.Exchanges()
+	var got []string
+	for _, ex := range exchanges {
+		got = append(got, ex.Request.URL)
+	}
+	if strings.Join(got, ",") != "/5,/4,/3" {
+		t.Errorf("Expected newest three exchanges, got %v", got)
+	}
+	if _, ok := recorder.Get(1); ok {
+		t.Error("Expected evicted exchange to be gone")
+	}
+	if ex, ok := recorder.Get(4); !ok || ex.Request.URL != "/4" {
+		t.Errorf("Expected exchange 4, got %+v %v", ex, ok)
+	}
+
+	recorder.Clear()
+	if len(recorder.Exchanges()) != 0 {
+		t.Error("Expected buffer to be empty after Clear")
+	}
+	serve(recorder.Middleware(), httptest.NewRequest(http.MethodGet, "/6", nil), handler)
+	if ex := recorder.Exchanges(); len(ex) != 1 || ex[0].ID != 6 {
+		t.Errorf("Expected IDs to keep increasing after Clear, got %+v", ex)
+	}
+}
+
+func TestRecorderDebugEndpoint(t *testing.T) {
+	recorder := NewRecorder(Config{})
+	r := router.NewRouter()
+	r.Use(recorder.Middleware())
+	recorder.Register(r)
+	r.Handle(http.MethodGet, "/hello", func(ctx forkCtx.Context) { ctx.String(http.StatusOK, "hi") })
+
+	request := func(method, target, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		req.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	request(http.MethodGet, "/hello", "203.0.113.9:5000")
+
+	if w := request(http.MethodGet, "/_debug/requests", "203.0.113.9:5000"); w.Code != http.StatusForbidden {
+		t.Errorf("Expected remote client to be forbidden, got %d", w.Code)
+	}
+
+	w := request(http.MethodGet, "/_debug/requests", "127.0.0.1:5000")
+	var list []Exchange
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil || w.Code != http.StatusOK {
+		t.Fatalf("Expected exchange list, got %d %s", w.Code, w.Body.String())
+	}
+	if len(list) != 1 || list[0].Request.URL != "/hello" || list[0].Response.Body != "hi" {
+		t.Errorf("Expected only the application request to be recorded, got %+v", list)
+	}
+
+	if w := request(http.MethodGet, "/_debug/requests/1", "[::1]:5000"); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"url":"/hello"`) {
+		t.Errorf("Expected exchange 1, got %d %s", w.Code, w.Body.String())
+	}
+	if w := request(http.MethodGet, "/_debug/requests/99", "127.0.0.1:5000"); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown exchange, got %d", w.Code)
+	}
+	if w := request(http.MethodGet, "/_debug/requests/abc", "127.0.0.1:5000"); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for invalid id, got %d", w.Code)
+	}
+	if w := request(http.MethodDelete, "/_debug/requests", "127.0.0.1:5000"); w.Code != http.StatusNoContent || len(recorder.Exchanges()) != 0 {
+		t.Errorf("Expected buffer to be cleared, got %d with %d exchanges", w.Code, len(recorder.Exchanges()))
+	}
+}
+
+func TestRecorderSkipper(t *testing.T) {
+	recorder := NewRecorder(Config{Skipper: func(ctx forkCtx.Context) bool { return ctx.Path() == "/health" }})
+	serve(recorder.Middleware(), httptest.NewRequest(http.MethodGet, "/health", nil), func(ctx forkCtx.Context) {
+		ctx.String(http.StatusOK, "ok")
+	})
+	if len(recorder.Exchanges()) != 0 {
+		t.Error("Expected skipped request not to be recorded")
+	}
+}
@@ -0,0 +1,349 @@
+// Package forwardauth cung cấp middleware ủy quyền xác thực cho một dịch vụ bên ngoài
+// (tương tự forward-auth của Traefik hoặc oauth2-proxy).
+//
+// Với mỗi request, middleware gửi một request GET tới dịch vụ xác thực kèm các header
+// được chọn và các header X-Forwarded-*. Nếu dịch vụ trả về mã 2xx, request được cho
+// phép đi tiếp và các header định danh (ví dụ: X-User-Id) được sao chép vào request
+// và context. Ngược lại, response của dịch vụ xác thực được trả thẳng về cho client.
+package forwardauth
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+	"go.fork.vn/fork/router"
+)
+
+// Các header X-Forwarded-* được gửi tới dịch vụ xác thực để mô tả request gốc.
+const (
+	HeaderForwardedMethod = "X-Forwarded-Method"
+	HeaderForwardedProto  = "X-Forwarded-Proto"
+	HeaderForwardedHost   = "X-Forwarded-Host"
+	HeaderForwardedURI    = "X-Forwarded-Uri"
+	HeaderForwardedFor    = "X-Forwarded-For"
+)
+
+// hopHeaders là các hop-by-hop headers không được chuyển tiếp giữa các kết nối.
+var hopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// Config chứa cấu hình cho forward-auth middleware.
+type Config struct {
+	// Address là URL của endpoint xác thực bên ngoài (bắt buộc)
+	Address string
+
+	// Client là HTTP client dùng để gọi dịch vụ xác thực.
+	// Mặc định: http.Client với Timeout
+	Client *http.Client
+
+	// Timeout là thời gian tối đa cho một lần gọi dịch vụ xác thực khi Client là nil.
+	// Mặc định: 5 giây
+	Timeout time.Duration
+
+	// AuthRequestHeaders là danh sách header của request gốc được chuyển tiếp.
+	// Nếu rỗng, tất cả header (trừ hop-by-hop headers) sẽ được chuyển tiếp.
+	AuthRequestHeaders []string
+
+	// AuthResponseHeaders là danh sách header từ response của dịch vụ xác thực
+	// được sao chép vào request gốc và context store khi xác thực thành công.
+	AuthResponseHeaders []string
+
+	// TrustForwardHeader cho phép giữ nguyên các header X-Forwarded-* có sẵn trong request gốc.
+	// Mặc định: false (các header này luôn được tính lại)
+	TrustForwardHeader bool
+
+	// CacheTTL là thời gian cache các quyết định cho phép (2xx).
+	// Giá trị 0 tắt cache. Các quyết định từ chối không bao giờ được cache.
+	CacheTTL time.Duration
+
+	// CacheKey trả về khóa cache cho request hiện tại.
+	// Trả về chuỗi rỗng để bỏ qua cache cho request đó.
+	// Mặc định: kết hợp method, host và URI được chuyển tiếp tới dịch vụ xác thực cùng
+	// header Authorization và Cookie, để quyết định cho một route không được dùng lại cho route khác
+	CacheKey func(ctx forkCtx.Context) string
+
+	// MaxCacheEntries giới hạn số lượng quyết định được cache.
+	// Mặc định: 10000
+	MaxCacheEntries int
+}
+
+// New tạo forward-auth middleware với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình middleware
+//
+// Returns:
+//   - router.HandlerFunc: Middleware thực hiện ủy quyền xác thực
+//
+// Panics:
+//   - Nếu config.Address rỗng
+func New(config Config) router.HandlerFunc {
+	if config.Address == "" {
+		panic("forwardauth: Address is required")
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Second
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{
+			Timeout: config.Timeout,
+			// Không tự động follow redirect để có thể trả redirect (ví dụ: tới trang login) về client
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+	if config.CacheKey == nil {
+		trust := config.TrustForwardHeader
+		config.CacheKey = func(ctx forkCtx.Context) string {
+			return defaultCacheKey(ctx, trust)
+		}
+	}
+	if config.MaxCacheEntries <= 0 {
+		config.MaxCacheEntries = 10000
+	}
+
+	var cache *decisionCache
+	if config.CacheTTL > 0 {
+		cache = newDecisionCache(config.CacheTTL, config.MaxCacheEntries)
+	}
+
+	return func(ctx forkCtx.Context) {
+		cacheKey := ""
+		if cache != nil {
+			cacheKey = config.CacheKey(ctx)
+			if cacheKey != "" {
+				if headers, ok := cache.get(cacheKey); ok {
+					applyIdentity(ctx, config.AuthResponseHeaders, headers)
+					ctx.Next()
+					return
+				}
+			}
+		}
+
+		authReq, err := buildAuthRequest(ctx, &config)
+		if err != nil {
+			httpError := forkerrors.NewInternalServerError("Failed to build authorization request", nil, err)
+			ctx.JSON(httpError.StatusCode, httpError)
+			ctx.Abort()
+			return
+		}
+
+		resp, err := config.Client.Do(authReq)
+		if err != nil {
+			httpError := forkerrors.NewBadGateway("Authorization service unavailable", nil, err)
+			ctx.JSON(httpError.StatusCode, httpError)
+			ctx.Abort()
+			return
+		}
+		defer resp.Body.Close()
+
+		// Dịch vụ xác thực từ chối: trả nguyên response về cho client
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			relayResponse(ctx, resp)
+			ctx.Abort()
+			return
+		}
+
+		// Xác thực thành công: bỏ qua body và sao chép các header định danh
+		io.Copy(io.Discard, resp.Body)
+
+		headers := make(map[string]string, len(config.AuthResponseHeaders))
+		for _, name := range config.AuthResponseHeaders {
+			if value := resp.Header.Get(name); value != "" {
+				headers[http.CanonicalHeaderKey(name)] = value
+			}
+		}
+
+		if cache != nil && cacheKey != "" {
+			cache.set(cacheKey, headers)
+		}
+
+		applyIdentity(ctx, config.AuthResponseHeaders, headers)
+		ctx.Next()
+	}
+}
+
+// buildAuthRequest tạo request gửi tới dịch vụ xác thực từ request gốc.
+func buildAuthRequest(ctx forkCtx.Context, config *Config) (*http.Request, error) {
+	authReq, err := http.NewRequestWithContext(ctx.Context(), http.MethodGet, config.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	src := ctx.Request().Header()
+	if len(config.AuthRequestHeaders) == 0 {
+		for name, values := range src {
+			authReq.Header[name] = append([]string(nil), values...)
+		}
+		for _, name := range hopHeaders {
+			authReq.Header.Del(name)
+		}
+	} else {
+		for _, name := range config.AuthRequestHeaders {
+			if values := src.Values(name); len(values) > 0 {
+				authReq.Header[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+			}
+		}
+	}
+
+	trust := config.TrustForwardHeader
+	authReq.Header.Set(HeaderForwardedMethod, forwardedValue(src, HeaderForwardedMethod, ctx.Method(), trust))
+	authReq.Header.Set(HeaderForwardedProto, forwardedValue(src, HeaderForwardedProto, ctx.Request().Scheme(), trust))
+	authReq.Header.Set(HeaderForwardedHost, forwardedValue(src, HeaderForwardedHost, ctx.Request().Host(), trust))
+	authReq.Header.Set(HeaderForwardedURI, forwardedValue(src, HeaderForwardedURI, ctx.Request().URL().RequestURI(), trust))
+	authReq.Header.Set(HeaderForwardedFor, forwardedValue(src, HeaderForwardedFor, remoteIP(ctx.Request().RemoteAddr()), trust))
+
+	return authReq, nil
+}
+
+// forwardedValue trả về giá trị của một header X-Forwarded-*, giữ giá trị gốc nếu được tin cậy.
+func forwardedValue(src http.Header, name, value string, trust bool) string {
+	if trust {
+		if existing := src.Get(name); existing != "" {
+			return existing
+		}
+	}
+	return value
+}
+
+// remoteIP loại bỏ phần port khỏi địa chỉ "IP:port".
+func remoteIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// relayResponse ghi response của dịch vụ xác thực về cho client.
+func relayResponse(ctx forkCtx.Context, resp *http.Response) {
+	dst := ctx.Response().Header()
+	for name, values := range resp.Header {
+		if name == "Content-Length" {
+			continue
+		}
+		dst[name] = append([]string(nil), values...)
+	}
+	for _, name := range hopHeaders {
+		dst.Del(name)
+	}
+
+	ctx.Response().WriteHeader(resp.StatusCode)
+	io.Copy(ctx.Response(), resp.Body)
+}
+
+// applyIdentity sao chép các header định danh vào request gốc và context store.
+// Mọi header trong names do client gửi đều bị xóa trước, để header định danh giả mạo
+// không tới được upstream khi dịch vụ xác thực không trả về header đó.
+func applyIdentity(ctx forkCtx.Context, names []string, headers map[string]string) {
+	header := ctx.Request().Header()
+	for _, name := range names {
+		for key := range header {
+			if strings.EqualFold(key, name) {
+				delete(header, key)
+			}
+		}
+	}
+	for name, value := range headers {
+		header.Set(name, value)
+		ctx.Set(name, value)
+	}
+}
+
+// defaultCacheKey tạo khóa cache từ method, host, URI được chuyển tiếp và header
+// Authorization, Cookie. Trả về chuỗi rỗng (không cache) nếu request không có thông tin xác thực.
+func defaultCacheKey(ctx forkCtx.Context, trust bool) string {
+	auth := ctx.GetHeader("Authorization")
+	cookie := ctx.GetHeader("Cookie")
+	if auth == "" && cookie == "" {
+		return ""
+	}
+	src := ctx.Request().Header()
+	return strings.Join([]string{
+		forwardedValue(src, HeaderForwardedMethod, ctx.Method(), trust),
+		forwardedValue(src, HeaderForwardedHost, ctx.Request().Host(), trust),
+		forwardedValue(src, HeaderForwardedURI, ctx.Request().URL().RequestURI(), trust),
+		auth,
+		cookie,
+	}, "\x00")
+}
+
+// cacheEntry là một quyết định cho phép đã được cache.
+type cacheEntry struct {
+	headers   map[string]string
+	expiresAt time.Time
+}
+
+// decisionCache lưu các quyết định cho phép trong bộ nhớ với thời hạn.
+type decisionCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]cacheEntry
+}
+
+// newDecisionCache tạo cache mới với TTL và giới hạn số lượng entries.
+func newDecisionCache(ttl time.Duration, maxEntries int) *decisionCache {
+	return &decisionCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]cacheEntry),
+	}
+}
+
+// get trả về headers đã cache nếu entry còn hạn.
+func (c *decisionCache) get(key string) (map[string]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.headers, true
+}
+
+// set lưu một quyết định cho phép, dọn dẹp các entries hết hạn khi cache đầy.
+func (c *decisionCache) set(key string, headers map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		// Vẫn đầy: bỏ một entry bất kỳ để giữ giới hạn bộ nhớ
+		for k := range c.entries {
+			if len(c.entries) < c.maxEntries {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = cacheEntry{
+		headers:   headers,
+		expiresAt: now.Add(c.ttl),
+	}
+}
@@ -0,0 +1,259 @@
+package forwardauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// serve chạy middleware và handler cuối trên một request mới.
+func serve(mw func(forkCtx.Context), req *http.Request, handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+func TestForwardAuthAllows(t *testing.T) {
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer good" {
+			t.Errorf("Expected Authorization header to be forwarded, got %q", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get(HeaderForwardedMethod) != http.MethodPost {
+			t.Errorf("Expected X-Forwarded-Method POST, got %q", r.Header.Get(HeaderForwardedMethod))
+		}
+		if r.Header.Get(HeaderForwardedURI) != "/orders?id=1" {
+			t.Errorf("Expected X-Forwarded-Uri /orders?id=1, got %q", r.Header.Get(HeaderForwardedURI))
+		}
+		if r.Header.Get("X-Secret") != "" {
+			t.Error("Expected non-selected header not to be forwarded")
+		}
+		w.Header().Set("X-User-Id", "42")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer auth.Close()
+
+	mw := New(Config{
+		Address:             auth.URL,
+		AuthRequestHeaders:  []string{"Authorization"},
+		AuthResponseHeaders: []string{"X-User-Id"},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/orders?id=1", nil)
+	req.Header.Set("Authorization", "Bearer good")
+	req.Header.Set("X-Secret", "value")
+
+	called := false
+	w := serve(mw, req, func(c forkCtx.Context) {
+		called = true
+		if c.GetHeader("X-User-Id") != "42" {
+			t.Errorf("Expected X-User-Id request header 42, got %q", c.GetHeader("X-User-Id"))
+		}
+		if c.GetString("X-User-Id") != "42" {
+			t.Errorf("Expected X-User-Id in context store, got %q", c.GetString("X-User-Id"))
+		}
+		c.String(http.StatusOK, "ok")
+	})
+
+	if !called {
+		t.Fatal("Expected next handler to be called")
+	}
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", w.Code)
+	}
+}
+
+func TestForwardAuthDenies(t *testing.T) {
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "https://login.example.com")
+		w.Header().Set("X-Auth-Reason", "expired")
+		w.WriteHeader(http.StatusFound)
+		w.Write([]byte("redirecting"))
+	}))
+	defer auth.Close()
+
+	mw := New(Config{Address: auth.URL})
+
+	called := false
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), func(c forkCtx.Context) {
+		called = true
+	})
+
+	if called {
+		t.Error("Expected next handler not to be called")
+	}
+	if w.Code != http.StatusFound {
+		t.Errorf("Expected status 302, got %d", w.Code)
+	}
+	if w.Header().Get("Location") != "https://login.example.com" {
+		t.Errorf("Expected Location header to be relayed, got %q", w.Header().Get("Location"))
+	}
+	if w.Header().Get("X-Auth-Reason") != "expired" {
+		t.Errorf("Expected X-Auth-Reason header to be relayed, got %q", w.Header().Get("X-Auth-Reason"))
+	}
+	if w.Body.String() != "redirecting" {
+		t.Errorf("Expected body to be relayed, got %q", w.Body.String())
+	}
+}
+
+func TestForwardAuthUnavailable(t *testing.T) {
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	address := auth.URL
+	auth.Close()
+
+	mw := New(Config{Address: address, Timeout: time.Second})
+
+	called := false
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), func(c forkCtx.Context) {
+		called = true
+	})
+
+	if called {
+		t.Error("Expected next handler not to be called")
+	}
+	if w.Code != http.StatusBadGateway {
+		t.Errorf("Expected status 502, got %d", w.Code)
+	}
+}
+
+func TestForwardAuthCache(t *testing.T) {
+	var calls int32
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") != "Bearer good" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("X-User-Id", "42")
+	}))
+	defer auth.Close()
+
+	mw := New(Config{
+		Address:             auth.URL,
+		AuthResponseHeaders: []string{"X-User-Id"},
+		CacheTTL:            time.Minute,
+	})
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer good")
+		serve(mw, req, func(c forkCtx.Context) {
+			if c.GetString("X-User-Id") != "42" {
+				t.Errorf("Expected cached identity 42, got %q", c.GetString("X-User-Id"))
+			}
+		})
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Expected 1 call to auth service for allowed decisions, got %d", got)
+	}
+
+	// Các quyết định từ chối không được cache
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer bad")
+		w := serve(mw, req, func(c forkCtx.Context) {
+			t.Error("Expected next handler not to be called")
+		})
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", w.Code)
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("Expected denied decisions not to be cached, got %d calls", got)
+	}
+}
+
+func TestForwardAuthStripsForgedIdentity(t *testing.T) {
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Dịch vụ xác thực cho phép nhưng không trả về X-User-Id
+		w.Header().Set("X-User-Email", "alice@example.com")
+	}))
+	defer auth.Close()
+
+	mw := New(Config{
+		Address:             auth.URL,
+		AuthResponseHeaders: []string{"X-User-Id", "X-User-Email"},
+		CacheTTL:            time.Minute,
+	})
+
+	// Lần đầu gọi dịch vụ xác thực, lần sau dùng quyết định đã cache
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer good")
+		req.Header.Set("X-User-Id", "admin")
+		req.Header["x-user-id"] = []string{"admin"}
+		called := false
+		serve(mw, req, func(c forkCtx.Context) {
+			called = true
+			if got := c.GetHeader("X-User-Id"); got != "" {
+				t.Errorf("Request %d: expected forged X-User-Id to be removed, got %q", i, got)
+			}
+			if got := c.GetHeader("X-User-Email"); got != "alice@example.com" {
+				t.Errorf("Request %d: expected X-User-Email from auth service, got %q", i, got)
+			}
+		})
+		if !called {
+			t.Errorf("Request %d: expected next handler to be called", i)
+		}
+	}
+}
+
+func TestForwardAuthCacheKeyIncludesRequest(t *testing.T) {
+	var calls int32
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer auth.Close()
+
+	mw := New(Config{Address: auth.URL, CacheTTL: time.Minute})
+	requests := []struct{ method, target string }{
+		{http.MethodGet, "/reports"},
+		{http.MethodGet, "/reports"},
+		{http.MethodDelete, "/reports"},
+		{http.MethodGet, "/admin"},
+		{http.MethodGet, "/reports?id=1"},
+	}
+	for _, r := range requests {
+		req := httptest.NewRequest(r.method, r.target, nil)
+		req.Header.Set("Authorization", "Bearer good")
+		serve(mw, req, func(forkCtx.Context) {})
+	}
+	if got := atomic.LoadInt32(&calls); got != 4 {
+		t.Errorf("Expected cached decisions per method and URI (4 calls), got %d", got)
+	}
+}
+
+func TestDecisionCacheExpiry(t *testing.T) {
+	cache := newDecisionCache(10*time.Millisecond, 2)
+	cache.set("a", map[string]string{"X-User-Id": "1"})
+
+	if _, ok := cache.get("a"); !ok {
+		t.Fatal("Expected entry to be cached")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := cache.get("a"); ok {
+		t.Error("Expected entry to expire")
+	}
+
+	cache.set("a", nil)
+	cache.set("b", nil)
+	cache.set("c", nil)
+	if len(cache.entries) > 2 {
+		t.Errorf("Expected at most 2 entries, got %d", len(cache.entries))
+	}
+}
+
+func TestNewPanicsWithoutAddress(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected New to panic without Address")
+		}
+	}()
+	New(Config{})
+}
@@ -0,0 +1,316 @@
+// Package mirror cung cấp middleware sao chép (shadow) một phần traffic tới upstream
+// hoặc handler phụ mà không ảnh hưởng tới response chính.
+//
+// Middleware thường được dùng để kiểm thử phiên bản mới của một service với traffic
+// production thật: request gốc vẫn được xử lý bình thường, trong khi một bản sao
+// (bao gồm cả body) được gửi bất đồng bộ tới đích shadow và response của nó bị bỏ qua.
+package mirror
+
+import (
+	"bytes"
+	gocontext "context"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// HeaderMirrored được thêm vào request shadow để upstream nhận biết traffic được sao chép.
+const HeaderMirrored = "X-Fork-Mirrored"
+
+// credentialHeaders là các header bị loại khỏi request shadow trừ khi bật ForwardCredentials.
+var credentialHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization"}
+
+// Config chứa cấu hình cho mirror middleware.
+type Config struct {
+	// Target là URL gốc của upstream shadow (ví dụ: "http://orders-v2:8080").
+	// Path và query của request gốc được nối vào Target.
+	Target string
+
+	// Handler là handler shadow chạy trong tiến trình, dùng thay cho Target.
+	// Nếu cả Target và Handler đều được thiết lập, Handler được ưu tiên.
+	Handler http.Handler
+
+	// Percent là tỷ lệ phần trăm request được sao chép (0-100).
+	// nil nghĩa là chưa thiết lập; trỏ tới 0 sẽ tắt việc sao chép.
+	// Mặc định: 100
+	Percent *float64
+
+	// MaxInFlight là số request shadow tối đa được gửi đồng thời.
+	// Khi đạt giới hạn, bản sao của request mới bị bỏ qua thay vì tạo thêm goroutine.
+	// Mặc định: 100
+	MaxInFlight int
+
+	// MaxBodySize là kích thước body tối đa (bytes) được sao chép.
+	// Request có body lớn hơn sẽ không được sao chép. Mặc định: 1MB
+	MaxBodySize int64
+
+	// Client là HTTP client dùng để gửi request shadow tới Target.
+	// Mặc định: http.Client với Timeout
+	Client *http.Client
+
+	// Timeout là thời gian tối đa cho một request shadow.
+	// Mặc định: 5 giây
+	Timeout time.Duration
+
+	// ForwardCredentials giữ các header chứa thông tin xác thực (Authorization, Cookie,
+	// Proxy-Authorization) trong request shadow. Mặc định các header này bị loại bỏ
+	// để credentials của người dùng không bị gửi tới đích shadow.
+	ForwardCredentials bool
+
+	// Skipper cho phép bỏ qua việc sao chép cho một số request.
+	Skipper func(ctx forkCtx.Context) bool
+
+	// OnError được gọi khi request shadow thất bại. Mặc định: bỏ qua lỗi.
+	OnError func(req *http.Request, err error)
+
+	// OnResponse được gọi với status code của response shadow (dùng cho so sánh/metrics).
+	// Không được gọi khi request shadow thất bại hoặc handler shadow panic (xem OnError).
+	OnResponse func(req *http.Request, statusCode int)
+}
+
+// New tạo mirror middleware với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình middleware
+//
+// Returns:
+//   - router.HandlerFunc: Middleware sao chép request
+//
+// Panics:
+//   - Nếu cả Target và Handler đều không được thiết lập, hoặc Target không hợp lệ
+func New(config Config) router.HandlerFunc {
+	var target *url.URL
+	if config.Handler == nil {
+		if config.Target == "" {
+			panic("mirror: Target or Handler is required")
+		}
+		parsed, err := url.Parse(config.Target)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			panic("mirror: invalid Target URL " + config.Target)
+		}
+		target = parsed
+	}
+	percent := 100.0
+	if config.Percent != nil {
+		percent = *config.Percent
+	}
+	if config.MaxInFlight <= 0 {
+		config.MaxInFlight = 100
+	}
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = 1 << 20
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Second
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: config.Timeout}
+	}
+
+	inFlight := make(chan struct{}, config.MaxInFlight)
+
+	return func(ctx forkCtx.Context) {
+		if config.Skipper != nil && config.Skipper(ctx) {
+			ctx.Next()
+			return
+		}
+		if percent < 100 && rand.Float64()*100 >= percent {
+			ctx.Next()
+			return
+		}
+
+		// Chiếm một slot trước khi đọc body; khi đã đủ MaxInFlight thì bỏ bản sao
+		select {
+		case inFlight <- struct{}{}:
+		default:
+			ctx.Next()
+			return
+		}
+
+		req := ctx.Request().Request()
+		body, ok := captureBody(req, config.MaxBodySize)
+		if !ok {
+			<-inFlight
+			ctx.Next()
+			return
+		}
+		shadow := buildShadowRequest(req, target, body)
+		if !config.ForwardCredentials {
+			for _, name := range credentialHeaders {
+				shadow.Header.Del(name)
+			}
+		}
+		go func() {
+			defer func() { <-inFlight }()
+			send(&config, shadow)
+		}()
+
+		ctx.Next()
+	}
+}
+
+// captureBody đọc body của request tối đa limit bytes và khôi phục lại body cho handler chính.
+// Trả về false nếu body vượt quá giới hạn hoặc không thể đọc.
+func captureBody(req *http.Request, limit int64) ([]byte, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, true
+	}
+	if req.ContentLength > limit {
+		return nil, false
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(req.Body, limit+1))
+	// Khôi phục body: phần đã đọc nối với phần còn lại (nếu có)
+	req.Body = &restoredBody{Reader: io.MultiReader(bytes.NewReader(buf), req.Body), closer: req.Body}
+	if err != nil || int64(len(buf)) > limit {
+		return nil, false
+	}
+	return buf, true
+}
+
+// restoredBody ghép phần body đã đọc với body gốc mà vẫn đóng được body gốc.
+type restoredBody struct {
+	io.Reader
+	closer io.Closer
+}
+
+// Close đóng body gốc.
+func (b *restoredBody) Close() error {
+	return b.closer.Close()
+}
+
+// buildShadowRequest tạo bản sao độc lập của request gốc để gửi tới đích shadow.
+func buildShadowRequest(req *http.Request, target *url.URL, body []byte) *http.Request {
+	shadowURL := *req.URL
+	if target != nil {
+		shadowURL.Scheme = target.Scheme
+		shadowURL.Host = target.Host
+		shadowURL.Path = singleJoiningSlash(target.Path, req.URL.Path)
+		shadowURL.RawPath = ""
+	}
+
+	shadow := req.Clone(gocontext.Background())
+	shadow.URL = &shadowURL
+	shadow.RequestURI = ""
+	if target != nil {
+		shadow.Host = target.Host
+	}
+	shadow.Header.Set(HeaderMirrored, "true")
+	shadow.ContentLength = int64(len(body))
+	if len(body) > 0 {
+		shadow.Body = io.NopCloser(bytes.NewReader(body))
+		shadow.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	} else {
+		shadow.Body = http.NoBody
+	}
+	return shadow
+}
+
+// send gửi request shadow và bỏ qua response.
+func send(config *Config, shadow *http.Request) {
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), config.Timeout)
+	defer cancel()
+	shadow = shadow.WithContext(ctx)
+
+	if config.Handler != nil {
+		writer := &discardWriter{header: http.Header{}}
+		panicked := func() (panicked bool) {
+			defer func() {
+				if r := recover(); r != nil {
+					panicked = true
+					if config.OnError != nil {
+						config.OnError(shadow, panicError{value: r})
+					}
+				}
+			}()
+			config.Handler.ServeHTTP(writer, shadow)
+			return false
+		}()
+		if !panicked && config.OnResponse != nil {
+			config.OnResponse(shadow, writer.statusCode())
+		}
+		return
+	}
+
+	resp, err := config.Client.Do(shadow)
+	if err != nil {
+		if config.OnError != nil {
+			config.OnError(shadow, err)
+		}
+		return
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if config.OnResponse != nil {
+		config.OnResponse(shadow, resp.StatusCode)
+	}
+}
+
+// discardWriter là http.ResponseWriter bỏ qua body của handler shadow, chỉ ghi lại status code.
+type discardWriter struct {
+	header http.Header
+	status int
+}
+
+// Header trả về headers của response shadow.
+func (w *discardWriter) Header() http.Header {
+	return w.header
+}
+
+// WriteHeader ghi lại status code đầu tiên.
+func (w *discardWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+}
+
+// Write bỏ qua dữ liệu và đặt status 200 nếu handler chưa gọi WriteHeader.
+func (w *discardWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return len(data), nil
+}
+
+// statusCode trả về status code của response shadow, mặc định 200.
+func (w *discardWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// panicError bọc giá trị panic của handler shadow thành error.
+type panicError struct {
+	value interface{}
+}
+
+// Error trả về mô tả của panic.
+func (e panicError) Error() string {
+	if err, ok := e.value.(error); ok {
+		return "mirror: shadow handler panic: " + err.Error()
+	}
+	if s, ok := e.value.(string); ok {
+		return "mirror: shadow handler panic: " + s
+	}
+	return "mirror: shadow handler panic"
+}
+
+// singleJoiningSlash nối hai path với đúng một dấu "/".
+func singleJoiningSlash(a, b string) string {
+	aslash := strings.HasSuffix(a, "/")
+	bslash := strings.HasPrefix(b, "/")
+	switch {
+	case aslash && bslash:
+		return a + b[1:]
+	case !aslash && !bslash:
+		return a + "/" + b
+	}
+	return a + b
+}
@@ -0,0 +1,289 @@
+package mirror
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// serve chạy middleware và handler cuối trên một request mới.
+func serve(mw func(forkCtx.Context), req *http.Request, handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+type shadowRequest struct {
+	method string
+	path   string
+	body   string
+	header http.Header
+}
+
+func TestMirrorToTarget(t *testing.T) {
+	received := make(chan shadowRequest, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- shadowRequest{method: r.Method, path: r.URL.RequestURI(), body: string(body), header: r.Header}
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer upstream.Close()
+
+	statuses := make(chan int, 1)
+	mw := New(Config{
+		Target: upstream.URL + "/v2",
+		OnResponse: func(req *http.Request, statusCode int) {
+			statuses <- statusCode
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/orders?id=1", strings.NewReader(`{"qty":1}`))
+	req.Header.Set("X-Request-Id", "abc")
+
+	w := serve(mw, req, func(c forkCtx.Context) {
+		body, err := c.GetRawData()
+		if err != nil {
+			t.Fatalf("Unexpected error reading body: %v", err)
+		}
+		if string(body) != `{"qty":1}` {
+			t.Errorf("Expected primary handler to read full body, got %q", body)
+		}
+		c.String(http.StatusCreated, "created")
+	})
+
+	if w.Code != http.StatusCreated || w.Body.String() != "created" {
+		t.Errorf("Expected primary response to be unaffected, got %d %q", w.Code, w.Body.String())
+	}
+
+	select {
+	case got := <-received:
+		if got.method != http.MethodPost {
+			t.Errorf("Expected shadow method POST, got %s", got.method)
+		}
+		if got.path != "/v2/orders?id=1" {
+			t.Errorf("Expected shadow path /v2/orders?id=1, got %s", got.path)
+		}
+		if got.body != `{"qty":1}` {
+			t.Errorf("Expected shadow body to be copied, got %q", got.body)
+		}
+		if got.header.Get("X-Request-Id") != "abc" {
+			t.Error("Expected headers to be copied to shadow request")
+		}
+		if got.header.Get(HeaderMirrored) != "true" {
+			t.Error("Expected shadow request to be marked as mirrored")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for shadow request")
+	}
+
+	select {
+	case code := <-statuses:
+		if code != http.StatusTeapot {
+			t.Errorf("Expected OnResponse with 418, got %d", code)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for OnResponse")
+	}
+}
+
+func TestMirrorToHandler(t *testing.T) {
+	received := make(chan string, 1)
+	mw := New(Config{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received <- string(body)
+		}),
+	})
+
+	serve(mw, httptest.NewRequest(http.MethodPut, "/items/1", strings.NewReader("payload")), func(c forkCtx.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	select {
+	case body := <-received:
+		if body != "payload" {
+			t.Errorf("Expected shadow body payload, got %q", body)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for shadow handler")
+	}
+}
+
+func TestMirrorCredentials(t *testing.T) {
+	for _, forward := range []bool{false, true} {
+		received := make(chan http.Header, 1)
+		statuses := make(chan int, 1)
+		mw := New(Config{
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received <- r.Header.Clone()
+				w.WriteHeader(http.StatusAccepted)
+			}),
+			ForwardCredentials: forward,
+			OnResponse: func(req *http.Request, statusCode int) {
+				statuses <- statusCode
+			},
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set("Cookie", "session=abc")
+		req.Header.Set("X-Trace", "1")
+		serve(mw, req, func(c forkCtx.Context) {})
+
+		select {
+		case header := <-received:
+			hasCredentials := header.Get("Authorization") != "" || header.Get("Cookie") != ""
+			if hasCredentials != forward {
+				t.Errorf("ForwardCredentials=%v: expected credentials forwarded=%v, got headers %v", forward, forward, header)
+			}
+			if header.Get("X-Trace") != "1" {
+				t.Errorf("Expected other headers to be mirrored, got %v", header)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("Timed out waiting for shadow handler")
+		}
+		if code := <-statuses; code != http.StatusAccepted {
+			t.Errorf("Expected OnResponse with 202, got %d", code)
+		}
+		if req.Header.Get("Authorization") == "" {
+			t.Error("Expected primary request headers to be untouched")
+		}
+	}
+}
+
+func TestMirrorSkipsLargeBody(t *testing.T) {
+	var calls int32
+	mw := New(Config{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		}),
+		MaxBodySize: 4,
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("too large body"))
+	req.ContentLength = -1 // Buộc middleware phải đọc body để phát hiện kích thước
+	serve(mw, req, func(c forkCtx.Context) {
+		body, _ := c.GetRawData()
+		if string(body) != "too large body" {
+			t.Errorf("Expected primary handler to read full body, got %q", body)
+		}
+	})
+
+	time.Sleep(50 * time.Millisecond)
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("Expected oversized body not to be mirrored, got %d calls", got)
+	}
+}
+
+func TestMirrorPercentAndSkipper(t *testing.T) {
+	var calls int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	})
+
+	zero := 0.0
+	never := New(Config{Handler: handler, Percent: &zero})
+	skipped := New(Config{Handler: handler, Skipper: func(c forkCtx.Context) bool { return true }})
+
+	for i := 0; i < 50; i++ {
+		serve(never, httptest.NewRequest(http.MethodGet, "/", nil), func(c forkCtx.Context) {})
+		serve(skipped, httptest.NewRequest(http.MethodGet, "/", nil), func(c forkCtx.Context) {})
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("Expected no mirrored requests, got %d", got)
+	}
+}
+
+func TestMirrorMaxInFlight(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	started := make(chan struct{}, 10)
+	mw := New(Config{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			started <- struct{}{}
+			<-release
+		}),
+		MaxInFlight: 2,
+	})
+
+	primary := 0
+	for i := 0; i < 5; i++ {
+		serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), func(c forkCtx.Context) { primary++ })
+	}
+	if primary != 5 {
+		t.Errorf("Expected every primary request to be served, got %d", primary)
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(2 * time.Second):
+			t.Fatal("Timed out waiting for shadow handler")
+		}
+	}
+	time.Sleep(50 * time.Millisecond)
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("Expected 2 mirrored requests while full, got %d", got)
+	}
+
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+	serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), func(c forkCtx.Context) {})
+	select {
+	case <-started:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected mirroring to resume once slots are released")
+	}
+}
+
+func TestMirrorShadowPanicDoesNotAffectPrimary(t *testing.T) {
+	errs := make(chan error, 1)
+	mw := New(Config{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic("boom")
+		}),
+		OnError: func(req *http.Request, err error) {
+			errs <- err
+		},
+		OnResponse: func(req *http.Request, statusCode int) {
+			t.Errorf("Expected no OnResponse for panicking shadow handler, got %d", statusCode)
+		},
+	})
+
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), func(c forkCtx.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", w.Code)
+	}
+
+	select {
+	case err := <-errs:
+		if !strings.Contains(err.Error(), "boom") {
+			t.Errorf("Expected panic error, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for OnError")
+	}
+	time.Sleep(20 * time.Millisecond)
+}
+
+func TestNewPanicsWithoutTarget(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected New to panic without Target or Handler")
+		}
+	}()
+	New(Config{})
+}
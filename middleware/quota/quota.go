@@ -0,0 +1,344 @@
+// Package quota cung cấp quản lý quota theo danh tính (API key hoặc người dùng) với các
+// chu kỳ ngày/tháng và lưu trữ tùy chọn.
+//
+// Khác với rate limiting (giới hạn tốc độ ngắn hạn), quota giới hạn tổng lượng sử dụng
+// trong một chu kỳ lịch. Middleware thiết lập các header X-Quota-* và từ chối request với
+// HttpError 429 khi quota đã dùng hết.
+package quota
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+	"go.fork.vn/fork/router"
+)
+
+// ErrExceeded được trả về khi quota đã dùng hết.
+var ErrExceeded = errors.New("quota: exceeded")
+
+// Period là chu kỳ tính quota.
+type Period string
+
+// Các chu kỳ quota được hỗ trợ.
+const (
+	Hourly  Period = "hourly"
+	Daily   Period = "daily"
+	Monthly Period = "monthly"
+)
+
+// window trả về thời điểm bắt đầu và kết thúc của chu kỳ chứa t.
+func (p Period) window(t time.Time) (time.Time, time.Time) {
+	switch p {
+	case Hourly:
+		start := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+		return start, start.Add(time.Hour)
+	case Monthly:
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		return start, start.AddDate(0, 1, 0)
+	default:
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		return start, start.AddDate(0, 0, 1)
+	}
+}
+
+// Limit là giới hạn sử dụng trong một chu kỳ.
+type Limit struct {
+	Period Period
+	Max    int64
+}
+
+// Usage là tình trạng sử dụng quota của một danh tính trong một chu kỳ.
+type Usage struct {
+	Period    Period
+	Limit     int64
+	Used      int64
+	Remaining int64
+	Reset     time.Time
+}
+
+// Config chứa cấu hình cho quota manager.
+type Config struct {
+	// Store lưu bộ đếm quota.
+	// Mặc định: MemoryStore
+	Store Store
+
+	// Limits là các giới hạn mặc định áp dụng cho mọi danh tính (bắt buộc nếu không có LimitFunc)
+	Limits []Limit
+
+	// LimitFunc trả về giới hạn riêng cho từng danh tính (ví dụ: theo gói dịch vụ).
+	// Trả về nil để dùng Limits mặc định.
+	LimitFunc func(identity string) []Limit
+
+	// KeyFunc trả về danh tính của request.
+	// Mặc định: header X-API-Key, nếu không có thì IP của client
+	KeyFunc func(ctx forkCtx.Context) string
+
+	// CostFunc trả về lượng quota request tiêu thụ.
+	// Mặc định: 1
+	CostFunc func(ctx forkCtx.Context) int64
+
+	// Location là múi giờ xác định ranh giới chu kỳ.
+	// Mặc định: UTC
+	Location *time.Location
+
+	// Skipper bỏ qua việc tính quota cho request
+	Skipper func(ctx forkCtx.Context) bool
+
+	// OnExceeded xử lý request vượt quota.
+	// Mặc định: trả về HttpError 429 kèm header Retry-After
+	OnExceeded func(ctx forkCtx.Context, usage Usage)
+
+	// OnError được gọi khi store gặp lỗi; request vẫn được cho qua (fail open)
+	OnError func(ctx forkCtx.Context, err error)
+
+	// Clock là nguồn thời gian xác định chu kỳ quota và Retry-After.
+	// Mặc định: clock.New()
+	Clock clock.Clock
+}
+
+// Manager quản lý quota của các danh tính.
+type Manager struct {
+	config Config
+	now    func() time.Time
+}
+
+// NewManager tạo Manager mới.
+//
+// Parameters:
+//   - config: Cấu hình quota
+//
+// Returns:
+//   - *Manager: Manager đã khởi tạo
+//
+// Panics:
+//   - Nếu không có Limits và LimitFunc
+func NewManager(config Config) *Manager {
+	if len(config.Limits) == 0 && config.LimitFunc == nil {
+		panic("quota: Limits or LimitFunc is required")
+	}
+	if config.Clock == nil {
+		config.Clock = clock.New()
+	}
+	if config.Store == nil {
+		store := NewMemoryStore()
+		store.SetClock(config.Clock)
+		config.Store = store
+	}
+	if config.KeyFunc == nil {
+		config.KeyFunc = defaultKeyFunc
+	}
+	if config.CostFunc == nil {
+		config.CostFunc = func(forkCtx.Context) int64 { return 1 }
+	}
+	if config.Location == nil {
+		config.Location = time.UTC
+	}
+	m := &Manager{config: config, now: config.Clock.Now}
+	if m.config.OnExceeded == nil {
+		m.config.OnExceeded = m.defaultOnExceeded
+	}
+	return m
+}
+
+// New tạo quota middleware với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình quota
+//
+// Returns:
+//   - router.HandlerFunc: Middleware quota
+func New(config Config) router.HandlerFunc {
+	return NewManager(config).Middleware()
+}
+
+// Consume tiêu thụ n đơn vị quota của danh tính trên mọi giới hạn.
+// Nếu bất kỳ giới hạn nào bị vượt, không giới hạn nào bị tính và ErrExceeded được trả về
+// cùng tình trạng của giới hạn bị vượt ở phần tử đầu tiên.
+//
+// Parameters:
+//   - ctx: Context cho thao tác với store
+//   - identity: Danh tính (API key, user ID)
+//   - n: Lượng quota tiêu thụ
+//
+// Returns:
+//   - []Usage: Tình trạng sử dụng sau khi tiêu thụ, theo thứ tự Limits
+//   - error: ErrExceeded hoặc lỗi từ store
+func (m *Manager) Consume(ctx gocontext.Context, identity string, n int64) ([]Usage, error) {
+	limits := m.limits(identity)
+	usages := make([]Usage, 0, len(limits))
+	now := m.now().In(m.config.Location)
+
+	for i, limit := range limits {
+		start, end := limit.Period.window(now)
+		key := counterKey(limit.Period, start, identity)
+		used, err := m.config.Store.Increment(ctx, key, n, end)
+		if err != nil {
+			m.rollback(ctx, limits[:i], identity, n, now)
+			return nil, err
+		}
+
+		usage := newUsage(limit, used, end)
+		if used > limit.Max {
+			// Hoàn lại các giới hạn đã tính, kể cả giới hạn hiện tại
+			m.rollback(ctx, limits[:i+1], identity, n, now)
+			usage.Used = used - n
+			usage.Remaining = max(limit.Max-usage.Used, 0)
+			return append([]Usage{usage}, usages...), ErrExceeded
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+// Usage trả về tình trạng sử dụng hiện tại của danh tính mà không tiêu thụ quota.
+//
+// Parameters:
+//   - ctx: Context cho thao tác với store
+//   - identity: Danh tính
+//
+// Returns:
+//   - []Usage: Tình trạng sử dụng theo thứ tự Limits
+//   - error: Lỗi từ store
+func (m *Manager) Usage(ctx gocontext.Context, identity string) ([]Usage, error) {
+	limits := m.limits(identity)
+	usages := make([]Usage, 0, len(limits))
+	now := m.now().In(m.config.Location)
+
+	for _, limit := range limits {
+		start, end := limit.Period.window(now)
+		used, err := m.config.Store.Get(ctx, counterKey(limit.Period, start, identity))
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, newUsage(limit, used, end))
+	}
+	return usages, nil
+}
+
+// Reset xóa bộ đếm của chu kỳ hiện tại cho danh tính (ví dụ: khi nâng cấp gói).
+//
+// Parameters:
+//   - ctx: Context cho thao tác với store
+//   - identity: Danh tính
+//
+// Returns:
+//   - error: Lỗi từ store
+func (m *Manager) Reset(ctx gocontext.Context, identity string) error {
+	now := m.now().In(m.config.Location)
+	for _, limit := range m.limits(identity) {
+		start, _ := limit.Period.window(now)
+		if err := m.config.Store.Delete(ctx, counterKey(limit.Period, start, identity)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Middleware trả về middleware tính quota cho mỗi request.
+//
+// Header được thiết lập theo giới hạn còn lại ít nhất:
+//   - X-Quota-Limit: giới hạn của chu kỳ
+//   - X-Quota-Remaining: lượng còn lại
+//   - X-Quota-Reset: thời điểm reset (Unix seconds)
+//   - X-Quota-Period: tên chu kỳ
+//
+// Returns:
+//   - router.HandlerFunc: Middleware quota
+func (m *Manager) Middleware() router.HandlerFunc {
+	return func(ctx forkCtx.Context) {
+		if m.config.Skipper != nil && m.config.Skipper(ctx) {
+			ctx.Next()
+			return
+		}
+
+		identity := m.config.KeyFunc(ctx)
+		usages, err := m.Consume(ctx.Context(), identity, m.config.CostFunc(ctx))
+		switch {
+		case errors.Is(err, ErrExceeded):
+			setHeaders(ctx, usages[0])
+			m.config.OnExceeded(ctx, usages[0])
+			ctx.Abort()
+			return
+		case err != nil:
+			if m.config.OnError != nil {
+				m.config.OnError(ctx, err)
+			}
+		case len(usages) > 0:
+			setHeaders(ctx, mostRestrictive(usages))
+		}
+		ctx.Next()
+	}
+}
+
+func (m *Manager) limits(identity string) []Limit {
+	if m.config.LimitFunc != nil {
+		if limits := m.config.LimitFunc(identity); limits != nil {
+			return limits
+		}
+	}
+	return m.config.Limits
+}
+
+// rollback hoàn lại lượng quota đã tính cho các giới hạn.
+func (m *Manager) rollback(ctx gocontext.Context, limits []Limit, identity string, n int64, now time.Time) {
+	for _, limit := range limits {
+		start, end := limit.Period.window(now)
+		m.config.Store.Increment(ctx, counterKey(limit.Period, start, identity), -n, end)
+	}
+}
+
+func newUsage(limit Limit, used int64, reset time.Time) Usage {
+	return Usage{
+		Period:    limit.Period,
+		Limit:     limit.Max,
+		Used:      used,
+		Remaining: max(limit.Max-used, 0),
+		Reset:     reset,
+	}
+}
+
+func mostRestrictive(usages []Usage) Usage {
+	result := usages[0]
+	for _, usage := range usages[1:] {
+		if usage.Remaining < result.Remaining {
+			result = usage
+		}
+	}
+	return result
+}
+
+func counterKey(period Period, start time.Time, identity string) string {
+	return fmt.Sprintf("quota:%s:%d:%s", period, start.Unix(), identity)
+}
+
+func setHeaders(ctx forkCtx.Context, usage Usage) {
+	ctx.Header("X-Quota-Limit", strconv.FormatInt(usage.Limit, 10))
+	ctx.Header("X-Quota-Remaining", strconv.FormatInt(usage.Remaining, 10))
+	ctx.Header("X-Quota-Reset", strconv.FormatInt(usage.Reset.Unix(), 10))
+	ctx.Header("X-Quota-Period", string(usage.Period))
+}
+
+func defaultKeyFunc(ctx forkCtx.Context) string {
+	if key := ctx.GetHeader("X-API-Key"); key != "" {
+		return "key:" + key
+	}
+	return "ip:" + ctx.ClientIP()
+}
+
+// defaultOnExceeded trả về HttpError 429 kèm header Retry-After.
+func (m *Manager) defaultOnExceeded(ctx forkCtx.Context, usage Usage) {
+	retryAfter := int(usage.Reset.Sub(m.now()).Seconds()) + 1
+	ctx.Header("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+	httpError := forkerrors.NewTooManyRequests("Quota exceeded", map[string]interface{}{
+		"period": usage.Period,
+		"limit":  usage.Limit,
+		"reset":  usage.Reset.Unix(),
+	}, ErrExceeded)
+	ctx.JSON(httpError.StatusCode, httpError)
+}
@@ -0,0 +1,167 @@
+package quota
+
+import (
+	gocontext "context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+)
+
+func serve(mw func(forkCtx.Context), req *http.Request, handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+func ok(ctx forkCtx.Context) {
+	ctx.String(http.StatusOK, "ok")
+}
+
+func TestMiddlewareEnforcesQuota(t *testing.T) {
+	mock := clock.NewMock(time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC))
+	m := NewManager(Config{Limits: []Limit{{Period: Daily, Max: 2}, {Period: Monthly, Max: 100}}, Clock: mock})
+	mw := m.Middleware()
+
+	newReq := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/api", nil)
+		req.Header.Set("X-API-Key", "abc")
+		return req
+	}
+
+	for i, remaining := range []string{"1", "0"} {
+		w := serve(mw, newReq(), ok)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Request %d: expected 200, got %d", i+1, w.Code)
+		}
+		if w.Header().Get("X-Quota-Remaining") != remaining || w.Header().Get("X-Quota-Period") != "daily" {
+			t.Errorf("Request %d: unexpected headers %v", i+1, w.Header())
+		}
+	}
+
+	w := serve(mw, newReq(), ok)
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected 429, got %d", w.Code)
+	}
+	if w.Header().Get("X-Quota-Reset") != "1710547200" {
+		t.Errorf("Expected reset at next UTC midnight, got %s", w.Header().Get("X-Quota-Reset"))
+	}
+	if w.Header().Get("Retry-After") != "50401" {
+		t.Errorf("Expected Retry-After until midnight, got %s", w.Header().Get("Retry-After"))
+	}
+
+	// Request bị từ chối không tiêu thụ quota tháng
+	usages, _ := m.Usage(gocontext.Background(), "key:abc")
+	if usages[1].Used != 2 {
+		t.Errorf("Expected rejected request to be rolled back, monthly used %d", usages[1].Used)
+	}
+
+	// Sang ngày mới quota ngày được reset
+	mock.Add(24 * time.Hour)
+	if w := serve(mw, newReq(), ok); w.Code != http.StatusOK {
+		t.Errorf("Expected quota to reset next day, got %d", w.Code)
+	}
+}
+
+func TestManagerLimitFuncAndReset(t *testing.T) {
+	m := NewManager(Config{
+		Limits: []Limit{{Period: Daily, Max: 1}},
+		LimitFunc: func(identity string) []Limit {
+			if identity == "pro" {
+				return []Limit{{Period: Monthly, Max: 1000}}
+			}
+			return nil
+		},
+	})
+	ctx := gocontext.Background()
+
+	if _, err := m.Consume(ctx, "free", 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := m.Consume(ctx, "free", 1); !errors.Is(err, ErrExceeded) {
+		t.Errorf("Expected ErrExceeded for free plan, got %v", err)
+	}
+	usages, err := m.Consume(ctx, "pro", 5)
+	if err != nil || usages[0].Remaining != 995 || usages[0].Period != Monthly {
+		t.Errorf("Expected pro plan usage, got %+v, %v", usages, err)
+	}
+
+	if err := m.Reset(ctx, "free"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := m.Consume(ctx, "free", 1); err != nil {
+		t.Errorf("Expected quota to be available after reset, got %v", err)
+	}
+}
+
+func TestMiddlewareSkipperAndCost(t *testing.T) {
+	mw := New(Config{
+		Limits:   []Limit{{Period: Hourly, Max: 10}},
+		CostFunc: func(forkCtx.Context) int64 { return 4 },
+		Skipper:  func(ctx forkCtx.Context) bool { return ctx.Path() == "/health" },
+	})
+
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "/health", nil), ok)
+	if w.Header().Get("X-Quota-Remaining") != "" {
+		t.Error("Expected skipped request to have no quota headers")
+	}
+	w = serve(mw, httptest.NewRequest(http.MethodGet, "/export", nil), ok)
+	if w.Header().Get("X-Quota-Remaining") != "6" {
+		t.Errorf("Expected cost of 4 to be consumed, got remaining %s", w.Header().Get("X-Quota-Remaining"))
+	}
+}
+
+// failingStore là Store luôn trả về lỗi
+type failingStore struct{ *MemoryStore }
+
+func (*failingStore) Increment(gocontext.Context, string, int64, time.Time) (int64, error) {
+	return 0, errors.New("store down")
+}
+
+func TestMiddlewareFailsOpen(t *testing.T) {
+	var reported error
+	mw := New(Config{
+		Store:   &failingStore{NewMemoryStore()},
+		Limits:  []Limit{{Period: Daily, Max: 1}},
+		OnError: func(ctx forkCtx.Context, err error) { reported = err },
+	})
+
+	w := serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), ok)
+	if w.Code != http.StatusOK || reported == nil {
+		t.Errorf("Expected request to pass and error to be reported, got %d, %v", w.Code, reported)
+	}
+}
+
+func TestPeriodWindow(t *testing.T) {
+	at := time.Date(2024, 12, 31, 23, 30, 0, 0, time.UTC)
+	tests := []struct {
+		period Period
+		start  time.Time
+		end    time.Time
+	}{
+		{Hourly, time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Daily, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Monthly, time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		start, end := tt.period.window(at)
+		if !start.Equal(tt.start) || !end.Equal(tt.end) {
+			t.Errorf("%s: expected [%v, %v), got [%v, %v)", tt.period, tt.start, tt.end, start, end)
+		}
+	}
+}
+
+func TestNewManagerPanicsWithoutLimits(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic without limits")
+		}
+	}()
+	NewManager(Config{})
+}
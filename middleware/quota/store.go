@@ -0,0 +1,98 @@
+package quota
+
+import (
+	gocontext "context"
+	"sync"
+	"time"
+
+	"go.fork.vn/fork/clock"
+)
+
+// Store là interface lưu trữ bộ đếm quota. Implementation cho Redis hoặc database
+// có thể dùng INCRBY + EXPIREAT (hoặc tương đương) để đếm nguyên tử.
+type Store interface {
+	// Increment cộng n vào bộ đếm của key và trả về giá trị mới.
+	// Bộ đếm hết hạn tại expiresAt (thời điểm kết thúc chu kỳ quota).
+	Increment(ctx gocontext.Context, key string, n int64, expiresAt time.Time) (int64, error)
+
+	// Get trả về giá trị hiện tại của bộ đếm, 0 nếu không tồn tại.
+	Get(ctx gocontext.Context, key string) (int64, error)
+
+	// Delete xóa bộ đếm.
+	Delete(ctx gocontext.Context, key string) error
+}
+
+type memoryCounter struct {
+	value     int64
+	expiresAt time.Time
+}
+
+// MemoryStore là Store lưu trong bộ nhớ của một process.
+type MemoryStore struct {
+	mu       sync.Mutex
+	counters map[string]*memoryCounter
+	now      func() time.Time
+}
+
+// NewMemoryStore tạo MemoryStore mới.
+//
+// Returns:
+//   - *MemoryStore: Store đã khởi tạo
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{counters: make(map[string]*memoryCounter), now: time.Now}
+}
+
+// SetClock thiết lập nguồn thời gian dùng để xác định bộ đếm hết hạn.
+//
+// Parameters:
+//   - c: Clock (ví dụ: clock.NewMock trong test)
+func (s *MemoryStore) SetClock(c clock.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = c.Now
+}
+
+// Increment cộng n vào bộ đếm của key và trả về giá trị mới.
+func (s *MemoryStore) Increment(_ gocontext.Context, key string, n int64, expiresAt time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	counter, ok := s.counters[key]
+	if !ok || !now.Before(counter.expiresAt) {
+		s.evictExpired(now)
+		counter = &memoryCounter{expiresAt: expiresAt}
+		s.counters[key] = counter
+	}
+	counter.value += n
+	return counter.value, nil
+}
+
+// Get trả về giá trị hiện tại của bộ đếm.
+func (s *MemoryStore) Get(_ gocontext.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counter, ok := s.counters[key]
+	if !ok || !s.now().Before(counter.expiresAt) {
+		return 0, nil
+	}
+	return counter.value, nil
+}
+
+// Delete xóa bộ đếm.
+func (s *MemoryStore) Delete(_ gocontext.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.counters, key)
+	return nil
+}
+
+// evictExpired xóa các bộ đếm đã hết hạn. Gọi khi đang giữ lock.
+func (s *MemoryStore) evictExpired(now time.Time) {
+	for key, counter := range s.counters {
+		if !now.Before(counter.expiresAt) {
+			delete(s.counters, key)
+		}
+	}
+}
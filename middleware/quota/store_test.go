@@ -0,0 +1,35 @@
+package quota
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	s := NewMemoryStore()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	ctx := gocontext.Background()
+
+	s.Increment(ctx, "a", 3, now.Add(time.Hour))
+	if v, _ := s.Increment(ctx, "a", 2, now.Add(time.Hour)); v != 5 {
+		t.Errorf("Expected 5, got %d", v)
+	}
+	if v, _ := s.Get(ctx, "a"); v != 5 {
+		t.Errorf("Expected Get to return 5, got %d", v)
+	}
+
+	now = now.Add(time.Hour)
+	if v, _ := s.Get(ctx, "a"); v != 0 {
+		t.Errorf("Expected expired counter to read 0, got %d", v)
+	}
+	if v, _ := s.Increment(ctx, "a", 1, now.Add(time.Hour)); v != 1 {
+		t.Errorf("Expected expired counter to restart at 1, got %d", v)
+	}
+
+	s.Delete(ctx, "a")
+	if v, _ := s.Get(ctx, "a"); v != 0 {
+		t.Errorf("Expected deleted counter to read 0, got %d", v)
+	}
+}
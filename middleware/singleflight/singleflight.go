@@ -0,0 +1,204 @@
+// Package singleflight cung cấp middleware gộp các request GET giống nhau đang chạy đồng thời.
+//
+// Khi nhiều request có cùng khóa đến cùng lúc, chỉ request đầu tiên (leader) được chuyển tới
+// handler; các request còn lại (followers) chờ và nhận bản sao response của leader. Middleware
+// thường được đặt trước response cache để tránh hiện tượng cache stampede.
+package singleflight
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// HeaderShared được thêm vào response của followers để cho biết response được chia sẻ.
+const HeaderShared = "X-Singleflight"
+
+// Config chứa cấu hình cho singleflight middleware.
+type Config struct {
+	// KeyFunc trả về khóa gộp cho request hiện tại.
+	// Trả về chuỗi rỗng để không gộp request đó.
+	// Mặc định: method + request URI; request có header Authorization hoặc Cookie không được gộp
+	// vì response có thể riêng cho từng người dùng
+	KeyFunc func(ctx forkCtx.Context) string
+
+	// Methods là danh sách HTTP methods được gộp.
+	// Mặc định: GET, HEAD
+	Methods []string
+
+	// MaxBodySize là kích thước response tối đa (bytes) được chia sẻ.
+	// Nếu response của leader lớn hơn, followers sẽ tự thực thi handler. Mặc định: 1MB
+	MaxBodySize int
+
+	// SharedHeader bật header X-Singleflight: shared trên response của followers.
+	SharedHeader bool
+}
+
+// call là một lần thực thi handler đang được chia sẻ.
+type call struct {
+	done   chan struct{}
+	ok     bool
+	status int
+	header http.Header
+	body   []byte
+}
+
+// group quản lý các call đang chạy theo khóa.
+type group struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// New tạo singleflight middleware với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình middleware
+//
+// Returns:
+//   - router.HandlerFunc: Middleware gộp request
+func New(config Config) router.HandlerFunc {
+	if config.KeyFunc == nil {
+		config.KeyFunc = defaultKey
+	}
+	if len(config.Methods) == 0 {
+		config.Methods = []string{http.MethodGet, http.MethodHead}
+	}
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = 1 << 20
+	}
+
+	methods := make(map[string]bool, len(config.Methods))
+	for _, method := range config.Methods {
+		methods[method] = true
+	}
+	g := &group{calls: make(map[string]*call)}
+
+	return func(ctx forkCtx.Context) {
+		if !methods[ctx.Method()] {
+			ctx.Next()
+			return
+		}
+		key := config.KeyFunc(ctx)
+		if key == "" {
+			ctx.Next()
+			return
+		}
+
+		g.mu.Lock()
+		if c, ok := g.calls[key]; ok {
+			g.mu.Unlock()
+			follow(ctx, c, config.SharedHeader)
+			return
+		}
+		c := &call{done: make(chan struct{})}
+		g.calls[key] = c
+		g.mu.Unlock()
+
+		lead(ctx, g, key, c, config.MaxBodySize)
+	}
+}
+
+// lead thực thi handler và ghi lại response để chia sẻ với followers.
+func lead(ctx forkCtx.Context, g *group, key string, c *call, maxBodySize int) {
+	original := ctx.Response().ResponseWriter()
+	recorder := &teeWriter{ResponseWriter: original, status: http.StatusOK, limit: maxBodySize}
+	ctx.Response().Reset(recorder)
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+
+		// Nếu handler panic, c.ok vẫn là false và followers sẽ tự thực thi handler
+		close(c.done)
+	}()
+
+	ctx.Next()
+
+	if !recorder.overflow {
+		c.ok = true
+		c.status = recorder.status
+		c.header = original.Header().Clone()
+		// Cookie của leader (ví dụ session) không bao giờ được chia sẻ cho người dùng khác
+		c.header.Del("Set-Cookie")
+		c.body = recorder.body.Bytes()
+	}
+}
+
+// defaultKey trả về method + request URI, hoặc chuỗi rỗng nếu request mang thông tin
+// xác thực (Authorization, Cookie) để không gộp response của các người dùng khác nhau.
+func defaultKey(ctx forkCtx.Context) string {
+	if ctx.GetHeader("Authorization") != "" || ctx.GetHeader("Cookie") != "" {
+		return ""
+	}
+	return ctx.Method() + " " + ctx.Request().URL().RequestURI()
+}
+
+// follow chờ leader hoàn thành và ghi lại response đã chia sẻ.
+func follow(ctx forkCtx.Context, c *call, sharedHeader bool) {
+	select {
+	case <-c.done:
+	case <-ctx.Context().Done():
+		ctx.Abort()
+		return
+	}
+
+	if !c.ok {
+		ctx.Next()
+		return
+	}
+
+	header := ctx.Response().Header()
+	for name, values := range c.header {
+		header[name] = append([]string(nil), values...)
+	}
+	if sharedHeader {
+		header.Set(HeaderShared, "shared")
+	}
+	ctx.Response().WriteHeader(c.status)
+	ctx.Response().Write(c.body)
+	ctx.Abort()
+}
+
+// teeWriter ghi response tới writer gốc đồng thời lưu lại status và body.
+type teeWriter struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	limit       int
+	overflow    bool
+	wroteHeader bool
+}
+
+// WriteHeader lưu status code và chuyển tiếp tới writer gốc.
+func (w *teeWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.status = code
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write lưu body (tối đa limit bytes) và chuyển tiếp tới writer gốc.
+func (w *teeWriter) Write(data []byte) (int, error) {
+	w.wroteHeader = true
+	if !w.overflow {
+		if w.body.Len()+len(data) > w.limit {
+			w.overflow = true
+			w.body.Reset()
+		} else {
+			w.body.Write(data)
+		}
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// Flush chuyển tiếp tới writer gốc nếu hỗ trợ.
+func (w *teeWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,173 @@
+package singleflight
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// serve chạy middleware và handler cuối trên một request mới.
+func serve(mw func(forkCtx.Context), req *http.Request, handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+func TestSingleflightCoalescesConcurrentRequests(t *testing.T) {
+	mw := New(Config{SharedHeader: true})
+
+	var executions int32
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	handler := func(c forkCtx.Context) {
+		if atomic.AddInt32(&executions, 1) == 1 {
+			close(entered)
+		}
+		<-release
+		c.Header("X-Backend", "hit")
+		c.Header("Set-Cookie", "session=leader")
+		c.String(http.StatusAccepted, "shared body")
+	}
+
+	const followers = 5
+	recorders := make([]*httptest.ResponseRecorder, followers+1)
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		recorders[0] = serve(mw, httptest.NewRequest(http.MethodGet, "/items?page=1", nil), handler)
+	}()
+	<-entered
+
+	for i := 1; i <= followers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			recorders[i] = serve(mw, httptest.NewRequest(http.MethodGet, "/items?page=1", nil), handler)
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if executions != 1 {
+		t.Errorf("Expected handler to run once, got %d", executions)
+	}
+	shared := 0
+	for i, w := range recorders {
+		if w.Code != http.StatusAccepted || w.Body.String() != "shared body" {
+			t.Errorf("Recorder %d: expected 202 shared body, got %d %q", i, w.Code, w.Body.String())
+		}
+		if w.Header().Get("X-Backend") != "hit" {
+			t.Errorf("Recorder %d: expected headers to be shared", i)
+		}
+		if w.Header().Get(HeaderShared) == "shared" {
+			shared++
+			if cookie := w.Header().Get("Set-Cookie"); cookie != "" {
+				t.Errorf("Recorder %d: expected Set-Cookie not to be shared, got %q", i, cookie)
+			}
+		}
+	}
+	if shared != followers {
+		t.Errorf("Expected %d shared responses, got %d", followers, shared)
+	}
+}
+
+func TestSingleflightDefaultKeySkipsCredentials(t *testing.T) {
+	tests := []struct {
+		header, value string
+		coalesced     bool
+	}{
+		{"", "", true},
+		{"Authorization", "Bearer alice", false},
+		{"Cookie", "session=alice", false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		if tt.header != "" {
+			req.Header.Set(tt.header, tt.value)
+		}
+		key := defaultKey(forkCtx.NewContext(httptest.NewRecorder(), req))
+		if (key != "") != tt.coalesced {
+			t.Errorf("%q: expected coalesced=%v, got key %q", tt.header, tt.coalesced, key)
+		}
+	}
+}
+
+func TestSingleflightDifferentKeysAndMethods(t *testing.T) {
+	mw := New(Config{})
+
+	var executions int32
+	handler := func(c forkCtx.Context) {
+		atomic.AddInt32(&executions, 1)
+		c.String(http.StatusOK, "ok")
+	}
+
+	serve(mw, httptest.NewRequest(http.MethodGet, "/a", nil), handler)
+	serve(mw, httptest.NewRequest(http.MethodGet, "/b", nil), handler)
+	serve(mw, httptest.NewRequest(http.MethodPost, "/a", nil), handler)
+
+	if executions != 3 {
+		t.Errorf("Expected 3 executions, got %d", executions)
+	}
+}
+
+func TestSingleflightLeaderPanicLetsFollowersRun(t *testing.T) {
+	mw := New(Config{})
+
+	var executions int32
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	handler := func(c forkCtx.Context) {
+		if atomic.AddInt32(&executions, 1) == 1 {
+			close(entered)
+			<-release
+			panic("boom")
+		}
+		c.String(http.StatusOK, "recovered")
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer func() { recover() }()
+		serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), handler)
+	}()
+	<-entered
+
+	var follower *httptest.ResponseRecorder
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		follower = serve(mw, httptest.NewRequest(http.MethodGet, "/", nil), handler)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if follower.Body.String() != "recovered" {
+		t.Errorf("Expected follower to execute handler itself, got %q", follower.Body.String())
+	}
+}
+
+func TestTeeWriterOverflow(t *testing.T) {
+	w := &teeWriter{ResponseWriter: httptest.NewRecorder(), status: http.StatusOK, limit: 4}
+	w.Write([]byte("abc"))
+	w.Write([]byte("def"))
+
+	if !w.overflow {
+		t.Error("Expected overflow when body exceeds limit")
+	}
+	if w.body.Len() != 0 {
+		t.Errorf("Expected buffered body to be discarded, got %d bytes", w.body.Len())
+	}
+}
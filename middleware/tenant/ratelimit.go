@@ -0,0 +1,105 @@
+package tenant
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+	"go.fork.vn/fork/router"
+)
+
+// Limit là số request tối đa của một tenant trong một cửa sổ thời gian.
+type Limit struct {
+	// Requests là số request tối đa trong mỗi cửa sổ
+	Requests int
+
+	// Window là độ dài cửa sổ
+	Window time.Duration
+}
+
+// RateLimitConfig chứa cấu hình cho rate limit theo tenant.
+type RateLimitConfig struct {
+	// Default là giới hạn cho tenant không có Limit riêng.
+	// Mặc định: nil, tenant không có Limit riêng không bị giới hạn
+	Default *Limit
+
+	// Clock là nguồn thời gian xác định cửa sổ rate limit.
+	// Mặc định: clock.New()
+	Clock clock.Clock
+}
+
+// window là bộ đếm của một tenant trong cửa sổ hiện tại.
+type window struct {
+	start time.Time
+	count int
+}
+
+// RateLimit tạo middleware giới hạn số request của mỗi tenant theo cửa sổ cố định.
+// Middleware phải đứng sau New; request không có tenant không bị giới hạn.
+// Header X-RateLimit-Limit, X-RateLimit-Remaining và X-RateLimit-Reset được thiết lập
+// cho mọi request bị giới hạn; request vượt giới hạn nhận HttpError 429 kèm Retry-After.
+//
+// Parameters:
+//   - config: Cấu hình rate limit
+//
+// Returns:
+//   - router.HandlerFunc: Middleware rate limit theo tenant
+func RateLimit(config RateLimitConfig) router.HandlerFunc {
+	if config.Clock == nil {
+		config.Clock = clock.New()
+	}
+
+	var mu sync.Mutex
+	windows := make(map[string]*window)
+
+	return func(ctx forkCtx.Context) {
+		t, ok := FromContext(ctx)
+		if !ok {
+			ctx.Next()
+			return
+		}
+		limit := t.Limit
+		if limit == nil {
+			limit = config.Default
+		}
+		if limit == nil || limit.Requests <= 0 || limit.Window <= 0 {
+			ctx.Next()
+			return
+		}
+
+		now := config.Clock.Now()
+		mu.Lock()
+		w, exists := windows[t.ID]
+		if !exists || !now.Before(w.start.Add(limit.Window)) {
+			w = &window{start: now}
+			windows[t.ID] = w
+		}
+		allowed := w.count < limit.Requests
+		if allowed {
+			w.count++
+		}
+		remaining := limit.Requests - w.count
+		reset := w.start.Add(limit.Window).Sub(now)
+		mu.Unlock()
+
+		resetSeconds := strconv.Itoa(int((reset + time.Second - 1) / time.Second))
+		ctx.Header("X-RateLimit-Limit", strconv.Itoa(limit.Requests))
+		ctx.Header("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		ctx.Header("X-RateLimit-Reset", resetSeconds)
+
+		if !allowed {
+			ctx.Header("Retry-After", resetSeconds)
+			httpError := forkerrors.NewTooManyRequests("Tenant rate limit exceeded", map[string]interface{}{
+				"tenant": t.ID,
+				"limit":  limit.Requests,
+			}, nil)
+			ctx.JSON(httpError.StatusCode, httpError)
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,72 @@
+package tenant
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.fork.vn/fork/clock"
+	forkCtx "go.fork.vn/fork/context"
+)
+
+func TestRateLimitPerTenant(t *testing.T) {
+	mock := clock.NewMock(time.Time{})
+	store := NewMemoryStore(
+		&Tenant{ID: "acme", Limit: &Limit{Requests: 2, Window: time.Minute}},
+		&Tenant{ID: "globex"},
+		&Tenant{ID: "free"},
+	)
+	resolve := New(Config{Resolver: FromHeader("X-Tenant-ID"), Store: store})
+	limit := RateLimit(RateLimitConfig{Default: &Limit{Requests: 1, Window: time.Minute}, Clock: mock})
+
+	do := func(id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if id != "" {
+			req.Header.Set("X-Tenant-ID", id)
+		}
+		w := httptest.NewRecorder()
+		ctx := forkCtx.NewContext(w, req)
+		ctx.SetHandlers([]func(forkCtx.Context){resolve, limit, func(ctx forkCtx.Context) { ctx.Status(http.StatusOK) }})
+		ctx.Next()
+		return w
+	}
+
+	for i, expected := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		if w := do("acme"); w.Code != expected {
+			t.Errorf("acme request %d: expected %d, got %d", i+1, expected, w.Code)
+		}
+	}
+	if w := do("globex"); w.Code != http.StatusOK || w.Header().Get("X-RateLimit-Limit") != "1" || w.Header().Get("X-RateLimit-Remaining") != "0" {
+		t.Errorf("Expected globex to use the default limit independently, got %d %v", w.Code, w.Header())
+	}
+
+	mock.Add(20 * time.Second)
+	w := do("acme")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "40" {
+		t.Errorf("Expected Retry-After 40, got %d %q", w.Code, w.Header().Get("Retry-After"))
+	}
+
+	mock.Add(40 * time.Second)
+	if w := do("acme"); w.Code != http.StatusOK || w.Header().Get("X-RateLimit-Remaining") != "1" {
+		t.Errorf("Expected a new window, got %d %v", w.Code, w.Header())
+	}
+}
+
+func TestRateLimitWithoutTenantOrLimit(t *testing.T) {
+	limit := RateLimit(RateLimitConfig{})
+	for _, tenant := range []*Tenant{nil, {ID: "unlimited"}} {
+		for i := 0; i < 3; i++ {
+			w := httptest.NewRecorder()
+			ctx := forkCtx.NewContext(w, httptest.NewRequest(http.MethodGet, "/", nil))
+			if tenant != nil {
+				ctx.Set(ContextKey, tenant)
+			}
+			ctx.SetHandlers([]func(forkCtx.Context){limit, func(ctx forkCtx.Context) { ctx.Status(http.StatusOK) }})
+			ctx.Next()
+			if w.Code != http.StatusOK || w.Header().Get("X-RateLimit-Limit") != "" {
+				t.Errorf("Expected request without limit to pass, got %d %v", w.Code, w.Header())
+			}
+		}
+	}
+}
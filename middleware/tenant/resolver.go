@@ -0,0 +1,99 @@
+package tenant
+
+import (
+	"net"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// Resolver xác định ID tenant của request.
+// Trả về false nếu request không chứa thông tin tenant.
+type Resolver func(ctx forkCtx.Context) (string, bool)
+
+// FromHost xác định tenant theo host của request.
+// Với baseDomain "example.com", host "acme.example.com" cho tenant "acme";
+// với baseDomain rỗng, toàn bộ host (không kèm port) là ID tenant, phù hợp với custom domain.
+//
+// Parameters:
+//   - baseDomain: Domain gốc của ứng dụng
+//
+// Returns:
+//   - Resolver: Resolver theo host
+func FromHost(baseDomain string) Resolver {
+	suffix := "." + strings.ToLower(strings.Trim(baseDomain, "."))
+
+	return func(ctx forkCtx.Context) (string, bool) {
+		host := strings.ToLower(ctx.Request().Request().Host)
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if baseDomain == "" {
+			return host, host != ""
+		}
+
+		sub, ok := strings.CutSuffix(host, suffix)
+		if !ok || sub == "" || strings.Contains(sub, ".") {
+			return "", false
+		}
+		return sub, true
+	}
+}
+
+// FromHeader xác định tenant theo giá trị của header.
+//
+// Parameters:
+//   - name: Tên header (ví dụ: "X-Tenant-ID")
+//
+// Returns:
+//   - Resolver: Resolver theo header
+func FromHeader(name string) Resolver {
+	return func(ctx forkCtx.Context) (string, bool) {
+		id := strings.TrimSpace(ctx.GetHeader(name))
+		return id, id != ""
+	}
+}
+
+// FromPathPrefix xác định tenant theo segment đầu tiên sau prefix của path.
+// Với prefix "/t", path "/t/acme/users" cho tenant "acme"; với prefix rỗng,
+// segment đầu tiên của path là ID tenant. Routes tương ứng thường được đăng ký
+// trong group "/t/:tenant".
+//
+// Parameters:
+//   - prefix: Tiền tố path đứng trước ID tenant
+//
+// Returns:
+//   - Resolver: Resolver theo tiền tố path
+func FromPathPrefix(prefix string) Resolver {
+	prefix = "/" + strings.Trim(prefix, "/")
+	if prefix != "/" {
+		prefix += "/"
+	}
+
+	return func(ctx forkCtx.Context) (string, bool) {
+		rest, ok := strings.CutPrefix(ctx.Path(), prefix)
+		if !ok {
+			return "", false
+		}
+		id, _, _ := strings.Cut(rest, "/")
+		return id, id != ""
+	}
+}
+
+// Chain thử lần lượt các resolver và trả về kết quả đầu tiên xác định được tenant.
+//
+// Parameters:
+//   - resolvers: Các resolver theo thứ tự ưu tiên
+//
+// Returns:
+//   - Resolver: Resolver kết hợp
+func Chain(resolvers ...Resolver) Resolver {
+	return func(ctx forkCtx.Context) (string, bool) {
+		for _, resolve := range resolvers {
+			if id, ok := resolve(ctx); ok {
+				return id, true
+			}
+		}
+		return "", false
+	}
+}
@@ -0,0 +1,76 @@
+package tenant
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+func resolve(resolver Resolver, req *http.Request) (string, bool) {
+	return resolver(forkCtx.NewContext(httptest.NewRecorder(), req))
+}
+
+func TestFromHost(t *testing.T) {
+	tests := []struct {
+		base, host, id string
+		ok             bool
+	}{
+		{"example.com", "acme.example.com", "acme", true},
+		{"example.com", "ACME.example.com:8080", "acme", true},
+		{".example.com.", "globex.example.com", "globex", true},
+		{"example.com", "example.com", "", false},
+		{"example.com", "a.b.example.com", "", false},
+		{"example.com", "acme.other.com", "", false},
+		{"", "shop.customer.io:443", "shop.customer.io", true},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Host = tt.host
+		id, ok := resolve(FromHost(tt.base), req)
+		if id != tt.id || ok != tt.ok {
+			t.Errorf("FromHost(%q) with host %q: expected %q %v, got %q %v", tt.base, tt.host, tt.id, tt.ok, id, ok)
+		}
+	}
+}
+
+func TestFromPathPrefix(t *testing.T) {
+	tests := []struct {
+		prefix, path, id string
+		ok               bool
+	}{
+		{"/t", "/t/acme/users", "acme", true},
+		{"t/", "/t/acme", "acme", true},
+		{"/t", "/t/", "", false},
+		{"/t", "/tenants/acme", "", false},
+		{"", "/acme/users", "acme", true},
+		{"", "/", "", false},
+	}
+
+	for _, tt := range tests {
+		id, ok := resolve(FromPathPrefix(tt.prefix), httptest.NewRequest(http.MethodGet, tt.path, nil))
+		if id != tt.id || ok != tt.ok {
+			t.Errorf("FromPathPrefix(%q) with path %q: expected %q %v, got %q %v", tt.prefix, tt.path, tt.id, tt.ok, id, ok)
+		}
+	}
+}
+
+func TestFromHeaderAndChain(t *testing.T) {
+	resolver := Chain(FromHeader("X-Tenant-ID"), FromPathPrefix("/t"))
+
+	req := httptest.NewRequest(http.MethodGet, "/t/fromPath", nil)
+	req.Header.Set("X-Tenant-ID", " fromHeader ")
+	if id, _ := resolve(resolver, req); id != "fromHeader" {
+		t.Errorf("Expected header to take priority, got %q", id)
+	}
+
+	if id, _ := resolve(resolver, httptest.NewRequest(http.MethodGet, "/t/fromPath", nil)); id != "fromPath" {
+		t.Errorf("Expected path fallback, got %q", id)
+	}
+
+	if _, ok := resolve(resolver, httptest.NewRequest(http.MethodGet, "/", nil)); ok {
+		t.Error("Expected no tenant")
+	}
+}
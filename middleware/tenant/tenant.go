@@ -0,0 +1,339 @@
+// Package tenant cung cấp hỗ trợ multi-tenancy cho ứng dụng SaaS: middleware xác định tenant
+// của request (theo host, header hoặc tiền tố path), lưu Tenant vào context, giới hạn route group
+// theo tenant, cấu hình ghi đè theo tenant và rate limit riêng cho từng tenant.
+//
+// Ví dụ:
+//
+//	store := tenant.NewMemoryStore(
+//		&tenant.Tenant{ID: "acme", Config: map[string]interface{}{"theme": "dark"}, Limit: &tenant.Limit{Requests: 100, Window: time.Minute}},
+//		&tenant.Tenant{ID: "globex"},
+//	)
+//	app.Use(tenant.New(tenant.Config{Resolver: tenant.FromHost("example.com"), Store: store}))
+//	app.Use(tenant.RateLimit(tenant.RateLimitConfig{}))
+//
+//	reports := app.Group("/reports")
+//	reports.Use(tenant.Only("acme"))
+package tenant
+
+import (
+	gocontext "context"
+	"errors"
+	"net/http"
+	"sync"
+
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+	"go.fork.vn/fork/router"
+)
+
+// ContextKey là khóa trong context store chứa *Tenant của request.
+const ContextKey = "tenant"
+
+var (
+	// ErrMissing được trả về khi request không chứa thông tin tenant.
+	ErrMissing = errors.New("tenant: missing tenant")
+
+	// ErrNotFound được trả về khi tenant không tồn tại trong store.
+	ErrNotFound = errors.New("tenant: not found")
+)
+
+// Tenant là một khách hàng (tổ chức) dùng chung ứng dụng.
+type Tenant struct {
+	// ID là định danh duy nhất của tenant
+	ID string
+
+	// Name là tên hiển thị của tenant
+	Name string
+
+	// Config chứa các giá trị cấu hình ghi đè cho tenant
+	Config map[string]interface{}
+
+	// Limit là rate limit riêng của tenant, nil để dùng giới hạn mặc định
+	Limit *Limit
+}
+
+// Value trả về giá trị cấu hình ghi đè của tenant.
+//
+// Parameters:
+//   - key: Khóa cấu hình
+//
+// Returns:
+//   - interface{}: Giá trị cấu hình
+//   - bool: true nếu tenant có ghi đè khóa này
+func (t *Tenant) Value(key string) (interface{}, bool) {
+	if t == nil || t.Config == nil {
+		return nil, false
+	}
+	value, ok := t.Config[key]
+	return value, ok
+}
+
+// String trả về giá trị cấu hình kiểu string hoặc fallback nếu không có ghi đè.
+//
+// Parameters:
+//   - key: Khóa cấu hình
+//   - fallback: Giá trị mặc định của ứng dụng
+//
+// Returns:
+//   - string: Giá trị của tenant hoặc fallback
+func (t *Tenant) String(key, fallback string) string {
+	if value, ok := t.Value(key); ok {
+		if s, ok := value.(string); ok {
+			return s
+		}
+	}
+	return fallback
+}
+
+// Int trả về giá trị cấu hình kiểu số nguyên hoặc fallback nếu không có ghi đè.
+//
+// Parameters:
+//   - key: Khóa cấu hình
+//   - fallback: Giá trị mặc định của ứng dụng
+//
+// Returns:
+//   - int: Giá trị của tenant hoặc fallback
+func (t *Tenant) Int(key string, fallback int) int {
+	value, ok := t.Value(key)
+	if !ok {
+		return fallback
+	}
+	switch v := value.(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return fallback
+}
+
+// Bool trả về giá trị cấu hình kiểu bool hoặc fallback nếu không có ghi đè.
+//
+// Parameters:
+//   - key: Khóa cấu hình
+//   - fallback: Giá trị mặc định của ứng dụng
+//
+// Returns:
+//   - bool: Giá trị của tenant hoặc fallback
+func (t *Tenant) Bool(key string, fallback bool) bool {
+	if value, ok := t.Value(key); ok {
+		if b, ok := value.(bool); ok {
+			return b
+		}
+	}
+	return fallback
+}
+
+// Store cung cấp thông tin tenant theo ID.
+type Store interface {
+	// Get trả về tenant theo ID.
+	//
+	// Parameters:
+	//   - ctx: Context của request
+	//   - id: ID của tenant
+	//
+	// Returns:
+	//   - *Tenant: Tenant tìm thấy
+	//   - error: ErrNotFound nếu tenant không tồn tại
+	Get(ctx gocontext.Context, id string) (*Tenant, error)
+}
+
+// MemoryStore là Store lưu tenant trong bộ nhớ.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	tenants map[string]*Tenant
+}
+
+// NewMemoryStore tạo MemoryStore chứa các tenant đã cho.
+//
+// Parameters:
+//   - tenants: Các tenant ban đầu
+//
+// Returns:
+//   - *MemoryStore: Store mới
+func NewMemoryStore(tenants ...*Tenant) *MemoryStore {
+	s := &MemoryStore{tenants: make(map[string]*Tenant, len(tenants))}
+	for _, t := range tenants {
+		s.Add(t)
+	}
+	return s
+}
+
+// Get trả về tenant theo ID.
+func (s *MemoryStore) Get(_ gocontext.Context, id string) (*Tenant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if t, ok := s.tenants[id]; ok {
+		return t, nil
+	}
+	return nil, ErrNotFound
+}
+
+// Add thêm hoặc thay thế tenant.
+//
+// Parameters:
+//   - t: Tenant cần thêm
+func (s *MemoryStore) Add(t *Tenant) {
+	if t == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tenants[t.ID] = t
+}
+
+// Remove xóa tenant theo ID.
+//
+// Parameters:
+//   - id: ID của tenant
+func (s *MemoryStore) Remove(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tenants, id)
+}
+
+// Config chứa cấu hình cho tenant middleware.
+type Config struct {
+	// Resolver xác định ID tenant của request (bắt buộc).
+	// Dùng Chain để thử nhiều cách theo thứ tự.
+	Resolver Resolver
+
+	// Store tra cứu tenant theo ID.
+	// Mặc định: nil, mọi ID được chấp nhận và Tenant chỉ có ID
+	Store Store
+
+	// Optional cho phép request không có tenant đi tiếp mà không đặt Tenant vào context.
+	// Mặc định: false, request không có tenant bị từ chối với 400
+	Optional bool
+
+	// Skipper cho phép bỏ qua việc xác định tenant cho một số request.
+	Skipper func(ctx forkCtx.Context) bool
+
+	// ErrorHandler xử lý lỗi khi không xác định được tenant.
+	// Mặc định: 400 cho ErrMissing, 404 cho ErrNotFound, 500 cho lỗi của store
+	ErrorHandler func(ctx forkCtx.Context, err error)
+}
+
+// New tạo middleware xác định tenant và lưu vào context với khóa ContextKey.
+//
+// Parameters:
+//   - config: Cấu hình middleware
+//
+// Returns:
+//   - router.HandlerFunc: Middleware xác định tenant
+//
+// Panics:
+//   - Nếu Resolver không được thiết lập
+func New(config Config) router.HandlerFunc {
+	if config.Resolver == nil {
+		panic("tenant: Resolver is required")
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = defaultErrorHandler
+	}
+
+	return func(ctx forkCtx.Context) {
+		if config.Skipper != nil && config.Skipper(ctx) {
+			ctx.Next()
+			return
+		}
+
+		id, ok := config.Resolver(ctx)
+		if !ok || id == "" {
+			if config.Optional {
+				ctx.Next()
+				return
+			}
+			config.ErrorHandler(ctx, ErrMissing)
+			ctx.Abort()
+			return
+		}
+
+		t := &Tenant{ID: id}
+		if config.Store != nil {
+			found, err := config.Store.Get(ctx.Context(), id)
+			if err != nil {
+				config.ErrorHandler(ctx, err)
+				ctx.Abort()
+				return
+			}
+			t = found
+		}
+
+		ctx.Set(ContextKey, t)
+		ctx.Next()
+	}
+}
+
+// FromContext trả về tenant của request.
+//
+// Parameters:
+//   - ctx: Context của request
+//
+// Returns:
+//   - *Tenant: Tenant của request
+//   - bool: false nếu request không có tenant
+func FromContext(ctx forkCtx.Context) (*Tenant, bool) {
+	value, exists := ctx.Get(ContextKey)
+	if !exists {
+		return nil, false
+	}
+	t, ok := value.(*Tenant)
+	return t, ok && t != nil
+}
+
+// ID trả về ID tenant của request hoặc chuỗi rỗng. Có thể dùng làm KeyFunc
+// cho các middleware theo danh tính như quota.
+//
+// Parameters:
+//   - ctx: Context của request
+//
+// Returns:
+//   - string: ID của tenant
+func ID(ctx forkCtx.Context) string {
+	if t, ok := FromContext(ctx); ok {
+		return t.ID
+	}
+	return ""
+}
+
+// Only tạo middleware giới hạn route group cho các tenant đã cho.
+// Request của tenant khác nhận 404 để không làm lộ sự tồn tại của route.
+//
+// Parameters:
+//   - ids: Danh sách ID tenant được phép
+//
+// Returns:
+//   - router.HandlerFunc: Middleware kiểm tra tenant
+func Only(ids ...string) router.HandlerFunc {
+	allowed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		allowed[id] = true
+	}
+
+	return func(ctx forkCtx.Context) {
+		if !allowed[ID(ctx)] {
+			httpError := forkerrors.NotFound("Not found")
+			ctx.JSON(httpError.StatusCode, httpError)
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// defaultErrorHandler trả về HttpError tương ứng với lỗi xác định tenant.
+func defaultErrorHandler(ctx forkCtx.Context, err error) {
+	var httpError *forkerrors.HttpError
+	switch {
+	case errors.Is(err, ErrMissing):
+		httpError = forkerrors.NewBadRequest("Tenant is required", nil, err)
+	case errors.Is(err, ErrNotFound):
+		httpError = forkerrors.NewNotFound("Tenant not found", nil, err)
+	default:
+		httpError = forkerrors.NewHttpError(http.StatusInternalServerError, "Failed to resolve tenant", nil, err)
+	}
+	ctx.JSON(httpError.StatusCode, httpError)
+}
@@ -0,0 +1,136 @@
+package tenant
+
+import (
+	gocontext "context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+func serve(mw func(forkCtx.Context), req *http.Request, handler func(forkCtx.Context)) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, handler})
+	ctx.Next()
+	return w
+}
+
+// failingStore luôn trả về lỗi để kiểm tra xử lý lỗi của store.
+type failingStore struct{}
+
+func (failingStore) Get(gocontext.Context, string) (*Tenant, error) {
+	return nil, errors.New("database unavailable")
+}
+
+func TestMiddlewareSetsTenant(t *testing.T) {
+	store := NewMemoryStore(&Tenant{ID: "acme", Name: "Acme Inc"})
+	mw := New(Config{Resolver: FromHeader("X-Tenant-ID"), Store: store})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Tenant-ID", "acme")
+	var name, id string
+	w := serve(mw, req, func(ctx forkCtx.Context) {
+		current, _ := FromContext(ctx)
+		name, id = current.Name, ID(ctx)
+		ctx.Status(http.StatusOK)
+	})
+
+	if w.Code != http.StatusOK || name != "Acme Inc" || id != "acme" {
+		t.Errorf("Expected tenant acme in context, got %d %q %q", w.Code, name, id)
+	}
+}
+
+func TestMiddlewareErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		header string
+		status int
+	}{
+		{"missing", Config{Store: NewMemoryStore()}, "", http.StatusBadRequest},
+		{"unknown", Config{Store: NewMemoryStore()}, "ghost", http.StatusNotFound},
+		{"store error", Config{Store: failingStore{}}, "acme", http.StatusInternalServerError},
+		{"optional", Config{Store: NewMemoryStore(), Optional: true}, "", http.StatusOK},
+		{"no store", Config{}, "anything", http.StatusOK},
+		{"skipped", Config{Skipper: func(forkCtx.Context) bool { return true }}, "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		tt.config.Resolver = FromHeader("X-Tenant-ID")
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if tt.header != "" {
+			req.Header.Set("X-Tenant-ID", tt.header)
+		}
+		handled := false
+		w := serve(New(tt.config), req, func(ctx forkCtx.Context) {
+			handled = true
+			ctx.Status(http.StatusOK)
+		})
+		if w.Code != tt.status || handled != (tt.status == http.StatusOK) {
+			t.Errorf("%s: expected status %d, got %d (handled=%v)", tt.name, tt.status, w.Code, handled)
+		}
+	}
+}
+
+func TestNewPanicsWithoutResolver(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic without Resolver")
+		}
+	}()
+	New(Config{})
+}
+
+func TestOnly(t *testing.T) {
+	mw := New(Config{Resolver: FromHeader("X-Tenant-ID")})
+	only := Only("acme")
+
+	for id, expected := range map[string]int{"acme": http.StatusOK, "globex": http.StatusNotFound} {
+		req := httptest.NewRequest(http.MethodGet, "/reports", nil)
+		req.Header.Set("X-Tenant-ID", id)
+		w := httptest.NewRecorder()
+		ctx := forkCtx.NewContext(w, req)
+		ctx.SetHandlers([]func(forkCtx.Context){mw, only, func(ctx forkCtx.Context) { ctx.Status(http.StatusOK) }})
+		ctx.Next()
+		if w.Code != expected {
+			t.Errorf("%s: expected %d, got %d", id, expected, w.Code)
+		}
+	}
+}
+
+func TestTenantConfigOverrides(t *testing.T) {
+	tenant := &Tenant{ID: "acme", Config: map[string]interface{}{
+		"theme": "dark", "max_users": 50, "seats": float64(12), "beta": true, "wrong": 1,
+	}}
+
+	if tenant.String("theme", "light") != "dark" || tenant.String("locale", "en") != "en" || tenant.String("wrong", "x") != "x" {
+		t.Error("Unexpected String overrides")
+	}
+	if tenant.Int("max_users", 10) != 50 || tenant.Int("seats", 0) != 12 || tenant.Int("theme", 7) != 7 {
+		t.Error("Unexpected Int overrides")
+	}
+	if !tenant.Bool("beta", false) || tenant.Bool("missing", false) {
+		t.Error("Unexpected Bool overrides")
+	}
+
+	var none *Tenant
+	if _, ok := none.Value("theme"); ok || none.String("theme", "light") != "light" {
+		t.Error("Expected nil tenant to fall back to defaults")
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore(&Tenant{ID: "acme"}, nil)
+	store.Add(&Tenant{ID: "globex"})
+	store.Remove("acme")
+
+	if _, err := store.Get(gocontext.Background(), "acme"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if found, err := store.Get(gocontext.Background(), "globex"); err != nil || found.ID != "globex" {
+		t.Errorf("Expected globex, got %v %v", found, err)
+	}
+}
@@ -0,0 +1,125 @@
+package fork_mocks
+
+import (
+	"fmt"
+	"sync"
+
+	mock "github.com/stretchr/testify/mock"
+	context "go.fork.vn/fork/context"
+)
+
+// ChainContext is a MockContext with real handler-chain semantics.
+//
+// SetHandlers, Handlers, Next, Abort and IsAborted behave exactly like the
+// production context: Next runs the remaining handlers in order until the chain
+// ends or Abort is called, and handlers receive the ChainContext itself so nested
+// Next calls advance the same chain. Every other method is delegated to the
+// embedded MockContext and must be set up with expectations as usual.
+//
+// Each chain operation is recorded so tests can assert on execution order:
+//
+//	ctx := fork_mocks.NewChainContext(t)
+//	ctx.SetHandlers([]func(context.Context){authMiddleware, handler})
+//	ctx.Next()
+//	assert.Equal(t, []int{0, 1}, ctx.Executed())
+type ChainContext struct {
+	*MockContext
+
+	mu       sync.Mutex
+	handlers []func(context.Context)
+	index    int
+	aborted  bool
+	calls    []string
+	executed []int
+}
+
+// NewChainContext creates a ChainContext backed by a new MockContext whose
+// expectations are asserted when the test finishes.
+func NewChainContext(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChainContext {
+	return &ChainContext{MockContext: NewMockContext(t), index: -1}
+}
+
+// SetHandlers replaces the handler chain.
+func (c *ChainContext) SetHandlers(handlers []func(context.Context)) {
+	c.mu.Lock()
+	c.handlers = handlers
+	c.calls = append(c.calls, "SetHandlers")
+	c.mu.Unlock()
+}
+
+// Handlers returns the current handler chain.
+func (c *ChainContext) Handlers() []func(context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.handlers
+}
+
+// Next executes the remaining handlers until the chain ends or is aborted.
+func (c *ChainContext) Next() {
+	c.mu.Lock()
+	c.calls = append(c.calls, "Next")
+	c.index++
+	c.mu.Unlock()
+
+	for {
+		c.mu.Lock()
+		if c.index >= len(c.handlers) || c.aborted {
+			c.mu.Unlock()
+			return
+		}
+		index := c.index
+		handler := c.handlers[index]
+		c.calls = append(c.calls, fmt.Sprintf("handler[%d]", index))
+		c.executed = append(c.executed, index)
+		c.mu.Unlock()
+
+		handler(c)
+
+		c.mu.Lock()
+		c.index++
+		c.mu.Unlock()
+	}
+}
+
+// Abort stops execution of the remaining handlers.
+func (c *ChainContext) Abort() {
+	c.mu.Lock()
+	c.aborted = true
+	c.calls = append(c.calls, "Abort")
+	c.mu.Unlock()
+}
+
+// IsAborted reports whether Abort has been called.
+func (c *ChainContext) IsAborted() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.aborted
+}
+
+// Executed returns the indexes of the handlers that ran, in execution order.
+func (c *ChainContext) Executed() []int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]int(nil), c.executed...)
+}
+
+// Calls returns the recorded chain operations in order, for example
+// ["SetHandlers", "Next", "handler[0]", "Next", "handler[1]", "Abort"].
+func (c *ChainContext) Calls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.calls...)
+}
+
+// Reset rewinds the chain so the same handlers can be executed again.
+func (c *ChainContext) Reset() {
+	c.mu.Lock()
+	c.index = -1
+	c.aborted = false
+	c.calls = nil
+	c.executed = nil
+	c.mu.Unlock()
+}
@@ -0,0 +1,63 @@
+package fork_mocks
+
+import (
+	"reflect"
+	"testing"
+
+	context "go.fork.vn/fork/context"
+)
+
+func TestChainContextRunsChain(t *testing.T) {
+	ctx := NewChainContext(t)
+	var order []string
+	ctx.SetHandlers([]func(context.Context){
+		func(c context.Context) {
+			order = append(order, "outer:before")
+			c.Next()
+			order = append(order, "outer:after")
+		},
+		func(c context.Context) {
+			order = append(order, "inner")
+		},
+		func(c context.Context) {
+			order = append(order, "handler")
+		},
+	})
+	ctx.Next()
+
+	expected := []string{"outer:before", "inner", "handler", "outer:after"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected %v, got %v", expected, order)
+	}
+	if !reflect.DeepEqual(ctx.Executed(), []int{0, 1, 2}) {
+		t.Errorf("Expected all handlers to run, got %v", ctx.Executed())
+	}
+	calls := []string{"SetHandlers", "Next", "handler[0]", "Next", "handler[1]", "handler[2]"}
+	if !reflect.DeepEqual(ctx.Calls(), calls) {
+		t.Errorf("Expected calls %v, got %v", calls, ctx.Calls())
+	}
+}
+
+func TestChainContextAbort(t *testing.T) {
+	ctx := NewChainContext(t)
+	ctx.EXPECT().String(401, "unauthorized").Once()
+	ctx.SetHandlers([]func(context.Context){
+		func(c context.Context) {
+			c.String(401, "unauthorized")
+			c.Abort()
+		},
+		func(c context.Context) {
+			t.Error("Expected handler after Abort not to run")
+		},
+	})
+	ctx.Next()
+
+	if !ctx.IsAborted() || !reflect.DeepEqual(ctx.Executed(), []int{0}) {
+		t.Errorf("Expected chain to stop after abort, executed %v", ctx.Executed())
+	}
+
+	ctx.Reset()
+	if ctx.IsAborted() || len(ctx.Calls()) != 0 {
+		t.Error("Expected Reset to clear chain state")
+	}
+}
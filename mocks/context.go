@@ -15,8 +15,12 @@ import (
 
 	multipart "mime/multipart"
 
+	proto "google.golang.org/protobuf/proto"
+
 	time "time"
 
+	uuid "github.com/google/uuid"
+
 	validator "github.com/go-playground/validator/v10"
 )
 
@@ -65,6 +69,98 @@ func (_c *MockContext_Abort_Call) RunAndReturn(run func()) *MockContext_Abort_Ca
 	return _c
 }
 
+// Accepts provides a mock function with given fields: offers
+func (_m *MockContext) Accepts(offers ...string) string {
+	_va := make([]interface{}, len(offers))
+	for _i := range offers {
+		_va[_i] = offers[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accepts")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(...string) string); ok {
+		r0 = rf(offers...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockContext_Accepts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accepts'
+type MockContext_Accepts_Call struct {
+	*mock.Call
+}
+
+// Accepts is a helper method to define mock.On call
+//   - offers ...string
+func (_e *MockContext_Expecter) Accepts(offers ...interface{}) *MockContext_Accepts_Call {
+	return &MockContext_Accepts_Call{Call: _e.mock.On("Accepts",
+		append([]interface{}{}, offers...)...)}
+}
+
+func (_c *MockContext_Accepts_Call) Run(run func(offers ...string)) *MockContext_Accepts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContext_Accepts_Call) Return(_a0 string) *MockContext_Accepts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Accepts_Call) RunAndReturn(run func(...string) string) *MockContext_Accepts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddError provides a mock function with given fields: err
+func (_m *MockContext) AddError(err error) {
+	_m.Called(err)
+}
+
+// MockContext_AddError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddError'
+type MockContext_AddError_Call struct {
+	*mock.Call
+}
+
+// AddError is a helper method to define mock.On call
+//   - err error
+func (_e *MockContext_Expecter) AddError(err interface{}) *MockContext_AddError_Call {
+	return &MockContext_AddError_Call{Call: _e.mock.On("AddError", err)}
+}
+
+func (_c *MockContext_AddError_Call) Run(run func(err error)) *MockContext_AddError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(error))
+	})
+	return _c
+}
+
+func (_c *MockContext_AddError_Call) Return() *MockContext_AddError_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_AddError_Call) RunAndReturn(run func(error)) *MockContext_AddError_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Bind provides a mock function with given fields: obj
 func (_m *MockContext) Bind(obj interface{}) error {
 	ret := _m.Called(obj)
@@ -157,6 +253,52 @@ func (_c *MockContext_BindAndValidate_Call) RunAndReturn(run func(interface{}) e
 	return _c
 }
 
+// BindCBOR provides a mock function with given fields: obj
+func (_m *MockContext) BindCBOR(obj interface{}) error {
+	ret := _m.Called(obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindCBOR")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContext_BindCBOR_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindCBOR'
+type MockContext_BindCBOR_Call struct {
+	*mock.Call
+}
+
+// BindCBOR is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) BindCBOR(obj interface{}) *MockContext_BindCBOR_Call {
+	return &MockContext_BindCBOR_Call{Call: _e.mock.On("BindCBOR", obj)}
+}
+
+func (_c *MockContext_BindCBOR_Call) Run(run func(obj interface{})) *MockContext_BindCBOR_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContext_BindCBOR_Call) Return(_a0 error) *MockContext_BindCBOR_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_BindCBOR_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindCBOR_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BindForm provides a mock function with given fields: obj
 func (_m *MockContext) BindForm(obj interface{}) error {
 	ret := _m.Called(obj)
@@ -203,12 +345,12 @@ func (_c *MockContext_BindForm_Call) RunAndReturn(run func(interface{}) error) *
 	return _c
 }
 
-// BindJSON provides a mock function with given fields: obj
-func (_m *MockContext) BindJSON(obj interface{}) error {
+// BindHeader provides a mock function with given fields: obj
+func (_m *MockContext) BindHeader(obj interface{}) error {
 	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BindJSON")
+		panic("no return value specified for BindHeader")
 	}
 
 	var r0 error
@@ -221,40 +363,40 @@ func (_m *MockContext) BindJSON(obj interface{}) error {
 	return r0
 }
 
-// MockContext_BindJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindJSON'
-type MockContext_BindJSON_Call struct {
+// MockContext_BindHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindHeader'
+type MockContext_BindHeader_Call struct {
 	*mock.Call
 }
 
-// BindJSON is a helper method to define mock.On call
+// BindHeader is a helper method to define mock.On call
 //   - obj interface{}
-func (_e *MockContext_Expecter) BindJSON(obj interface{}) *MockContext_BindJSON_Call {
-	return &MockContext_BindJSON_Call{Call: _e.mock.On("BindJSON", obj)}
+func (_e *MockContext_Expecter) BindHeader(obj interface{}) *MockContext_BindHeader_Call {
+	return &MockContext_BindHeader_Call{Call: _e.mock.On("BindHeader", obj)}
 }
 
-func (_c *MockContext_BindJSON_Call) Run(run func(obj interface{})) *MockContext_BindJSON_Call {
+func (_c *MockContext_BindHeader_Call) Run(run func(obj interface{})) *MockContext_BindHeader_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_BindJSON_Call) Return(_a0 error) *MockContext_BindJSON_Call {
+func (_c *MockContext_BindHeader_Call) Return(_a0 error) *MockContext_BindHeader_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_BindJSON_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindJSON_Call {
+func (_c *MockContext_BindHeader_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindHeader_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// BindQuery provides a mock function with given fields: obj
-func (_m *MockContext) BindQuery(obj interface{}) error {
+// BindJSON provides a mock function with given fields: obj
+func (_m *MockContext) BindJSON(obj interface{}) error {
 	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BindQuery")
+		panic("no return value specified for BindJSON")
 	}
 
 	var r0 error
@@ -267,40 +409,40 @@ func (_m *MockContext) BindQuery(obj interface{}) error {
 	return r0
 }
 
-// MockContext_BindQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindQuery'
-type MockContext_BindQuery_Call struct {
+// MockContext_BindJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindJSON'
+type MockContext_BindJSON_Call struct {
 	*mock.Call
 }
 
-// BindQuery is a helper method to define mock.On call
+// BindJSON is a helper method to define mock.On call
 //   - obj interface{}
-func (_e *MockContext_Expecter) BindQuery(obj interface{}) *MockContext_BindQuery_Call {
-	return &MockContext_BindQuery_Call{Call: _e.mock.On("BindQuery", obj)}
+func (_e *MockContext_Expecter) BindJSON(obj interface{}) *MockContext_BindJSON_Call {
+	return &MockContext_BindJSON_Call{Call: _e.mock.On("BindJSON", obj)}
 }
 
-func (_c *MockContext_BindQuery_Call) Run(run func(obj interface{})) *MockContext_BindQuery_Call {
+func (_c *MockContext_BindJSON_Call) Run(run func(obj interface{})) *MockContext_BindJSON_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_BindQuery_Call) Return(_a0 error) *MockContext_BindQuery_Call {
+func (_c *MockContext_BindJSON_Call) Return(_a0 error) *MockContext_BindJSON_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_BindQuery_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindQuery_Call {
+func (_c *MockContext_BindJSON_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindJSON_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// BindXML provides a mock function with given fields: obj
-func (_m *MockContext) BindXML(obj interface{}) error {
+// BindJSONStrict provides a mock function with given fields: obj
+func (_m *MockContext) BindJSONStrict(obj interface{}) error {
 	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BindXML")
+		panic("no return value specified for BindJSONStrict")
 	}
 
 	var r0 error
@@ -313,514 +455,540 @@ func (_m *MockContext) BindXML(obj interface{}) error {
 	return r0
 }
 
-// MockContext_BindXML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindXML'
-type MockContext_BindXML_Call struct {
+// MockContext_BindJSONStrict_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindJSONStrict'
+type MockContext_BindJSONStrict_Call struct {
 	*mock.Call
 }
 
-// BindXML is a helper method to define mock.On call
+// BindJSONStrict is a helper method to define mock.On call
 //   - obj interface{}
-func (_e *MockContext_Expecter) BindXML(obj interface{}) *MockContext_BindXML_Call {
-	return &MockContext_BindXML_Call{Call: _e.mock.On("BindXML", obj)}
+func (_e *MockContext_Expecter) BindJSONStrict(obj interface{}) *MockContext_BindJSONStrict_Call {
+	return &MockContext_BindJSONStrict_Call{Call: _e.mock.On("BindJSONStrict", obj)}
 }
 
-func (_c *MockContext_BindXML_Call) Run(run func(obj interface{})) *MockContext_BindXML_Call {
+func (_c *MockContext_BindJSONStrict_Call) Run(run func(obj interface{})) *MockContext_BindJSONStrict_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_BindXML_Call) Return(_a0 error) *MockContext_BindXML_Call {
+func (_c *MockContext_BindJSONStrict_Call) Return(_a0 error) *MockContext_BindJSONStrict_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_BindXML_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindXML_Call {
+func (_c *MockContext_BindJSONStrict_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindJSONStrict_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Blob provides a mock function with given fields: code, contentType, data
-func (_m *MockContext) Blob(code int, contentType string, data []byte) {
-	_m.Called(code, contentType, data)
+// BindMsgpack provides a mock function with given fields: obj
+func (_m *MockContext) BindMsgpack(obj interface{}) error {
+	ret := _m.Called(obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindMsgpack")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
-// MockContext_Blob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Blob'
-type MockContext_Blob_Call struct {
+// MockContext_BindMsgpack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindMsgpack'
+type MockContext_BindMsgpack_Call struct {
 	*mock.Call
 }
 
-// Blob is a helper method to define mock.On call
-//   - code int
-//   - contentType string
-//   - data []byte
-func (_e *MockContext_Expecter) Blob(code interface{}, contentType interface{}, data interface{}) *MockContext_Blob_Call {
-	return &MockContext_Blob_Call{Call: _e.mock.On("Blob", code, contentType, data)}
+// BindMsgpack is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) BindMsgpack(obj interface{}) *MockContext_BindMsgpack_Call {
+	return &MockContext_BindMsgpack_Call{Call: _e.mock.On("BindMsgpack", obj)}
 }
 
-func (_c *MockContext_Blob_Call) Run(run func(code int, contentType string, data []byte)) *MockContext_Blob_Call {
+func (_c *MockContext_BindMsgpack_Call) Run(run func(obj interface{})) *MockContext_BindMsgpack_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(string), args[2].([]byte))
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_Blob_Call) Return() *MockContext_Blob_Call {
-	_c.Call.Return()
+func (_c *MockContext_BindMsgpack_Call) Return(_a0 error) *MockContext_BindMsgpack_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Blob_Call) RunAndReturn(run func(int, string, []byte)) *MockContext_Blob_Call {
-	_c.Run(run)
+func (_c *MockContext_BindMsgpack_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindMsgpack_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// ClientIP provides a mock function with no fields
-func (_m *MockContext) ClientIP() string {
-	ret := _m.Called()
+// BindProtobuf provides a mock function with given fields: obj
+func (_m *MockContext) BindProtobuf(obj proto.Message) error {
+	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ClientIP")
+		panic("no return value specified for BindProtobuf")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(proto.Message) error); ok {
+		r0 = rf(obj)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_ClientIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientIP'
-type MockContext_ClientIP_Call struct {
+// MockContext_BindProtobuf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindProtobuf'
+type MockContext_BindProtobuf_Call struct {
 	*mock.Call
 }
 
-// ClientIP is a helper method to define mock.On call
-func (_e *MockContext_Expecter) ClientIP() *MockContext_ClientIP_Call {
-	return &MockContext_ClientIP_Call{Call: _e.mock.On("ClientIP")}
+// BindProtobuf is a helper method to define mock.On call
+//   - obj proto.Message
+func (_e *MockContext_Expecter) BindProtobuf(obj interface{}) *MockContext_BindProtobuf_Call {
+	return &MockContext_BindProtobuf_Call{Call: _e.mock.On("BindProtobuf", obj)}
 }
 
-func (_c *MockContext_ClientIP_Call) Run(run func()) *MockContext_ClientIP_Call {
+func (_c *MockContext_BindProtobuf_Call) Run(run func(obj proto.Message)) *MockContext_BindProtobuf_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(proto.Message))
 	})
 	return _c
 }
 
-func (_c *MockContext_ClientIP_Call) Return(_a0 string) *MockContext_ClientIP_Call {
+func (_c *MockContext_BindProtobuf_Call) Return(_a0 error) *MockContext_BindProtobuf_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_ClientIP_Call) RunAndReturn(run func() string) *MockContext_ClientIP_Call {
+func (_c *MockContext_BindProtobuf_Call) RunAndReturn(run func(proto.Message) error) *MockContext_BindProtobuf_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ContentType provides a mock function with no fields
-func (_m *MockContext) ContentType() string {
-	ret := _m.Called()
+// BindQuery provides a mock function with given fields: obj
+func (_m *MockContext) BindQuery(obj interface{}) error {
+	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ContentType")
+		panic("no return value specified for BindQuery")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_ContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContentType'
-type MockContext_ContentType_Call struct {
+// MockContext_BindQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindQuery'
+type MockContext_BindQuery_Call struct {
 	*mock.Call
 }
 
-// ContentType is a helper method to define mock.On call
-func (_e *MockContext_Expecter) ContentType() *MockContext_ContentType_Call {
-	return &MockContext_ContentType_Call{Call: _e.mock.On("ContentType")}
+// BindQuery is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) BindQuery(obj interface{}) *MockContext_BindQuery_Call {
+	return &MockContext_BindQuery_Call{Call: _e.mock.On("BindQuery", obj)}
 }
 
-func (_c *MockContext_ContentType_Call) Run(run func()) *MockContext_ContentType_Call {
+func (_c *MockContext_BindQuery_Call) Run(run func(obj interface{})) *MockContext_BindQuery_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_ContentType_Call) Return(_a0 string) *MockContext_ContentType_Call {
+func (_c *MockContext_BindQuery_Call) Return(_a0 error) *MockContext_BindQuery_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_ContentType_Call) RunAndReturn(run func() string) *MockContext_ContentType_Call {
+func (_c *MockContext_BindQuery_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindQuery_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Context provides a mock function with no fields
-func (_m *MockContext) Context() context2.Context {
-	ret := _m.Called()
+// BindTOML provides a mock function with given fields: obj
+func (_m *MockContext) BindTOML(obj interface{}) error {
+	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Context")
+		panic("no return value specified for BindTOML")
 	}
 
-	var r0 context2.Context
-	if rf, ok := ret.Get(0).(func() context2.Context); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(context2.Context)
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_Context_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Context'
-type MockContext_Context_Call struct {
+// MockContext_BindTOML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindTOML'
+type MockContext_BindTOML_Call struct {
 	*mock.Call
 }
 
-// Context is a helper method to define mock.On call
-func (_e *MockContext_Expecter) Context() *MockContext_Context_Call {
-	return &MockContext_Context_Call{Call: _e.mock.On("Context")}
+// BindTOML is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) BindTOML(obj interface{}) *MockContext_BindTOML_Call {
+	return &MockContext_BindTOML_Call{Call: _e.mock.On("BindTOML", obj)}
 }
 
-func (_c *MockContext_Context_Call) Run(run func()) *MockContext_Context_Call {
+func (_c *MockContext_BindTOML_Call) Run(run func(obj interface{})) *MockContext_BindTOML_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_Context_Call) Return(_a0 context2.Context) *MockContext_Context_Call {
+func (_c *MockContext_BindTOML_Call) Return(_a0 error) *MockContext_BindTOML_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Context_Call) RunAndReturn(run func() context2.Context) *MockContext_Context_Call {
+func (_c *MockContext_BindTOML_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindTOML_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Cookie provides a mock function with given fields: name
-func (_m *MockContext) Cookie(name string) (string, error) {
-	ret := _m.Called(name)
+// BindURI provides a mock function with given fields: obj
+func (_m *MockContext) BindURI(obj interface{}) error {
+	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Cookie")
-	}
-
-	var r0 string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return rf(name)
-	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(name)
-	} else {
-		r0 = ret.Get(0).(string)
+		panic("no return value specified for BindURI")
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(name)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockContext_Cookie_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cookie'
-type MockContext_Cookie_Call struct {
+// MockContext_BindURI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindURI'
+type MockContext_BindURI_Call struct {
 	*mock.Call
 }
 
-// Cookie is a helper method to define mock.On call
-//   - name string
-func (_e *MockContext_Expecter) Cookie(name interface{}) *MockContext_Cookie_Call {
-	return &MockContext_Cookie_Call{Call: _e.mock.On("Cookie", name)}
+// BindURI is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) BindURI(obj interface{}) *MockContext_BindURI_Call {
+	return &MockContext_BindURI_Call{Call: _e.mock.On("BindURI", obj)}
 }
 
-func (_c *MockContext_Cookie_Call) Run(run func(name string)) *MockContext_Cookie_Call {
+func (_c *MockContext_BindURI_Call) Run(run func(obj interface{})) *MockContext_BindURI_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_Cookie_Call) Return(_a0 string, _a1 error) *MockContext_Cookie_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockContext_BindURI_Call) Return(_a0 error) *MockContext_BindURI_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Cookie_Call) RunAndReturn(run func(string) (string, error)) *MockContext_Cookie_Call {
+func (_c *MockContext_BindURI_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindURI_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Cookies provides a mock function with no fields
-func (_m *MockContext) Cookies() []*http.Cookie {
-	ret := _m.Called()
+// BindWithLimit provides a mock function with given fields: obj, maxBytes
+func (_m *MockContext) BindWithLimit(obj interface{}, maxBytes int64) error {
+	ret := _m.Called(obj, maxBytes)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Cookies")
+		panic("no return value specified for BindWithLimit")
 	}
 
-	var r0 []*http.Cookie
-	if rf, ok := ret.Get(0).(func() []*http.Cookie); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, int64) error); ok {
+		r0 = rf(obj, maxBytes)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*http.Cookie)
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_Cookies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cookies'
-type MockContext_Cookies_Call struct {
+// MockContext_BindWithLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindWithLimit'
+type MockContext_BindWithLimit_Call struct {
 	*mock.Call
 }
 
-// Cookies is a helper method to define mock.On call
-func (_e *MockContext_Expecter) Cookies() *MockContext_Cookies_Call {
-	return &MockContext_Cookies_Call{Call: _e.mock.On("Cookies")}
+// BindWithLimit is a helper method to define mock.On call
+//   - obj interface{}
+//   - maxBytes int64
+func (_e *MockContext_Expecter) BindWithLimit(obj interface{}, maxBytes interface{}) *MockContext_BindWithLimit_Call {
+	return &MockContext_BindWithLimit_Call{Call: _e.mock.On("BindWithLimit", obj, maxBytes)}
 }
 
-func (_c *MockContext_Cookies_Call) Run(run func()) *MockContext_Cookies_Call {
+func (_c *MockContext_BindWithLimit_Call) Run(run func(obj interface{}, maxBytes int64)) *MockContext_BindWithLimit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(interface{}), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockContext_Cookies_Call) Return(_a0 []*http.Cookie) *MockContext_Cookies_Call {
+func (_c *MockContext_BindWithLimit_Call) Return(_a0 error) *MockContext_BindWithLimit_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Cookies_Call) RunAndReturn(run func() []*http.Cookie) *MockContext_Cookies_Call {
+func (_c *MockContext_BindWithLimit_Call) RunAndReturn(run func(interface{}, int64) error) *MockContext_BindWithLimit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DefaultForm provides a mock function with given fields: name, defaultValue
-func (_m *MockContext) DefaultForm(name string, defaultValue string) string {
-	ret := _m.Called(name, defaultValue)
+// BindXML provides a mock function with given fields: obj
+func (_m *MockContext) BindXML(obj interface{}) error {
+	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DefaultForm")
+		panic("no return value specified for BindXML")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = rf(name, defaultValue)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_DefaultForm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DefaultForm'
-type MockContext_DefaultForm_Call struct {
+// MockContext_BindXML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindXML'
+type MockContext_BindXML_Call struct {
 	*mock.Call
 }
 
-// DefaultForm is a helper method to define mock.On call
-//   - name string
-//   - defaultValue string
-func (_e *MockContext_Expecter) DefaultForm(name interface{}, defaultValue interface{}) *MockContext_DefaultForm_Call {
-	return &MockContext_DefaultForm_Call{Call: _e.mock.On("DefaultForm", name, defaultValue)}
+// BindXML is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) BindXML(obj interface{}) *MockContext_BindXML_Call {
+	return &MockContext_BindXML_Call{Call: _e.mock.On("BindXML", obj)}
 }
 
-func (_c *MockContext_DefaultForm_Call) Run(run func(name string, defaultValue string)) *MockContext_DefaultForm_Call {
+func (_c *MockContext_BindXML_Call) Run(run func(obj interface{})) *MockContext_BindXML_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_DefaultForm_Call) Return(_a0 string) *MockContext_DefaultForm_Call {
+func (_c *MockContext_BindXML_Call) Return(_a0 error) *MockContext_BindXML_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_DefaultForm_Call) RunAndReturn(run func(string, string) string) *MockContext_DefaultForm_Call {
+func (_c *MockContext_BindXML_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindXML_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DefaultQuery provides a mock function with given fields: name, defaultValue
-func (_m *MockContext) DefaultQuery(name string, defaultValue string) string {
-	ret := _m.Called(name, defaultValue)
+// BindYAML provides a mock function with given fields: obj
+func (_m *MockContext) BindYAML(obj interface{}) error {
+	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DefaultQuery")
+		panic("no return value specified for BindYAML")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = rf(name, defaultValue)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_DefaultQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DefaultQuery'
-type MockContext_DefaultQuery_Call struct {
+// MockContext_BindYAML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindYAML'
+type MockContext_BindYAML_Call struct {
 	*mock.Call
 }
 
-// DefaultQuery is a helper method to define mock.On call
-//   - name string
-//   - defaultValue string
-func (_e *MockContext_Expecter) DefaultQuery(name interface{}, defaultValue interface{}) *MockContext_DefaultQuery_Call {
-	return &MockContext_DefaultQuery_Call{Call: _e.mock.On("DefaultQuery", name, defaultValue)}
+// BindYAML is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) BindYAML(obj interface{}) *MockContext_BindYAML_Call {
+	return &MockContext_BindYAML_Call{Call: _e.mock.On("BindYAML", obj)}
 }
 
-func (_c *MockContext_DefaultQuery_Call) Run(run func(name string, defaultValue string)) *MockContext_DefaultQuery_Call {
+func (_c *MockContext_BindYAML_Call) Run(run func(obj interface{})) *MockContext_BindYAML_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_DefaultQuery_Call) Return(_a0 string) *MockContext_DefaultQuery_Call {
+func (_c *MockContext_BindYAML_Call) Return(_a0 error) *MockContext_BindYAML_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_DefaultQuery_Call) RunAndReturn(run func(string, string) string) *MockContext_DefaultQuery_Call {
+func (_c *MockContext_BindYAML_Call) RunAndReturn(run func(interface{}) error) *MockContext_BindYAML_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Error provides a mock function with given fields: err
-func (_m *MockContext) Error(err error) {
-	_m.Called(err)
+// Blob provides a mock function with given fields: code, contentType, data
+func (_m *MockContext) Blob(code int, contentType string, data []byte) {
+	_m.Called(code, contentType, data)
 }
 
-// MockContext_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
-type MockContext_Error_Call struct {
+// MockContext_Blob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Blob'
+type MockContext_Blob_Call struct {
 	*mock.Call
 }
 
-// Error is a helper method to define mock.On call
-//   - err error
-func (_e *MockContext_Expecter) Error(err interface{}) *MockContext_Error_Call {
-	return &MockContext_Error_Call{Call: _e.mock.On("Error", err)}
+// Blob is a helper method to define mock.On call
+//   - code int
+//   - contentType string
+//   - data []byte
+func (_e *MockContext_Expecter) Blob(code interface{}, contentType interface{}, data interface{}) *MockContext_Blob_Call {
+	return &MockContext_Blob_Call{Call: _e.mock.On("Blob", code, contentType, data)}
 }
 
-func (_c *MockContext_Error_Call) Run(run func(err error)) *MockContext_Error_Call {
+func (_c *MockContext_Blob_Call) Run(run func(code int, contentType string, data []byte)) *MockContext_Blob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(error))
+		run(args[0].(int), args[1].(string), args[2].([]byte))
 	})
 	return _c
 }
 
-func (_c *MockContext_Error_Call) Return() *MockContext_Error_Call {
+func (_c *MockContext_Blob_Call) Return() *MockContext_Blob_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockContext_Error_Call) RunAndReturn(run func(error)) *MockContext_Error_Call {
+func (_c *MockContext_Blob_Call) RunAndReturn(run func(int, string, []byte)) *MockContext_Blob_Call {
 	_c.Run(run)
 	return _c
 }
 
-// File provides a mock function with given fields: filepath
-func (_m *MockContext) File(filepath string) {
-	_m.Called(filepath)
+// BridgeStore provides a mock function with given fields: keys
+func (_m *MockContext) BridgeStore(keys ...string) {
+	_va := make([]interface{}, len(keys))
+	for _i := range keys {
+		_va[_i] = keys[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
 }
 
-// MockContext_File_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'File'
-type MockContext_File_Call struct {
+// MockContext_BridgeStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeStore'
+type MockContext_BridgeStore_Call struct {
 	*mock.Call
 }
 
-// File is a helper method to define mock.On call
-//   - filepath string
-func (_e *MockContext_Expecter) File(filepath interface{}) *MockContext_File_Call {
-	return &MockContext_File_Call{Call: _e.mock.On("File", filepath)}
+// BridgeStore is a helper method to define mock.On call
+//   - keys ...string
+func (_e *MockContext_Expecter) BridgeStore(keys ...interface{}) *MockContext_BridgeStore_Call {
+	return &MockContext_BridgeStore_Call{Call: _e.mock.On("BridgeStore",
+		append([]interface{}{}, keys...)...)}
 }
 
-func (_c *MockContext_File_Call) Run(run func(filepath string)) *MockContext_File_Call {
+func (_c *MockContext_BridgeStore_Call) Run(run func(keys ...string)) *MockContext_BridgeStore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockContext_File_Call) Return() *MockContext_File_Call {
+func (_c *MockContext_BridgeStore_Call) Return() *MockContext_BridgeStore_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockContext_File_Call) RunAndReturn(run func(string)) *MockContext_File_Call {
+func (_c *MockContext_BridgeStore_Call) RunAndReturn(run func(...string)) *MockContext_BridgeStore_Call {
 	_c.Run(run)
 	return _c
 }
 
-// FileAttachment provides a mock function with given fields: filepath, filename
-func (_m *MockContext) FileAttachment(filepath string, filename string) {
-	_m.Called(filepath, filename)
+// ClientIP provides a mock function with no fields
+func (_m *MockContext) ClientIP() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClientIP")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
 }
 
-// MockContext_FileAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FileAttachment'
-type MockContext_FileAttachment_Call struct {
+// MockContext_ClientIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientIP'
+type MockContext_ClientIP_Call struct {
 	*mock.Call
 }
 
-// FileAttachment is a helper method to define mock.On call
-//   - filepath string
-//   - filename string
-func (_e *MockContext_Expecter) FileAttachment(filepath interface{}, filename interface{}) *MockContext_FileAttachment_Call {
-	return &MockContext_FileAttachment_Call{Call: _e.mock.On("FileAttachment", filepath, filename)}
+// ClientIP is a helper method to define mock.On call
+func (_e *MockContext_Expecter) ClientIP() *MockContext_ClientIP_Call {
+	return &MockContext_ClientIP_Call{Call: _e.mock.On("ClientIP")}
 }
 
-func (_c *MockContext_FileAttachment_Call) Run(run func(filepath string, filename string)) *MockContext_FileAttachment_Call {
+func (_c *MockContext_ClientIP_Call) Run(run func()) *MockContext_ClientIP_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_FileAttachment_Call) Return() *MockContext_FileAttachment_Call {
-	_c.Call.Return()
+func (_c *MockContext_ClientIP_Call) Return(_a0 string) *MockContext_ClientIP_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_FileAttachment_Call) RunAndReturn(run func(string, string)) *MockContext_FileAttachment_Call {
-	_c.Run(run)
+func (_c *MockContext_ClientIP_Call) RunAndReturn(run func() string) *MockContext_ClientIP_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// Form provides a mock function with given fields: name
-func (_m *MockContext) Form(name string) string {
-	ret := _m.Called(name)
+// ContentType provides a mock function with no fields
+func (_m *MockContext) ContentType() string {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Form")
+		panic("no return value specified for ContentType")
 	}
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
 	} else {
 		r0 = ret.Get(0).(string)
 	}
@@ -828,101 +996,97 @@ func (_m *MockContext) Form(name string) string {
 	return r0
 }
 
-// MockContext_Form_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Form'
-type MockContext_Form_Call struct {
+// MockContext_ContentType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContentType'
+type MockContext_ContentType_Call struct {
 	*mock.Call
 }
 
-// Form is a helper method to define mock.On call
-//   - name string
-func (_e *MockContext_Expecter) Form(name interface{}) *MockContext_Form_Call {
-	return &MockContext_Form_Call{Call: _e.mock.On("Form", name)}
+// ContentType is a helper method to define mock.On call
+func (_e *MockContext_Expecter) ContentType() *MockContext_ContentType_Call {
+	return &MockContext_ContentType_Call{Call: _e.mock.On("ContentType")}
 }
 
-func (_c *MockContext_Form_Call) Run(run func(name string)) *MockContext_Form_Call {
+func (_c *MockContext_ContentType_Call) Run(run func()) *MockContext_ContentType_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_Form_Call) Return(_a0 string) *MockContext_Form_Call {
+func (_c *MockContext_ContentType_Call) Return(_a0 string) *MockContext_ContentType_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Form_Call) RunAndReturn(run func(string) string) *MockContext_Form_Call {
+func (_c *MockContext_ContentType_Call) RunAndReturn(run func() string) *MockContext_ContentType_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FormArray provides a mock function with given fields: name
-func (_m *MockContext) FormArray(name string) []string {
-	ret := _m.Called(name)
+// Context provides a mock function with no fields
+func (_m *MockContext) Context() context2.Context {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for FormArray")
+		panic("no return value specified for Context")
 	}
 
-	var r0 []string
-	if rf, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = rf(name)
-	} else {
+	var r0 context2.Context
+	if rf, ok := ret.Get(0).(func() context2.Context); ok {
+		r0 = rf()
+	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(context2.Context)
 		}
 	}
 
 	return r0
 }
 
-// MockContext_FormArray_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FormArray'
-type MockContext_FormArray_Call struct {
+// MockContext_Context_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Context'
+type MockContext_Context_Call struct {
 	*mock.Call
 }
 
-// FormArray is a helper method to define mock.On call
-//   - name string
-func (_e *MockContext_Expecter) FormArray(name interface{}) *MockContext_FormArray_Call {
-	return &MockContext_FormArray_Call{Call: _e.mock.On("FormArray", name)}
+// Context is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Context() *MockContext_Context_Call {
+	return &MockContext_Context_Call{Call: _e.mock.On("Context")}
 }
 
-func (_c *MockContext_FormArray_Call) Run(run func(name string)) *MockContext_FormArray_Call {
+func (_c *MockContext_Context_Call) Run(run func()) *MockContext_Context_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_FormArray_Call) Return(_a0 []string) *MockContext_FormArray_Call {
+func (_c *MockContext_Context_Call) Return(_a0 context2.Context) *MockContext_Context_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_FormArray_Call) RunAndReturn(run func(string) []string) *MockContext_FormArray_Call {
+func (_c *MockContext_Context_Call) RunAndReturn(run func() context2.Context) *MockContext_Context_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FormFile provides a mock function with given fields: name
-func (_m *MockContext) FormFile(name string) (*multipart.FileHeader, error) {
+// Cookie provides a mock function with given fields: name
+func (_m *MockContext) Cookie(name string) (string, error) {
 	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FormFile")
+		panic("no return value specified for Cookie")
 	}
 
-	var r0 *multipart.FileHeader
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*multipart.FileHeader, error)); ok {
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
 		return rf(name)
 	}
-	if rf, ok := ret.Get(0).(func(string) *multipart.FileHeader); ok {
+	if rf, ok := ret.Get(0).(func(string) string); ok {
 		r0 = rf(name)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*multipart.FileHeader)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	if rf, ok := ret.Get(1).(func(string) error); ok {
@@ -934,1221 +1098,1298 @@ func (_m *MockContext) FormFile(name string) (*multipart.FileHeader, error) {
 	return r0, r1
 }
 
-// MockContext_FormFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FormFile'
-type MockContext_FormFile_Call struct {
+// MockContext_Cookie_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cookie'
+type MockContext_Cookie_Call struct {
 	*mock.Call
 }
 
-// FormFile is a helper method to define mock.On call
+// Cookie is a helper method to define mock.On call
 //   - name string
-func (_e *MockContext_Expecter) FormFile(name interface{}) *MockContext_FormFile_Call {
-	return &MockContext_FormFile_Call{Call: _e.mock.On("FormFile", name)}
+func (_e *MockContext_Expecter) Cookie(name interface{}) *MockContext_Cookie_Call {
+	return &MockContext_Cookie_Call{Call: _e.mock.On("Cookie", name)}
 }
 
-func (_c *MockContext_FormFile_Call) Run(run func(name string)) *MockContext_FormFile_Call {
+func (_c *MockContext_Cookie_Call) Run(run func(name string)) *MockContext_Cookie_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_FormFile_Call) Return(_a0 *multipart.FileHeader, _a1 error) *MockContext_FormFile_Call {
+func (_c *MockContext_Cookie_Call) Return(_a0 string, _a1 error) *MockContext_Cookie_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_FormFile_Call) RunAndReturn(run func(string) (*multipart.FileHeader, error)) *MockContext_FormFile_Call {
+func (_c *MockContext_Cookie_Call) RunAndReturn(run func(string) (string, error)) *MockContext_Cookie_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FormMap provides a mock function with given fields: prefix
-func (_m *MockContext) FormMap(prefix string) map[string]string {
-	ret := _m.Called(prefix)
+// Cookies provides a mock function with no fields
+func (_m *MockContext) Cookies() []*http.Cookie {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for FormMap")
+		panic("no return value specified for Cookies")
 	}
 
-	var r0 map[string]string
-	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
-		r0 = rf(prefix)
+	var r0 []*http.Cookie
+	if rf, ok := ret.Get(0).(func() []*http.Cookie); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]string)
+			r0 = ret.Get(0).([]*http.Cookie)
 		}
 	}
 
 	return r0
 }
 
-// MockContext_FormMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FormMap'
-type MockContext_FormMap_Call struct {
+// MockContext_Cookies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cookies'
+type MockContext_Cookies_Call struct {
 	*mock.Call
 }
 
-// FormMap is a helper method to define mock.On call
-//   - prefix string
-func (_e *MockContext_Expecter) FormMap(prefix interface{}) *MockContext_FormMap_Call {
-	return &MockContext_FormMap_Call{Call: _e.mock.On("FormMap", prefix)}
+// Cookies is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Cookies() *MockContext_Cookies_Call {
+	return &MockContext_Cookies_Call{Call: _e.mock.On("Cookies")}
 }
 
-func (_c *MockContext_FormMap_Call) Run(run func(prefix string)) *MockContext_FormMap_Call {
+func (_c *MockContext_Cookies_Call) Run(run func()) *MockContext_Cookies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_FormMap_Call) Return(_a0 map[string]string) *MockContext_FormMap_Call {
+func (_c *MockContext_Cookies_Call) Return(_a0 []*http.Cookie) *MockContext_Cookies_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_FormMap_Call) RunAndReturn(run func(string) map[string]string) *MockContext_FormMap_Call {
+func (_c *MockContext_Cookies_Call) RunAndReturn(run func() []*http.Cookie) *MockContext_Cookies_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function with given fields: key
-func (_m *MockContext) Get(key string) (interface{}, bool) {
-	ret := _m.Called(key)
+// Copy provides a mock function with no fields
+func (_m *MockContext) Copy() context.Context {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for Copy")
 	}
 
-	var r0 interface{}
-	var r1 bool
-	if rf, ok := ret.Get(0).(func(string) (interface{}, bool)); ok {
-		return rf(key)
-	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(key)
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).(context.Context)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) bool); ok {
-		r1 = rf(key)
-	} else {
-		r1 = ret.Get(1).(bool)
-	}
-
-	return r0, r1
+	return r0
 }
 
-// MockContext_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockContext_Get_Call struct {
+// MockContext_Copy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Copy'
+type MockContext_Copy_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) Get(key interface{}) *MockContext_Get_Call {
-	return &MockContext_Get_Call{Call: _e.mock.On("Get", key)}
+// Copy is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Copy() *MockContext_Copy_Call {
+	return &MockContext_Copy_Call{Call: _e.mock.On("Copy")}
 }
 
-func (_c *MockContext_Get_Call) Run(run func(key string)) *MockContext_Get_Call {
+func (_c *MockContext_Copy_Call) Run(run func()) *MockContext_Copy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_Get_Call) Return(_a0 interface{}, _a1 bool) *MockContext_Get_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockContext_Copy_Call) Return(_a0 context.Context) *MockContext_Copy_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Get_Call) RunAndReturn(run func(string) (interface{}, bool)) *MockContext_Get_Call {
+func (_c *MockContext_Copy_Call) RunAndReturn(run func() context.Context) *MockContext_Copy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBool provides a mock function with given fields: key
-func (_m *MockContext) GetBool(key string) bool {
-	ret := _m.Called(key)
+// Deadline provides a mock function with no fields
+func (_m *MockContext) Deadline() (time.Time, bool) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBool")
+		panic("no return value specified for Deadline")
 	}
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(key)
+	var r0 time.Time
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (time.Time, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(time.Time)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// MockContext_GetBool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBool'
-type MockContext_GetBool_Call struct {
+// MockContext_Deadline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deadline'
+type MockContext_Deadline_Call struct {
 	*mock.Call
 }
 
-// GetBool is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetBool(key interface{}) *MockContext_GetBool_Call {
-	return &MockContext_GetBool_Call{Call: _e.mock.On("GetBool", key)}
+// Deadline is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Deadline() *MockContext_Deadline_Call {
+	return &MockContext_Deadline_Call{Call: _e.mock.On("Deadline")}
 }
 
-func (_c *MockContext_GetBool_Call) Run(run func(key string)) *MockContext_GetBool_Call {
+func (_c *MockContext_Deadline_Call) Run(run func()) *MockContext_Deadline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_GetBool_Call) Return(_a0 bool) *MockContext_GetBool_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_Deadline_Call) Return(deadline time.Time, ok bool) *MockContext_Deadline_Call {
+	_c.Call.Return(deadline, ok)
 	return _c
 }
 
-func (_c *MockContext_GetBool_Call) RunAndReturn(run func(string) bool) *MockContext_GetBool_Call {
+func (_c *MockContext_Deadline_Call) RunAndReturn(run func() (time.Time, bool)) *MockContext_Deadline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDuration provides a mock function with given fields: key
-func (_m *MockContext) GetDuration(key string) time.Duration {
-	ret := _m.Called(key)
+// DefaultForm provides a mock function with given fields: name, defaultValue
+func (_m *MockContext) DefaultForm(name string, defaultValue string) string {
+	ret := _m.Called(name, defaultValue)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDuration")
+		panic("no return value specified for DefaultForm")
 	}
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func(string) time.Duration); ok {
-		r0 = rf(key)
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(name, defaultValue)
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// MockContext_GetDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuration'
-type MockContext_GetDuration_Call struct {
+// MockContext_DefaultForm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DefaultForm'
+type MockContext_DefaultForm_Call struct {
 	*mock.Call
 }
 
-// GetDuration is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetDuration(key interface{}) *MockContext_GetDuration_Call {
-	return &MockContext_GetDuration_Call{Call: _e.mock.On("GetDuration", key)}
+// DefaultForm is a helper method to define mock.On call
+//   - name string
+//   - defaultValue string
+func (_e *MockContext_Expecter) DefaultForm(name interface{}, defaultValue interface{}) *MockContext_DefaultForm_Call {
+	return &MockContext_DefaultForm_Call{Call: _e.mock.On("DefaultForm", name, defaultValue)}
 }
 
-func (_c *MockContext_GetDuration_Call) Run(run func(key string)) *MockContext_GetDuration_Call {
+func (_c *MockContext_DefaultForm_Call) Run(run func(name string, defaultValue string)) *MockContext_DefaultForm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_GetDuration_Call) Return(_a0 time.Duration) *MockContext_GetDuration_Call {
+func (_c *MockContext_DefaultForm_Call) Return(_a0 string) *MockContext_DefaultForm_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetDuration_Call) RunAndReturn(run func(string) time.Duration) *MockContext_GetDuration_Call {
+func (_c *MockContext_DefaultForm_Call) RunAndReturn(run func(string, string) string) *MockContext_DefaultForm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFloat64 provides a mock function with given fields: key
-func (_m *MockContext) GetFloat64(key string) float64 {
-	ret := _m.Called(key)
+// DefaultQuery provides a mock function with given fields: name, defaultValue
+func (_m *MockContext) DefaultQuery(name string, defaultValue string) string {
+	ret := _m.Called(name, defaultValue)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFloat64")
+		panic("no return value specified for DefaultQuery")
 	}
 
-	var r0 float64
-	if rf, ok := ret.Get(0).(func(string) float64); ok {
-		r0 = rf(key)
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(name, defaultValue)
 	} else {
-		r0 = ret.Get(0).(float64)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// MockContext_GetFloat64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFloat64'
-type MockContext_GetFloat64_Call struct {
+// MockContext_DefaultQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DefaultQuery'
+type MockContext_DefaultQuery_Call struct {
 	*mock.Call
 }
 
-// GetFloat64 is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetFloat64(key interface{}) *MockContext_GetFloat64_Call {
-	return &MockContext_GetFloat64_Call{Call: _e.mock.On("GetFloat64", key)}
+// DefaultQuery is a helper method to define mock.On call
+//   - name string
+//   - defaultValue string
+func (_e *MockContext_Expecter) DefaultQuery(name interface{}, defaultValue interface{}) *MockContext_DefaultQuery_Call {
+	return &MockContext_DefaultQuery_Call{Call: _e.mock.On("DefaultQuery", name, defaultValue)}
 }
 
-func (_c *MockContext_GetFloat64_Call) Run(run func(key string)) *MockContext_GetFloat64_Call {
+func (_c *MockContext_DefaultQuery_Call) Run(run func(name string, defaultValue string)) *MockContext_DefaultQuery_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_GetFloat64_Call) Return(_a0 float64) *MockContext_GetFloat64_Call {
+func (_c *MockContext_DefaultQuery_Call) Return(_a0 string) *MockContext_DefaultQuery_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetFloat64_Call) RunAndReturn(run func(string) float64) *MockContext_GetFloat64_Call {
+func (_c *MockContext_DefaultQuery_Call) RunAndReturn(run func(string, string) string) *MockContext_DefaultQuery_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetHeader provides a mock function with given fields: key
-func (_m *MockContext) GetHeader(key string) string {
-	ret := _m.Called(key)
+// Done provides a mock function with no fields
+func (_m *MockContext) Done() <-chan struct{} {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetHeader")
+		panic("no return value specified for Done")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(key)
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
 	}
 
 	return r0
 }
 
-// MockContext_GetHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeader'
-type MockContext_GetHeader_Call struct {
+// MockContext_Done_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Done'
+type MockContext_Done_Call struct {
 	*mock.Call
 }
 
-// GetHeader is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetHeader(key interface{}) *MockContext_GetHeader_Call {
-	return &MockContext_GetHeader_Call{Call: _e.mock.On("GetHeader", key)}
+// Done is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Done() *MockContext_Done_Call {
+	return &MockContext_Done_Call{Call: _e.mock.On("Done")}
 }
 
-func (_c *MockContext_GetHeader_Call) Run(run func(key string)) *MockContext_GetHeader_Call {
+func (_c *MockContext_Done_Call) Run(run func()) *MockContext_Done_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_GetHeader_Call) Return(_a0 string) *MockContext_GetHeader_Call {
+func (_c *MockContext_Done_Call) Return(_a0 <-chan struct{}) *MockContext_Done_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetHeader_Call) RunAndReturn(run func(string) string) *MockContext_GetHeader_Call {
+func (_c *MockContext_Done_Call) RunAndReturn(run func() <-chan struct{}) *MockContext_Done_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetInt provides a mock function with given fields: key
-func (_m *MockContext) GetInt(key string) int {
-	ret := _m.Called(key)
+// EarlyHints provides a mock function with given fields: links
+func (_m *MockContext) EarlyHints(links []string) error {
+	ret := _m.Called(links)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetInt")
+		panic("no return value specified for EarlyHints")
 	}
 
-	var r0 int
-	if rf, ok := ret.Get(0).(func(string) int); ok {
-		r0 = rf(key)
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(links)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_GetInt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInt'
-type MockContext_GetInt_Call struct {
+// MockContext_EarlyHints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EarlyHints'
+type MockContext_EarlyHints_Call struct {
 	*mock.Call
 }
 
-// GetInt is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetInt(key interface{}) *MockContext_GetInt_Call {
-	return &MockContext_GetInt_Call{Call: _e.mock.On("GetInt", key)}
+// EarlyHints is a helper method to define mock.On call
+//   - links []string
+func (_e *MockContext_Expecter) EarlyHints(links interface{}) *MockContext_EarlyHints_Call {
+	return &MockContext_EarlyHints_Call{Call: _e.mock.On("EarlyHints", links)}
 }
 
-func (_c *MockContext_GetInt_Call) Run(run func(key string)) *MockContext_GetInt_Call {
+func (_c *MockContext_EarlyHints_Call) Run(run func(links []string)) *MockContext_EarlyHints_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].([]string))
 	})
 	return _c
 }
 
-func (_c *MockContext_GetInt_Call) Return(_a0 int) *MockContext_GetInt_Call {
+func (_c *MockContext_EarlyHints_Call) Return(_a0 error) *MockContext_EarlyHints_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetInt_Call) RunAndReturn(run func(string) int) *MockContext_GetInt_Call {
+func (_c *MockContext_EarlyHints_Call) RunAndReturn(run func([]string) error) *MockContext_EarlyHints_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetInt64 provides a mock function with given fields: key
-func (_m *MockContext) GetInt64(key string) int64 {
-	ret := _m.Called(key)
+// Err provides a mock function with no fields
+func (_m *MockContext) Err() error {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetInt64")
+		panic("no return value specified for Err")
 	}
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
-		r0 = rf(key)
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_GetInt64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInt64'
-type MockContext_GetInt64_Call struct {
+// MockContext_Err_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Err'
+type MockContext_Err_Call struct {
 	*mock.Call
 }
 
-// GetInt64 is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetInt64(key interface{}) *MockContext_GetInt64_Call {
-	return &MockContext_GetInt64_Call{Call: _e.mock.On("GetInt64", key)}
+// Err is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Err() *MockContext_Err_Call {
+	return &MockContext_Err_Call{Call: _e.mock.On("Err")}
 }
 
-func (_c *MockContext_GetInt64_Call) Run(run func(key string)) *MockContext_GetInt64_Call {
+func (_c *MockContext_Err_Call) Run(run func()) *MockContext_Err_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_GetInt64_Call) Return(_a0 int64) *MockContext_GetInt64_Call {
+func (_c *MockContext_Err_Call) Return(_a0 error) *MockContext_Err_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetInt64_Call) RunAndReturn(run func(string) int64) *MockContext_GetInt64_Call {
+func (_c *MockContext_Err_Call) RunAndReturn(run func() error) *MockContext_Err_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRawData provides a mock function with no fields
-func (_m *MockContext) GetRawData() ([]byte, error) {
-	ret := _m.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetRawData")
-	}
-
-	var r0 []byte
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
-		return rf()
-	}
-	if rf, ok := ret.Get(0).(func() []byte); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]byte)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+// Error provides a mock function with given fields: err
+func (_m *MockContext) Error(err error) {
+	_m.Called(err)
 }
 
-// MockContext_GetRawData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRawData'
-type MockContext_GetRawData_Call struct {
+// MockContext_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockContext_Error_Call struct {
 	*mock.Call
 }
 
-// GetRawData is a helper method to define mock.On call
-func (_e *MockContext_Expecter) GetRawData() *MockContext_GetRawData_Call {
-	return &MockContext_GetRawData_Call{Call: _e.mock.On("GetRawData")}
+// Error is a helper method to define mock.On call
+//   - err error
+func (_e *MockContext_Expecter) Error(err interface{}) *MockContext_Error_Call {
+	return &MockContext_Error_Call{Call: _e.mock.On("Error", err)}
 }
 
-func (_c *MockContext_GetRawData_Call) Run(run func()) *MockContext_GetRawData_Call {
+func (_c *MockContext_Error_Call) Run(run func(err error)) *MockContext_Error_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(error))
 	})
 	return _c
 }
 
-func (_c *MockContext_GetRawData_Call) Return(_a0 []byte, _a1 error) *MockContext_GetRawData_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockContext_Error_Call) Return() *MockContext_Error_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockContext_GetRawData_Call) RunAndReturn(run func() ([]byte, error)) *MockContext_GetRawData_Call {
-	_c.Call.Return(run)
+func (_c *MockContext_Error_Call) RunAndReturn(run func(error)) *MockContext_Error_Call {
+	_c.Run(run)
 	return _c
 }
 
-// GetString provides a mock function with given fields: key
-func (_m *MockContext) GetString(key string) string {
-	ret := _m.Called(key)
+// Errors provides a mock function with no fields
+func (_m *MockContext) Errors() []error {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetString")
+		panic("no return value specified for Errors")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(key)
+	var r0 []error
+	if rf, ok := ret.Get(0).(func() []error); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
 	}
 
 	return r0
 }
 
-// MockContext_GetString_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetString'
-type MockContext_GetString_Call struct {
+// MockContext_Errors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Errors'
+type MockContext_Errors_Call struct {
 	*mock.Call
 }
 
-// GetString is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetString(key interface{}) *MockContext_GetString_Call {
-	return &MockContext_GetString_Call{Call: _e.mock.On("GetString", key)}
+// Errors is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Errors() *MockContext_Errors_Call {
+	return &MockContext_Errors_Call{Call: _e.mock.On("Errors")}
 }
 
-func (_c *MockContext_GetString_Call) Run(run func(key string)) *MockContext_GetString_Call {
+func (_c *MockContext_Errors_Call) Run(run func()) *MockContext_Errors_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_GetString_Call) Return(_a0 string) *MockContext_GetString_Call {
+func (_c *MockContext_Errors_Call) Return(_a0 []error) *MockContext_Errors_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetString_Call) RunAndReturn(run func(string) string) *MockContext_GetString_Call {
+func (_c *MockContext_Errors_Call) RunAndReturn(run func() []error) *MockContext_Errors_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStringMap provides a mock function with given fields: key
-func (_m *MockContext) GetStringMap(key string) map[string]interface{} {
-	ret := _m.Called(key)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetStringMap")
-	}
-
-	var r0 map[string]interface{}
-	if rf, ok := ret.Get(0).(func(string) map[string]interface{}); ok {
-		r0 = rf(key)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]interface{})
-		}
-	}
-
-	return r0
+// File provides a mock function with given fields: filepath
+func (_m *MockContext) File(filepath string) {
+	_m.Called(filepath)
 }
 
-// MockContext_GetStringMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringMap'
-type MockContext_GetStringMap_Call struct {
+// MockContext_File_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'File'
+type MockContext_File_Call struct {
 	*mock.Call
 }
 
-// GetStringMap is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetStringMap(key interface{}) *MockContext_GetStringMap_Call {
-	return &MockContext_GetStringMap_Call{Call: _e.mock.On("GetStringMap", key)}
+// File is a helper method to define mock.On call
+//   - filepath string
+func (_e *MockContext_Expecter) File(filepath interface{}) *MockContext_File_Call {
+	return &MockContext_File_Call{Call: _e.mock.On("File", filepath)}
 }
 
-func (_c *MockContext_GetStringMap_Call) Run(run func(key string)) *MockContext_GetStringMap_Call {
+func (_c *MockContext_File_Call) Run(run func(filepath string)) *MockContext_File_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_GetStringMap_Call) Return(_a0 map[string]interface{}) *MockContext_GetStringMap_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_File_Call) Return() *MockContext_File_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockContext_GetStringMap_Call) RunAndReturn(run func(string) map[string]interface{}) *MockContext_GetStringMap_Call {
-	_c.Call.Return(run)
+func (_c *MockContext_File_Call) RunAndReturn(run func(string)) *MockContext_File_Call {
+	_c.Run(run)
 	return _c
 }
 
-// GetStringMapString provides a mock function with given fields: key
-func (_m *MockContext) GetStringMapString(key string) map[string]string {
-	ret := _m.Called(key)
+// FileAttachment provides a mock function with given fields: filepath, filename
+func (_m *MockContext) FileAttachment(filepath string, filename string) {
+	_m.Called(filepath, filename)
+}
 
-	if len(ret) == 0 {
-		panic("no return value specified for GetStringMapString")
-	}
+// MockContext_FileAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FileAttachment'
+type MockContext_FileAttachment_Call struct {
+	*mock.Call
+}
 
-	var r0 map[string]string
-	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
-		r0 = rf(key)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]string)
-		}
-	}
+// FileAttachment is a helper method to define mock.On call
+//   - filepath string
+//   - filename string
+func (_e *MockContext_Expecter) FileAttachment(filepath interface{}, filename interface{}) *MockContext_FileAttachment_Call {
+	return &MockContext_FileAttachment_Call{Call: _e.mock.On("FileAttachment", filepath, filename)}
+}
 
-	return r0
+func (_c *MockContext_FileAttachment_Call) Run(run func(filepath string, filename string)) *MockContext_FileAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
 }
 
-// MockContext_GetStringMapString_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringMapString'
-type MockContext_GetStringMapString_Call struct {
+func (_c *MockContext_FileAttachment_Call) Return() *MockContext_FileAttachment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_FileAttachment_Call) RunAndReturn(run func(string, string)) *MockContext_FileAttachment_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Flash provides a mock function with given fields: category, message
+func (_m *MockContext) Flash(category string, message string) {
+	_m.Called(category, message)
+}
+
+// MockContext_Flash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flash'
+type MockContext_Flash_Call struct {
 	*mock.Call
 }
 
-// GetStringMapString is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetStringMapString(key interface{}) *MockContext_GetStringMapString_Call {
-	return &MockContext_GetStringMapString_Call{Call: _e.mock.On("GetStringMapString", key)}
+// Flash is a helper method to define mock.On call
+//   - category string
+//   - message string
+func (_e *MockContext_Expecter) Flash(category interface{}, message interface{}) *MockContext_Flash_Call {
+	return &MockContext_Flash_Call{Call: _e.mock.On("Flash", category, message)}
 }
 
-func (_c *MockContext_GetStringMapString_Call) Run(run func(key string)) *MockContext_GetStringMapString_Call {
+func (_c *MockContext_Flash_Call) Run(run func(category string, message string)) *MockContext_Flash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_GetStringMapString_Call) Return(_a0 map[string]string) *MockContext_GetStringMapString_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_Flash_Call) Return() *MockContext_Flash_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockContext_GetStringMapString_Call) RunAndReturn(run func(string) map[string]string) *MockContext_GetStringMapString_Call {
-	_c.Call.Return(run)
+func (_c *MockContext_Flash_Call) RunAndReturn(run func(string, string)) *MockContext_Flash_Call {
+	_c.Run(run)
 	return _c
 }
 
-// GetStringSlice provides a mock function with given fields: key
-func (_m *MockContext) GetStringSlice(key string) []string {
-	ret := _m.Called(key)
+// Flashes provides a mock function with no fields
+func (_m *MockContext) Flashes() []context.FlashMessage {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStringSlice")
+		panic("no return value specified for Flashes")
 	}
 
-	var r0 []string
-	if rf, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = rf(key)
+	var r0 []context.FlashMessage
+	if rf, ok := ret.Get(0).(func() []context.FlashMessage); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).([]context.FlashMessage)
 		}
 	}
 
 	return r0
 }
 
-// MockContext_GetStringSlice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringSlice'
-type MockContext_GetStringSlice_Call struct {
+// MockContext_Flashes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flashes'
+type MockContext_Flashes_Call struct {
 	*mock.Call
 }
 
-// GetStringSlice is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetStringSlice(key interface{}) *MockContext_GetStringSlice_Call {
-	return &MockContext_GetStringSlice_Call{Call: _e.mock.On("GetStringSlice", key)}
+// Flashes is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Flashes() *MockContext_Flashes_Call {
+	return &MockContext_Flashes_Call{Call: _e.mock.On("Flashes")}
 }
 
-func (_c *MockContext_GetStringSlice_Call) Run(run func(key string)) *MockContext_GetStringSlice_Call {
+func (_c *MockContext_Flashes_Call) Run(run func()) *MockContext_Flashes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_GetStringSlice_Call) Return(_a0 []string) *MockContext_GetStringSlice_Call {
+func (_c *MockContext_Flashes_Call) Return(_a0 []context.FlashMessage) *MockContext_Flashes_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetStringSlice_Call) RunAndReturn(run func(string) []string) *MockContext_GetStringSlice_Call {
+func (_c *MockContext_Flashes_Call) RunAndReturn(run func() []context.FlashMessage) *MockContext_Flashes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTime provides a mock function with given fields: key
-func (_m *MockContext) GetTime(key string) time.Time {
-	ret := _m.Called(key)
+// Form provides a mock function with given fields: name
+func (_m *MockContext) Form(name string) string {
+	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTime")
+		panic("no return value specified for Form")
 	}
 
-	var r0 time.Time
-	if rf, ok := ret.Get(0).(func(string) time.Time); ok {
-		r0 = rf(key)
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(time.Time)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// MockContext_GetTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTime'
-type MockContext_GetTime_Call struct {
+// MockContext_Form_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Form'
+type MockContext_Form_Call struct {
 	*mock.Call
 }
 
-// GetTime is a helper method to define mock.On call
-//   - key string
-func (_e *MockContext_Expecter) GetTime(key interface{}) *MockContext_GetTime_Call {
-	return &MockContext_GetTime_Call{Call: _e.mock.On("GetTime", key)}
+// Form is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) Form(name interface{}) *MockContext_Form_Call {
+	return &MockContext_Form_Call{Call: _e.mock.On("Form", name)}
 }
 
-func (_c *MockContext_GetTime_Call) Run(run func(key string)) *MockContext_GetTime_Call {
+func (_c *MockContext_Form_Call) Run(run func(name string)) *MockContext_Form_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_GetTime_Call) Return(_a0 time.Time) *MockContext_GetTime_Call {
+func (_c *MockContext_Form_Call) Return(_a0 string) *MockContext_Form_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetTime_Call) RunAndReturn(run func(string) time.Time) *MockContext_GetTime_Call {
+func (_c *MockContext_Form_Call) RunAndReturn(run func(string) string) *MockContext_Form_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetValidator provides a mock function with no fields
-func (_m *MockContext) GetValidator() *validator.Validate {
-	ret := _m.Called()
+// FormArray provides a mock function with given fields: name
+func (_m *MockContext) FormArray(name string) []string {
+	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetValidator")
+		panic("no return value specified for FormArray")
 	}
 
-	var r0 *validator.Validate
-	if rf, ok := ret.Get(0).(func() *validator.Validate); ok {
-		r0 = rf()
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*validator.Validate)
+			r0 = ret.Get(0).([]string)
 		}
 	}
 
 	return r0
 }
 
-// MockContext_GetValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetValidator'
-type MockContext_GetValidator_Call struct {
+// MockContext_FormArray_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FormArray'
+type MockContext_FormArray_Call struct {
 	*mock.Call
 }
 
-// GetValidator is a helper method to define mock.On call
-func (_e *MockContext_Expecter) GetValidator() *MockContext_GetValidator_Call {
-	return &MockContext_GetValidator_Call{Call: _e.mock.On("GetValidator")}
+// FormArray is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) FormArray(name interface{}) *MockContext_FormArray_Call {
+	return &MockContext_FormArray_Call{Call: _e.mock.On("FormArray", name)}
 }
 
-func (_c *MockContext_GetValidator_Call) Run(run func()) *MockContext_GetValidator_Call {
+func (_c *MockContext_FormArray_Call) Run(run func(name string)) *MockContext_FormArray_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_GetValidator_Call) Return(_a0 *validator.Validate) *MockContext_GetValidator_Call {
+func (_c *MockContext_FormArray_Call) Return(_a0 []string) *MockContext_FormArray_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_GetValidator_Call) RunAndReturn(run func() *validator.Validate) *MockContext_GetValidator_Call {
+func (_c *MockContext_FormArray_Call) RunAndReturn(run func(string) []string) *MockContext_FormArray_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HTML provides a mock function with given fields: code, html
-func (_m *MockContext) HTML(code int, html string) {
-	_m.Called(code, html)
+// FormFile provides a mock function with given fields: name
+func (_m *MockContext) FormFile(name string) (*multipart.FileHeader, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FormFile")
+	}
+
+	var r0 *multipart.FileHeader
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*multipart.FileHeader, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *multipart.FileHeader); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*multipart.FileHeader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockContext_HTML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTML'
-type MockContext_HTML_Call struct {
+// MockContext_FormFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FormFile'
+type MockContext_FormFile_Call struct {
 	*mock.Call
 }
 
-// HTML is a helper method to define mock.On call
-//   - code int
-//   - html string
-func (_e *MockContext_Expecter) HTML(code interface{}, html interface{}) *MockContext_HTML_Call {
-	return &MockContext_HTML_Call{Call: _e.mock.On("HTML", code, html)}
+// FormFile is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) FormFile(name interface{}) *MockContext_FormFile_Call {
+	return &MockContext_FormFile_Call{Call: _e.mock.On("FormFile", name)}
 }
 
-func (_c *MockContext_HTML_Call) Run(run func(code int, html string)) *MockContext_HTML_Call {
+func (_c *MockContext_FormFile_Call) Run(run func(name string)) *MockContext_FormFile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_HTML_Call) Return() *MockContext_HTML_Call {
-	_c.Call.Return()
+func (_c *MockContext_FormFile_Call) Return(_a0 *multipart.FileHeader, _a1 error) *MockContext_FormFile_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_HTML_Call) RunAndReturn(run func(int, string)) *MockContext_HTML_Call {
-	_c.Run(run)
+func (_c *MockContext_FormFile_Call) RunAndReturn(run func(string) (*multipart.FileHeader, error)) *MockContext_FormFile_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// Handlers provides a mock function with no fields
-func (_m *MockContext) Handlers() []func(context.Context) {
-	ret := _m.Called()
+// FormMap provides a mock function with given fields: prefix
+func (_m *MockContext) FormMap(prefix string) map[string]string {
+	ret := _m.Called(prefix)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Handlers")
+		panic("no return value specified for FormMap")
 	}
 
-	var r0 []func(context.Context)
-	if rf, ok := ret.Get(0).(func() []func(context.Context)); ok {
-		r0 = rf()
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
+		r0 = rf(prefix)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]func(context.Context))
+			r0 = ret.Get(0).(map[string]string)
 		}
 	}
 
 	return r0
 }
 
-// MockContext_Handlers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handlers'
-type MockContext_Handlers_Call struct {
+// MockContext_FormMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FormMap'
+type MockContext_FormMap_Call struct {
 	*mock.Call
 }
 
-// Handlers is a helper method to define mock.On call
-func (_e *MockContext_Expecter) Handlers() *MockContext_Handlers_Call {
-	return &MockContext_Handlers_Call{Call: _e.mock.On("Handlers")}
+// FormMap is a helper method to define mock.On call
+//   - prefix string
+func (_e *MockContext_Expecter) FormMap(prefix interface{}) *MockContext_FormMap_Call {
+	return &MockContext_FormMap_Call{Call: _e.mock.On("FormMap", prefix)}
 }
 
-func (_c *MockContext_Handlers_Call) Run(run func()) *MockContext_Handlers_Call {
+func (_c *MockContext_FormMap_Call) Run(run func(prefix string)) *MockContext_FormMap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_Handlers_Call) Return(_a0 []func(context.Context)) *MockContext_Handlers_Call {
+func (_c *MockContext_FormMap_Call) Return(_a0 map[string]string) *MockContext_FormMap_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Handlers_Call) RunAndReturn(run func() []func(context.Context)) *MockContext_Handlers_Call {
+func (_c *MockContext_FormMap_Call) RunAndReturn(run func(string) map[string]string) *MockContext_FormMap_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Header provides a mock function with given fields: key, value
-func (_m *MockContext) Header(key string, value string) {
-	_m.Called(key, value)
+// Fresh provides a mock function with no fields
+func (_m *MockContext) Fresh() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fresh")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
 }
 
-// MockContext_Header_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Header'
-type MockContext_Header_Call struct {
+// MockContext_Fresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fresh'
+type MockContext_Fresh_Call struct {
 	*mock.Call
 }
 
-// Header is a helper method to define mock.On call
-//   - key string
-//   - value string
-func (_e *MockContext_Expecter) Header(key interface{}, value interface{}) *MockContext_Header_Call {
-	return &MockContext_Header_Call{Call: _e.mock.On("Header", key, value)}
+// Fresh is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Fresh() *MockContext_Fresh_Call {
+	return &MockContext_Fresh_Call{Call: _e.mock.On("Fresh")}
 }
 
-func (_c *MockContext_Header_Call) Run(run func(key string, value string)) *MockContext_Header_Call {
+func (_c *MockContext_Fresh_Call) Run(run func()) *MockContext_Fresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_Header_Call) Return() *MockContext_Header_Call {
-	_c.Call.Return()
+func (_c *MockContext_Fresh_Call) Return(_a0 bool) *MockContext_Fresh_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Header_Call) RunAndReturn(run func(string, string)) *MockContext_Header_Call {
-	_c.Run(run)
+func (_c *MockContext_Fresh_Call) RunAndReturn(run func() bool) *MockContext_Fresh_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// IsAborted provides a mock function with no fields
-func (_m *MockContext) IsAborted() bool {
+// FullPath provides a mock function with no fields
+func (_m *MockContext) FullPath() string {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsAborted")
+		panic("no return value specified for FullPath")
 	}
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// MockContext_IsAborted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAborted'
-type MockContext_IsAborted_Call struct {
+// MockContext_FullPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FullPath'
+type MockContext_FullPath_Call struct {
 	*mock.Call
 }
 
-// IsAborted is a helper method to define mock.On call
-func (_e *MockContext_Expecter) IsAborted() *MockContext_IsAborted_Call {
-	return &MockContext_IsAborted_Call{Call: _e.mock.On("IsAborted")}
+// FullPath is a helper method to define mock.On call
+func (_e *MockContext_Expecter) FullPath() *MockContext_FullPath_Call {
+	return &MockContext_FullPath_Call{Call: _e.mock.On("FullPath")}
 }
 
-func (_c *MockContext_IsAborted_Call) Run(run func()) *MockContext_IsAborted_Call {
+func (_c *MockContext_FullPath_Call) Run(run func()) *MockContext_FullPath_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_IsAborted_Call) Return(_a0 bool) *MockContext_IsAborted_Call {
+func (_c *MockContext_FullPath_Call) Return(_a0 string) *MockContext_FullPath_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_IsAborted_Call) RunAndReturn(run func() bool) *MockContext_IsAborted_Call {
+func (_c *MockContext_FullPath_Call) RunAndReturn(run func() string) *MockContext_FullPath_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsWebsocket provides a mock function with no fields
-func (_m *MockContext) IsWebsocket() bool {
-	ret := _m.Called()
+// Get provides a mock function with given fields: key
+func (_m *MockContext) Get(key string) (interface{}, bool) {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsWebsocket")
+		panic("no return value specified for Get")
 	}
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
-		r0 = rf()
+	var r0 interface{}
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (interface{}, bool)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(key)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
 }
 
-// MockContext_IsWebsocket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsWebsocket'
-type MockContext_IsWebsocket_Call struct {
+// MockContext_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockContext_Get_Call struct {
 	*mock.Call
 }
 
-// IsWebsocket is a helper method to define mock.On call
-func (_e *MockContext_Expecter) IsWebsocket() *MockContext_IsWebsocket_Call {
-	return &MockContext_IsWebsocket_Call{Call: _e.mock.On("IsWebsocket")}
+// Get is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) Get(key interface{}) *MockContext_Get_Call {
+	return &MockContext_Get_Call{Call: _e.mock.On("Get", key)}
 }
 
-func (_c *MockContext_IsWebsocket_Call) Run(run func()) *MockContext_IsWebsocket_Call {
+func (_c *MockContext_Get_Call) Run(run func(key string)) *MockContext_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_IsWebsocket_Call) Return(_a0 bool) *MockContext_IsWebsocket_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_Get_Call) Return(_a0 interface{}, _a1 bool) *MockContext_Get_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_IsWebsocket_Call) RunAndReturn(run func() bool) *MockContext_IsWebsocket_Call {
+func (_c *MockContext_Get_Call) RunAndReturn(run func(string) (interface{}, bool)) *MockContext_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// JSON provides a mock function with given fields: code, obj
-func (_m *MockContext) JSON(code int, obj interface{}) {
-	_m.Called(code, obj)
+// GetBool provides a mock function with given fields: key
+func (_m *MockContext) GetBool(key string) bool {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBool")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
 }
 
-// MockContext_JSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JSON'
-type MockContext_JSON_Call struct {
+// MockContext_GetBool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBool'
+type MockContext_GetBool_Call struct {
 	*mock.Call
 }
 
-// JSON is a helper method to define mock.On call
-//   - code int
-//   - obj interface{}
-func (_e *MockContext_Expecter) JSON(code interface{}, obj interface{}) *MockContext_JSON_Call {
-	return &MockContext_JSON_Call{Call: _e.mock.On("JSON", code, obj)}
+// GetBool is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetBool(key interface{}) *MockContext_GetBool_Call {
+	return &MockContext_GetBool_Call{Call: _e.mock.On("GetBool", key)}
 }
 
-func (_c *MockContext_JSON_Call) Run(run func(code int, obj interface{})) *MockContext_JSON_Call {
+func (_c *MockContext_GetBool_Call) Run(run func(key string)) *MockContext_GetBool_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(interface{}))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_JSON_Call) Return() *MockContext_JSON_Call {
-	_c.Call.Return()
+func (_c *MockContext_GetBool_Call) Return(_a0 bool) *MockContext_GetBool_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_JSON_Call) RunAndReturn(run func(int, interface{})) *MockContext_JSON_Call {
-	_c.Run(run)
+func (_c *MockContext_GetBool_Call) RunAndReturn(run func(string) bool) *MockContext_GetBool_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// JSONP provides a mock function with given fields: code, callback, obj
-func (_m *MockContext) JSONP(code int, callback string, obj interface{}) {
-	_m.Called(code, callback, obj)
+// GetDuration provides a mock function with given fields: key
+func (_m *MockContext) GetDuration(key string) time.Duration {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDuration")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(string) time.Duration); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
 }
 
-// MockContext_JSONP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JSONP'
-type MockContext_JSONP_Call struct {
+// MockContext_GetDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuration'
+type MockContext_GetDuration_Call struct {
 	*mock.Call
 }
 
-// JSONP is a helper method to define mock.On call
-//   - code int
-//   - callback string
-//   - obj interface{}
-func (_e *MockContext_Expecter) JSONP(code interface{}, callback interface{}, obj interface{}) *MockContext_JSONP_Call {
-	return &MockContext_JSONP_Call{Call: _e.mock.On("JSONP", code, callback, obj)}
+// GetDuration is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetDuration(key interface{}) *MockContext_GetDuration_Call {
+	return &MockContext_GetDuration_Call{Call: _e.mock.On("GetDuration", key)}
 }
 
-func (_c *MockContext_JSONP_Call) Run(run func(code int, callback string, obj interface{})) *MockContext_JSONP_Call {
+func (_c *MockContext_GetDuration_Call) Run(run func(key string)) *MockContext_GetDuration_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(string), args[2].(interface{}))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_JSONP_Call) Return() *MockContext_JSONP_Call {
-	_c.Call.Return()
+func (_c *MockContext_GetDuration_Call) Return(_a0 time.Duration) *MockContext_GetDuration_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_JSONP_Call) RunAndReturn(run func(int, string, interface{})) *MockContext_JSONP_Call {
-	_c.Run(run)
+func (_c *MockContext_GetDuration_Call) RunAndReturn(run func(string) time.Duration) *MockContext_GetDuration_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// Method provides a mock function with no fields
-func (_m *MockContext) Method() string {
-	ret := _m.Called()
+// GetFloat64 provides a mock function with given fields: key
+func (_m *MockContext) GetFloat64(key string) float64 {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Method")
+		panic("no return value specified for GetFloat64")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string) float64); ok {
+		r0 = rf(key)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(float64)
 	}
 
 	return r0
 }
 
-// MockContext_Method_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Method'
-type MockContext_Method_Call struct {
+// MockContext_GetFloat64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFloat64'
+type MockContext_GetFloat64_Call struct {
 	*mock.Call
 }
 
-// Method is a helper method to define mock.On call
-func (_e *MockContext_Expecter) Method() *MockContext_Method_Call {
-	return &MockContext_Method_Call{Call: _e.mock.On("Method")}
+// GetFloat64 is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetFloat64(key interface{}) *MockContext_GetFloat64_Call {
+	return &MockContext_GetFloat64_Call{Call: _e.mock.On("GetFloat64", key)}
 }
 
-func (_c *MockContext_Method_Call) Run(run func()) *MockContext_Method_Call {
+func (_c *MockContext_GetFloat64_Call) Run(run func(key string)) *MockContext_GetFloat64_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_Method_Call) Return(_a0 string) *MockContext_Method_Call {
+func (_c *MockContext_GetFloat64_Call) Return(_a0 float64) *MockContext_GetFloat64_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Method_Call) RunAndReturn(run func() string) *MockContext_Method_Call {
+func (_c *MockContext_GetFloat64_Call) RunAndReturn(run func(string) float64) *MockContext_GetFloat64_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MultipartForm provides a mock function with no fields
-func (_m *MockContext) MultipartForm() (*multipart.Form, error) {
-	ret := _m.Called()
+// GetHeader provides a mock function with given fields: key
+func (_m *MockContext) GetHeader(key string) string {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MultipartForm")
-	}
-
-	var r0 *multipart.Form
-	var r1 error
-	if rf, ok := ret.Get(0).(func() (*multipart.Form, error)); ok {
-		return rf()
-	}
-	if rf, ok := ret.Get(0).(func() *multipart.Form); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*multipart.Form)
-		}
+		panic("no return value specified for GetHeader")
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(key)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockContext_MultipartForm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultipartForm'
-type MockContext_MultipartForm_Call struct {
+// MockContext_GetHeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeader'
+type MockContext_GetHeader_Call struct {
 	*mock.Call
 }
 
-// MultipartForm is a helper method to define mock.On call
-func (_e *MockContext_Expecter) MultipartForm() *MockContext_MultipartForm_Call {
-	return &MockContext_MultipartForm_Call{Call: _e.mock.On("MultipartForm")}
+// GetHeader is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetHeader(key interface{}) *MockContext_GetHeader_Call {
+	return &MockContext_GetHeader_Call{Call: _e.mock.On("GetHeader", key)}
 }
 
-func (_c *MockContext_MultipartForm_Call) Run(run func()) *MockContext_MultipartForm_Call {
+func (_c *MockContext_GetHeader_Call) Run(run func(key string)) *MockContext_GetHeader_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_MultipartForm_Call) Return(_a0 *multipart.Form, _a1 error) *MockContext_MultipartForm_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockContext_GetHeader_Call) Return(_a0 string) *MockContext_GetHeader_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_MultipartForm_Call) RunAndReturn(run func() (*multipart.Form, error)) *MockContext_MultipartForm_Call {
+func (_c *MockContext_GetHeader_Call) RunAndReturn(run func(string) string) *MockContext_GetHeader_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Next provides a mock function with no fields
-func (_m *MockContext) Next() {
-	_m.Called()
+// GetHeaderInt provides a mock function with given fields: key
+func (_m *MockContext) GetHeaderInt(key string) (int, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeaderInt")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockContext_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
-type MockContext_Next_Call struct {
+// MockContext_GetHeaderInt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeaderInt'
+type MockContext_GetHeaderInt_Call struct {
 	*mock.Call
 }
 
-// Next is a helper method to define mock.On call
-func (_e *MockContext_Expecter) Next() *MockContext_Next_Call {
-	return &MockContext_Next_Call{Call: _e.mock.On("Next")}
+// GetHeaderInt is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetHeaderInt(key interface{}) *MockContext_GetHeaderInt_Call {
+	return &MockContext_GetHeaderInt_Call{Call: _e.mock.On("GetHeaderInt", key)}
 }
 
-func (_c *MockContext_Next_Call) Run(run func()) *MockContext_Next_Call {
+func (_c *MockContext_GetHeaderInt_Call) Run(run func(key string)) *MockContext_GetHeaderInt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_Next_Call) Return() *MockContext_Next_Call {
-	_c.Call.Return()
+func (_c *MockContext_GetHeaderInt_Call) Return(_a0 int, _a1 error) *MockContext_GetHeaderInt_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_Next_Call) RunAndReturn(run func()) *MockContext_Next_Call {
-	_c.Run(run)
+func (_c *MockContext_GetHeaderInt_Call) RunAndReturn(run func(string) (int, error)) *MockContext_GetHeaderInt_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// Param provides a mock function with given fields: name
-func (_m *MockContext) Param(name string) string {
-	ret := _m.Called(name)
+// GetHeaderTime provides a mock function with given fields: key
+func (_m *MockContext) GetHeaderTime(key string) (time.Time, error) {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Param")
+		panic("no return value specified for GetHeaderTime")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(name)
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (time.Time, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) time.Time); ok {
+		r0 = rf(key)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(time.Time)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockContext_Param_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Param'
-type MockContext_Param_Call struct {
+// MockContext_GetHeaderTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeaderTime'
+type MockContext_GetHeaderTime_Call struct {
 	*mock.Call
 }
 
-// Param is a helper method to define mock.On call
-//   - name string
-func (_e *MockContext_Expecter) Param(name interface{}) *MockContext_Param_Call {
-	return &MockContext_Param_Call{Call: _e.mock.On("Param", name)}
+// GetHeaderTime is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetHeaderTime(key interface{}) *MockContext_GetHeaderTime_Call {
+	return &MockContext_GetHeaderTime_Call{Call: _e.mock.On("GetHeaderTime", key)}
 }
 
-func (_c *MockContext_Param_Call) Run(run func(name string)) *MockContext_Param_Call {
+func (_c *MockContext_GetHeaderTime_Call) Run(run func(key string)) *MockContext_GetHeaderTime_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_Param_Call) Return(_a0 string) *MockContext_Param_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_GetHeaderTime_Call) Return(_a0 time.Time, _a1 error) *MockContext_GetHeaderTime_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_Param_Call) RunAndReturn(run func(string) string) *MockContext_Param_Call {
+func (_c *MockContext_GetHeaderTime_Call) RunAndReturn(run func(string) (time.Time, error)) *MockContext_GetHeaderTime_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ParamArray provides a mock function with given fields: name
-func (_m *MockContext) ParamArray(name string) []string {
-	ret := _m.Called(name)
+// GetHeaders provides a mock function with given fields: key
+func (_m *MockContext) GetHeaders(key string) []string {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ParamArray")
+		panic("no return value specified for GetHeaders")
 	}
 
 	var r0 []string
 	if rf, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = rf(name)
+		r0 = rf(key)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -2158,358 +2399,3063 @@ func (_m *MockContext) ParamArray(name string) []string {
 	return r0
 }
 
-// MockContext_ParamArray_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParamArray'
-type MockContext_ParamArray_Call struct {
+// MockContext_GetHeaders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeaders'
+type MockContext_GetHeaders_Call struct {
 	*mock.Call
 }
 
-// ParamArray is a helper method to define mock.On call
-//   - name string
-func (_e *MockContext_Expecter) ParamArray(name interface{}) *MockContext_ParamArray_Call {
-	return &MockContext_ParamArray_Call{Call: _e.mock.On("ParamArray", name)}
+// GetHeaders is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetHeaders(key interface{}) *MockContext_GetHeaders_Call {
+	return &MockContext_GetHeaders_Call{Call: _e.mock.On("GetHeaders", key)}
 }
 
-func (_c *MockContext_ParamArray_Call) Run(run func(name string)) *MockContext_ParamArray_Call {
+func (_c *MockContext_GetHeaders_Call) Run(run func(key string)) *MockContext_GetHeaders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_ParamArray_Call) Return(_a0 []string) *MockContext_ParamArray_Call {
+func (_c *MockContext_GetHeaders_Call) Return(_a0 []string) *MockContext_GetHeaders_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_ParamArray_Call) RunAndReturn(run func(string) []string) *MockContext_ParamArray_Call {
+func (_c *MockContext_GetHeaders_Call) RunAndReturn(run func(string) []string) *MockContext_GetHeaders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ParamMap provides a mock function with no fields
-func (_m *MockContext) ParamMap() map[string]string {
-	ret := _m.Called()
+// GetInt provides a mock function with given fields: key
+func (_m *MockContext) GetInt(key string) int {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ParamMap")
+		panic("no return value specified for GetInt")
 	}
 
-	var r0 map[string]string
-	if rf, ok := ret.Get(0).(func() map[string]string); ok {
-		r0 = rf()
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(key)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]string)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
 	return r0
 }
 
-// MockContext_ParamMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParamMap'
-type MockContext_ParamMap_Call struct {
+// MockContext_GetInt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInt'
+type MockContext_GetInt_Call struct {
 	*mock.Call
 }
 
-// ParamMap is a helper method to define mock.On call
-func (_e *MockContext_Expecter) ParamMap() *MockContext_ParamMap_Call {
-	return &MockContext_ParamMap_Call{Call: _e.mock.On("ParamMap")}
+// GetInt is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetInt(key interface{}) *MockContext_GetInt_Call {
+	return &MockContext_GetInt_Call{Call: _e.mock.On("GetInt", key)}
 }
 
-func (_c *MockContext_ParamMap_Call) Run(run func()) *MockContext_ParamMap_Call {
+func (_c *MockContext_GetInt_Call) Run(run func(key string)) *MockContext_GetInt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_ParamMap_Call) Return(_a0 map[string]string) *MockContext_ParamMap_Call {
+func (_c *MockContext_GetInt_Call) Return(_a0 int) *MockContext_GetInt_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_ParamMap_Call) RunAndReturn(run func() map[string]string) *MockContext_ParamMap_Call {
+func (_c *MockContext_GetInt_Call) RunAndReturn(run func(string) int) *MockContext_GetInt_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Path provides a mock function with no fields
-func (_m *MockContext) Path() string {
-	ret := _m.Called()
+// GetInt64 provides a mock function with given fields: key
+func (_m *MockContext) GetInt64(key string) int64 {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Path")
+		panic("no return value specified for GetInt64")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(key)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(int64)
 	}
 
 	return r0
 }
 
-// MockContext_Path_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Path'
-type MockContext_Path_Call struct {
+// MockContext_GetInt64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInt64'
+type MockContext_GetInt64_Call struct {
 	*mock.Call
 }
 
-// Path is a helper method to define mock.On call
-func (_e *MockContext_Expecter) Path() *MockContext_Path_Call {
-	return &MockContext_Path_Call{Call: _e.mock.On("Path")}
+// GetInt64 is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetInt64(key interface{}) *MockContext_GetInt64_Call {
+	return &MockContext_GetInt64_Call{Call: _e.mock.On("GetInt64", key)}
 }
 
-func (_c *MockContext_Path_Call) Run(run func()) *MockContext_Path_Call {
+func (_c *MockContext_GetInt64_Call) Run(run func(key string)) *MockContext_GetInt64_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_Path_Call) Return(_a0 string) *MockContext_Path_Call {
+func (_c *MockContext_GetInt64_Call) Return(_a0 int64) *MockContext_GetInt64_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Path_Call) RunAndReturn(run func() string) *MockContext_Path_Call {
+func (_c *MockContext_GetInt64_Call) RunAndReturn(run func(string) int64) *MockContext_GetInt64_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Query provides a mock function with given fields: name
-func (_m *MockContext) Query(name string) string {
-	ret := _m.Called(name)
+// GetOrSet provides a mock function with given fields: key, fn
+func (_m *MockContext) GetOrSet(key string, fn func() interface{}) interface{} {
+	ret := _m.Called(key, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Query")
+		panic("no return value specified for GetOrSet")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(name)
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(string, func() interface{}) interface{}); ok {
+		r0 = rf(key, fn)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
 	}
 
 	return r0
 }
 
-// MockContext_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
-type MockContext_Query_Call struct {
+// MockContext_GetOrSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrSet'
+type MockContext_GetOrSet_Call struct {
 	*mock.Call
 }
 
-// Query is a helper method to define mock.On call
-//   - name string
-func (_e *MockContext_Expecter) Query(name interface{}) *MockContext_Query_Call {
-	return &MockContext_Query_Call{Call: _e.mock.On("Query", name)}
+// GetOrSet is a helper method to define mock.On call
+//   - key string
+//   - fn func() interface{}
+func (_e *MockContext_Expecter) GetOrSet(key interface{}, fn interface{}) *MockContext_GetOrSet_Call {
+	return &MockContext_GetOrSet_Call{Call: _e.mock.On("GetOrSet", key, fn)}
 }
 
-func (_c *MockContext_Query_Call) Run(run func(name string)) *MockContext_Query_Call {
+func (_c *MockContext_GetOrSet_Call) Run(run func(key string, fn func() interface{})) *MockContext_GetOrSet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(func() interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_Query_Call) Return(_a0 string) *MockContext_Query_Call {
+func (_c *MockContext_GetOrSet_Call) Return(_a0 interface{}) *MockContext_GetOrSet_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Query_Call) RunAndReturn(run func(string) string) *MockContext_Query_Call {
+func (_c *MockContext_GetOrSet_Call) RunAndReturn(run func(string, func() interface{}) interface{}) *MockContext_GetOrSet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// QueryArray provides a mock function with given fields: name
-func (_m *MockContext) QueryArray(name string) []string {
-	ret := _m.Called(name)
+// GetRawData provides a mock function with no fields
+func (_m *MockContext) GetRawData() ([]byte, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for QueryArray")
+		panic("no return value specified for GetRawData")
 	}
 
-	var r0 []string
-	if rf, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = rf(name)
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).([]byte)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockContext_QueryArray_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryArray'
-type MockContext_QueryArray_Call struct {
+// MockContext_GetRawData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRawData'
+type MockContext_GetRawData_Call struct {
 	*mock.Call
 }
 
-// QueryArray is a helper method to define mock.On call
-//   - name string
-func (_e *MockContext_Expecter) QueryArray(name interface{}) *MockContext_QueryArray_Call {
-	return &MockContext_QueryArray_Call{Call: _e.mock.On("QueryArray", name)}
+// GetRawData is a helper method to define mock.On call
+func (_e *MockContext_Expecter) GetRawData() *MockContext_GetRawData_Call {
+	return &MockContext_GetRawData_Call{Call: _e.mock.On("GetRawData")}
 }
 
-func (_c *MockContext_QueryArray_Call) Run(run func(name string)) *MockContext_QueryArray_Call {
+func (_c *MockContext_GetRawData_Call) Run(run func()) *MockContext_GetRawData_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_QueryArray_Call) Return(_a0 []string) *MockContext_QueryArray_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_GetRawData_Call) Return(_a0 []byte, _a1 error) *MockContext_GetRawData_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_QueryArray_Call) RunAndReturn(run func(string) []string) *MockContext_QueryArray_Call {
+func (_c *MockContext_GetRawData_Call) RunAndReturn(run func() ([]byte, error)) *MockContext_GetRawData_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// QueryMap provides a mock function with given fields: prefix
-func (_m *MockContext) QueryMap(prefix string) map[string]string {
-	ret := _m.Called(prefix)
+// GetString provides a mock function with given fields: key
+func (_m *MockContext) GetString(key string) string {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for QueryMap")
+		panic("no return value specified for GetString")
 	}
 
-	var r0 map[string]string
-	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
-		r0 = rf(prefix)
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(key)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]string)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	return r0
 }
 
-// MockContext_QueryMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryMap'
-type MockContext_QueryMap_Call struct {
+// MockContext_GetString_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetString'
+type MockContext_GetString_Call struct {
 	*mock.Call
 }
 
-// QueryMap is a helper method to define mock.On call
-//   - prefix string
-func (_e *MockContext_Expecter) QueryMap(prefix interface{}) *MockContext_QueryMap_Call {
-	return &MockContext_QueryMap_Call{Call: _e.mock.On("QueryMap", prefix)}
+// GetString is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetString(key interface{}) *MockContext_GetString_Call {
+	return &MockContext_GetString_Call{Call: _e.mock.On("GetString", key)}
 }
 
-func (_c *MockContext_QueryMap_Call) Run(run func(prefix string)) *MockContext_QueryMap_Call {
+func (_c *MockContext_GetString_Call) Run(run func(key string)) *MockContext_GetString_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_QueryMap_Call) Return(_a0 map[string]string) *MockContext_QueryMap_Call {
+func (_c *MockContext_GetString_Call) Return(_a0 string) *MockContext_GetString_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_QueryMap_Call) RunAndReturn(run func(string) map[string]string) *MockContext_QueryMap_Call {
+func (_c *MockContext_GetString_Call) RunAndReturn(run func(string) string) *MockContext_GetString_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RawPath provides a mock function with no fields
-func (_m *MockContext) RawPath() string {
-	ret := _m.Called()
+// GetStringMap provides a mock function with given fields: key
+func (_m *MockContext) GetStringMap(key string) map[string]interface{} {
+	ret := _m.Called(key)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RawPath")
+		panic("no return value specified for GetStringMap")
 	}
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func(string) map[string]interface{}); ok {
+		r0 = rf(key)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
 	}
 
 	return r0
 }
 
-// MockContext_RawPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RawPath'
-type MockContext_RawPath_Call struct {
+// MockContext_GetStringMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringMap'
+type MockContext_GetStringMap_Call struct {
 	*mock.Call
 }
 
-// RawPath is a helper method to define mock.On call
-func (_e *MockContext_Expecter) RawPath() *MockContext_RawPath_Call {
-	return &MockContext_RawPath_Call{Call: _e.mock.On("RawPath")}
+// GetStringMap is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetStringMap(key interface{}) *MockContext_GetStringMap_Call {
+	return &MockContext_GetStringMap_Call{Call: _e.mock.On("GetStringMap", key)}
 }
 
-func (_c *MockContext_RawPath_Call) Run(run func()) *MockContext_RawPath_Call {
+func (_c *MockContext_GetStringMap_Call) Run(run func(key string)) *MockContext_GetStringMap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_RawPath_Call) Return(_a0 string) *MockContext_RawPath_Call {
+func (_c *MockContext_GetStringMap_Call) Return(_a0 map[string]interface{}) *MockContext_GetStringMap_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_RawPath_Call) RunAndReturn(run func() string) *MockContext_RawPath_Call {
+func (_c *MockContext_GetStringMap_Call) RunAndReturn(run func(string) map[string]interface{}) *MockContext_GetStringMap_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Redirect provides a mock function with given fields: code, location
-func (_m *MockContext) Redirect(code int, location string) {
-	_m.Called(code, location)
+// GetStringMapString provides a mock function with given fields: key
+func (_m *MockContext) GetStringMapString(key string) map[string]string {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStringMapString")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
 }
 
-// MockContext_Redirect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redirect'
-type MockContext_Redirect_Call struct {
+// MockContext_GetStringMapString_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringMapString'
+type MockContext_GetStringMapString_Call struct {
 	*mock.Call
 }
 
-// Redirect is a helper method to define mock.On call
-//   - code int
-//   - location string
-func (_e *MockContext_Expecter) Redirect(code interface{}, location interface{}) *MockContext_Redirect_Call {
-	return &MockContext_Redirect_Call{Call: _e.mock.On("Redirect", code, location)}
+// GetStringMapString is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetStringMapString(key interface{}) *MockContext_GetStringMapString_Call {
+	return &MockContext_GetStringMapString_Call{Call: _e.mock.On("GetStringMapString", key)}
+}
+
+func (_c *MockContext_GetStringMapString_Call) Run(run func(key string)) *MockContext_GetStringMapString_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_GetStringMapString_Call) Return(_a0 map[string]string) *MockContext_GetStringMapString_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_GetStringMapString_Call) RunAndReturn(run func(string) map[string]string) *MockContext_GetStringMapString_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStringSlice provides a mock function with given fields: key
+func (_m *MockContext) GetStringSlice(key string) []string {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStringSlice")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_GetStringSlice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStringSlice'
+type MockContext_GetStringSlice_Call struct {
+	*mock.Call
+}
+
+// GetStringSlice is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetStringSlice(key interface{}) *MockContext_GetStringSlice_Call {
+	return &MockContext_GetStringSlice_Call{Call: _e.mock.On("GetStringSlice", key)}
+}
+
+func (_c *MockContext_GetStringSlice_Call) Run(run func(key string)) *MockContext_GetStringSlice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_GetStringSlice_Call) Return(_a0 []string) *MockContext_GetStringSlice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_GetStringSlice_Call) RunAndReturn(run func(string) []string) *MockContext_GetStringSlice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTime provides a mock function with given fields: key
+func (_m *MockContext) GetTime(key string) time.Time {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTime")
+	}
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(string) time.Time); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
+// MockContext_GetTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTime'
+type MockContext_GetTime_Call struct {
+	*mock.Call
+}
+
+// GetTime is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) GetTime(key interface{}) *MockContext_GetTime_Call {
+	return &MockContext_GetTime_Call{Call: _e.mock.On("GetTime", key)}
+}
+
+func (_c *MockContext_GetTime_Call) Run(run func(key string)) *MockContext_GetTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_GetTime_Call) Return(_a0 time.Time) *MockContext_GetTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_GetTime_Call) RunAndReturn(run func(string) time.Time) *MockContext_GetTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetValidator provides a mock function with no fields
+func (_m *MockContext) GetValidator() *validator.Validate {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValidator")
+	}
+
+	var r0 *validator.Validate
+	if rf, ok := ret.Get(0).(func() *validator.Validate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*validator.Validate)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_GetValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetValidator'
+type MockContext_GetValidator_Call struct {
+	*mock.Call
+}
+
+// GetValidator is a helper method to define mock.On call
+func (_e *MockContext_Expecter) GetValidator() *MockContext_GetValidator_Call {
+	return &MockContext_GetValidator_Call{Call: _e.mock.On("GetValidator")}
+}
+
+func (_c *MockContext_GetValidator_Call) Run(run func()) *MockContext_GetValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_GetValidator_Call) Return(_a0 *validator.Validate) *MockContext_GetValidator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_GetValidator_Call) RunAndReturn(run func() *validator.Validate) *MockContext_GetValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTML provides a mock function with given fields: code, html
+func (_m *MockContext) HTML(code int, html string) {
+	_m.Called(code, html)
+}
+
+// MockContext_HTML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTML'
+type MockContext_HTML_Call struct {
+	*mock.Call
+}
+
+// HTML is a helper method to define mock.On call
+//   - code int
+//   - html string
+func (_e *MockContext_Expecter) HTML(code interface{}, html interface{}) *MockContext_HTML_Call {
+	return &MockContext_HTML_Call{Call: _e.mock.On("HTML", code, html)}
+}
+
+func (_c *MockContext_HTML_Call) Run(run func(code int, html string)) *MockContext_HTML_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_HTML_Call) Return() *MockContext_HTML_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_HTML_Call) RunAndReturn(run func(int, string)) *MockContext_HTML_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Handlers provides a mock function with no fields
+func (_m *MockContext) Handlers() []func(context.Context) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handlers")
+	}
+
+	var r0 []func(context.Context)
+	if rf, ok := ret.Get(0).(func() []func(context.Context)); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]func(context.Context))
+		}
+	}
+
+	return r0
+}
+
+// MockContext_Handlers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handlers'
+type MockContext_Handlers_Call struct {
+	*mock.Call
+}
+
+// Handlers is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Handlers() *MockContext_Handlers_Call {
+	return &MockContext_Handlers_Call{Call: _e.mock.On("Handlers")}
+}
+
+func (_c *MockContext_Handlers_Call) Run(run func()) *MockContext_Handlers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Handlers_Call) Return(_a0 []func(context.Context)) *MockContext_Handlers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Handlers_Call) RunAndReturn(run func() []func(context.Context)) *MockContext_Handlers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Header provides a mock function with given fields: key, value
+func (_m *MockContext) Header(key string, value string) {
+	_m.Called(key, value)
+}
+
+// MockContext_Header_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Header'
+type MockContext_Header_Call struct {
+	*mock.Call
+}
+
+// Header is a helper method to define mock.On call
+//   - key string
+//   - value string
+func (_e *MockContext_Expecter) Header(key interface{}, value interface{}) *MockContext_Header_Call {
+	return &MockContext_Header_Call{Call: _e.mock.On("Header", key, value)}
+}
+
+func (_c *MockContext_Header_Call) Run(run func(key string, value string)) *MockContext_Header_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_Header_Call) Return() *MockContext_Header_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_Header_Call) RunAndReturn(run func(string, string)) *MockContext_Header_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IsAJAX provides a mock function with no fields
+func (_m *MockContext) IsAJAX() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAJAX")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockContext_IsAJAX_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAJAX'
+type MockContext_IsAJAX_Call struct {
+	*mock.Call
+}
+
+// IsAJAX is a helper method to define mock.On call
+func (_e *MockContext_Expecter) IsAJAX() *MockContext_IsAJAX_Call {
+	return &MockContext_IsAJAX_Call{Call: _e.mock.On("IsAJAX")}
+}
+
+func (_c *MockContext_IsAJAX_Call) Run(run func()) *MockContext_IsAJAX_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_IsAJAX_Call) Return(_a0 bool) *MockContext_IsAJAX_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_IsAJAX_Call) RunAndReturn(run func() bool) *MockContext_IsAJAX_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAborted provides a mock function with no fields
+func (_m *MockContext) IsAborted() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAborted")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockContext_IsAborted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAborted'
+type MockContext_IsAborted_Call struct {
+	*mock.Call
+}
+
+// IsAborted is a helper method to define mock.On call
+func (_e *MockContext_Expecter) IsAborted() *MockContext_IsAborted_Call {
+	return &MockContext_IsAborted_Call{Call: _e.mock.On("IsAborted")}
+}
+
+func (_c *MockContext_IsAborted_Call) Run(run func()) *MockContext_IsAborted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_IsAborted_Call) Return(_a0 bool) *MockContext_IsAborted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_IsAborted_Call) RunAndReturn(run func() bool) *MockContext_IsAborted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsClientGone provides a mock function with no fields
+func (_m *MockContext) IsClientGone() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsClientGone")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockContext_IsClientGone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsClientGone'
+type MockContext_IsClientGone_Call struct {
+	*mock.Call
+}
+
+// IsClientGone is a helper method to define mock.On call
+func (_e *MockContext_Expecter) IsClientGone() *MockContext_IsClientGone_Call {
+	return &MockContext_IsClientGone_Call{Call: _e.mock.On("IsClientGone")}
+}
+
+func (_c *MockContext_IsClientGone_Call) Run(run func()) *MockContext_IsClientGone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_IsClientGone_Call) Return(_a0 bool) *MockContext_IsClientGone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_IsClientGone_Call) RunAndReturn(run func() bool) *MockContext_IsClientGone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsJSON provides a mock function with no fields
+func (_m *MockContext) IsJSON() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsJSON")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockContext_IsJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJSON'
+type MockContext_IsJSON_Call struct {
+	*mock.Call
+}
+
+// IsJSON is a helper method to define mock.On call
+func (_e *MockContext_Expecter) IsJSON() *MockContext_IsJSON_Call {
+	return &MockContext_IsJSON_Call{Call: _e.mock.On("IsJSON")}
+}
+
+func (_c *MockContext_IsJSON_Call) Run(run func()) *MockContext_IsJSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_IsJSON_Call) Return(_a0 bool) *MockContext_IsJSON_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_IsJSON_Call) RunAndReturn(run func() bool) *MockContext_IsJSON_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsWebsocket provides a mock function with no fields
+func (_m *MockContext) IsWebsocket() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsWebsocket")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockContext_IsWebsocket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsWebsocket'
+type MockContext_IsWebsocket_Call struct {
+	*mock.Call
+}
+
+// IsWebsocket is a helper method to define mock.On call
+func (_e *MockContext_Expecter) IsWebsocket() *MockContext_IsWebsocket_Call {
+	return &MockContext_IsWebsocket_Call{Call: _e.mock.On("IsWebsocket")}
+}
+
+func (_c *MockContext_IsWebsocket_Call) Run(run func()) *MockContext_IsWebsocket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_IsWebsocket_Call) Return(_a0 bool) *MockContext_IsWebsocket_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_IsWebsocket_Call) RunAndReturn(run func() bool) *MockContext_IsWebsocket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JSON provides a mock function with given fields: code, obj
+func (_m *MockContext) JSON(code int, obj interface{}) {
+	_m.Called(code, obj)
+}
+
+// MockContext_JSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JSON'
+type MockContext_JSON_Call struct {
+	*mock.Call
+}
+
+// JSON is a helper method to define mock.On call
+//   - code int
+//   - obj interface{}
+func (_e *MockContext_Expecter) JSON(code interface{}, obj interface{}) *MockContext_JSON_Call {
+	return &MockContext_JSON_Call{Call: _e.mock.On("JSON", code, obj)}
+}
+
+func (_c *MockContext_JSON_Call) Run(run func(code int, obj interface{})) *MockContext_JSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContext_JSON_Call) Return() *MockContext_JSON_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_JSON_Call) RunAndReturn(run func(int, interface{})) *MockContext_JSON_Call {
+	_c.Run(run)
+	return _c
+}
+
+// JSONP provides a mock function with given fields: code, callback, obj
+func (_m *MockContext) JSONP(code int, callback string, obj interface{}) {
+	_m.Called(code, callback, obj)
+}
+
+// MockContext_JSONP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JSONP'
+type MockContext_JSONP_Call struct {
+	*mock.Call
+}
+
+// JSONP is a helper method to define mock.On call
+//   - code int
+//   - callback string
+//   - obj interface{}
+func (_e *MockContext_Expecter) JSONP(code interface{}, callback interface{}, obj interface{}) *MockContext_JSONP_Call {
+	return &MockContext_JSONP_Call{Call: _e.mock.On("JSONP", code, callback, obj)}
+}
+
+func (_c *MockContext_JSONP_Call) Run(run func(code int, callback string, obj interface{})) *MockContext_JSONP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContext_JSONP_Call) Return() *MockContext_JSONP_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_JSONP_Call) RunAndReturn(run func(int, string, interface{})) *MockContext_JSONP_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Logger provides a mock function with no fields
+func (_m *MockContext) Logger() context.Logger {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logger")
+	}
+
+	var r0 context.Logger
+	if rf, ok := ret.Get(0).(func() context.Logger); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Logger)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_Logger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logger'
+type MockContext_Logger_Call struct {
+	*mock.Call
+}
+
+// Logger is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Logger() *MockContext_Logger_Call {
+	return &MockContext_Logger_Call{Call: _e.mock.On("Logger")}
+}
+
+func (_c *MockContext_Logger_Call) Run(run func()) *MockContext_Logger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Logger_Call) Return(_a0 context.Logger) *MockContext_Logger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Logger_Call) RunAndReturn(run func() context.Logger) *MockContext_Logger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Method provides a mock function with no fields
+func (_m *MockContext) Method() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Method")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockContext_Method_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Method'
+type MockContext_Method_Call struct {
+	*mock.Call
+}
+
+// Method is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Method() *MockContext_Method_Call {
+	return &MockContext_Method_Call{Call: _e.mock.On("Method")}
+}
+
+func (_c *MockContext_Method_Call) Run(run func()) *MockContext_Method_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Method_Call) Return(_a0 string) *MockContext_Method_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Method_Call) RunAndReturn(run func() string) *MockContext_Method_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MultipartForm provides a mock function with no fields
+func (_m *MockContext) MultipartForm() (*multipart.Form, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultipartForm")
+	}
+
+	var r0 *multipart.Form
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*multipart.Form, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *multipart.Form); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*multipart.Form)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContext_MultipartForm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultipartForm'
+type MockContext_MultipartForm_Call struct {
+	*mock.Call
+}
+
+// MultipartForm is a helper method to define mock.On call
+func (_e *MockContext_Expecter) MultipartForm() *MockContext_MultipartForm_Call {
+	return &MockContext_MultipartForm_Call{Call: _e.mock.On("MultipartForm")}
+}
+
+func (_c *MockContext_MultipartForm_Call) Run(run func()) *MockContext_MultipartForm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_MultipartForm_Call) Return(_a0 *multipart.Form, _a1 error) *MockContext_MultipartForm_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_MultipartForm_Call) RunAndReturn(run func() (*multipart.Form, error)) *MockContext_MultipartForm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MultipartReader provides a mock function with no fields
+func (_m *MockContext) MultipartReader() (*multipart.Reader, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MultipartReader")
+	}
+
+	var r0 *multipart.Reader
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*multipart.Reader, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *multipart.Reader); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*multipart.Reader)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContext_MultipartReader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultipartReader'
+type MockContext_MultipartReader_Call struct {
+	*mock.Call
+}
+
+// MultipartReader is a helper method to define mock.On call
+func (_e *MockContext_Expecter) MultipartReader() *MockContext_MultipartReader_Call {
+	return &MockContext_MultipartReader_Call{Call: _e.mock.On("MultipartReader")}
+}
+
+func (_c *MockContext_MultipartReader_Call) Run(run func()) *MockContext_MultipartReader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_MultipartReader_Call) Return(_a0 *multipart.Reader, _a1 error) *MockContext_MultipartReader_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_MultipartReader_Call) RunAndReturn(run func() (*multipart.Reader, error)) *MockContext_MultipartReader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MustGet provides a mock function with given fields: key
+func (_m *MockContext) MustGet(key string) interface{} {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MustGet")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// MockContext_MustGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MustGet'
+type MockContext_MustGet_Call struct {
+	*mock.Call
+}
+
+// MustGet is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) MustGet(key interface{}) *MockContext_MustGet_Call {
+	return &MockContext_MustGet_Call{Call: _e.mock.On("MustGet", key)}
+}
+
+func (_c *MockContext_MustGet_Call) Run(run func(key string)) *MockContext_MustGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_MustGet_Call) Return(_a0 interface{}) *MockContext_MustGet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_MustGet_Call) RunAndReturn(run func(string) interface{}) *MockContext_MustGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MustParamInt provides a mock function with given fields: name
+func (_m *MockContext) MustParamInt(name string) (int, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MustParamInt")
+	}
+
+	var r0 int
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (int, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockContext_MustParamInt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MustParamInt'
+type MockContext_MustParamInt_Call struct {
+	*mock.Call
+}
+
+// MustParamInt is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) MustParamInt(name interface{}) *MockContext_MustParamInt_Call {
+	return &MockContext_MustParamInt_Call{Call: _e.mock.On("MustParamInt", name)}
+}
+
+func (_c *MockContext_MustParamInt_Call) Run(run func(name string)) *MockContext_MustParamInt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_MustParamInt_Call) Return(_a0 int, _a1 bool) *MockContext_MustParamInt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_MustParamInt_Call) RunAndReturn(run func(string) (int, bool)) *MockContext_MustParamInt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MustParamInt64 provides a mock function with given fields: name
+func (_m *MockContext) MustParamInt64(name string) (int64, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MustParamInt64")
+	}
+
+	var r0 int64
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (int64, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockContext_MustParamInt64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MustParamInt64'
+type MockContext_MustParamInt64_Call struct {
+	*mock.Call
+}
+
+// MustParamInt64 is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) MustParamInt64(name interface{}) *MockContext_MustParamInt64_Call {
+	return &MockContext_MustParamInt64_Call{Call: _e.mock.On("MustParamInt64", name)}
+}
+
+func (_c *MockContext_MustParamInt64_Call) Run(run func(name string)) *MockContext_MustParamInt64_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_MustParamInt64_Call) Return(_a0 int64, _a1 bool) *MockContext_MustParamInt64_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_MustParamInt64_Call) RunAndReturn(run func(string) (int64, bool)) *MockContext_MustParamInt64_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MustParamUUID provides a mock function with given fields: name
+func (_m *MockContext) MustParamUUID(name string) (uuid.UUID, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MustParamUUID")
+	}
+
+	var r0 uuid.UUID
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (uuid.UUID, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) uuid.UUID); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockContext_MustParamUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MustParamUUID'
+type MockContext_MustParamUUID_Call struct {
+	*mock.Call
+}
+
+// MustParamUUID is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) MustParamUUID(name interface{}) *MockContext_MustParamUUID_Call {
+	return &MockContext_MustParamUUID_Call{Call: _e.mock.On("MustParamUUID", name)}
+}
+
+func (_c *MockContext_MustParamUUID_Call) Run(run func(name string)) *MockContext_MustParamUUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_MustParamUUID_Call) Return(_a0 uuid.UUID, _a1 bool) *MockContext_MustParamUUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_MustParamUUID_Call) RunAndReturn(run func(string) (uuid.UUID, bool)) *MockContext_MustParamUUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Negotiate provides a mock function with given fields: code, config
+func (_m *MockContext) Negotiate(code int, config context.Negotiate) {
+	_m.Called(code, config)
+}
+
+// MockContext_Negotiate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Negotiate'
+type MockContext_Negotiate_Call struct {
+	*mock.Call
+}
+
+// Negotiate is a helper method to define mock.On call
+//   - code int
+//   - config context.Negotiate
+func (_e *MockContext_Expecter) Negotiate(code interface{}, config interface{}) *MockContext_Negotiate_Call {
+	return &MockContext_Negotiate_Call{Call: _e.mock.On("Negotiate", code, config)}
+}
+
+func (_c *MockContext_Negotiate_Call) Run(run func(code int, config context.Negotiate)) *MockContext_Negotiate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(context.Negotiate))
+	})
+	return _c
+}
+
+func (_c *MockContext_Negotiate_Call) Return() *MockContext_Negotiate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_Negotiate_Call) RunAndReturn(run func(int, context.Negotiate)) *MockContext_Negotiate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Next provides a mock function with no fields
+func (_m *MockContext) Next() {
+	_m.Called()
+}
+
+// MockContext_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockContext_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Next() *MockContext_Next_Call {
+	return &MockContext_Next_Call{Call: _e.mock.On("Next")}
+}
+
+func (_c *MockContext_Next_Call) Run(run func()) *MockContext_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Next_Call) Return() *MockContext_Next_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_Next_Call) RunAndReturn(run func()) *MockContext_Next_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Paginate provides a mock function with given fields: code, items, total
+func (_m *MockContext) Paginate(code int, items interface{}, total int64) {
+	_m.Called(code, items, total)
+}
+
+// MockContext_Paginate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Paginate'
+type MockContext_Paginate_Call struct {
+	*mock.Call
+}
+
+// Paginate is a helper method to define mock.On call
+//   - code int
+//   - items interface{}
+//   - total int64
+func (_e *MockContext_Expecter) Paginate(code interface{}, items interface{}, total interface{}) *MockContext_Paginate_Call {
+	return &MockContext_Paginate_Call{Call: _e.mock.On("Paginate", code, items, total)}
+}
+
+func (_c *MockContext_Paginate_Call) Run(run func(code int, items interface{}, total int64)) *MockContext_Paginate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(interface{}), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockContext_Paginate_Call) Return() *MockContext_Paginate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_Paginate_Call) RunAndReturn(run func(int, interface{}, int64)) *MockContext_Paginate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PaginateCursor provides a mock function with given fields: code, items, nextCursor
+func (_m *MockContext) PaginateCursor(code int, items interface{}, nextCursor string) {
+	_m.Called(code, items, nextCursor)
+}
+
+// MockContext_PaginateCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaginateCursor'
+type MockContext_PaginateCursor_Call struct {
+	*mock.Call
+}
+
+// PaginateCursor is a helper method to define mock.On call
+//   - code int
+//   - items interface{}
+//   - nextCursor string
+func (_e *MockContext_Expecter) PaginateCursor(code interface{}, items interface{}, nextCursor interface{}) *MockContext_PaginateCursor_Call {
+	return &MockContext_PaginateCursor_Call{Call: _e.mock.On("PaginateCursor", code, items, nextCursor)}
+}
+
+func (_c *MockContext_PaginateCursor_Call) Run(run func(code int, items interface{}, nextCursor string)) *MockContext_PaginateCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(interface{}), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_PaginateCursor_Call) Return() *MockContext_PaginateCursor_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_PaginateCursor_Call) RunAndReturn(run func(int, interface{}, string)) *MockContext_PaginateCursor_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Pagination provides a mock function with no fields
+func (_m *MockContext) Pagination() context.Pagination {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pagination")
+	}
+
+	var r0 context.Pagination
+	if rf, ok := ret.Get(0).(func() context.Pagination); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(context.Pagination)
+	}
+
+	return r0
+}
+
+// MockContext_Pagination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pagination'
+type MockContext_Pagination_Call struct {
+	*mock.Call
+}
+
+// Pagination is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Pagination() *MockContext_Pagination_Call {
+	return &MockContext_Pagination_Call{Call: _e.mock.On("Pagination")}
+}
+
+func (_c *MockContext_Pagination_Call) Run(run func()) *MockContext_Pagination_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Pagination_Call) Return(_a0 context.Pagination) *MockContext_Pagination_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Pagination_Call) RunAndReturn(run func() context.Pagination) *MockContext_Pagination_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Param provides a mock function with given fields: name
+func (_m *MockContext) Param(name string) string {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Param")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockContext_Param_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Param'
+type MockContext_Param_Call struct {
+	*mock.Call
+}
+
+// Param is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) Param(name interface{}) *MockContext_Param_Call {
+	return &MockContext_Param_Call{Call: _e.mock.On("Param", name)}
+}
+
+func (_c *MockContext_Param_Call) Run(run func(name string)) *MockContext_Param_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_Param_Call) Return(_a0 string) *MockContext_Param_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Param_Call) RunAndReturn(run func(string) string) *MockContext_Param_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParamArray provides a mock function with given fields: name
+func (_m *MockContext) ParamArray(name string) []string {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParamArray")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_ParamArray_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParamArray'
+type MockContext_ParamArray_Call struct {
+	*mock.Call
+}
+
+// ParamArray is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) ParamArray(name interface{}) *MockContext_ParamArray_Call {
+	return &MockContext_ParamArray_Call{Call: _e.mock.On("ParamArray", name)}
+}
+
+func (_c *MockContext_ParamArray_Call) Run(run func(name string)) *MockContext_ParamArray_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_ParamArray_Call) Return(_a0 []string) *MockContext_ParamArray_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_ParamArray_Call) RunAndReturn(run func(string) []string) *MockContext_ParamArray_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParamInt provides a mock function with given fields: name
+func (_m *MockContext) ParamInt(name string) (int, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParamInt")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContext_ParamInt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParamInt'
+type MockContext_ParamInt_Call struct {
+	*mock.Call
+}
+
+// ParamInt is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) ParamInt(name interface{}) *MockContext_ParamInt_Call {
+	return &MockContext_ParamInt_Call{Call: _e.mock.On("ParamInt", name)}
+}
+
+func (_c *MockContext_ParamInt_Call) Run(run func(name string)) *MockContext_ParamInt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_ParamInt_Call) Return(_a0 int, _a1 error) *MockContext_ParamInt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_ParamInt_Call) RunAndReturn(run func(string) (int, error)) *MockContext_ParamInt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParamInt64 provides a mock function with given fields: name
+func (_m *MockContext) ParamInt64(name string) (int64, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParamInt64")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContext_ParamInt64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParamInt64'
+type MockContext_ParamInt64_Call struct {
+	*mock.Call
+}
+
+// ParamInt64 is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) ParamInt64(name interface{}) *MockContext_ParamInt64_Call {
+	return &MockContext_ParamInt64_Call{Call: _e.mock.On("ParamInt64", name)}
+}
+
+func (_c *MockContext_ParamInt64_Call) Run(run func(name string)) *MockContext_ParamInt64_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_ParamInt64_Call) Return(_a0 int64, _a1 error) *MockContext_ParamInt64_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_ParamInt64_Call) RunAndReturn(run func(string) (int64, error)) *MockContext_ParamInt64_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParamMap provides a mock function with no fields
+func (_m *MockContext) ParamMap() map[string]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParamMap")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_ParamMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParamMap'
+type MockContext_ParamMap_Call struct {
+	*mock.Call
+}
+
+// ParamMap is a helper method to define mock.On call
+func (_e *MockContext_Expecter) ParamMap() *MockContext_ParamMap_Call {
+	return &MockContext_ParamMap_Call{Call: _e.mock.On("ParamMap")}
+}
+
+func (_c *MockContext_ParamMap_Call) Run(run func()) *MockContext_ParamMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_ParamMap_Call) Return(_a0 map[string]string) *MockContext_ParamMap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_ParamMap_Call) RunAndReturn(run func() map[string]string) *MockContext_ParamMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParamUUID provides a mock function with given fields: name
+func (_m *MockContext) ParamUUID(name string) (uuid.UUID, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParamUUID")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (uuid.UUID, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) uuid.UUID); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockContext_ParamUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParamUUID'
+type MockContext_ParamUUID_Call struct {
+	*mock.Call
+}
+
+// ParamUUID is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) ParamUUID(name interface{}) *MockContext_ParamUUID_Call {
+	return &MockContext_ParamUUID_Call{Call: _e.mock.On("ParamUUID", name)}
+}
+
+func (_c *MockContext_ParamUUID_Call) Run(run func(name string)) *MockContext_ParamUUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_ParamUUID_Call) Return(_a0 uuid.UUID, _a1 error) *MockContext_ParamUUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_ParamUUID_Call) RunAndReturn(run func(string) (uuid.UUID, error)) *MockContext_ParamUUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Params provides a mock function with no fields
+func (_m *MockContext) Params() map[string]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Params")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_Params_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Params'
+type MockContext_Params_Call struct {
+	*mock.Call
+}
+
+// Params is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Params() *MockContext_Params_Call {
+	return &MockContext_Params_Call{Call: _e.mock.On("Params")}
+}
+
+func (_c *MockContext_Params_Call) Run(run func()) *MockContext_Params_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Params_Call) Return(_a0 map[string]string) *MockContext_Params_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Params_Call) RunAndReturn(run func() map[string]string) *MockContext_Params_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Path provides a mock function with no fields
+func (_m *MockContext) Path() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Path")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockContext_Path_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Path'
+type MockContext_Path_Call struct {
+	*mock.Call
+}
+
+// Path is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Path() *MockContext_Path_Call {
+	return &MockContext_Path_Call{Call: _e.mock.On("Path")}
+}
+
+func (_c *MockContext_Path_Call) Run(run func()) *MockContext_Path_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Path_Call) Return(_a0 string) *MockContext_Path_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Path_Call) RunAndReturn(run func() string) *MockContext_Path_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: name
+func (_m *MockContext) Query(name string) string {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockContext_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockContext_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) Query(name interface{}) *MockContext_Query_Call {
+	return &MockContext_Query_Call{Call: _e.mock.On("Query", name)}
+}
+
+func (_c *MockContext_Query_Call) Run(run func(name string)) *MockContext_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_Query_Call) Return(_a0 string) *MockContext_Query_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Query_Call) RunAndReturn(run func(string) string) *MockContext_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryArray provides a mock function with given fields: name
+func (_m *MockContext) QueryArray(name string) []string {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryArray")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_QueryArray_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryArray'
+type MockContext_QueryArray_Call struct {
+	*mock.Call
+}
+
+// QueryArray is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) QueryArray(name interface{}) *MockContext_QueryArray_Call {
+	return &MockContext_QueryArray_Call{Call: _e.mock.On("QueryArray", name)}
+}
+
+func (_c *MockContext_QueryArray_Call) Run(run func(name string)) *MockContext_QueryArray_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_QueryArray_Call) Return(_a0 []string) *MockContext_QueryArray_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_QueryArray_Call) RunAndReturn(run func(string) []string) *MockContext_QueryArray_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryBool provides a mock function with given fields: name, defaultValue
+func (_m *MockContext) QueryBool(name string, defaultValue bool) bool {
+	ret := _m.Called(name, defaultValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryBool")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, bool) bool); ok {
+		r0 = rf(name, defaultValue)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockContext_QueryBool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryBool'
+type MockContext_QueryBool_Call struct {
+	*mock.Call
+}
+
+// QueryBool is a helper method to define mock.On call
+//   - name string
+//   - defaultValue bool
+func (_e *MockContext_Expecter) QueryBool(name interface{}, defaultValue interface{}) *MockContext_QueryBool_Call {
+	return &MockContext_QueryBool_Call{Call: _e.mock.On("QueryBool", name, defaultValue)}
+}
+
+func (_c *MockContext_QueryBool_Call) Run(run func(name string, defaultValue bool)) *MockContext_QueryBool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockContext_QueryBool_Call) Return(_a0 bool) *MockContext_QueryBool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_QueryBool_Call) RunAndReturn(run func(string, bool) bool) *MockContext_QueryBool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryFloat64 provides a mock function with given fields: name, defaultValue
+func (_m *MockContext) QueryFloat64(name string, defaultValue float64) float64 {
+	ret := _m.Called(name, defaultValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryFloat64")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(string, float64) float64); ok {
+		r0 = rf(name, defaultValue)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// MockContext_QueryFloat64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryFloat64'
+type MockContext_QueryFloat64_Call struct {
+	*mock.Call
+}
+
+// QueryFloat64 is a helper method to define mock.On call
+//   - name string
+//   - defaultValue float64
+func (_e *MockContext_Expecter) QueryFloat64(name interface{}, defaultValue interface{}) *MockContext_QueryFloat64_Call {
+	return &MockContext_QueryFloat64_Call{Call: _e.mock.On("QueryFloat64", name, defaultValue)}
+}
+
+func (_c *MockContext_QueryFloat64_Call) Run(run func(name string, defaultValue float64)) *MockContext_QueryFloat64_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(float64))
+	})
+	return _c
+}
+
+func (_c *MockContext_QueryFloat64_Call) Return(_a0 float64) *MockContext_QueryFloat64_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_QueryFloat64_Call) RunAndReturn(run func(string, float64) float64) *MockContext_QueryFloat64_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryInt provides a mock function with given fields: name, defaultValue
+func (_m *MockContext) QueryInt(name string, defaultValue int) int {
+	ret := _m.Called(name, defaultValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryInt")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, int) int); ok {
+		r0 = rf(name, defaultValue)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// MockContext_QueryInt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryInt'
+type MockContext_QueryInt_Call struct {
+	*mock.Call
+}
+
+// QueryInt is a helper method to define mock.On call
+//   - name string
+//   - defaultValue int
+func (_e *MockContext_Expecter) QueryInt(name interface{}, defaultValue interface{}) *MockContext_QueryInt_Call {
+	return &MockContext_QueryInt_Call{Call: _e.mock.On("QueryInt", name, defaultValue)}
+}
+
+func (_c *MockContext_QueryInt_Call) Run(run func(name string, defaultValue int)) *MockContext_QueryInt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockContext_QueryInt_Call) Return(_a0 int) *MockContext_QueryInt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_QueryInt_Call) RunAndReturn(run func(string, int) int) *MockContext_QueryInt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryInt64 provides a mock function with given fields: name, defaultValue
+func (_m *MockContext) QueryInt64(name string, defaultValue int64) int64 {
+	ret := _m.Called(name, defaultValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryInt64")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, int64) int64); ok {
+		r0 = rf(name, defaultValue)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// MockContext_QueryInt64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryInt64'
+type MockContext_QueryInt64_Call struct {
+	*mock.Call
+}
+
+// QueryInt64 is a helper method to define mock.On call
+//   - name string
+//   - defaultValue int64
+func (_e *MockContext_Expecter) QueryInt64(name interface{}, defaultValue interface{}) *MockContext_QueryInt64_Call {
+	return &MockContext_QueryInt64_Call{Call: _e.mock.On("QueryInt64", name, defaultValue)}
+}
+
+func (_c *MockContext_QueryInt64_Call) Run(run func(name string, defaultValue int64)) *MockContext_QueryInt64_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockContext_QueryInt64_Call) Return(_a0 int64) *MockContext_QueryInt64_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_QueryInt64_Call) RunAndReturn(run func(string, int64) int64) *MockContext_QueryInt64_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryMap provides a mock function with given fields: prefix
+func (_m *MockContext) QueryMap(prefix string) map[string]string {
+	ret := _m.Called(prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryMap")
+	}
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
+		r0 = rf(prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_QueryMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryMap'
+type MockContext_QueryMap_Call struct {
+	*mock.Call
+}
+
+// QueryMap is a helper method to define mock.On call
+//   - prefix string
+func (_e *MockContext_Expecter) QueryMap(prefix interface{}) *MockContext_QueryMap_Call {
+	return &MockContext_QueryMap_Call{Call: _e.mock.On("QueryMap", prefix)}
+}
+
+func (_c *MockContext_QueryMap_Call) Run(run func(prefix string)) *MockContext_QueryMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_QueryMap_Call) Return(_a0 map[string]string) *MockContext_QueryMap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_QueryMap_Call) RunAndReturn(run func(string) map[string]string) *MockContext_QueryMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryTime provides a mock function with given fields: name, layout, defaultValue
+func (_m *MockContext) QueryTime(name string, layout string, defaultValue time.Time) time.Time {
+	ret := _m.Called(name, layout, defaultValue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryTime")
+	}
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) time.Time); ok {
+		r0 = rf(name, layout, defaultValue)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
+// MockContext_QueryTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryTime'
+type MockContext_QueryTime_Call struct {
+	*mock.Call
+}
+
+// QueryTime is a helper method to define mock.On call
+//   - name string
+//   - layout string
+//   - defaultValue time.Time
+func (_e *MockContext_Expecter) QueryTime(name interface{}, layout interface{}, defaultValue interface{}) *MockContext_QueryTime_Call {
+	return &MockContext_QueryTime_Call{Call: _e.mock.On("QueryTime", name, layout, defaultValue)}
+}
+
+func (_c *MockContext_QueryTime_Call) Run(run func(name string, layout string, defaultValue time.Time)) *MockContext_QueryTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockContext_QueryTime_Call) Return(_a0 time.Time) *MockContext_QueryTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_QueryTime_Call) RunAndReturn(run func(string, string, time.Time) time.Time) *MockContext_QueryTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RawPath provides a mock function with no fields
+func (_m *MockContext) RawPath() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RawPath")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockContext_RawPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RawPath'
+type MockContext_RawPath_Call struct {
+	*mock.Call
+}
+
+// RawPath is a helper method to define mock.On call
+func (_e *MockContext_Expecter) RawPath() *MockContext_RawPath_Call {
+	return &MockContext_RawPath_Call{Call: _e.mock.On("RawPath")}
+}
+
+func (_c *MockContext_RawPath_Call) Run(run func()) *MockContext_RawPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_RawPath_Call) Return(_a0 string) *MockContext_RawPath_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_RawPath_Call) RunAndReturn(run func() string) *MockContext_RawPath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redirect provides a mock function with given fields: code, location
+func (_m *MockContext) Redirect(code int, location string) {
+	_m.Called(code, location)
+}
+
+// MockContext_Redirect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redirect'
+type MockContext_Redirect_Call struct {
+	*mock.Call
+}
+
+// Redirect is a helper method to define mock.On call
+//   - code int
+//   - location string
+func (_e *MockContext_Expecter) Redirect(code interface{}, location interface{}) *MockContext_Redirect_Call {
+	return &MockContext_Redirect_Call{Call: _e.mock.On("Redirect", code, location)}
+}
+
+func (_c *MockContext_Redirect_Call) Run(run func(code int, location string)) *MockContext_Redirect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_Redirect_Call) Return() *MockContext_Redirect_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_Redirect_Call) RunAndReturn(run func(int, string)) *MockContext_Redirect_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RegisterValidation provides a mock function with given fields: tag, fn
+func (_m *MockContext) RegisterValidation(tag string, fn validator.Func) error {
+	ret := _m.Called(tag, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterValidation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, validator.Func) error); ok {
+		r0 = rf(tag, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContext_RegisterValidation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterValidation'
+type MockContext_RegisterValidation_Call struct {
+	*mock.Call
+}
+
+// RegisterValidation is a helper method to define mock.On call
+//   - tag string
+//   - fn validator.Func
+func (_e *MockContext_Expecter) RegisterValidation(tag interface{}, fn interface{}) *MockContext_RegisterValidation_Call {
+	return &MockContext_RegisterValidation_Call{Call: _e.mock.On("RegisterValidation", tag, fn)}
+}
+
+func (_c *MockContext_RegisterValidation_Call) Run(run func(tag string, fn validator.Func)) *MockContext_RegisterValidation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(validator.Func))
+	})
+	return _c
+}
+
+func (_c *MockContext_RegisterValidation_Call) Return(_a0 error) *MockContext_RegisterValidation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_RegisterValidation_Call) RunAndReturn(run func(string, validator.Func) error) *MockContext_RegisterValidation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Render provides a mock function with given fields: code, name, data
+func (_m *MockContext) Render(code int, name string, data interface{}) {
+	_m.Called(code, name, data)
+}
+
+// MockContext_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type MockContext_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - code int
+//   - name string
+//   - data interface{}
+func (_e *MockContext_Expecter) Render(code interface{}, name interface{}, data interface{}) *MockContext_Render_Call {
+	return &MockContext_Render_Call{Call: _e.mock.On("Render", code, name, data)}
+}
+
+func (_c *MockContext_Render_Call) Run(run func(code int, name string, data interface{})) *MockContext_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContext_Render_Call) Return() *MockContext_Render_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_Render_Call) RunAndReturn(run func(int, string, interface{})) *MockContext_Render_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Request provides a mock function with no fields
+func (_m *MockContext) Request() context.Request {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Request")
+	}
+
+	var r0 context.Request
+	if rf, ok := ret.Get(0).(func() context.Request); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Request)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_Request_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Request'
+type MockContext_Request_Call struct {
+	*mock.Call
+}
+
+// Request is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Request() *MockContext_Request_Call {
+	return &MockContext_Request_Call{Call: _e.mock.On("Request")}
+}
+
+func (_c *MockContext_Request_Call) Run(run func()) *MockContext_Request_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Request_Call) Return(_a0 context.Request) *MockContext_Request_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Request_Call) RunAndReturn(run func() context.Request) *MockContext_Request_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestID provides a mock function with no fields
+func (_m *MockContext) RequestID() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestID")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockContext_RequestID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestID'
+type MockContext_RequestID_Call struct {
+	*mock.Call
+}
+
+// RequestID is a helper method to define mock.On call
+func (_e *MockContext_Expecter) RequestID() *MockContext_RequestID_Call {
+	return &MockContext_RequestID_Call{Call: _e.mock.On("RequestID")}
+}
+
+func (_c *MockContext_RequestID_Call) Run(run func()) *MockContext_RequestID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_RequestID_Call) Return(_a0 string) *MockContext_RequestID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_RequestID_Call) RunAndReturn(run func() string) *MockContext_RequestID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Response provides a mock function with no fields
+func (_m *MockContext) Response() context.Response {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Response")
+	}
+
+	var r0 context.Response
+	if rf, ok := ret.Get(0).(func() context.Response); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Response)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_Response_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Response'
+type MockContext_Response_Call struct {
+	*mock.Call
+}
+
+// Response is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Response() *MockContext_Response_Call {
+	return &MockContext_Response_Call{Call: _e.mock.On("Response")}
+}
+
+func (_c *MockContext_Response_Call) Run(run func()) *MockContext_Response_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_Response_Call) Return(_a0 context.Response) *MockContext_Response_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Response_Call) RunAndReturn(run func() context.Response) *MockContext_Response_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RouteMeta provides a mock function with given fields: key
+func (_m *MockContext) RouteMeta(key string) (interface{}, bool) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RouteMeta")
+	}
+
+	var r0 interface{}
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (interface{}, bool)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockContext_RouteMeta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RouteMeta'
+type MockContext_RouteMeta_Call struct {
+	*mock.Call
+}
+
+// RouteMeta is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) RouteMeta(key interface{}) *MockContext_RouteMeta_Call {
+	return &MockContext_RouteMeta_Call{Call: _e.mock.On("RouteMeta", key)}
+}
+
+func (_c *MockContext_RouteMeta_Call) Run(run func(key string)) *MockContext_RouteMeta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_RouteMeta_Call) Return(_a0 interface{}, _a1 bool) *MockContext_RouteMeta_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockContext_RouteMeta_Call) RunAndReturn(run func(string) (interface{}, bool)) *MockContext_RouteMeta_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveUploadedFile provides a mock function with given fields: file, dst
+func (_m *MockContext) SaveUploadedFile(file *multipart.FileHeader, dst string) error {
+	ret := _m.Called(file, dst)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUploadedFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*multipart.FileHeader, string) error); ok {
+		r0 = rf(file, dst)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContext_SaveUploadedFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUploadedFile'
+type MockContext_SaveUploadedFile_Call struct {
+	*mock.Call
+}
+
+// SaveUploadedFile is a helper method to define mock.On call
+//   - file *multipart.FileHeader
+//   - dst string
+func (_e *MockContext_Expecter) SaveUploadedFile(file interface{}, dst interface{}) *MockContext_SaveUploadedFile_Call {
+	return &MockContext_SaveUploadedFile_Call{Call: _e.mock.On("SaveUploadedFile", file, dst)}
+}
+
+func (_c *MockContext_SaveUploadedFile_Call) Run(run func(file *multipart.FileHeader, dst string)) *MockContext_SaveUploadedFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*multipart.FileHeader), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_SaveUploadedFile_Call) Return(_a0 error) *MockContext_SaveUploadedFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_SaveUploadedFile_Call) RunAndReturn(run func(*multipart.FileHeader, string) error) *MockContext_SaveUploadedFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: key, value
+func (_m *MockContext) Set(key string, value interface{}) {
+	_m.Called(key, value)
+}
+
+// MockContext_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockContext_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - key string
+//   - value interface{}
+func (_e *MockContext_Expecter) Set(key interface{}, value interface{}) *MockContext_Set_Call {
+	return &MockContext_Set_Call{Call: _e.mock.On("Set", key, value)}
+}
+
+func (_c *MockContext_Set_Call) Run(run func(key string, value interface{})) *MockContext_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContext_Set_Call) Return() *MockContext_Set_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_Set_Call) RunAndReturn(run func(string, interface{})) *MockContext_Set_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetCookie provides a mock function with given fields: name, value, maxAge, path, domain, secure, httpOnly
+func (_m *MockContext) SetCookie(name string, value string, maxAge int, path string, domain string, secure bool, httpOnly bool) {
+	_m.Called(name, value, maxAge, path, domain, secure, httpOnly)
+}
+
+// MockContext_SetCookie_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCookie'
+type MockContext_SetCookie_Call struct {
+	*mock.Call
+}
+
+// SetCookie is a helper method to define mock.On call
+//   - name string
+//   - value string
+//   - maxAge int
+//   - path string
+//   - domain string
+//   - secure bool
+//   - httpOnly bool
+func (_e *MockContext_Expecter) SetCookie(name interface{}, value interface{}, maxAge interface{}, path interface{}, domain interface{}, secure interface{}, httpOnly interface{}) *MockContext_SetCookie_Call {
+	return &MockContext_SetCookie_Call{Call: _e.mock.On("SetCookie", name, value, maxAge, path, domain, secure, httpOnly)}
+}
+
+func (_c *MockContext_SetCookie_Call) Run(run func(name string, value string, maxAge int, path string, domain string, secure bool, httpOnly bool)) *MockContext_SetCookie_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int), args[3].(string), args[4].(string), args[5].(bool), args[6].(bool))
+	})
+	return _c
+}
+
+func (_c *MockContext_SetCookie_Call) Return() *MockContext_SetCookie_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_SetCookie_Call) RunAndReturn(run func(string, string, int, string, string, bool, bool)) *MockContext_SetCookie_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetCookieWithOptions provides a mock function with given fields: cookie
+func (_m *MockContext) SetCookieWithOptions(cookie http.Cookie) {
+	_m.Called(cookie)
+}
+
+// MockContext_SetCookieWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCookieWithOptions'
+type MockContext_SetCookieWithOptions_Call struct {
+	*mock.Call
+}
+
+// SetCookieWithOptions is a helper method to define mock.On call
+//   - cookie http.Cookie
+func (_e *MockContext_Expecter) SetCookieWithOptions(cookie interface{}) *MockContext_SetCookieWithOptions_Call {
+	return &MockContext_SetCookieWithOptions_Call{Call: _e.mock.On("SetCookieWithOptions", cookie)}
+}
+
+func (_c *MockContext_SetCookieWithOptions_Call) Run(run func(cookie http.Cookie)) *MockContext_SetCookieWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(http.Cookie))
+	})
+	return _c
+}
+
+func (_c *MockContext_SetCookieWithOptions_Call) Return() *MockContext_SetCookieWithOptions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_SetCookieWithOptions_Call) RunAndReturn(run func(http.Cookie)) *MockContext_SetCookieWithOptions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetFullPath provides a mock function with given fields: path
+func (_m *MockContext) SetFullPath(path string) {
+	_m.Called(path)
+}
+
+// MockContext_SetFullPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFullPath'
+type MockContext_SetFullPath_Call struct {
+	*mock.Call
+}
+
+// SetFullPath is a helper method to define mock.On call
+//   - path string
+func (_e *MockContext_Expecter) SetFullPath(path interface{}) *MockContext_SetFullPath_Call {
+	return &MockContext_SetFullPath_Call{Call: _e.mock.On("SetFullPath", path)}
+}
+
+func (_c *MockContext_SetFullPath_Call) Run(run func(path string)) *MockContext_SetFullPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_SetFullPath_Call) Return() *MockContext_SetFullPath_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_SetFullPath_Call) RunAndReturn(run func(string)) *MockContext_SetFullPath_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetHandlers provides a mock function with given fields: handlers
+func (_m *MockContext) SetHandlers(handlers []func(context.Context)) {
+	_m.Called(handlers)
+}
+
+// MockContext_SetHandlers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHandlers'
+type MockContext_SetHandlers_Call struct {
+	*mock.Call
+}
+
+// SetHandlers is a helper method to define mock.On call
+//   - handlers []func(context.Context)
+func (_e *MockContext_Expecter) SetHandlers(handlers interface{}) *MockContext_SetHandlers_Call {
+	return &MockContext_SetHandlers_Call{Call: _e.mock.On("SetHandlers", handlers)}
+}
+
+func (_c *MockContext_SetHandlers_Call) Run(run func(handlers []func(context.Context))) *MockContext_SetHandlers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]func(context.Context)))
+	})
+	return _c
+}
+
+func (_c *MockContext_SetHandlers_Call) Return() *MockContext_SetHandlers_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_SetHandlers_Call) RunAndReturn(run func([]func(context.Context))) *MockContext_SetHandlers_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetLogger provides a mock function with given fields: logger
+func (_m *MockContext) SetLogger(logger context.Logger) {
+	_m.Called(logger)
+}
+
+// MockContext_SetLogger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLogger'
+type MockContext_SetLogger_Call struct {
+	*mock.Call
+}
+
+// SetLogger is a helper method to define mock.On call
+//   - logger context.Logger
+func (_e *MockContext_Expecter) SetLogger(logger interface{}) *MockContext_SetLogger_Call {
+	return &MockContext_SetLogger_Call{Call: _e.mock.On("SetLogger", logger)}
+}
+
+func (_c *MockContext_SetLogger_Call) Run(run func(logger context.Logger)) *MockContext_SetLogger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Logger))
+	})
+	return _c
+}
+
+func (_c *MockContext_SetLogger_Call) Return() *MockContext_SetLogger_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_SetLogger_Call) RunAndReturn(run func(context.Logger)) *MockContext_SetLogger_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetParams provides a mock function with given fields: params
+func (_m *MockContext) SetParams(params map[string]string) {
+	_m.Called(params)
+}
+
+// MockContext_SetParams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetParams'
+type MockContext_SetParams_Call struct {
+	*mock.Call
+}
+
+// SetParams is a helper method to define mock.On call
+//   - params map[string]string
+func (_e *MockContext_Expecter) SetParams(params interface{}) *MockContext_SetParams_Call {
+	return &MockContext_SetParams_Call{Call: _e.mock.On("SetParams", params)}
+}
+
+func (_c *MockContext_SetParams_Call) Run(run func(params map[string]string)) *MockContext_SetParams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *MockContext_SetParams_Call) Return() *MockContext_SetParams_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_SetParams_Call) RunAndReturn(run func(map[string]string)) *MockContext_SetParams_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetRouteMeta provides a mock function with given fields: meta
+func (_m *MockContext) SetRouteMeta(meta map[string]interface{}) {
+	_m.Called(meta)
+}
+
+// MockContext_SetRouteMeta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRouteMeta'
+type MockContext_SetRouteMeta_Call struct {
+	*mock.Call
+}
+
+// SetRouteMeta is a helper method to define mock.On call
+//   - meta map[string]interface{}
+func (_e *MockContext_Expecter) SetRouteMeta(meta interface{}) *MockContext_SetRouteMeta_Call {
+	return &MockContext_SetRouteMeta_Call{Call: _e.mock.On("SetRouteMeta", meta)}
+}
+
+func (_c *MockContext_SetRouteMeta_Call) Run(run func(meta map[string]interface{})) *MockContext_SetRouteMeta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockContext_SetRouteMeta_Call) Return() *MockContext_SetRouteMeta_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_SetRouteMeta_Call) RunAndReturn(run func(map[string]interface{})) *MockContext_SetRouteMeta_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetTrailer provides a mock function with given fields: key
+func (_m *MockContext) SetTrailer(key string) {
+	_m.Called(key)
+}
+
+// MockContext_SetTrailer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTrailer'
+type MockContext_SetTrailer_Call struct {
+	*mock.Call
+}
+
+// SetTrailer is a helper method to define mock.On call
+//   - key string
+func (_e *MockContext_Expecter) SetTrailer(key interface{}) *MockContext_SetTrailer_Call {
+	return &MockContext_SetTrailer_Call{Call: _e.mock.On("SetTrailer", key)}
+}
+
+func (_c *MockContext_SetTrailer_Call) Run(run func(key string)) *MockContext_SetTrailer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_SetTrailer_Call) Return() *MockContext_SetTrailer_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_SetTrailer_Call) RunAndReturn(run func(string)) *MockContext_SetTrailer_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetValidator provides a mock function with given fields: v
+func (_m *MockContext) SetValidator(v context.Validator) {
+	_m.Called(v)
+}
+
+// MockContext_SetValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetValidator'
+type MockContext_SetValidator_Call struct {
+	*mock.Call
 }
 
-func (_c *MockContext_Redirect_Call) Run(run func(code int, location string)) *MockContext_Redirect_Call {
+// SetValidator is a helper method to define mock.On call
+//   - v context.Validator
+func (_e *MockContext_Expecter) SetValidator(v interface{}) *MockContext_SetValidator_Call {
+	return &MockContext_SetValidator_Call{Call: _e.mock.On("SetValidator", v)}
+}
+
+func (_c *MockContext_SetValidator_Call) Run(run func(v context.Validator)) *MockContext_SetValidator_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(string))
+		run(args[0].(context.Validator))
 	})
 	return _c
 }
 
-func (_c *MockContext_Redirect_Call) Return() *MockContext_Redirect_Call {
+func (_c *MockContext_SetValidator_Call) Return() *MockContext_SetValidator_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockContext_Redirect_Call) RunAndReturn(run func(int, string)) *MockContext_Redirect_Call {
+func (_c *MockContext_SetValidator_Call) RunAndReturn(run func(context.Validator)) *MockContext_SetValidator_Call {
 	_c.Run(run)
 	return _c
 }
 
-// RegisterValidation provides a mock function with given fields: tag, fn
-func (_m *MockContext) RegisterValidation(tag string, fn validator.Func) error {
-	ret := _m.Called(tag, fn)
+// ShouldBind provides a mock function with given fields: obj
+func (_m *MockContext) ShouldBind(obj interface{}) error {
+	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterValidation")
+		panic("no return value specified for ShouldBind")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, validator.Func) error); ok {
-		r0 = rf(tag, fn)
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2517,405 +5463,448 @@ func (_m *MockContext) RegisterValidation(tag string, fn validator.Func) error {
 	return r0
 }
 
-// MockContext_RegisterValidation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterValidation'
-type MockContext_RegisterValidation_Call struct {
+// MockContext_ShouldBind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldBind'
+type MockContext_ShouldBind_Call struct {
 	*mock.Call
 }
 
-// RegisterValidation is a helper method to define mock.On call
-//   - tag string
-//   - fn validator.Func
-func (_e *MockContext_Expecter) RegisterValidation(tag interface{}, fn interface{}) *MockContext_RegisterValidation_Call {
-	return &MockContext_RegisterValidation_Call{Call: _e.mock.On("RegisterValidation", tag, fn)}
+// ShouldBind is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) ShouldBind(obj interface{}) *MockContext_ShouldBind_Call {
+	return &MockContext_ShouldBind_Call{Call: _e.mock.On("ShouldBind", obj)}
 }
 
-func (_c *MockContext_RegisterValidation_Call) Run(run func(tag string, fn validator.Func)) *MockContext_RegisterValidation_Call {
+func (_c *MockContext_ShouldBind_Call) Run(run func(obj interface{})) *MockContext_ShouldBind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(validator.Func))
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_RegisterValidation_Call) Return(_a0 error) *MockContext_RegisterValidation_Call {
+func (_c *MockContext_ShouldBind_Call) Return(_a0 error) *MockContext_ShouldBind_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_RegisterValidation_Call) RunAndReturn(run func(string, validator.Func) error) *MockContext_RegisterValidation_Call {
+func (_c *MockContext_ShouldBind_Call) RunAndReturn(run func(interface{}) error) *MockContext_ShouldBind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Render provides a mock function with given fields: code, name, data
-func (_m *MockContext) Render(code int, name string, data interface{}) {
-	_m.Called(code, name, data)
+// ShouldBindAndValidate provides a mock function with given fields: obj
+func (_m *MockContext) ShouldBindAndValidate(obj interface{}) error {
+	ret := _m.Called(obj)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShouldBindAndValidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
-// MockContext_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
-type MockContext_Render_Call struct {
+// MockContext_ShouldBindAndValidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldBindAndValidate'
+type MockContext_ShouldBindAndValidate_Call struct {
 	*mock.Call
 }
 
-// Render is a helper method to define mock.On call
-//   - code int
-//   - name string
-//   - data interface{}
-func (_e *MockContext_Expecter) Render(code interface{}, name interface{}, data interface{}) *MockContext_Render_Call {
-	return &MockContext_Render_Call{Call: _e.mock.On("Render", code, name, data)}
+// ShouldBindAndValidate is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) ShouldBindAndValidate(obj interface{}) *MockContext_ShouldBindAndValidate_Call {
+	return &MockContext_ShouldBindAndValidate_Call{Call: _e.mock.On("ShouldBindAndValidate", obj)}
 }
 
-func (_c *MockContext_Render_Call) Run(run func(code int, name string, data interface{})) *MockContext_Render_Call {
+func (_c *MockContext_ShouldBindAndValidate_Call) Run(run func(obj interface{})) *MockContext_ShouldBindAndValidate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(string), args[2].(interface{}))
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_Render_Call) Return() *MockContext_Render_Call {
-	_c.Call.Return()
+func (_c *MockContext_ShouldBindAndValidate_Call) Return(_a0 error) *MockContext_ShouldBindAndValidate_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Render_Call) RunAndReturn(run func(int, string, interface{})) *MockContext_Render_Call {
-	_c.Run(run)
+func (_c *MockContext_ShouldBindAndValidate_Call) RunAndReturn(run func(interface{}) error) *MockContext_ShouldBindAndValidate_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// Request provides a mock function with no fields
-func (_m *MockContext) Request() context.Request {
-	ret := _m.Called()
+// ShouldBindJSONStrict provides a mock function with given fields: obj
+func (_m *MockContext) ShouldBindJSONStrict(obj interface{}) error {
+	ret := _m.Called(obj)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Request")
+		panic("no return value specified for ShouldBindJSONStrict")
 	}
 
-	var r0 context.Request
-	if rf, ok := ret.Get(0).(func() context.Request); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(context.Request)
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockContext_Request_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Request'
-type MockContext_Request_Call struct {
+// MockContext_ShouldBindJSONStrict_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldBindJSONStrict'
+type MockContext_ShouldBindJSONStrict_Call struct {
 	*mock.Call
 }
 
-// Request is a helper method to define mock.On call
-func (_e *MockContext_Expecter) Request() *MockContext_Request_Call {
-	return &MockContext_Request_Call{Call: _e.mock.On("Request")}
+// ShouldBindJSONStrict is a helper method to define mock.On call
+//   - obj interface{}
+func (_e *MockContext_Expecter) ShouldBindJSONStrict(obj interface{}) *MockContext_ShouldBindJSONStrict_Call {
+	return &MockContext_ShouldBindJSONStrict_Call{Call: _e.mock.On("ShouldBindJSONStrict", obj)}
 }
 
-func (_c *MockContext_Request_Call) Run(run func()) *MockContext_Request_Call {
+func (_c *MockContext_ShouldBindJSONStrict_Call) Run(run func(obj interface{})) *MockContext_ShouldBindJSONStrict_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(interface{}))
 	})
 	return _c
 }
 
-func (_c *MockContext_Request_Call) Return(_a0 context.Request) *MockContext_Request_Call {
+func (_c *MockContext_ShouldBindJSONStrict_Call) Return(_a0 error) *MockContext_ShouldBindJSONStrict_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_Request_Call) RunAndReturn(run func() context.Request) *MockContext_Request_Call {
+func (_c *MockContext_ShouldBindJSONStrict_Call) RunAndReturn(run func(interface{}) error) *MockContext_ShouldBindJSONStrict_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Response provides a mock function with no fields
-func (_m *MockContext) Response() context.Response {
-	ret := _m.Called()
+// ShouldQueryBool provides a mock function with given fields: name
+func (_m *MockContext) ShouldQueryBool(name string) (bool, error) {
+	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Response")
+		panic("no return value specified for ShouldQueryBool")
 	}
 
-	var r0 context.Response
-	if rf, ok := ret.Get(0).(func() context.Response); ok {
-		r0 = rf()
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(context.Response)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockContext_Response_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Response'
-type MockContext_Response_Call struct {
+// MockContext_ShouldQueryBool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldQueryBool'
+type MockContext_ShouldQueryBool_Call struct {
 	*mock.Call
 }
 
-// Response is a helper method to define mock.On call
-func (_e *MockContext_Expecter) Response() *MockContext_Response_Call {
-	return &MockContext_Response_Call{Call: _e.mock.On("Response")}
+// ShouldQueryBool is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) ShouldQueryBool(name interface{}) *MockContext_ShouldQueryBool_Call {
+	return &MockContext_ShouldQueryBool_Call{Call: _e.mock.On("ShouldQueryBool", name)}
 }
 
-func (_c *MockContext_Response_Call) Run(run func()) *MockContext_Response_Call {
+func (_c *MockContext_ShouldQueryBool_Call) Run(run func(name string)) *MockContext_ShouldQueryBool_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_Response_Call) Return(_a0 context.Response) *MockContext_Response_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_ShouldQueryBool_Call) Return(_a0 bool, _a1 error) *MockContext_ShouldQueryBool_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_Response_Call) RunAndReturn(run func() context.Response) *MockContext_Response_Call {
+func (_c *MockContext_ShouldQueryBool_Call) RunAndReturn(run func(string) (bool, error)) *MockContext_ShouldQueryBool_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveUploadedFile provides a mock function with given fields: file, dst
-func (_m *MockContext) SaveUploadedFile(file *multipart.FileHeader, dst string) error {
-	ret := _m.Called(file, dst)
+// ShouldQueryFloat64 provides a mock function with given fields: name
+func (_m *MockContext) ShouldQueryFloat64(name string) (float64, error) {
+	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveUploadedFile")
+		panic("no return value specified for ShouldQueryFloat64")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*multipart.FileHeader, string) error); ok {
-		r0 = rf(file, dst)
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (float64, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) float64); ok {
+		r0 = rf(name)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(float64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockContext_SaveUploadedFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUploadedFile'
-type MockContext_SaveUploadedFile_Call struct {
+// MockContext_ShouldQueryFloat64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldQueryFloat64'
+type MockContext_ShouldQueryFloat64_Call struct {
 	*mock.Call
 }
 
-// SaveUploadedFile is a helper method to define mock.On call
-//   - file *multipart.FileHeader
-//   - dst string
-func (_e *MockContext_Expecter) SaveUploadedFile(file interface{}, dst interface{}) *MockContext_SaveUploadedFile_Call {
-	return &MockContext_SaveUploadedFile_Call{Call: _e.mock.On("SaveUploadedFile", file, dst)}
+// ShouldQueryFloat64 is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) ShouldQueryFloat64(name interface{}) *MockContext_ShouldQueryFloat64_Call {
+	return &MockContext_ShouldQueryFloat64_Call{Call: _e.mock.On("ShouldQueryFloat64", name)}
 }
 
-func (_c *MockContext_SaveUploadedFile_Call) Run(run func(file *multipart.FileHeader, dst string)) *MockContext_SaveUploadedFile_Call {
+func (_c *MockContext_ShouldQueryFloat64_Call) Run(run func(name string)) *MockContext_ShouldQueryFloat64_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*multipart.FileHeader), args[1].(string))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_SaveUploadedFile_Call) Return(_a0 error) *MockContext_SaveUploadedFile_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_ShouldQueryFloat64_Call) Return(_a0 float64, _a1 error) *MockContext_ShouldQueryFloat64_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_SaveUploadedFile_Call) RunAndReturn(run func(*multipart.FileHeader, string) error) *MockContext_SaveUploadedFile_Call {
+func (_c *MockContext_ShouldQueryFloat64_Call) RunAndReturn(run func(string) (float64, error)) *MockContext_ShouldQueryFloat64_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Set provides a mock function with given fields: key, value
-func (_m *MockContext) Set(key string, value interface{}) {
-	_m.Called(key, value)
-}
-
-// MockContext_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
-type MockContext_Set_Call struct {
-	*mock.Call
-}
-
-// Set is a helper method to define mock.On call
-//   - key string
-//   - value interface{}
-func (_e *MockContext_Expecter) Set(key interface{}, value interface{}) *MockContext_Set_Call {
-	return &MockContext_Set_Call{Call: _e.mock.On("Set", key, value)}
-}
+// ShouldQueryInt provides a mock function with given fields: name
+func (_m *MockContext) ShouldQueryInt(name string) (int, error) {
+	ret := _m.Called(name)
 
-func (_c *MockContext_Set_Call) Run(run func(key string, value interface{})) *MockContext_Set_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}))
-	})
-	return _c
-}
+	if len(ret) == 0 {
+		panic("no return value specified for ShouldQueryInt")
+	}
 
-func (_c *MockContext_Set_Call) Return() *MockContext_Set_Call {
-	_c.Call.Return()
-	return _c
-}
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
 
-func (_c *MockContext_Set_Call) RunAndReturn(run func(string, interface{})) *MockContext_Set_Call {
-	_c.Run(run)
-	return _c
-}
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-// SetCookie provides a mock function with given fields: name, value, maxAge, path, domain, secure, httpOnly
-func (_m *MockContext) SetCookie(name string, value string, maxAge int, path string, domain string, secure bool, httpOnly bool) {
-	_m.Called(name, value, maxAge, path, domain, secure, httpOnly)
+	return r0, r1
 }
 
-// MockContext_SetCookie_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCookie'
-type MockContext_SetCookie_Call struct {
+// MockContext_ShouldQueryInt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldQueryInt'
+type MockContext_ShouldQueryInt_Call struct {
 	*mock.Call
 }
 
-// SetCookie is a helper method to define mock.On call
+// ShouldQueryInt is a helper method to define mock.On call
 //   - name string
-//   - value string
-//   - maxAge int
-//   - path string
-//   - domain string
-//   - secure bool
-//   - httpOnly bool
-func (_e *MockContext_Expecter) SetCookie(name interface{}, value interface{}, maxAge interface{}, path interface{}, domain interface{}, secure interface{}, httpOnly interface{}) *MockContext_SetCookie_Call {
-	return &MockContext_SetCookie_Call{Call: _e.mock.On("SetCookie", name, value, maxAge, path, domain, secure, httpOnly)}
+func (_e *MockContext_Expecter) ShouldQueryInt(name interface{}) *MockContext_ShouldQueryInt_Call {
+	return &MockContext_ShouldQueryInt_Call{Call: _e.mock.On("ShouldQueryInt", name)}
 }
 
-func (_c *MockContext_SetCookie_Call) Run(run func(name string, value string, maxAge int, path string, domain string, secure bool, httpOnly bool)) *MockContext_SetCookie_Call {
+func (_c *MockContext_ShouldQueryInt_Call) Run(run func(name string)) *MockContext_ShouldQueryInt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(int), args[3].(string), args[4].(string), args[5].(bool), args[6].(bool))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_SetCookie_Call) Return() *MockContext_SetCookie_Call {
-	_c.Call.Return()
+func (_c *MockContext_ShouldQueryInt_Call) Return(_a0 int, _a1 error) *MockContext_ShouldQueryInt_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_SetCookie_Call) RunAndReturn(run func(string, string, int, string, string, bool, bool)) *MockContext_SetCookie_Call {
-	_c.Run(run)
+func (_c *MockContext_ShouldQueryInt_Call) RunAndReturn(run func(string) (int, error)) *MockContext_ShouldQueryInt_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// SetHandlers provides a mock function with given fields: handlers
-func (_m *MockContext) SetHandlers(handlers []func(context.Context)) {
-	_m.Called(handlers)
+// ShouldQueryInt64 provides a mock function with given fields: name
+func (_m *MockContext) ShouldQueryInt64(name string) (int64, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShouldQueryInt64")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockContext_SetHandlers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHandlers'
-type MockContext_SetHandlers_Call struct {
+// MockContext_ShouldQueryInt64_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldQueryInt64'
+type MockContext_ShouldQueryInt64_Call struct {
 	*mock.Call
 }
 
-// SetHandlers is a helper method to define mock.On call
-//   - handlers []func(context.Context)
-func (_e *MockContext_Expecter) SetHandlers(handlers interface{}) *MockContext_SetHandlers_Call {
-	return &MockContext_SetHandlers_Call{Call: _e.mock.On("SetHandlers", handlers)}
+// ShouldQueryInt64 is a helper method to define mock.On call
+//   - name string
+func (_e *MockContext_Expecter) ShouldQueryInt64(name interface{}) *MockContext_ShouldQueryInt64_Call {
+	return &MockContext_ShouldQueryInt64_Call{Call: _e.mock.On("ShouldQueryInt64", name)}
 }
 
-func (_c *MockContext_SetHandlers_Call) Run(run func(handlers []func(context.Context))) *MockContext_SetHandlers_Call {
+func (_c *MockContext_ShouldQueryInt64_Call) Run(run func(name string)) *MockContext_ShouldQueryInt64_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]func(context.Context)))
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_SetHandlers_Call) Return() *MockContext_SetHandlers_Call {
-	_c.Call.Return()
+func (_c *MockContext_ShouldQueryInt64_Call) Return(_a0 int64, _a1 error) *MockContext_ShouldQueryInt64_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_SetHandlers_Call) RunAndReturn(run func([]func(context.Context))) *MockContext_SetHandlers_Call {
-	_c.Run(run)
+func (_c *MockContext_ShouldQueryInt64_Call) RunAndReturn(run func(string) (int64, error)) *MockContext_ShouldQueryInt64_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// ShouldBind provides a mock function with given fields: obj
-func (_m *MockContext) ShouldBind(obj interface{}) error {
-	ret := _m.Called(obj)
+// ShouldQueryTime provides a mock function with given fields: name, layout
+func (_m *MockContext) ShouldQueryTime(name string, layout string) (time.Time, error) {
+	ret := _m.Called(name, layout)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ShouldBind")
+		panic("no return value specified for ShouldQueryTime")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
-		r0 = rf(obj)
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (time.Time, error)); ok {
+		return rf(name, layout)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) time.Time); ok {
+		r0 = rf(name, layout)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(time.Time)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, layout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockContext_ShouldBind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldBind'
-type MockContext_ShouldBind_Call struct {
+// MockContext_ShouldQueryTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldQueryTime'
+type MockContext_ShouldQueryTime_Call struct {
 	*mock.Call
 }
 
-// ShouldBind is a helper method to define mock.On call
-//   - obj interface{}
-func (_e *MockContext_Expecter) ShouldBind(obj interface{}) *MockContext_ShouldBind_Call {
-	return &MockContext_ShouldBind_Call{Call: _e.mock.On("ShouldBind", obj)}
+// ShouldQueryTime is a helper method to define mock.On call
+//   - name string
+//   - layout string
+func (_e *MockContext_Expecter) ShouldQueryTime(name interface{}, layout interface{}) *MockContext_ShouldQueryTime_Call {
+	return &MockContext_ShouldQueryTime_Call{Call: _e.mock.On("ShouldQueryTime", name, layout)}
 }
 
-func (_c *MockContext_ShouldBind_Call) Run(run func(obj interface{})) *MockContext_ShouldBind_Call {
+func (_c *MockContext_ShouldQueryTime_Call) Run(run func(name string, layout string)) *MockContext_ShouldQueryTime_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(interface{}))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockContext_ShouldBind_Call) Return(_a0 error) *MockContext_ShouldBind_Call {
-	_c.Call.Return(_a0)
+func (_c *MockContext_ShouldQueryTime_Call) Return(_a0 time.Time, _a1 error) *MockContext_ShouldQueryTime_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockContext_ShouldBind_Call) RunAndReturn(run func(interface{}) error) *MockContext_ShouldBind_Call {
+func (_c *MockContext_ShouldQueryTime_Call) RunAndReturn(run func(string, string) (time.Time, error)) *MockContext_ShouldQueryTime_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ShouldBindAndValidate provides a mock function with given fields: obj
-func (_m *MockContext) ShouldBindAndValidate(obj interface{}) error {
-	ret := _m.Called(obj)
+// Stale provides a mock function with no fields
+func (_m *MockContext) Stale() bool {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ShouldBindAndValidate")
+		panic("no return value specified for Stale")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
-		r0 = rf(obj)
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
 	return r0
 }
 
-// MockContext_ShouldBindAndValidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShouldBindAndValidate'
-type MockContext_ShouldBindAndValidate_Call struct {
+// MockContext_Stale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stale'
+type MockContext_Stale_Call struct {
 	*mock.Call
 }
 
-// ShouldBindAndValidate is a helper method to define mock.On call
-//   - obj interface{}
-func (_e *MockContext_Expecter) ShouldBindAndValidate(obj interface{}) *MockContext_ShouldBindAndValidate_Call {
-	return &MockContext_ShouldBindAndValidate_Call{Call: _e.mock.On("ShouldBindAndValidate", obj)}
+// Stale is a helper method to define mock.On call
+func (_e *MockContext_Expecter) Stale() *MockContext_Stale_Call {
+	return &MockContext_Stale_Call{Call: _e.mock.On("Stale")}
 }
 
-func (_c *MockContext_ShouldBindAndValidate_Call) Run(run func(obj interface{})) *MockContext_ShouldBindAndValidate_Call {
+func (_c *MockContext_Stale_Call) Run(run func()) *MockContext_Stale_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(interface{}))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockContext_ShouldBindAndValidate_Call) Return(_a0 error) *MockContext_ShouldBindAndValidate_Call {
+func (_c *MockContext_Stale_Call) Return(_a0 bool) *MockContext_Stale_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockContext_ShouldBindAndValidate_Call) RunAndReturn(run func(interface{}) error) *MockContext_ShouldBindAndValidate_Call {
+func (_c *MockContext_Stale_Call) RunAndReturn(run func() bool) *MockContext_Stale_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3033,6 +6022,63 @@ func (_c *MockContext_String_Call) RunAndReturn(run func(int, string, ...interfa
 	return _c
 }
 
+// T provides a mock function with given fields: key, args
+func (_m *MockContext) T(key string, args ...interface{}) string {
+	var _ca []interface{}
+	_ca = append(_ca, key)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for T")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) string); ok {
+		r0 = rf(key, args...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockContext_T_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'T'
+type MockContext_T_Call struct {
+	*mock.Call
+}
+
+// T is a helper method to define mock.On call
+//   - key string
+//   - args ...interface{}
+func (_e *MockContext_Expecter) T(key interface{}, args ...interface{}) *MockContext_T_Call {
+	return &MockContext_T_Call{Call: _e.mock.On("T",
+		append([]interface{}{key}, args...)...)}
+}
+
+func (_c *MockContext_T_Call) Run(run func(key string, args ...interface{})) *MockContext_T_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockContext_T_Call) Return(_a0 string) *MockContext_T_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_T_Call) RunAndReturn(run func(string, ...interface{}) string) *MockContext_T_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateStruct provides a mock function with given fields: obj
 func (_m *MockContext) ValidateStruct(obj interface{}) error {
 	ret := _m.Called(obj)
@@ -3079,6 +6125,99 @@ func (_c *MockContext_ValidateStruct_Call) RunAndReturn(run func(interface{}) er
 	return _c
 }
 
+// Value provides a mock function with given fields: key
+func (_m *MockContext) Value(key any) any {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Value")
+	}
+
+	var r0 any
+	if rf, ok := ret.Get(0).(func(any) any); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_Value_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Value'
+type MockContext_Value_Call struct {
+	*mock.Call
+}
+
+// Value is a helper method to define mock.On call
+//   - key any
+func (_e *MockContext_Expecter) Value(key interface{}) *MockContext_Value_Call {
+	return &MockContext_Value_Call{Call: _e.mock.On("Value", key)}
+}
+
+func (_c *MockContext_Value_Call) Run(run func(key any)) *MockContext_Value_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(any))
+	})
+	return _c
+}
+
+func (_c *MockContext_Value_Call) Return(_a0 any) *MockContext_Value_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_Value_Call) RunAndReturn(run func(any) any) *MockContext_Value_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WantsJSON provides a mock function with no fields
+func (_m *MockContext) WantsJSON() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WantsJSON")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockContext_WantsJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WantsJSON'
+type MockContext_WantsJSON_Call struct {
+	*mock.Call
+}
+
+// WantsJSON is a helper method to define mock.On call
+func (_e *MockContext_Expecter) WantsJSON() *MockContext_WantsJSON_Call {
+	return &MockContext_WantsJSON_Call{Call: _e.mock.On("WantsJSON")}
+}
+
+func (_c *MockContext_WantsJSON_Call) Run(run func()) *MockContext_WantsJSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContext_WantsJSON_Call) Return(_a0 bool) *MockContext_WantsJSON_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_WantsJSON_Call) RunAndReturn(run func() bool) *MockContext_WantsJSON_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithContext provides a mock function with given fields: ctx
 func (_m *MockContext) WithContext(ctx context2.Context) context.Context {
 	ret := _m.Called(ctx)
@@ -3127,6 +6266,88 @@ func (_c *MockContext_WithContext_Call) RunAndReturn(run func(context2.Context)
 	return _c
 }
 
+// WithTimeout provides a mock function with given fields: timeout
+func (_m *MockContext) WithTimeout(timeout time.Duration) context2.CancelFunc {
+	ret := _m.Called(timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTimeout")
+	}
+
+	var r0 context2.CancelFunc
+	if rf, ok := ret.Get(0).(func(time.Duration) context2.CancelFunc); ok {
+		r0 = rf(timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context2.CancelFunc)
+		}
+	}
+
+	return r0
+}
+
+// MockContext_WithTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTimeout'
+type MockContext_WithTimeout_Call struct {
+	*mock.Call
+}
+
+// WithTimeout is a helper method to define mock.On call
+//   - timeout time.Duration
+func (_e *MockContext_Expecter) WithTimeout(timeout interface{}) *MockContext_WithTimeout_Call {
+	return &MockContext_WithTimeout_Call{Call: _e.mock.On("WithTimeout", timeout)}
+}
+
+func (_c *MockContext_WithTimeout_Call) Run(run func(timeout time.Duration)) *MockContext_WithTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockContext_WithTimeout_Call) Return(_a0 context2.CancelFunc) *MockContext_WithTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContext_WithTimeout_Call) RunAndReturn(run func(time.Duration) context2.CancelFunc) *MockContext_WithTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteTrailer provides a mock function with given fields: key, value
+func (_m *MockContext) WriteTrailer(key string, value string) {
+	_m.Called(key, value)
+}
+
+// MockContext_WriteTrailer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteTrailer'
+type MockContext_WriteTrailer_Call struct {
+	*mock.Call
+}
+
+// WriteTrailer is a helper method to define mock.On call
+//   - key string
+//   - value string
+func (_e *MockContext_Expecter) WriteTrailer(key interface{}, value interface{}) *MockContext_WriteTrailer_Call {
+	return &MockContext_WriteTrailer_Call{Call: _e.mock.On("WriteTrailer", key, value)}
+}
+
+func (_c *MockContext_WriteTrailer_Call) Run(run func(key string, value string)) *MockContext_WriteTrailer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockContext_WriteTrailer_Call) Return() *MockContext_WriteTrailer_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContext_WriteTrailer_Call) RunAndReturn(run func(string, string)) *MockContext_WriteTrailer_Call {
+	_c.Run(run)
+	return _c
+}
+
 // XML provides a mock function with given fields: code, obj
 func (_m *MockContext) XML(code int, obj interface{}) {
 	_m.Called(code, obj)
@@ -9,28 +9,24 @@
 // that depend on the HTTP context interfaces. They track method calls and manipulations
 // so that tests can assert on expected behavior.
 //
+// ChainContext wraps MockContext with real handler-chain semantics so middleware
+// composition can be tested without a router.
+//
 // Usage Example:
 //
 //	func TestMiddleware(t *testing.T) {
-//	    // Create a new request
-//	    req, _ := http.NewRequest("GET", "/test", nil)
-//
-//	    // Create a mock ResponseWriter
-//	    rw := httptest.NewRecorder()
+//	    ctx := fork_mocks.NewChainContext(t)
+//	    ctx.EXPECT().GetHeader("Authorization").Return("")
+//	    ctx.EXPECT().JSON(401, mock.Anything).Once()
 //
-//	    // Create a mock context
-//	    ctx := mocks.NewMockContext(rw, req)
+//	    ctx.SetHandlers([]func(context.Context){authMiddleware, handler})
+//	    ctx.Next()
 //
-//	    // Run middleware with the mock context
-//	    middleware(ctx)
-//
-//	    // Assert on expected behavior
-//	    if !ctx.NextCalled {
-//	        t.Error("Expected middleware to call Next()")
+//	    if !ctx.IsAborted() {
+//	        t.Error("Expected middleware to abort the chain")
 //	    }
-//
-//	    if ctx.StatusCode != http.StatusOK {
-//	        t.Errorf("Expected status 200, got %d", ctx.StatusCode)
+//	    if len(ctx.Executed()) != 1 {
+//	        t.Errorf("Expected only the middleware to run, got %v", ctx.Executed())
 //	    }
 //	}
 //
@@ -0,0 +1,18 @@
+package fork_mocks
+
+import (
+	"go.fork.vn/fork/adapter"
+	context "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// Compile-time assertions keep the generated mocks in sync with the source interfaces.
+// When an interface changes, run `go generate` in the module root to regenerate them.
+var (
+	_ adapter.Adapter  = (*MockAdapter)(nil)
+	_ context.Context  = (*MockContext)(nil)
+	_ context.Request  = (*MockRequest)(nil)
+	_ context.Response = (*MockResponse)(nil)
+	_ router.Router    = (*MockRouter)(nil)
+	_ context.Context  = (*ChainContext)(nil)
+)
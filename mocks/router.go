@@ -3,10 +3,14 @@
 package fork_mocks
 
 import (
+	fs "io/fs"
 	http "net/http"
 
 	mock "github.com/stretchr/testify/mock"
+
 	router "go.fork.vn/fork/router"
+
+	url "net/url"
 )
 
 // MockRouter is an autogenerated mock type for the Router type
@@ -71,17 +75,24 @@ func (_c *MockRouter_Find_Call) RunAndReturn(run func(string, string) router.Han
 	return _c
 }
 
-// Group provides a mock function with given fields: prefix
-func (_m *MockRouter) Group(prefix string) router.Router {
-	ret := _m.Called(prefix)
+// Group provides a mock function with given fields: prefix, opts
+func (_m *MockRouter) Group(prefix string, opts ...router.GroupOption) router.Router {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, prefix)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Group")
 	}
 
 	var r0 router.Router
-	if rf, ok := ret.Get(0).(func(string) router.Router); ok {
-		r0 = rf(prefix)
+	if rf, ok := ret.Get(0).(func(string, ...router.GroupOption) router.Router); ok {
+		r0 = rf(prefix, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(router.Router)
@@ -98,13 +109,21 @@ type MockRouter_Group_Call struct {
 
 // Group is a helper method to define mock.On call
 //   - prefix string
-func (_e *MockRouter_Expecter) Group(prefix interface{}) *MockRouter_Group_Call {
-	return &MockRouter_Group_Call{Call: _e.mock.On("Group", prefix)}
+//   - opts ...router.GroupOption
+func (_e *MockRouter_Expecter) Group(prefix interface{}, opts ...interface{}) *MockRouter_Group_Call {
+	return &MockRouter_Group_Call{Call: _e.mock.On("Group",
+		append([]interface{}{prefix}, opts...)...)}
 }
 
-func (_c *MockRouter_Group_Call) Run(run func(prefix string)) *MockRouter_Group_Call {
+func (_c *MockRouter_Group_Call) Run(run func(prefix string, opts ...router.GroupOption)) *MockRouter_Group_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		variadicArgs := make([]router.GroupOption, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(router.GroupOption)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
@@ -114,13 +133,13 @@ func (_c *MockRouter_Group_Call) Return(_a0 router.Router) *MockRouter_Group_Cal
 	return _c
 }
 
-func (_c *MockRouter_Group_Call) RunAndReturn(run func(string) router.Router) *MockRouter_Group_Call {
+func (_c *MockRouter_Group_Call) RunAndReturn(run func(string, ...router.GroupOption) router.Router) *MockRouter_Group_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Handle provides a mock function with given fields: method, path, handlers
-func (_m *MockRouter) Handle(method string, path string, handlers ...router.HandlerFunc) {
+func (_m *MockRouter) Handle(method string, path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
 	_va := make([]interface{}, len(handlers))
 	for _i := range handlers {
 		_va[_i] = handlers[_i]
@@ -128,7 +147,22 @@ func (_m *MockRouter) Handle(method string, path string, handlers ...router.Hand
 	var _ca []interface{}
 	_ca = append(_ca, method, path)
 	_ca = append(_ca, _va...)
-	_m.Called(_ca...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 *router.RouteBuilder
+	if rf, ok := ret.Get(0).(func(string, string, ...router.HandlerFunc) *router.RouteBuilder); ok {
+		r0 = rf(method, path, handlers...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*router.RouteBuilder)
+		}
+	}
+
+	return r0
 }
 
 // MockRouter_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
@@ -158,12 +192,368 @@ func (_c *MockRouter_Handle_Call) Run(run func(method string, path string, handl
 	return _c
 }
 
-func (_c *MockRouter_Handle_Call) Return() *MockRouter_Handle_Call {
+func (_c *MockRouter_Handle_Call) Return(_a0 *router.RouteBuilder) *MockRouter_Handle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRouter_Handle_Call) RunAndReturn(run func(string, string, ...router.HandlerFunc) *router.RouteBuilder) *MockRouter_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Host provides a mock function with given fields: pattern
+func (_m *MockRouter) Host(pattern string) router.Router {
+	ret := _m.Called(pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Host")
+	}
+
+	var r0 router.Router
+	if rf, ok := ret.Get(0).(func(string) router.Router); ok {
+		r0 = rf(pattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(router.Router)
+		}
+	}
+
+	return r0
+}
+
+// MockRouter_Host_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Host'
+type MockRouter_Host_Call struct {
+	*mock.Call
+}
+
+// Host is a helper method to define mock.On call
+//   - pattern string
+func (_e *MockRouter_Expecter) Host(pattern interface{}) *MockRouter_Host_Call {
+	return &MockRouter_Host_Call{Call: _e.mock.On("Host", pattern)}
+}
+
+func (_c *MockRouter_Host_Call) Run(run func(pattern string)) *MockRouter_Host_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRouter_Host_Call) Return(_a0 router.Router) *MockRouter_Host_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRouter_Host_Call) RunAndReturn(run func(string) router.Router) *MockRouter_Host_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Match provides a mock function with given fields: methods, path, handlers
+func (_m *MockRouter) Match(methods []string, path string, handlers ...router.HandlerFunc) []*router.RouteBuilder {
+	_va := make([]interface{}, len(handlers))
+	for _i := range handlers {
+		_va[_i] = handlers[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, methods, path)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Match")
+	}
+
+	var r0 []*router.RouteBuilder
+	if rf, ok := ret.Get(0).(func([]string, string, ...router.HandlerFunc) []*router.RouteBuilder); ok {
+		r0 = rf(methods, path, handlers...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*router.RouteBuilder)
+		}
+	}
+
+	return r0
+}
+
+// MockRouter_Match_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Match'
+type MockRouter_Match_Call struct {
+	*mock.Call
+}
+
+// Match is a helper method to define mock.On call
+//   - methods []string
+//   - path string
+//   - handlers ...router.HandlerFunc
+func (_e *MockRouter_Expecter) Match(methods interface{}, path interface{}, handlers ...interface{}) *MockRouter_Match_Call {
+	return &MockRouter_Match_Call{Call: _e.mock.On("Match",
+		append([]interface{}{methods, path}, handlers...)...)}
+}
+
+func (_c *MockRouter_Match_Call) Run(run func(methods []string, path string, handlers ...router.HandlerFunc)) *MockRouter_Match_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]router.HandlerFunc, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(router.HandlerFunc)
+			}
+		}
+		run(args[0].([]string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRouter_Match_Call) Return(_a0 []*router.RouteBuilder) *MockRouter_Match_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRouter_Match_Call) RunAndReturn(run func([]string, string, ...router.HandlerFunc) []*router.RouteBuilder) *MockRouter_Match_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mount provides a mock function with given fields: prefix, h
+func (_m *MockRouter) Mount(prefix string, h http.Handler) {
+	_m.Called(prefix, h)
+}
+
+// MockRouter_Mount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mount'
+type MockRouter_Mount_Call struct {
+	*mock.Call
+}
+
+// Mount is a helper method to define mock.On call
+//   - prefix string
+//   - h http.Handler
+func (_e *MockRouter_Expecter) Mount(prefix interface{}, h interface{}) *MockRouter_Mount_Call {
+	return &MockRouter_Mount_Call{Call: _e.mock.On("Mount", prefix, h)}
+}
+
+func (_c *MockRouter_Mount_Call) Run(run func(prefix string, h http.Handler)) *MockRouter_Mount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(http.Handler))
+	})
+	return _c
+}
+
+func (_c *MockRouter_Mount_Call) Return() *MockRouter_Mount_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRouter_Mount_Call) RunAndReturn(run func(string, http.Handler)) *MockRouter_Mount_Call {
+	_c.Run(run)
+	return _c
+}
+
+// MountRouter provides a mock function with given fields: prefix, sub
+func (_m *MockRouter) MountRouter(prefix string, sub router.Router) {
+	_m.Called(prefix, sub)
+}
+
+// MockRouter_MountRouter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MountRouter'
+type MockRouter_MountRouter_Call struct {
+	*mock.Call
+}
+
+// MountRouter is a helper method to define mock.On call
+//   - prefix string
+//   - sub router.Router
+func (_e *MockRouter_Expecter) MountRouter(prefix interface{}, sub interface{}) *MockRouter_MountRouter_Call {
+	return &MockRouter_MountRouter_Call{Call: _e.mock.On("MountRouter", prefix, sub)}
+}
+
+func (_c *MockRouter_MountRouter_Call) Run(run func(prefix string, sub router.Router)) *MockRouter_MountRouter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(router.Router))
+	})
+	return _c
+}
+
+func (_c *MockRouter_MountRouter_Call) Return() *MockRouter_MountRouter_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRouter_MountRouter_Call) RunAndReturn(run func(string, router.Router)) *MockRouter_MountRouter_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NoMethod provides a mock function with given fields: handlers
+func (_m *MockRouter) NoMethod(handlers ...router.HandlerFunc) {
+	_va := make([]interface{}, len(handlers))
+	for _i := range handlers {
+		_va[_i] = handlers[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// MockRouter_NoMethod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoMethod'
+type MockRouter_NoMethod_Call struct {
+	*mock.Call
+}
+
+// NoMethod is a helper method to define mock.On call
+//   - handlers ...router.HandlerFunc
+func (_e *MockRouter_Expecter) NoMethod(handlers ...interface{}) *MockRouter_NoMethod_Call {
+	return &MockRouter_NoMethod_Call{Call: _e.mock.On("NoMethod",
+		append([]interface{}{}, handlers...)...)}
+}
+
+func (_c *MockRouter_NoMethod_Call) Run(run func(handlers ...router.HandlerFunc)) *MockRouter_NoMethod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]router.HandlerFunc, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(router.HandlerFunc)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRouter_NoMethod_Call) Return() *MockRouter_NoMethod_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRouter_NoMethod_Call) RunAndReturn(run func(...router.HandlerFunc)) *MockRouter_NoMethod_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NoRoute provides a mock function with given fields: handlers
+func (_m *MockRouter) NoRoute(handlers ...router.HandlerFunc) {
+	_va := make([]interface{}, len(handlers))
+	for _i := range handlers {
+		_va[_i] = handlers[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// MockRouter_NoRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NoRoute'
+type MockRouter_NoRoute_Call struct {
+	*mock.Call
+}
+
+// NoRoute is a helper method to define mock.On call
+//   - handlers ...router.HandlerFunc
+func (_e *MockRouter_Expecter) NoRoute(handlers ...interface{}) *MockRouter_NoRoute_Call {
+	return &MockRouter_NoRoute_Call{Call: _e.mock.On("NoRoute",
+		append([]interface{}{}, handlers...)...)}
+}
+
+func (_c *MockRouter_NoRoute_Call) Run(run func(handlers ...router.HandlerFunc)) *MockRouter_NoRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]router.HandlerFunc, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(router.HandlerFunc)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRouter_NoRoute_Call) Return() *MockRouter_NoRoute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRouter_NoRoute_Call) RunAndReturn(run func(...router.HandlerFunc)) *MockRouter_NoRoute_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Proxy provides a mock function with given fields: prefix, target, opts
+func (_m *MockRouter) Proxy(prefix string, target *url.URL, opts ...router.ProxyOption) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, prefix, target)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// MockRouter_Proxy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Proxy'
+type MockRouter_Proxy_Call struct {
+	*mock.Call
+}
+
+// Proxy is a helper method to define mock.On call
+//   - prefix string
+//   - target *url.URL
+//   - opts ...router.ProxyOption
+func (_e *MockRouter_Expecter) Proxy(prefix interface{}, target interface{}, opts ...interface{}) *MockRouter_Proxy_Call {
+	return &MockRouter_Proxy_Call{Call: _e.mock.On("Proxy",
+		append([]interface{}{prefix, target}, opts...)...)}
+}
+
+func (_c *MockRouter_Proxy_Call) Run(run func(prefix string, target *url.URL, opts ...router.ProxyOption)) *MockRouter_Proxy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]router.ProxyOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(router.ProxyOption)
+			}
+		}
+		run(args[0].(string), args[1].(*url.URL), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRouter_Proxy_Call) Return() *MockRouter_Proxy_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRouter_Proxy_Call) RunAndReturn(run func(string, *url.URL, ...router.ProxyOption)) *MockRouter_Proxy_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Redirect provides a mock function with given fields: path, targetPath, code
+func (_m *MockRouter) Redirect(path string, targetPath string, code int) {
+	_m.Called(path, targetPath, code)
+}
+
+// MockRouter_Redirect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redirect'
+type MockRouter_Redirect_Call struct {
+	*mock.Call
+}
+
+// Redirect is a helper method to define mock.On call
+//   - path string
+//   - targetPath string
+//   - code int
+func (_e *MockRouter_Expecter) Redirect(path interface{}, targetPath interface{}, code interface{}) *MockRouter_Redirect_Call {
+	return &MockRouter_Redirect_Call{Call: _e.mock.On("Redirect", path, targetPath, code)}
+}
+
+func (_c *MockRouter_Redirect_Call) Run(run func(path string, targetPath string, code int)) *MockRouter_Redirect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRouter_Redirect_Call) Return() *MockRouter_Redirect_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockRouter_Handle_Call) RunAndReturn(run func(string, string, ...router.HandlerFunc)) *MockRouter_Handle_Call {
+func (_c *MockRouter_Redirect_Call) RunAndReturn(run func(string, string, int)) *MockRouter_Redirect_Call {
 	_c.Run(run)
 	return _c
 }
@@ -249,9 +639,16 @@ func (_c *MockRouter_ServeHTTP_Call) RunAndReturn(run func(http.ResponseWriter,
 	return _c
 }
 
-// Static provides a mock function with given fields: prefix, root
-func (_m *MockRouter) Static(prefix string, root string) {
-	_m.Called(prefix, root)
+// Static provides a mock function with given fields: prefix, root, config
+func (_m *MockRouter) Static(prefix string, root string, config ...router.StaticConfig) {
+	_va := make([]interface{}, len(config))
+	for _i := range config {
+		_va[_i] = config[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, prefix, root)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
 }
 
 // MockRouter_Static_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Static'
@@ -262,13 +659,21 @@ type MockRouter_Static_Call struct {
 // Static is a helper method to define mock.On call
 //   - prefix string
 //   - root string
-func (_e *MockRouter_Expecter) Static(prefix interface{}, root interface{}) *MockRouter_Static_Call {
-	return &MockRouter_Static_Call{Call: _e.mock.On("Static", prefix, root)}
+//   - config ...router.StaticConfig
+func (_e *MockRouter_Expecter) Static(prefix interface{}, root interface{}, config ...interface{}) *MockRouter_Static_Call {
+	return &MockRouter_Static_Call{Call: _e.mock.On("Static",
+		append([]interface{}{prefix, root}, config...)...)}
 }
 
-func (_c *MockRouter_Static_Call) Run(run func(prefix string, root string)) *MockRouter_Static_Call {
+func (_c *MockRouter_Static_Call) Run(run func(prefix string, root string, config ...router.StaticConfig)) *MockRouter_Static_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		variadicArgs := make([]router.StaticConfig, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(router.StaticConfig)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
@@ -278,7 +683,56 @@ func (_c *MockRouter_Static_Call) Return() *MockRouter_Static_Call {
 	return _c
 }
 
-func (_c *MockRouter_Static_Call) RunAndReturn(run func(string, string)) *MockRouter_Static_Call {
+func (_c *MockRouter_Static_Call) RunAndReturn(run func(string, string, ...router.StaticConfig)) *MockRouter_Static_Call {
+	_c.Run(run)
+	return _c
+}
+
+// StaticFS provides a mock function with given fields: prefix, fsys, config
+func (_m *MockRouter) StaticFS(prefix string, fsys fs.FS, config ...router.StaticConfig) {
+	_va := make([]interface{}, len(config))
+	for _i := range config {
+		_va[_i] = config[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, prefix, fsys)
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// MockRouter_StaticFS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StaticFS'
+type MockRouter_StaticFS_Call struct {
+	*mock.Call
+}
+
+// StaticFS is a helper method to define mock.On call
+//   - prefix string
+//   - fsys fs.FS
+//   - config ...router.StaticConfig
+func (_e *MockRouter_Expecter) StaticFS(prefix interface{}, fsys interface{}, config ...interface{}) *MockRouter_StaticFS_Call {
+	return &MockRouter_StaticFS_Call{Call: _e.mock.On("StaticFS",
+		append([]interface{}{prefix, fsys}, config...)...)}
+}
+
+func (_c *MockRouter_StaticFS_Call) Run(run func(prefix string, fsys fs.FS, config ...router.StaticConfig)) *MockRouter_StaticFS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]router.StaticConfig, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(router.StaticConfig)
+			}
+		}
+		run(args[0].(string), args[1].(fs.FS), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRouter_StaticFS_Call) Return() *MockRouter_StaticFS_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRouter_StaticFS_Call) RunAndReturn(run func(string, fs.FS, ...router.StaticConfig)) *MockRouter_StaticFS_Call {
 	_c.Run(run)
 	return _c
 }
@@ -329,6 +783,55 @@ func (_c *MockRouter_Use_Call) RunAndReturn(run func(...router.HandlerFunc)) *Mo
 	return _c
 }
 
+// Version provides a mock function with given fields: version, strategy
+func (_m *MockRouter) Version(version string, strategy router.VersionStrategy) router.Router {
+	ret := _m.Called(version, strategy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 router.Router
+	if rf, ok := ret.Get(0).(func(string, router.VersionStrategy) router.Router); ok {
+		r0 = rf(version, strategy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(router.Router)
+		}
+	}
+
+	return r0
+}
+
+// MockRouter_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type MockRouter_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+//   - version string
+//   - strategy router.VersionStrategy
+func (_e *MockRouter_Expecter) Version(version interface{}, strategy interface{}) *MockRouter_Version_Call {
+	return &MockRouter_Version_Call{Call: _e.mock.On("Version", version, strategy)}
+}
+
+func (_c *MockRouter_Version_Call) Run(run func(version string, strategy router.VersionStrategy)) *MockRouter_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(router.VersionStrategy))
+	})
+	return _c
+}
+
+func (_c *MockRouter_Version_Call) Return(_a0 router.Router) *MockRouter_Version_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRouter_Version_Call) RunAndReturn(run func(string, router.VersionStrategy) router.Router) *MockRouter_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRouter creates a new instance of MockRouter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRouter(t interface {
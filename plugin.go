@@ -0,0 +1,285 @@
+package fork
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Plugin là extension của bên thứ ba đóng góp routes, middleware, template funcs và
+// lifecycle hooks cho WebApp theo một cấu trúc thống nhất, bổ sung cho ServiceProvider
+// vốn chỉ làm việc với DI container.
+//
+// Vòng đời của plugin:
+//  1. RegisterPlugin ghi nhận plugin
+//  2. BootPlugins (tự động gọi bởi Serve/RunTLS, hoặc ở request đầu tiên của ServeHTTP/Test) sắp xếp plugins theo phụ thuộc,
+//     gọi Register của tất cả rồi mới gọi Boot của tất cả
+//  3. Shutdown/GracefulShutdown gọi Shutdown của plugins theo thứ tự ngược lại
+//
+// Plugin có thể khai báo phụ thuộc bằng cách implement PluginDependencies.
+// Nhúng BasePlugin để chỉ cần implement các phương thức cần thiết.
+type Plugin interface {
+	// Name trả về tên duy nhất của plugin, dùng cho phụ thuộc và thông báo lỗi.
+	//
+	// Returns:
+	//   - string: Tên plugin
+	Name() string
+
+	// Register đăng ký routes, middleware và template funcs vào WebApp.
+	//
+	// Parameters:
+	//   - app: WebApp đang được khởi tạo
+	//
+	// Returns:
+	//   - error: Lỗi nếu đăng ký thất bại
+	Register(app *WebApp) error
+
+	// Boot được gọi sau khi mọi plugin đã Register, dùng để khởi động tài nguyên
+	// hoặc sử dụng những gì plugin khác đã đăng ký.
+	//
+	// Parameters:
+	//   - app: WebApp đang được khởi tạo
+	//
+	// Returns:
+	//   - error: Lỗi nếu khởi động thất bại
+	Boot(app *WebApp) error
+
+	// Shutdown giải phóng tài nguyên của plugin khi WebApp dừng.
+	//
+	// Parameters:
+	//   - ctx: Context bị hủy khi hết thời gian shutdown
+	//
+	// Returns:
+	//   - error: Lỗi nếu giải phóng thất bại
+	Shutdown(ctx context.Context) error
+}
+
+// PluginDependencies được plugin implement để khai báo các plugin cần được
+// Register và Boot trước nó.
+type PluginDependencies interface {
+	// Requires trả về tên các plugin phụ thuộc.
+	//
+	// Returns:
+	//   - []string: Tên các plugin phụ thuộc
+	Requires() []string
+}
+
+// BasePlugin cung cấp implementation rỗng cho Register, Boot và Shutdown để nhúng vào plugin.
+type BasePlugin struct{}
+
+// Register không làm gì.
+func (BasePlugin) Register(*WebApp) error { return nil }
+
+// Boot không làm gì.
+func (BasePlugin) Boot(*WebApp) error { return nil }
+
+// Shutdown không làm gì.
+func (BasePlugin) Shutdown(context.Context) error { return nil }
+
+// RegisterPlugin ghi nhận các plugin để khởi tạo khi BootPlugins được gọi.
+//
+// Parameters:
+//   - plugins: Các plugin cần đăng ký
+//
+// Returns:
+//   - error: Lỗi nếu plugin nil, trùng tên hoặc plugins đã boot
+//
+// Errors:
+//   - ErrPluginConflict: Khi tên plugin đã được đăng ký hoặc plugins đã boot
+func (app *WebApp) RegisterPlugin(plugins ...Plugin) error {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	if app.pluginsBooted {
+		return fmt.Errorf("%w: cannot register plugins after boot", ErrPluginConflict)
+	}
+	for _, plugin := range plugins {
+		if plugin == nil {
+			return fmt.Errorf("%w: plugin is nil", ErrPluginConflict)
+		}
+		for _, existing := range app.plugins {
+			if existing.Name() == plugin.Name() {
+				return fmt.Errorf("%w: plugin %q already registered", ErrPluginConflict, plugin.Name())
+			}
+		}
+		app.plugins = append(app.plugins, plugin)
+	}
+	return nil
+}
+
+// Plugins trả về các plugin đã đăng ký, theo thứ tự khởi tạo nếu đã boot.
+//
+// Returns:
+//   - []Plugin: Danh sách plugin
+func (app *WebApp) Plugins() []Plugin {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+	return append([]Plugin(nil), app.plugins...)
+}
+
+// pluginState là trạng thái khởi tạo của một plugin.
+type pluginState int
+
+const (
+	// pluginRegistered đánh dấu Register của plugin đã thành công
+	pluginRegistered pluginState = iota + 1
+
+	// pluginBooted đánh dấu Boot của plugin đã thành công
+	pluginBooted
+)
+
+// BootPlugins sắp xếp plugins theo phụ thuộc (giữ thứ tự đăng ký khi không ràng buộc),
+// gọi Register của tất cả plugin rồi gọi Boot của tất cả plugin.
+// Sau khi mọi plugin boot thành công, các lần gọi sau trả về nil. Nếu một plugin thất bại,
+// lần gọi sau thử lại từ plugin đó, bỏ qua các bước đã thành công của plugin khác.
+//
+// Returns:
+//   - error: Lỗi đầu tiên từ sắp xếp, Register hoặc Boot
+//
+// Errors:
+//   - ErrPluginDependency: Khi phụ thuộc chưa đăng ký hoặc phụ thuộc vòng
+func (app *WebApp) BootPlugins() error {
+	app.pluginMu.Lock()
+	defer app.pluginMu.Unlock()
+
+	for {
+		done, err := app.bootPending()
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// bootPending Register và Boot các plugin chưa khởi tạo xong. Trả về false nếu có plugin
+// mới được đăng ký trong lúc boot (ví dụ plugin gọi RegisterPlugin trong Register) và cần
+// chạy thêm một lượt. Phải được gọi khi giữ pluginMu.
+func (app *WebApp) bootPending() (bool, error) {
+	app.mu.Lock()
+	if app.pluginsBooted {
+		app.mu.Unlock()
+		return true, nil
+	}
+	ordered, err := sortPlugins(app.plugins)
+	if err != nil {
+		app.mu.Unlock()
+		return false, err
+	}
+	app.plugins = ordered
+	app.mu.Unlock()
+
+	if app.pluginStates == nil {
+		app.pluginStates = make(map[string]pluginState, len(ordered))
+	}
+
+	// Register và Boot chạy ngoài app.mu vì plugin gọi lại các phương thức của WebApp
+	for _, plugin := range ordered {
+		if app.pluginStates[plugin.Name()] >= pluginRegistered {
+			continue
+		}
+		if err := plugin.Register(app); err != nil {
+			return false, fmt.Errorf("plugin %q: register: %w", plugin.Name(), err)
+		}
+		app.pluginStates[plugin.Name()] = pluginRegistered
+	}
+	for _, plugin := range ordered {
+		if app.pluginStates[plugin.Name()] == pluginBooted {
+			continue
+		}
+		if err := plugin.Boot(app); err != nil {
+			return false, fmt.Errorf("plugin %q: boot: %w", plugin.Name(), err)
+		}
+		app.pluginStates[plugin.Name()] = pluginBooted
+		app.bootedPlugins = append(app.bootedPlugins, plugin)
+	}
+
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	app.pluginsBooted = len(app.plugins) == len(ordered)
+	if app.pluginsBooted {
+		app.pluginsInitialized.Store(true)
+	}
+	return app.pluginsBooted, nil
+}
+
+// ShutdownPlugins gọi Shutdown của các plugin đã Boot thành công theo thứ tự ngược với thứ tự boot.
+// Plugin chưa boot (do BootPlugins thất bại trước đó) không được gọi Shutdown.
+// Mọi plugin đều được gọi kể cả khi plugin trước đó trả về lỗi.
+//
+// Parameters:
+//   - ctx: Context giới hạn thời gian shutdown
+//
+// Returns:
+//   - error: Tổng hợp lỗi của các plugin
+func (app *WebApp) ShutdownPlugins(ctx context.Context) error {
+	app.pluginMu.Lock()
+	defer app.pluginMu.Unlock()
+
+	plugins := app.bootedPlugins
+	app.bootedPlugins = nil
+	for _, plugin := range plugins {
+		// Routes của plugin vẫn còn trong router nên chỉ cần Boot lại, không Register lại
+		app.pluginStates[plugin.Name()] = pluginRegistered
+	}
+
+	app.mu.Lock()
+	app.pluginsBooted = false
+	app.mu.Unlock()
+
+	var errs []error
+	for i := len(plugins) - 1; i >= 0; i-- {
+		if err := plugins[i].Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("plugin %q: shutdown: %w", plugins[i].Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sortPlugins sắp xếp topo plugins theo phụ thuộc, ưu tiên thứ tự đăng ký.
+func sortPlugins(plugins []Plugin) ([]Plugin, error) {
+	byName := make(map[string]Plugin, len(plugins))
+	for _, plugin := range plugins {
+		byName[plugin.Name()] = plugin
+	}
+
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[string]int, len(plugins))
+	ordered := make([]Plugin, 0, len(plugins))
+
+	var visit func(plugin Plugin, path []string) error
+	visit = func(plugin Plugin, path []string) error {
+		name := plugin.Name()
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("%w: cycle %v", ErrPluginDependency, append(path, name))
+		}
+		state[name] = visiting
+
+		if deps, ok := plugin.(PluginDependencies); ok {
+			for _, dep := range deps.Requires() {
+				required, exists := byName[dep]
+				if !exists {
+					return fmt.Errorf("%w: plugin %q requires %q", ErrPluginDependency, name, dep)
+				}
+				if err := visit(required, append(path, name)); err != nil {
+					return err
+				}
+			}
+		}
+
+		state[name] = done
+		ordered = append(ordered, plugin)
+		return nil
+	}
+
+	for _, plugin := range plugins {
+		if err := visit(plugin, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
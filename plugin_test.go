@@ -0,0 +1,221 @@
+package fork_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.fork.vn/fork"
+	forkContext "go.fork.vn/fork/context"
+	fork_mocks "go.fork.vn/fork/mocks"
+)
+
+// testPlugin ghi lại các lời gọi lifecycle vào log dùng chung
+type testPlugin struct {
+	fork.BasePlugin
+	name        string
+	requires    []string
+	log         *[]string
+	bootErr     error
+	shutdownErr error
+}
+
+func (p *testPlugin) Name() string       { return p.name }
+func (p *testPlugin) Requires() []string { return p.requires }
+
+func (p *testPlugin) Register(app *fork.WebApp) error {
+	*p.log = append(*p.log, "register:"+p.name)
+	app.GET("/"+p.name, func(ctx forkContext.Context) {
+		ctx.String(http.StatusOK, p.name)
+	})
+	return nil
+}
+
+func (p *testPlugin) Boot(app *fork.WebApp) error {
+	*p.log = append(*p.log, "boot:"+p.name)
+	return p.bootErr
+}
+
+func (p *testPlugin) Shutdown(ctx context.Context) error {
+	*p.log = append(*p.log, "shutdown:"+p.name)
+	return p.shutdownErr
+}
+
+// minimalPlugin chỉ implement Name và dùng BasePlugin cho phần còn lại
+type minimalPlugin struct {
+	fork.BasePlugin
+}
+
+func (minimalPlugin) Name() string { return "minimal" }
+
+// TestWebApp_PluginLifecycle tests dependency ordering and lifecycle hooks of plugins
+func TestWebApp_PluginLifecycle(t *testing.T) {
+	var log []string
+	app := fork.NewWebApp()
+
+	err := app.RegisterPlugin(
+		&testPlugin{name: "admin", requires: []string{"auth", "db"}, log: &log},
+		&testPlugin{name: "auth", requires: []string{"db"}, log: &log},
+		&testPlugin{name: "db", log: &log},
+		minimalPlugin{},
+	)
+	assert.NoError(t, err)
+
+	assert.NoError(t, app.BootPlugins())
+	assert.NoError(t, app.BootPlugins(), "boot should run only once")
+	assert.Equal(t, []string{
+		"register:db", "register:auth", "register:admin",
+		"boot:db", "boot:auth", "boot:admin",
+	}, log)
+
+	var names []string
+	for _, plugin := range app.Plugins() {
+		names = append(names, plugin.Name())
+	}
+	assert.Equal(t, []string{"db", "auth", "admin", "minimal"}, names)
+
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/auth", nil))
+	assert.Equal(t, "auth", w.Body.String())
+
+	assert.ErrorIs(t, app.RegisterPlugin(&testPlugin{name: "late", log: &log}), fork.ErrPluginConflict)
+
+	log = nil
+	assert.NoError(t, app.Shutdown())
+	assert.Equal(t, []string{"shutdown:admin", "shutdown:auth", "shutdown:db"}, log)
+}
+
+// TestWebApp_PluginErrors tests registration and dependency errors
+func TestWebApp_PluginErrors(t *testing.T) {
+	var log []string
+
+	t.Run("duplicate and nil plugins", func(t *testing.T) {
+		app := fork.NewWebApp()
+		assert.NoError(t, app.RegisterPlugin(&testPlugin{name: "a", log: &log}))
+		assert.ErrorIs(t, app.RegisterPlugin(&testPlugin{name: "a", log: &log}), fork.ErrPluginConflict)
+		assert.ErrorIs(t, app.RegisterPlugin(nil), fork.ErrPluginConflict)
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		app := fork.NewWebApp()
+		assert.NoError(t, app.RegisterPlugin(&testPlugin{name: "a", requires: []string{"ghost"}, log: &log}))
+		err := app.BootPlugins()
+		assert.ErrorIs(t, err, fork.ErrPluginDependency)
+		assert.Contains(t, err.Error(), `"a" requires "ghost"`)
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		app := fork.NewWebApp()
+		assert.NoError(t, app.RegisterPlugin(
+			&testPlugin{name: "a", requires: []string{"b"}, log: &log},
+			&testPlugin{name: "b", requires: []string{"a"}, log: &log},
+		))
+		assert.ErrorIs(t, app.BootPlugins(), fork.ErrPluginDependency)
+	})
+
+	t.Run("boot and shutdown errors", func(t *testing.T) {
+		log = nil
+		app := fork.NewWebApp()
+		assert.NoError(t, app.RegisterPlugin(
+			&testPlugin{name: "a", log: &log, shutdownErr: errors.New("close failed")},
+			&testPlugin{name: "b", log: &log, bootErr: errors.New("boot failed")},
+		))
+		err := app.BootPlugins()
+		assert.EqualError(t, err, `plugin "b": boot: boot failed`)
+
+		// Chỉ plugin đã boot thành công được Shutdown
+		err = app.ShutdownPlugins(context.Background())
+		assert.EqualError(t, err, `plugin "a": shutdown: close failed`)
+		assert.Equal(t, []string{"register:a", "register:b", "boot:a", "boot:b", "shutdown:a"}, log)
+	})
+
+	t.Run("retry after boot failure", func(t *testing.T) {
+		log = nil
+		app := fork.NewWebApp()
+		failing := &testPlugin{name: "b", log: &log, bootErr: errors.New("boot failed")}
+		assert.NoError(t, app.RegisterPlugin(&testPlugin{name: "a", log: &log}, failing))
+		assert.Error(t, app.BootPlugins())
+
+		// Lần gọi sau không phải no-op: thử lại Boot của plugin lỗi, không Register lại
+		assert.Error(t, app.BootPlugins())
+		failing.bootErr = nil
+		assert.NoError(t, app.BootPlugins())
+		assert.Equal(t, []string{"register:a", "register:b", "boot:a", "boot:b", "boot:b", "boot:b"}, log)
+		assert.ErrorIs(t, app.RegisterPlugin(&testPlugin{name: "late", log: &log}), fork.ErrPluginConflict)
+	})
+}
+
+// nestedPlugin đăng ký thêm một plugin khác trong Register
+type nestedPlugin struct {
+	fork.BasePlugin
+	child fork.Plugin
+}
+
+func (p *nestedPlugin) Name() string { return "nested" }
+
+func (p *nestedPlugin) Register(app *fork.WebApp) error {
+	return app.RegisterPlugin(p.child)
+}
+
+// TestWebApp_PluginRegisteredDuringBoot tests that plugins registered by another plugin are booted too
+func TestWebApp_PluginRegisteredDuringBoot(t *testing.T) {
+	var log []string
+	app := fork.NewWebApp()
+	assert.NoError(t, app.RegisterPlugin(&nestedPlugin{child: &testPlugin{name: "child", log: &log}}))
+	assert.NoError(t, app.BootPlugins())
+	assert.Equal(t, []string{"register:child", "boot:child"}, log)
+}
+
+// TestWebApp_TestBootsPlugins tests that in-process requests boot plugins lazily
+func TestWebApp_TestBootsPlugins(t *testing.T) {
+	var log []string
+	app := fork.NewWebApp()
+	assert.NoError(t, app.RegisterPlugin(&testPlugin{name: "status", log: &log}))
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/status", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/status", nil))
+	assert.Equal(t, "status", w.Body.String())
+	assert.Equal(t, []string{"register:status", "boot:status"}, log, "plugins should boot only once")
+
+	broken := fork.NewWebApp()
+	assert.NoError(t, broken.RegisterPlugin(&testPlugin{name: "x", requires: []string{"y"}, log: &log}))
+	_, err = broken.Test(httptest.NewRequest(http.MethodGet, "/x", nil))
+	assert.ErrorIs(t, err, fork.ErrPluginDependency)
+
+	w = httptest.NewRecorder()
+	broken.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/x", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+// TestWebApp_ServeBootsPlugins tests that Serve boots plugins before starting the adapter
+func TestWebApp_ServeBootsPlugins(t *testing.T) {
+	var log []string
+	app := fork.NewWebApp()
+	mockAdapter := fork_mocks.NewMockAdapter(t)
+	mockAdapter.EXPECT().SetHandler(mock.Anything).Maybe()
+	mockAdapter.EXPECT().Serve().RunAndReturn(func() error {
+		log = append(log, "serve")
+		return nil
+	}).Once()
+	app.SetAdapter(mockAdapter)
+
+	assert.NoError(t, app.RegisterPlugin(&testPlugin{name: "metrics", log: &log}))
+	assert.NoError(t, app.Serve())
+	assert.Equal(t, []string{"register:metrics", "boot:metrics", "serve"}, log)
+
+	broken := fork.NewWebApp()
+	brokenAdapter := fork_mocks.NewMockAdapter(t)
+	brokenAdapter.EXPECT().SetHandler(mock.Anything).Maybe()
+	broken.SetAdapter(brokenAdapter)
+	assert.NoError(t, broken.RegisterPlugin(&testPlugin{name: "x", requires: []string{"y"}, log: &log}))
+	assert.ErrorIs(t, broken.Serve(), fork.ErrPluginDependency)
+}
@@ -111,6 +111,9 @@ func (p *ServiceProvider) Boot(app di.Application) {
 		panic("fork.ServiceProvider.Boot: config service is not a config.Manager type")
 	}
 
+	// Gắn logger cho ctx.Logger() của mọi request
+	httpApp.SetLogger(logger)
+
 	// Tạo config mặc định
 	appConfig := DefaultWebAppConfig()
 
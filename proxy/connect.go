@@ -0,0 +1,196 @@
+package proxy
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrHijackNotSupported được trả về khi ResponseWriter không hỗ trợ http.Hijacker (ví dụ: HTTP/2).
+var ErrHijackNotSupported = errors.New("proxy: response writer does not support hijacking")
+
+// ConnectConfig chứa cấu hình cho CONNECT tunneling.
+type ConnectConfig struct {
+	// Authorize quyết định request CONNECT có được phép hay không.
+	// Trả về status code khác 0 để từ chối (ví dụ: 407 Proxy Authentication Required).
+	// Nếu nil, tất cả request CONNECT bị từ chối với 403 để tránh vô tình tạo open proxy.
+	Authorize func(r *http.Request) (allowed bool, statusCode int)
+
+	// DialTimeout là thời gian tối đa để kết nối tới đích. Mặc định: 10 giây
+	DialTimeout time.Duration
+
+	// IdleTimeout đóng tunnel khi không có dữ liệu trong khoảng thời gian này.
+	// Giá trị 0 không giới hạn.
+	IdleTimeout time.Duration
+
+	// Dial thiết lập kết nối tới đích. Mặc định: net.Dialer với DialTimeout
+	Dial func(network, address string) (net.Conn, error)
+
+	// OnTunnel được gọi khi tunnel đóng với số bytes đã truyền theo mỗi chiều.
+	OnTunnel func(r *http.Request, sent, received int64, err error)
+}
+
+// WithConnect bọc handler để xử lý method CONNECT như một forward proxy đơn giản.
+// Các request không phải CONNECT được chuyển cho next.
+//
+// Handler trả về có thể được cài đặt vào bất kỳ adapter nào qua Adapter.SetHandler,
+// ví dụ: adapter.SetHandler(proxy.WithConnect(router, config)).
+//
+// Parameters:
+//   - next: Handler xử lý các request thông thường
+//   - config: Cấu hình CONNECT tunneling
+//
+// Returns:
+//   - http.Handler: Handler hỗ trợ CONNECT
+func WithConnect(next http.Handler, config ConnectConfig) http.Handler {
+	if config.DialTimeout <= 0 {
+		config.DialTimeout = 10 * time.Second
+	}
+	if config.Dial == nil {
+		dialer := &net.Dialer{Timeout: config.DialTimeout}
+		config.Dial = dialer.Dial
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			next.ServeHTTP(w, r)
+			return
+		}
+		serveConnect(w, r, &config)
+	})
+}
+
+// serveConnect thiết lập tunnel giữa client và đích của request CONNECT.
+func serveConnect(w http.ResponseWriter, r *http.Request, config *ConnectConfig) {
+	if config.Authorize == nil {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+	if allowed, statusCode := config.Authorize(r); !allowed {
+		if statusCode == 0 {
+			statusCode = http.StatusForbidden
+		}
+		http.Error(w, http.StatusText(statusCode), statusCode)
+		return
+	}
+
+	target := r.Host
+	if _, _, err := net.SplitHostPort(target); err != nil {
+		http.Error(w, "invalid CONNECT target", http.StatusBadRequest)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, ErrHijackNotSupported.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	upstream, err := config.Dial("tcp", target)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	client, buffered, err := hijacker.Hijack()
+	if err != nil {
+		upstream.Close()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if _, err := client.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n")); err != nil {
+		client.Close()
+		upstream.Close()
+		return
+	}
+
+	// Dữ liệu client đã gửi trước khi nhận response (nếu có) nằm trong buffer của hijack
+	var src io.Reader = client
+	if buffered != nil && buffered.Reader.Buffered() > 0 {
+		src = io.MultiReader(io.LimitReader(buffered.Reader, int64(buffered.Reader.Buffered())), client)
+	}
+
+	sent, received, err := tunnel(client, src, upstream, config.IdleTimeout)
+	if config.OnTunnel != nil {
+		config.OnTunnel(r, sent, received, err)
+	}
+}
+
+// tunnel sao chép dữ liệu hai chiều cho đến khi một phía đóng kết nối.
+// Trả về số bytes client gửi tới đích và số bytes đích gửi về client.
+func tunnel(client net.Conn, clientReader io.Reader, upstream net.Conn, idle time.Duration) (int64, int64, error) {
+	defer client.Close()
+	defer upstream.Close()
+
+	var sent, received int64
+	var sentErr, receivedErr error
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		sent, sentErr = copyWithIdle(upstream, clientReader, client, idle)
+		closeWrite(upstream)
+	}()
+	go func() {
+		defer wg.Done()
+		received, receivedErr = copyWithIdle(client, upstream, upstream, idle)
+		closeWrite(client)
+	}()
+	wg.Wait()
+
+	if sentErr != nil {
+		return sent, received, sentErr
+	}
+	return sent, received, receivedErr
+}
+
+// copyWithIdle sao chép dữ liệu, gia hạn deadline đọc sau mỗi lần đọc nếu idle > 0.
+func copyWithIdle(dst io.Writer, src io.Reader, conn net.Conn, idle time.Duration) (int64, error) {
+	if idle <= 0 {
+		n, err := io.Copy(dst, src)
+		return n, ignoreClosed(err)
+	}
+
+	buf := make([]byte, 32*1024)
+	var total int64
+	for {
+		conn.SetReadDeadline(time.Now().Add(idle))
+		n, err := src.Read(buf)
+		if n > 0 {
+			written, writeErr := dst.Write(buf[:n])
+			total += int64(written)
+			if writeErr != nil {
+				return total, ignoreClosed(writeErr)
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				return total, nil
+			}
+			return total, ignoreClosed(err)
+		}
+	}
+}
+
+// closeWrite đóng chiều ghi của kết nối TCP để báo EOF cho phía bên kia,
+// hoặc đóng hẳn kết nối nếu không hỗ trợ half-close.
+func closeWrite(conn net.Conn) {
+	if tcp, ok := conn.(interface{ CloseWrite() error }); ok {
+		tcp.CloseWrite()
+		return
+	}
+	conn.Close()
+}
+
+// ignoreClosed bỏ qua lỗi do kết nối đã bị đóng bởi phía còn lại.
+func ignoreClosed(err error) error {
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
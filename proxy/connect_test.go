@@ -0,0 +1,137 @@
+package proxy
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// startEcho khởi động TCP server phản hồi lại dữ liệu nhận được.
+func startEcho(t *testing.T) net.Listener {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return ln
+}
+
+// connect gửi request CONNECT tới proxy và trả về kết nối cùng status line.
+func connect(t *testing.T, proxyAddr, target string, header string) (net.Conn, *bufio.Reader, string) {
+	conn, err := net.Dial("tcp", proxyAddr)
+	if err != nil {
+		t.Fatalf("Failed to dial proxy: %v", err)
+	}
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	io.WriteString(conn, "CONNECT "+target+" HTTP/1.1\r\nHost: "+target+"\r\n"+header+"\r\n")
+
+	reader := bufio.NewReader(conn)
+	status, err := reader.ReadString('\n')
+	if err != nil {
+		t.Fatalf("Failed to read status: %v", err)
+	}
+	return conn, reader, strings.TrimSpace(status)
+}
+
+func TestWithConnectTunnels(t *testing.T) {
+	echo := startEcho(t)
+	defer echo.Close()
+
+	tunnels := make(chan int64, 1)
+	handler := WithConnect(http.NotFoundHandler(), ConnectConfig{
+		Authorize: func(r *http.Request) (bool, int) {
+			if r.Header.Get("Proxy-Authorization") != "Basic dG9vbDpzZWNyZXQ=" {
+				return false, http.StatusProxyAuthRequired
+			}
+			return true, 0
+		},
+		OnTunnel: func(r *http.Request, sent, received int64, err error) {
+			tunnels <- sent
+		},
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	proxyAddr := strings.TrimPrefix(server.URL, "http://")
+
+	conn, reader, status := connect(t, proxyAddr, echo.Addr().String(), "Proxy-Authorization: Basic dG9vbDpzZWNyZXQ=\r\n")
+	defer conn.Close()
+	if status != "HTTP/1.1 200 Connection Established" {
+		t.Fatalf("Unexpected status %q", status)
+	}
+	reader.ReadString('\n') // Dòng trống kết thúc headers
+
+	io.WriteString(conn, "ping")
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		t.Fatalf("Failed to read echo: %v", err)
+	}
+	if string(buf) != "ping" {
+		t.Errorf("Expected echo ping, got %q", buf)
+	}
+
+	conn.(*net.TCPConn).CloseWrite()
+	select {
+	case sent := <-tunnels:
+		if sent != 4 {
+			t.Errorf("Expected 4 bytes sent through tunnel, got %d", sent)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for tunnel to close")
+	}
+}
+
+func TestWithConnectRejects(t *testing.T) {
+	echo := startEcho(t)
+	defer echo.Close()
+
+	tests := []struct {
+		name     string
+		config   ConnectConfig
+		expected string
+	}{
+		{"no authorize callback", ConnectConfig{}, "403"},
+		{"unauthorized", ConnectConfig{Authorize: func(*http.Request) (bool, int) { return false, http.StatusProxyAuthRequired }}, "407"},
+		{"default status", ConnectConfig{Authorize: func(*http.Request) (bool, int) { return false, 0 }}, "403"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(WithConnect(http.NotFoundHandler(), tt.config))
+			defer server.Close()
+
+			conn, _, status := connect(t, strings.TrimPrefix(server.URL, "http://"), echo.Addr().String(), "")
+			defer conn.Close()
+			if !strings.Contains(status, " "+tt.expected+" ") {
+				t.Errorf("Expected status %s, got %q", tt.expected, status)
+			}
+		})
+	}
+}
+
+func TestWithConnectPassesThroughOtherMethods(t *testing.T) {
+	handler := WithConnect(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("next"))
+	}), ConnectConfig{})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Body.String() != "next" {
+		t.Errorf("Expected non-CONNECT request to reach next handler, got %q", w.Body.String())
+	}
+}
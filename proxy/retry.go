@@ -0,0 +1,502 @@
+// Package proxy cung cấp các thành phần cho việc chuyển tiếp request tới upstream,
+// bao gồm RetryTransport hỗ trợ retry, per-try timeout, hedged requests và retry budget,
+// và WithConnect cho phép xử lý method CONNECT như một forward proxy đơn giản.
+package proxy
+
+import (
+	"bytes"
+	gocontext "context"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RetryConfig chứa cấu hình retry và hedging cho RetryTransport.
+type RetryConfig struct {
+	// Transport là RoundTripper thực hiện request tới upstream.
+	// Mặc định: http.DefaultTransport
+	Transport http.RoundTripper
+
+	// MaxRetries là số lần retry tối đa sau lần thử đầu tiên.
+	// Giá trị 0 tắt retry.
+	MaxRetries int
+
+	// RetryOn là danh sách status codes được coi là lỗi tạm thời và cần retry.
+	// Mặc định: 502, 503, 504
+	RetryOn []int
+
+	// RetryMethods là danh sách HTTP methods được phép retry và hedge.
+	// Mặc định: các idempotent methods GET, HEAD, OPTIONS, PUT, DELETE, TRACE
+	RetryMethods []string
+
+	// PerTryTimeout là thời gian tối đa cho mỗi lần thử (bao gồm đọc response body).
+	// Giá trị 0 không giới hạn.
+	PerTryTimeout time.Duration
+
+	// Backoff là thời gian chờ cơ sở giữa các lần retry, tăng theo cấp số nhân kèm jitter.
+	// Mặc định: 25ms
+	Backoff time.Duration
+
+	// MaxBackoff là thời gian chờ tối đa giữa các lần retry.
+	// Mặc định: 1 giây
+	MaxBackoff time.Duration
+
+	// HedgeDelay là thời gian chờ trước khi gửi một hedged request song song nếu
+	// lần thử hiện tại chưa có response. Giá trị 0 tắt hedging.
+	HedgeDelay time.Duration
+
+	// MaxHedges là số hedged requests tối đa cho mỗi lần thử.
+	// Mặc định: 1 khi HedgeDelay > 0
+	MaxHedges int
+
+	// MaxBufferedBody là kích thước body tối đa (bytes) được buffer để có thể gửi lại.
+	// Request có body lớn hơn chỉ được thử một lần. Mặc định: 1MB
+	MaxBufferedBody int64
+
+	// Budget giới hạn tổng số retry và hedge so với số request.
+	// Nếu nil, không giới hạn.
+	Budget *RetryBudget
+
+	// Metrics nhận các sự kiện của transport. Nếu nil, các sự kiện bị bỏ qua.
+	Metrics Metrics
+}
+
+// Metrics là interface nhận các sự kiện retry và hedging để xuất ra hệ thống giám sát.
+type Metrics interface {
+	// ObserveAttempt được gọi sau mỗi lần thử với status code (0 nếu lỗi) và thời gian thực hiện.
+	ObserveAttempt(req *http.Request, statusCode int, err error, duration time.Duration)
+
+	// IncRetry được gọi mỗi khi một retry được thực hiện.
+	IncRetry(req *http.Request)
+
+	// IncHedge được gọi mỗi khi một hedged request được gửi.
+	IncHedge(req *http.Request)
+
+	// IncBudgetExhausted được gọi khi retry hoặc hedge bị từ chối do hết budget.
+	IncBudgetExhausted(req *http.Request)
+}
+
+// RetryBudget giới hạn số lượng retry và hedge để tránh khuếch đại tải khi upstream gặp sự cố.
+//
+// Mỗi request ban đầu nạp Ratio token, mỗi retry hoặc hedge tiêu thụ một token.
+// Ngoài ra, MinPerSecond token được nạp thêm mỗi giây để luôn cho phép một lượng retry tối thiểu.
+type RetryBudget struct {
+	mu           sync.Mutex
+	ratio        float64
+	minPerSecond float64
+	maxTokens    float64
+	tokens       float64
+	last         time.Time
+}
+
+// NewRetryBudget tạo retry budget mới.
+//
+// Parameters:
+//   - ratio: Tỷ lệ retry trên số request (ví dụ: 0.2 cho phép thêm 20% request)
+//   - minPerSecond: Số retry tối thiểu luôn được phép mỗi giây
+//
+// Returns:
+//   - *RetryBudget: Retry budget đã khởi tạo
+func NewRetryBudget(ratio float64, minPerSecond int) *RetryBudget {
+	maxTokens := float64(minPerSecond)
+	if maxTokens < 10 {
+		maxTokens = 10
+	}
+	return &RetryBudget{
+		ratio:        ratio,
+		minPerSecond: float64(minPerSecond),
+		maxTokens:    maxTokens,
+		tokens:       float64(minPerSecond),
+		last:         time.Now(),
+	}
+}
+
+// deposit nạp token cho một request ban đầu.
+func (b *RetryBudget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	b.tokens += b.ratio
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+}
+
+// withdraw tiêu thụ một token, trả về false nếu budget đã hết.
+func (b *RetryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// refill nạp token theo MinPerSecond dựa trên thời gian đã trôi qua.
+func (b *RetryBudget) refill() {
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.minPerSecond
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+	b.last = now
+}
+
+// RetryTransport là http.RoundTripper thực hiện retry và hedging cho các request tới upstream.
+type RetryTransport struct {
+	config  RetryConfig
+	retryOn map[int]bool
+	methods map[string]bool
+}
+
+// NewRetryTransport tạo RetryTransport mới với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình retry và hedging
+//
+// Returns:
+//   - *RetryTransport: Transport đã khởi tạo
+func NewRetryTransport(config RetryConfig) *RetryTransport {
+	if config.Transport == nil {
+		config.Transport = http.DefaultTransport
+	}
+	if len(config.RetryOn) == 0 {
+		config.RetryOn = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	}
+	if len(config.RetryMethods) == 0 {
+		config.RetryMethods = []string{
+			http.MethodGet, http.MethodHead, http.MethodOptions,
+			http.MethodPut, http.MethodDelete, http.MethodTrace,
+		}
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = 25 * time.Millisecond
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = time.Second
+	}
+	if config.HedgeDelay > 0 && config.MaxHedges <= 0 {
+		config.MaxHedges = 1
+	}
+	if config.MaxBufferedBody <= 0 {
+		config.MaxBufferedBody = 1 << 20
+	}
+	if config.Metrics == nil {
+		config.Metrics = noopMetrics{}
+	}
+
+	t := &RetryTransport{
+		config:  config,
+		retryOn: make(map[int]bool, len(config.RetryOn)),
+		methods: make(map[string]bool, len(config.RetryMethods)),
+	}
+	for _, code := range config.RetryOn {
+		t.retryOn[code] = true
+	}
+	for _, method := range config.RetryMethods {
+		t.methods[method] = true
+	}
+	return t
+}
+
+// NewReverseProxy tạo httputil.ReverseProxy tới target sử dụng RetryTransport.
+//
+// Parameters:
+//   - target: URL của upstream
+//   - config: Cấu hình retry và hedging
+//
+// Returns:
+//   - *httputil.ReverseProxy: Reverse proxy đã cấu hình
+func NewReverseProxy(target *url.URL, config RetryConfig) *httputil.ReverseProxy {
+	rp := httputil.NewSingleHostReverseProxy(target)
+	rp.Transport = NewRetryTransport(config)
+	return rp
+}
+
+// RoundTrip thực hiện request với retry và hedging.
+// Triển khai phương thức RoundTrip của http.RoundTripper interface.
+//
+// Parameters:
+//   - req: Request cần gửi tới upstream
+//
+// Returns:
+//   - *http.Response: Response của lần thử thành công hoặc lần thử cuối cùng
+//   - error: Lỗi của lần thử cuối cùng nếu không có response
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.config.Budget != nil {
+		t.config.Budget.deposit()
+	}
+
+	body, replayable, err := t.bufferBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if !replayable || !t.methods[req.Method] {
+		// Không thể hoặc không được phép gửi lại: chỉ thử một lần
+		return t.attempt(req.Context(), req, body, replayable)
+	}
+
+	var resp *http.Response
+	for try := 0; ; try++ {
+		if try > 0 {
+			if !t.allow(req) {
+				return resp, err
+			}
+			if !t.sleep(req.Context(), try) {
+				return resp, err
+			}
+			t.config.Metrics.IncRetry(req)
+			if resp != nil {
+				drain(resp)
+			}
+		}
+
+		resp, err = t.hedged(req, body)
+		if try >= t.config.MaxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+	}
+}
+
+// bufferBody đọc body của request để có thể gửi lại trong các lần thử sau.
+// Trả về replayable=false nếu body vượt quá MaxBufferedBody; khi đó body gốc được giữ nguyên.
+func (t *RetryTransport) bufferBody(req *http.Request) ([]byte, bool, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, true, nil
+	}
+	if req.ContentLength > t.config.MaxBufferedBody {
+		return nil, false, nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(req.Body, t.config.MaxBufferedBody+1))
+	if err != nil {
+		return nil, false, err
+	}
+	if int64(len(buf)) > t.config.MaxBufferedBody {
+		req.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(buf), req.Body), Closer: req.Body}
+		return nil, false, nil
+	}
+	req.Body.Close()
+	return buf, true, nil
+}
+
+// hedged thực hiện một lần thử, gửi thêm hedged requests nếu upstream phản hồi chậm.
+// Response đầu tiên không cần retry được trả về, các request còn lại bị hủy.
+func (t *RetryTransport) hedged(req *http.Request, body []byte) (*http.Response, error) {
+	if t.config.HedgeDelay <= 0 {
+		return t.attempt(req.Context(), req, body, true)
+	}
+
+	type result struct {
+		index int
+		resp  *http.Response
+		err   error
+	}
+
+	results := make(chan result, t.config.MaxHedges+1)
+	var cancels []gocontext.CancelFunc
+	launch := func() {
+		ctx, cancel := gocontext.WithCancel(req.Context())
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			resp, err := t.attempt(ctx, req, body, true)
+			results <- result{index: index, resp: resp, err: err}
+		}()
+	}
+
+	launch()
+	timer := time.NewTimer(t.config.HedgeDelay)
+	defer timer.Stop()
+
+	received := 0
+	var last result
+	for {
+		select {
+		case <-timer.C:
+			if len(cancels) <= t.config.MaxHedges && t.allow(req) {
+				t.config.Metrics.IncHedge(req)
+				launch()
+				timer.Reset(t.config.HedgeDelay)
+			}
+		case r := <-results:
+			received++
+			done := received == len(cancels)
+			if t.shouldRetry(req, r.resp, r.err) && !done {
+				// Chờ các request còn lại; giữ kết quả mới nhất làm phương án dự phòng
+				if last.resp != nil {
+					drain(last.resp)
+				}
+				last = r
+				continue
+			}
+			if last.resp != nil {
+				drain(last.resp)
+			}
+
+			// Hủy các request còn lại và dọn dẹp response của chúng
+			for i, cancel := range cancels {
+				if i != r.index {
+					cancel()
+				}
+			}
+			pending := len(cancels) - received
+			go func() {
+				for i := 0; i < pending; i++ {
+					if lr := <-results; lr.resp != nil {
+						drain(lr.resp)
+					}
+				}
+			}()
+
+			winnerCancel := cancels[r.index]
+			if r.resp == nil {
+				winnerCancel()
+				return nil, r.err
+			}
+			r.resp.Body = cancelOnClose{ReadCloser: r.resp.Body, cancel: winnerCancel}
+			return r.resp, nil
+		}
+	}
+}
+
+// attempt thực hiện một lần gửi request tới upstream với per-try timeout.
+func (t *RetryTransport) attempt(parent gocontext.Context, req *http.Request, body []byte, replayable bool) (*http.Response, error) {
+	ctx, cancel := parent, gocontext.CancelFunc(func() {})
+	if t.config.PerTryTimeout > 0 {
+		ctx, cancel = gocontext.WithTimeout(parent, t.config.PerTryTimeout)
+	}
+
+	out := req.Clone(ctx)
+	if replayable && body != nil {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+		out.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		out.ContentLength = int64(len(body))
+	} else if replayable {
+		out.Body = req.Body
+	}
+
+	start := time.Now()
+	resp, err := t.config.Transport.RoundTrip(out)
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	t.config.Metrics.ObserveAttempt(req, statusCode, err, time.Since(start))
+
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// Chỉ hủy context khi body được đóng để không cắt ngang việc đọc response
+	resp.Body = cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// shouldRetry kiểm tra kết quả của một lần thử có cần retry hay không.
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		// Client đã hủy request: không retry
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return t.retryOn[resp.StatusCode]
+}
+
+// allow kiểm tra retry budget, ghi nhận metrics khi budget đã hết.
+func (t *RetryTransport) allow(req *http.Request) bool {
+	if t.config.Budget == nil || t.config.Budget.withdraw() {
+		return true
+	}
+	t.config.Metrics.IncBudgetExhausted(req)
+	return false
+}
+
+// sleep chờ theo exponential backoff kèm jitter. Trả về false nếu request bị hủy.
+func (t *RetryTransport) sleep(ctx gocontext.Context, try int) bool {
+	backoff := t.config.Backoff << uint(try-1)
+	if backoff <= 0 || backoff > t.config.MaxBackoff {
+		backoff = t.config.MaxBackoff
+	}
+	// Full jitter trong khoảng [backoff/2, backoff]
+	backoff = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// drain đọc hết và đóng body để connection có thể được tái sử dụng.
+func drain(resp *http.Response) {
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+}
+
+// cancelOnClose hủy context của lần thử khi response body được đóng.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel gocontext.CancelFunc
+}
+
+// Close đóng body và hủy context.
+func (b cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// readCloser ghép một Reader với Closer của body gốc.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// Stats là triển khai Metrics đơn giản dùng bộ đếm atomic.
+type Stats struct {
+	Attempts        atomic.Int64
+	Failures        atomic.Int64
+	Retries         atomic.Int64
+	Hedges          atomic.Int64
+	BudgetExhausted atomic.Int64
+}
+
+// ObserveAttempt đếm số lần thử và số lần thử thất bại (lỗi transport hoặc status 5xx).
+func (s *Stats) ObserveAttempt(_ *http.Request, statusCode int, err error, _ time.Duration) {
+	s.Attempts.Add(1)
+	if err != nil || statusCode >= 500 {
+		s.Failures.Add(1)
+	}
+}
+
+// IncRetry đếm số lần retry.
+func (s *Stats) IncRetry(*http.Request) { s.Retries.Add(1) }
+
+// IncHedge đếm số hedged requests.
+func (s *Stats) IncHedge(*http.Request) { s.Hedges.Add(1) }
+
+// IncBudgetExhausted đếm số lần bị từ chối do hết budget.
+func (s *Stats) IncBudgetExhausted(*http.Request) { s.BudgetExhausted.Add(1) }
+
+// noopMetrics bỏ qua tất cả các sự kiện.
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveAttempt(*http.Request, int, error, time.Duration) {}
+func (noopMetrics) IncRetry(*http.Request)                                  {}
+func (noopMetrics) IncHedge(*http.Request)                                  {}
+func (noopMetrics) IncBudgetExhausted(*http.Request)                        {}
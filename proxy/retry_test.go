@@ -0,0 +1,240 @@
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransportRetriesIdempotent(t *testing.T) {
+	var calls int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("Expected body to be replayed, got %q", body)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer upstream.Close()
+
+	stats := &Stats{}
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{
+		MaxRetries: 3,
+		Backoff:    time.Millisecond,
+		Metrics:    stats,
+	})}
+
+	req, _ := http.NewRequest(http.MethodPut, upstream.URL, strings.NewReader("payload"))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK || string(body) != "ok" {
+		t.Errorf("Expected 200 ok, got %d %q", resp.StatusCode, body)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+	if stats.Retries.Load() != 2 || stats.Attempts.Load() != 3 || stats.Failures.Load() != 2 {
+		t.Errorf("Unexpected stats: retries=%d attempts=%d failures=%d",
+			stats.Retries.Load(), stats.Attempts.Load(), stats.Failures.Load())
+	}
+}
+
+func TestRetryTransportSkipsNonIdempotent(t *testing.T) {
+	var calls int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{MaxRetries: 3, Backoff: time.Millisecond})}
+	resp, err := client.Post(upstream.URL, "text/plain", strings.NewReader("x"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected 502, got %d", resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Errorf("Expected POST not to be retried, got %d calls", calls)
+	}
+}
+
+func TestRetryTransportReturnsLastResponse(t *testing.T) {
+	var calls int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusGatewayTimeout)
+		w.Write([]byte("last"))
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{MaxRetries: 2, Backoff: time.Millisecond})}
+	resp, err := client.Get(upstream.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusGatewayTimeout || string(body) != "last" {
+		t.Errorf("Expected last 504 response, got %d %q", resp.StatusCode, body)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryTransportPerTryTimeout(t *testing.T) {
+	var calls int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(2 * time.Second):
+			}
+			return
+		}
+		w.Write([]byte("fast"))
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{
+		MaxRetries:    1,
+		PerTryTimeout: 50 * time.Millisecond,
+		Backoff:       time.Millisecond,
+	})}
+
+	start := time.Now()
+	resp, err := client.Get(upstream.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if string(body) != "fast" {
+		t.Errorf("Expected body fast, got %q", body)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("Expected per-try timeout to cut the slow attempt, took %v", time.Since(start))
+	}
+}
+
+func TestRetryTransportHedging(t *testing.T) {
+	var calls int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(2 * time.Second):
+			}
+			return
+		}
+		w.Write([]byte("hedged"))
+	}))
+	defer upstream.Close()
+
+	stats := &Stats{}
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{
+		HedgeDelay: 30 * time.Millisecond,
+		Metrics:    stats,
+	})}
+
+	start := time.Now()
+	resp, err := client.Get(upstream.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if string(body) != "hedged" {
+		t.Errorf("Expected hedged response, got %q", body)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("Expected hedged request to win, took %v", time.Since(start))
+	}
+	if stats.Hedges.Load() != 1 {
+		t.Errorf("Expected 1 hedge, got %d", stats.Hedges.Load())
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	var calls int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer upstream.Close()
+
+	stats := &Stats{}
+	client := &http.Client{Transport: NewRetryTransport(RetryConfig{
+		MaxRetries: 5,
+		Backoff:    time.Millisecond,
+		Budget:     NewRetryBudget(0, 0),
+		Metrics:    stats,
+	})}
+
+	resp, err := client.Get(upstream.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if calls != 1 {
+		t.Errorf("Expected empty budget to prevent retries, got %d calls", calls)
+	}
+	if stats.BudgetExhausted.Load() != 1 {
+		t.Errorf("Expected 1 budget exhaustion, got %d", stats.BudgetExhausted.Load())
+	}
+
+	budget := NewRetryBudget(0.5, 0)
+	budget.tokens = 0
+	budget.deposit()
+	budget.deposit()
+	if !budget.withdraw() {
+		t.Error("Expected one retry to be allowed after two deposits")
+	}
+	if budget.withdraw() {
+		t.Error("Expected budget to be exhausted")
+	}
+}
+
+func TestNewReverseProxy(t *testing.T) {
+	var calls int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer upstream.Close()
+
+	target, _ := url.Parse(upstream.URL)
+	rp := NewReverseProxy(target, RetryConfig{MaxRetries: 1, Backoff: time.Millisecond})
+
+	w := httptest.NewRecorder()
+	rp.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/users", nil))
+
+	if w.Code != http.StatusOK || w.Body.String() != "/api/users" {
+		t.Errorf("Expected proxied 200 /api/users, got %d %q", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,350 @@
+// Package rememberme cung cấp remember-me tokens dài hạn cho luồng "ghi nhớ đăng nhập",
+// bổ sung cho session ngắn hạn.
+//
+// Token theo mô hình selector + validator: cookie chứa selector (công khai, dùng để tra
+// cứu) và validator (bí mật, chỉ lưu hash trong store), được ký HMAC. Selector giữ nguyên
+// suốt vòng đời token, còn validator được xoay vòng sau mỗi lần sử dụng; nếu selector hợp lệ
+// nhưng validator sai (cookie cũ bị đánh cắp và được dùng lại), toàn bộ tokens của người dùng
+// bị thu hồi.
+package rememberme
+
+import (
+	gocontext "context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// Các lỗi của remember-me.
+var (
+	// ErrNoCookie được trả về khi request không có remember-me cookie
+	ErrNoCookie = errors.New("rememberme: cookie not present")
+
+	// ErrInvalidCookie được trả về khi cookie sai định dạng hoặc chữ ký không hợp lệ
+	ErrInvalidCookie = errors.New("rememberme: invalid cookie")
+
+	// ErrTokenNotFound được trả về khi selector không tồn tại trong store
+	ErrTokenNotFound = errors.New("rememberme: token not found")
+
+	// ErrTokenExpired được trả về khi token đã hết hạn
+	ErrTokenExpired = errors.New("rememberme: token expired")
+
+	// ErrTokenTheft được trả về khi validator không khớp; mọi tokens của người dùng bị thu hồi
+	ErrTokenTheft = errors.New("rememberme: token validator mismatch, possible theft")
+)
+
+const (
+	selectorSize  = 12
+	validatorSize = 32
+)
+
+// DefaultContextKey là khóa mặc định trong context store chứa user ID được xác thực
+// bởi middleware.
+const DefaultContextKey = "remember_user_id"
+
+// Config chứa cấu hình cho remember-me manager.
+type Config struct {
+	// Store lưu trữ tokens (bắt buộc)
+	Store TokenStore
+
+	// Secret là khóa HMAC ký cookie (bắt buộc, tối thiểu 32 bytes được khuyến nghị)
+	Secret []byte
+
+	// CookieName là tên cookie.
+	// Mặc định: "remember_me"
+	CookieName string
+
+	// MaxAge là thời hạn của token.
+	// Mặc định: 30 ngày
+	MaxAge time.Duration
+
+	// Path, Domain, Secure và SameSite là thuộc tính cookie.
+	// Mặc định: Path "/", SameSite Lax
+	Path     string
+	Domain   string
+	Secure   bool
+	SameSite http.SameSite
+
+	// DisableRotation tắt việc xoay vòng validator sau mỗi lần xác thực
+	DisableRotation bool
+
+	// ContextKey là khóa context store chứa user ID do middleware thiết lập.
+	// Mặc định: DefaultContextKey
+	ContextKey string
+}
+
+// Manager phát hành, xác thực và thu hồi remember-me tokens.
+type Manager struct {
+	config Config
+	now    func() time.Time
+}
+
+// New tạo Manager mới.
+//
+// Parameters:
+//   - config: Cấu hình manager
+//
+// Returns:
+//   - *Manager: Manager đã khởi tạo
+//
+// Panics:
+//   - Nếu config.Store là nil hoặc config.Secret rỗng
+func New(config Config) *Manager {
+	if config.Store == nil {
+		panic("rememberme: Store is required")
+	}
+	if len(config.Secret) == 0 {
+		panic("rememberme: Secret is required")
+	}
+	if config.CookieName == "" {
+		config.CookieName = "remember_me"
+	}
+	if config.MaxAge <= 0 {
+		config.MaxAge = 30 * 24 * time.Hour
+	}
+	if config.Path == "" {
+		config.Path = "/"
+	}
+	if config.SameSite == 0 {
+		config.SameSite = http.SameSiteLaxMode
+	}
+	if config.ContextKey == "" {
+		config.ContextKey = DefaultContextKey
+	}
+	return &Manager{config: config, now: time.Now}
+}
+
+// Issue phát hành token mới cho người dùng và ghi cookie vào response.
+// Thường được gọi sau khi đăng nhập thành công với tùy chọn "ghi nhớ đăng nhập".
+//
+// Parameters:
+//   - ctx: Context của request
+//   - userID: Định danh người dùng
+//
+// Returns:
+//   - error: Lỗi nếu không tạo được token hoặc không lưu được vào store
+func (m *Manager) Issue(ctx forkCtx.Context, userID string) error {
+	selector, err := randomToken(selectorSize)
+	if err != nil {
+		return err
+	}
+	validator, err := randomToken(validatorSize)
+	if err != nil {
+		return err
+	}
+
+	now := m.now()
+	token := Token{
+		Selector:      selector,
+		ValidatorHash: hashValidator(validator),
+		UserID:        userID,
+		ExpiresAt:     now.Add(m.config.MaxAge),
+		CreatedAt:     now,
+	}
+	if err := m.config.Store.Save(requestContext(ctx), token); err != nil {
+		return err
+	}
+
+	m.setCookie(ctx, m.sign(selector+"."+validator), int(m.config.MaxAge/time.Second))
+	return nil
+}
+
+// Validate xác thực remember-me cookie của request và trả về user ID.
+// Khi xác thực thành công và rotation được bật, validator được thay mới (giữ nguyên selector)
+// và cookie mới được ghi vào response.
+// Cookie không hợp lệ bị xóa khỏi trình duyệt.
+//
+// Parameters:
+//   - ctx: Context của request
+//
+// Returns:
+//   - string: User ID của token
+//   - error: ErrNoCookie, ErrInvalidCookie, ErrTokenNotFound, ErrTokenExpired,
+//     ErrTokenTheft hoặc lỗi từ store
+func (m *Manager) Validate(ctx forkCtx.Context) (string, error) {
+	value, err := ctx.Cookie(m.config.CookieName)
+	if err != nil || value == "" {
+		return "", ErrNoCookie
+	}
+
+	selector, validator, ok := m.verify(value)
+	if !ok {
+		m.clearCookie(ctx)
+		return "", ErrInvalidCookie
+	}
+
+	reqCtx := requestContext(ctx)
+	token, err := m.config.Store.Find(reqCtx, selector)
+	if err != nil {
+		if errors.Is(err, ErrTokenNotFound) {
+			m.clearCookie(ctx)
+		}
+		return "", err
+	}
+
+	if subtle.ConstantTimeCompare(token.ValidatorHash, hashValidator(validator)) != 1 {
+		m.config.Store.DeleteUser(reqCtx, token.UserID)
+		m.clearCookie(ctx)
+		return "", ErrTokenTheft
+	}
+
+	if !m.now().Before(token.ExpiresAt) {
+		m.config.Store.Delete(reqCtx, selector)
+		m.clearCookie(ctx)
+		return "", ErrTokenExpired
+	}
+
+	if !m.config.DisableRotation {
+		if err := m.rotate(ctx, token); err != nil {
+			return "", err
+		}
+	}
+	return token.UserID, nil
+}
+
+// rotate thay validator của token bằng giá trị mới, giữ nguyên selector để validator cũ
+// bị dùng lại sau đó được nhận diện là đánh cắp.
+func (m *Manager) rotate(ctx forkCtx.Context, token Token) error {
+	validator, err := randomToken(validatorSize)
+	if err != nil {
+		return err
+	}
+	token.ValidatorHash = hashValidator(validator)
+	token.ExpiresAt = m.now().Add(m.config.MaxAge)
+	if err := m.config.Store.Update(requestContext(ctx), token); err != nil {
+		return err
+	}
+
+	m.setCookie(ctx, m.sign(token.Selector+"."+validator), int(m.config.MaxAge/time.Second))
+	return nil
+}
+
+// Forget thu hồi token của request hiện tại và xóa cookie, thường gọi khi đăng xuất.
+//
+// Parameters:
+//   - ctx: Context của request
+//
+// Returns:
+//   - error: Lỗi từ store nếu có
+func (m *Manager) Forget(ctx forkCtx.Context) error {
+	defer m.clearCookie(ctx)
+
+	value, err := ctx.Cookie(m.config.CookieName)
+	if err != nil || value == "" {
+		return nil
+	}
+	selector, _, ok := m.verify(value)
+	if !ok {
+		return nil
+	}
+	return m.config.Store.Delete(requestContext(ctx), selector)
+}
+
+// ForgetUser thu hồi tất cả tokens của người dùng (ví dụ: khi đổi mật khẩu).
+//
+// Parameters:
+//   - ctx: Context của request
+//   - userID: Định danh người dùng
+//
+// Returns:
+//   - error: Lỗi từ store nếu có
+func (m *Manager) ForgetUser(ctx forkCtx.Context, userID string) error {
+	return m.config.Store.DeleteUser(requestContext(ctx), userID)
+}
+
+// Middleware tạo middleware tự động đăng nhập từ remember-me cookie.
+//
+// Middleware bỏ qua nếu ContextKey đã có giá trị (người dùng đã được xác thực bởi session).
+// Khi cookie hợp lệ, user ID được lưu vào context với ContextKey và onLogin (nếu có)
+// được gọi để ứng dụng khôi phục session. Lỗi xác thực không chặn request.
+//
+// Parameters:
+//   - onLogin: Callback tùy chọn khi người dùng được xác thực qua cookie
+//
+// Returns:
+//   - router.HandlerFunc: Middleware remember-me
+func (m *Manager) Middleware(onLogin func(ctx forkCtx.Context, userID string)) router.HandlerFunc {
+	return func(ctx forkCtx.Context) {
+		if _, exists := ctx.Get(m.config.ContextKey); !exists {
+			if userID, err := m.Validate(ctx); err == nil {
+				ctx.Set(m.config.ContextKey, userID)
+				if onLogin != nil {
+					onLogin(ctx, userID)
+				}
+			}
+		}
+		ctx.Next()
+	}
+}
+
+// sign tạo giá trị cookie đã ký: payload + "." + HMAC(payload).
+func (m *Manager) sign(payload string) string {
+	mac := hmac.New(sha256.New, m.config.Secret)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify kiểm tra chữ ký cookie và tách selector, validator.
+func (m *Manager) verify(value string) (selector, validator string, ok bool) {
+	idx := strings.LastIndex(value, ".")
+	if idx < 0 {
+		return "", "", false
+	}
+	payload, signature := value[:idx], value[idx+1:]
+	if !hmac.Equal([]byte(m.sign(payload)[len(payload)+1:]), []byte(signature)) {
+		return "", "", false
+	}
+	selector, validator, ok = strings.Cut(payload, ".")
+	if !ok || selector == "" || validator == "" {
+		return "", "", false
+	}
+	return selector, validator, true
+}
+
+func (m *Manager) setCookie(ctx forkCtx.Context, value string, maxAge int) {
+	cookie := &http.Cookie{
+		Name:     m.config.CookieName,
+		Value:    value,
+		MaxAge:   maxAge,
+		Path:     m.config.Path,
+		Domain:   m.config.Domain,
+		Secure:   m.config.Secure,
+		HttpOnly: true,
+		SameSite: m.config.SameSite,
+	}
+	ctx.Response().Header().Add("Set-Cookie", cookie.String())
+}
+
+func (m *Manager) clearCookie(ctx forkCtx.Context) {
+	m.setCookie(ctx, "", -1)
+}
+
+// requestContext trả về context.Context của request cho các thao tác với store.
+func requestContext(ctx forkCtx.Context) gocontext.Context {
+	if c := ctx.Context(); c != nil {
+		return c
+	}
+	return gocontext.Background()
+}
+
+func randomToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashValidator(validator string) []byte {
+	sum := sha256.Sum256([]byte(validator))
+	return sum[:]
+}
@@ -0,0 +1,217 @@
+package rememberme
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+func newManager(store TokenStore) *Manager {
+	return New(Config{Store: store, Secret: []byte("0123456789abcdef0123456789abcdef")})
+}
+
+// issue phát hành token và trả về giá trị cookie.
+func issue(t *testing.T, m *Manager, userID string) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, httptest.NewRequest(http.MethodPost, "/login", nil))
+	if err := m.Issue(ctx, userID); err != nil {
+		t.Fatalf("Failed to issue token: %v", err)
+	}
+	return cookieValue(t, w)
+}
+
+// validate xác thực cookie và trả về user ID, response (chứa cookie mới nếu có) và lỗi.
+func validate(m *Manager, cookie string) (string, *httptest.ResponseRecorder, error) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if cookie != "" {
+		req.AddCookie(&http.Cookie{Name: "remember_me", Value: cookie})
+	}
+	userID, err := m.Validate(forkCtx.NewContext(w, req))
+	return userID, w, err
+}
+
+func cookieValue(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	for _, c := range w.Result().Cookies() {
+		if c.Name == "remember_me" {
+			return c.Value
+		}
+	}
+	t.Fatal("Expected remember_me cookie in response")
+	return ""
+}
+
+func TestIssueSetsCookie(t *testing.T) {
+	store := NewMemoryStore()
+	m := newManager(store)
+
+	w := httptest.NewRecorder()
+	ctx := forkCtx.NewContext(w, httptest.NewRequest(http.MethodPost, "/login", nil))
+	if err := m.Issue(ctx, "42"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	header := w.Header().Get("Set-Cookie")
+	for _, attr := range []string{"HttpOnly", "SameSite=Lax", "Path=/", "Max-Age=2592000"} {
+		if !strings.Contains(header, attr) {
+			t.Errorf("Expected cookie attribute %s in %q", attr, header)
+		}
+	}
+	if store.Len() != 1 {
+		t.Errorf("Expected 1 stored token, got %d", store.Len())
+	}
+}
+
+func TestValidateRotatesToken(t *testing.T) {
+	store := NewMemoryStore()
+	m := newManager(store)
+	cookie := issue(t, m, "42")
+
+	userID, w, err := validate(m, cookie)
+	if err != nil || userID != "42" {
+		t.Fatalf("Expected user 42, got %q, %v", userID, err)
+	}
+	rotated := cookieValue(t, w)
+	if rotated == cookie {
+		t.Error("Expected token to be rotated")
+	}
+	if store.Len() != 1 {
+		t.Errorf("Expected old token to be replaced, got %d tokens", store.Len())
+	}
+	oldSelector, _, _ := m.verify(cookie)
+	newSelector, _, _ := m.verify(rotated)
+	if oldSelector != newSelector {
+		t.Errorf("Expected selector to stay stable across rotations, got %q and %q", oldSelector, newSelector)
+	}
+
+	if userID, _, err := validate(m, rotated); err != nil || userID != "42" {
+		t.Errorf("Expected rotated cookie to validate, got %q, %v", userID, err)
+	}
+}
+
+func TestValidateDetectsReplayedCookie(t *testing.T) {
+	store := NewMemoryStore()
+	m := newManager(store)
+	stolen := issue(t, m, "42")
+	issue(t, m, "42") // Token trên thiết bị khác
+
+	// Người dùng hợp lệ sử dụng cookie, validator được xoay vòng
+	if _, _, err := validate(m, stolen); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Kẻ tấn công dùng lại cookie trước khi xoay vòng
+	if _, _, err := validate(m, stolen); !errors.Is(err, ErrTokenTheft) {
+		t.Fatalf("Expected ErrTokenTheft for replayed cookie, got %v", err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Expected all user tokens to be revoked, got %d", store.Len())
+	}
+}
+
+func TestValidateDetectsTheft(t *testing.T) {
+	store := NewMemoryStore()
+	m := newManager(store)
+	cookie := issue(t, m, "42")
+	issue(t, m, "42") // Token trên thiết bị khác
+
+	// Giả mạo validator với selector hợp lệ và chữ ký đúng
+	selector, _, _ := m.verify(cookie)
+	forged := m.sign(selector + ".forgedvalidator")
+
+	if _, _, err := validate(m, forged); !errors.Is(err, ErrTokenTheft) {
+		t.Fatalf("Expected ErrTokenTheft, got %v", err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Expected all user tokens to be revoked, got %d", store.Len())
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	store := NewMemoryStore()
+	m := newManager(store)
+	cookie := issue(t, m, "42")
+
+	if _, _, err := validate(m, ""); !errors.Is(err, ErrNoCookie) {
+		t.Errorf("Expected ErrNoCookie, got %v", err)
+	}
+	_, w, err := validate(m, cookie+"x")
+	if !errors.Is(err, ErrInvalidCookie) {
+		t.Errorf("Expected ErrInvalidCookie for tampered signature, got %v", err)
+	}
+	if !strings.Contains(w.Header().Get("Set-Cookie"), "Max-Age=0") {
+		t.Error("Expected invalid cookie to be cleared")
+	}
+
+	m.now = func() time.Time { return time.Now().Add(31 * 24 * time.Hour) }
+	if _, _, err := validate(m, cookie); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("Expected ErrTokenExpired, got %v", err)
+	}
+}
+
+func TestForget(t *testing.T) {
+	store := NewMemoryStore()
+	m := newManager(store)
+	cookie := issue(t, m, "42")
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+	req.AddCookie(&http.Cookie{Name: "remember_me", Value: cookie})
+	if err := m.Forget(forkCtx.NewContext(w, req)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if store.Len() != 0 {
+		t.Error("Expected token to be deleted")
+	}
+	if !strings.Contains(w.Header().Get("Set-Cookie"), "Max-Age=0") {
+		t.Error("Expected cookie to be cleared")
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	store := NewMemoryStore()
+	m := newManager(store)
+	cookie := issue(t, m, "42")
+
+	var loggedIn, seen string
+	mw := m.Middleware(func(ctx forkCtx.Context, userID string) { loggedIn = userID })
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: "remember_me", Value: cookie})
+	ctx := forkCtx.NewContext(httptest.NewRecorder(), req)
+	ctx.SetHandlers([]func(forkCtx.Context){mw, func(ctx forkCtx.Context) {
+		seen = ctx.GetString(DefaultContextKey)
+	}})
+	ctx.Next()
+
+	if loggedIn != "42" || seen != "42" {
+		t.Errorf("Expected user 42 to be logged in, got callback %q context %q", loggedIn, seen)
+	}
+}
+
+func TestNewPanics(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{"no store", Config{Secret: []byte("s")}},
+		{"no secret", Config{Store: NewMemoryStore()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected panic")
+				}
+			}()
+			New(tt.config)
+		})
+	}
+}
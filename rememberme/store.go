@@ -0,0 +1,116 @@
+package rememberme
+
+import (
+	gocontext "context"
+	"sync"
+	"time"
+)
+
+// Token là một remember-me token đã lưu. Chỉ hash của validator được lưu trữ,
+// vì vậy việc lộ dữ liệu của store không cho phép giả mạo cookie.
+type Token struct {
+	// Selector là định danh công khai dùng để tìm token
+	Selector string
+
+	// ValidatorHash là SHA-256 của phần validator bí mật
+	ValidatorHash []byte
+
+	// UserID là định danh người dùng sở hữu token
+	UserID string
+
+	// ExpiresAt là thời điểm token hết hạn
+	ExpiresAt time.Time
+
+	// CreatedAt là thời điểm token được phát hành
+	CreatedAt time.Time
+}
+
+// TokenStore là interface lưu trữ remember-me tokens.
+type TokenStore interface {
+	// Save lưu một token mới.
+	Save(ctx gocontext.Context, token Token) error
+
+	// Update thay thế token đã tồn tại có cùng selector (dùng khi xoay vòng validator),
+	// trả về ErrTokenNotFound nếu không tồn tại.
+	Update(ctx gocontext.Context, token Token) error
+
+	// Find tìm token theo selector, trả về ErrTokenNotFound nếu không tồn tại.
+	Find(ctx gocontext.Context, selector string) (Token, error)
+
+	// Delete xóa token theo selector.
+	Delete(ctx gocontext.Context, selector string) error
+
+	// DeleteUser xóa tất cả tokens của một người dùng.
+	DeleteUser(ctx gocontext.Context, userID string) error
+}
+
+// MemoryStore là TokenStore lưu trong bộ nhớ, phù hợp cho phát triển và test.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	tokens map[string]Token
+}
+
+// NewMemoryStore tạo MemoryStore mới.
+//
+// Returns:
+//   - *MemoryStore: Store đã khởi tạo
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tokens: make(map[string]Token)}
+}
+
+// Save lưu một token mới.
+func (s *MemoryStore) Save(_ gocontext.Context, token Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[token.Selector] = token
+	return nil
+}
+
+// Update thay thế token đã tồn tại có cùng selector.
+func (s *MemoryStore) Update(_ gocontext.Context, token Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tokens[token.Selector]; !ok {
+		return ErrTokenNotFound
+	}
+	s.tokens[token.Selector] = token
+	return nil
+}
+
+// Find tìm token theo selector.
+func (s *MemoryStore) Find(_ gocontext.Context, selector string) (Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	token, ok := s.tokens[selector]
+	if !ok {
+		return Token{}, ErrTokenNotFound
+	}
+	return token, nil
+}
+
+// Delete xóa token theo selector.
+func (s *MemoryStore) Delete(_ gocontext.Context, selector string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, selector)
+	return nil
+}
+
+// DeleteUser xóa tất cả tokens của một người dùng.
+func (s *MemoryStore) DeleteUser(_ gocontext.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for selector, token := range s.tokens {
+		if token.UserID == userID {
+			delete(s.tokens, selector)
+		}
+	}
+	return nil
+}
+
+// Len trả về số tokens đang lưu.
+func (s *MemoryStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.tokens)
+}
@@ -0,0 +1,104 @@
+package router
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+)
+
+// WithBodyLimit giới hạn kích thước body của request cho mọi route đăng ký trong group,
+// ví dụ 100MB cho group upload và 1MB cho group JSON API. Group con kế thừa giới hạn;
+// RouteBuilder.BodyLimit ghi đè giới hạn cho từng route.
+//
+// Parameters:
+//   - limit: Số bytes tối đa của body, 0 để bỏ giới hạn
+//
+// Returns:
+//   - GroupOption: Option cho Group
+func WithBodyLimit(limit int64) GroupOption {
+	return func(group *DefaultRouter) {
+		group.bodyLimit = limit
+	}
+}
+
+// BodyLimit giới hạn kích thước body của request cho route, ghi đè giới hạn của group.
+// Request có Content-Length vượt giới hạn nhận 413 trước khi handlers chạy; body không
+// khai báo độ dài (chunked) bị cắt ở giới hạn, việc đọc trả về *http.MaxBytesError
+// và router trả về 413 nếu handlers chưa ghi response.
+//
+// Parameters:
+//   - limit: Số bytes tối đa của body, 0 để bỏ giới hạn
+//
+// Returns:
+//   - *RouteBuilder: Chính builder để gọi nối tiếp
+func (b *RouteBuilder) BodyLimit(limit int64) *RouteBuilder {
+	defer b.writeLock()()
+	b.chain.update(func(state *chainState) { state.bodyLimit = limit })
+	return b
+}
+
+// limitedBody bọc body của request bằng http.MaxBytesReader và ghi nhận khi body vượt giới hạn.
+type limitedBody struct {
+	io.ReadCloser
+
+	// limit là số bytes tối đa của body
+	limit int64
+
+	// exceeded cho biết handlers đã đọc tới phần vượt giới hạn
+	exceeded bool
+}
+
+// Read đọc body và ghi nhận lỗi vượt giới hạn.
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		b.exceeded = true
+	}
+	return n, err
+}
+
+// limitBody áp dụng giới hạn kích thước body cho request trước khi chuỗi handlers chạy.
+//
+// Parameters:
+//   - ctx: Context của request
+//   - limit: Số bytes tối đa của body
+//
+// Returns:
+//   - *limitedBody: Body đã được bọc, nil nếu request không có body
+//   - bool: false nếu request đã bị từ chối với 413
+func limitBody(ctx forkCtx.Context, limit int64) (*limitedBody, bool) {
+	req := ctx.Request().Request()
+	if req.ContentLength > limit {
+		writeBodyTooLarge(ctx, limit)
+		return nil, false
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, true
+	}
+
+	body := &limitedBody{
+		ReadCloser: http.MaxBytesReader(ctx.Response().ResponseWriter(), req.Body, limit),
+		limit:      limit,
+	}
+	req.Body = body
+	return body, true
+}
+
+// finish trả về 413 nếu handlers đọc body vượt giới hạn mà chưa ghi response.
+func (b *limitedBody) finish(ctx forkCtx.Context) {
+	if b != nil && b.exceeded && !ctx.Response().Written() {
+		writeBodyTooLarge(ctx, b.limit)
+	}
+}
+
+// writeBodyTooLarge ghi HttpError 413 với giới hạn kích thước body.
+func writeBodyTooLarge(ctx forkCtx.Context, limit int64) {
+	httpError := forkerrors.NewRequestEntityTooLarge("Request body too large", map[string]interface{}{
+		"limit": limit,
+	}, nil)
+	ctx.JSON(httpError.StatusCode, httpError)
+}
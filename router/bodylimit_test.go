@@ -0,0 +1,75 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+// chunkedReader ẩn độ dài body để request không có Content-Length.
+type chunkedReader struct{ io.Reader }
+
+func readBody(ctx context.Context) {
+	data, err := io.ReadAll(ctx.Request().Body())
+	if err != nil {
+		return
+	}
+	ctx.String(http.StatusOK, "read "+strconv.Itoa(len(data)))
+}
+
+func TestDefaultRouter_BodyLimit(t *testing.T) {
+	r := NewRouter()
+	api := r.Group("/api", WithBodyLimit(4))
+	api.Handle("POST", "/json", readBody)
+	api.Handle("POST", "/upload", readBody).BodyLimit(8)
+	api.Group("/nested").Handle("POST", "/json", readBody)
+	r.Handle("POST", "/free", readBody)
+
+	tests := []struct {
+		name    string
+		path    string
+		body    io.Reader
+		code    int
+		content string
+	}{
+		{"within group limit", "/api/json", strings.NewReader("1234"), http.StatusOK, "read 4"},
+		{"content length over group limit", "/api/json", strings.NewReader("12345"), http.StatusRequestEntityTooLarge, "Request body too large"},
+		{"chunked over group limit", "/api/json", chunkedReader{strings.NewReader("12345")}, http.StatusRequestEntityTooLarge, "Request body too large"},
+		{"route overrides group limit", "/api/upload", strings.NewReader("12345678"), http.StatusOK, "read 8"},
+		{"nested group inherits limit", "/api/nested/json", strings.NewReader("12345"), http.StatusRequestEntityTooLarge, "Request body too large"},
+		{"no limit outside group", "/free", strings.NewReader("123456789"), http.StatusOK, "read 9"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest("POST", tt.path, tt.body)
+		if _, ok := tt.body.(chunkedReader); ok {
+			req.ContentLength = -1
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != tt.code || !strings.Contains(w.Body.String(), tt.content) {
+			t.Errorf("%s: expected %d %q, got %d %q", tt.name, tt.code, tt.content, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestDefaultRouter_BodyLimitHandlerResponse(t *testing.T) {
+	r := NewRouter()
+	r.Handle("POST", "/bind", func(ctx context.Context) {
+		if _, err := io.ReadAll(ctx.Request().Body()); err != nil {
+			ctx.String(http.StatusBadRequest, "invalid body")
+		}
+	}).BodyLimit(2)
+
+	req := httptest.NewRequest("POST", "/bind", chunkedReader{strings.NewReader("123")})
+	req.ContentLength = -1
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected handler response to be kept, got %d %q", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,121 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRouteConflict được trả về khi route mới xung đột với route đã đăng ký.
+var ErrRouteConflict = errors.New("router: route conflict")
+
+// ConflictPolicy xác định cách router xử lý route xung đột khi đăng ký. Hai route xung đột
+// khi có cùng method và pattern chỉ khác nhau ở tên tham số, ví dụ "GET /users/:id" và
+// "GET /users/:name", hoặc trùng hoàn toàn method và path.
+type ConflictPolicy int
+
+const (
+	// ConflictIgnore giữ route đăng ký trước và bỏ qua route sau (mặc định)
+	ConflictIgnore ConflictPolicy = iota
+
+	// ConflictPanic panic ngay tại Handle với lỗi ErrRouteConflict
+	ConflictPanic
+
+	// ConflictError không đăng ký route sau và ghi nhận lỗi, đọc qua RouteBuilder.Err
+	// hoặc DefaultRouter.Err
+	ConflictError
+
+	// ConflictLog ghi warning qua logger của router (SetLogger) và giữ route đăng ký trước.
+	// Nếu router chưa có logger, xung đột được bỏ qua như ConflictIgnore
+	ConflictLog
+)
+
+// SetConflictPolicy thiết lập cách xử lý route xung đột (mặc định: ConflictIgnore).
+// Policy của router gốc áp dụng cho router và mọi group của nó; với host group,
+// policy của host group áp dụng cho routes bên trong host group.
+//
+// Parameters:
+//   - policy: Policy xử lý xung đột
+func (r *DefaultRouter) SetConflictPolicy(policy ConflictPolicy) {
+	r.conflictPolicy = policy
+}
+
+// Err trả về lỗi tổng hợp của các route bị từ chối khi đăng ký, do xung đột với
+// ConflictError hoặc do method không hợp lệ (ErrInvalidMethod), kể cả của các groups.
+//
+// Returns:
+//   - error: Lỗi tổng hợp, nil nếu không có xung đột
+func (r *DefaultRouter) Err() error {
+	errs := append([]error(nil), r.conflicts.load()...)
+	for _, group := range r.groups.load() {
+		if err := group.Err(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// namespace trả về router sở hữu không gian route chứa r: router gốc,
+// hoặc group ràng buộc (host, version) gần nhất nếu r nằm trong group đó.
+func (r *DefaultRouter) namespace() *DefaultRouter {
+	top := r
+	for !top.scoped() && top.parent != nil {
+		top = top.parent
+	}
+	return top
+}
+
+// checkConflict kiểm tra route mới và áp dụng ConflictPolicy.
+//
+// Parameters:
+//   - method: HTTP method của route mới
+//   - path: Đường dẫn tuyệt đối của route mới
+//
+// Returns:
+//   - error: Lỗi xung đột nếu route không được đăng ký (ConflictError), nil nếu tiếp tục đăng ký
+func (r *DefaultRouter) checkConflict(method, path string) error {
+	top := r.namespace()
+	existing := top.findConflict(method, path)
+	if existing == nil {
+		return nil
+	}
+
+	err := fmt.Errorf("%w: %s %s conflicts with %s %s", ErrRouteConflict, method, path, existing.Method, existing.Path)
+	switch top.conflictPolicy {
+	case ConflictPanic:
+		panic(err)
+	case ConflictError:
+		r.conflicts.add(err)
+		return err
+	case ConflictLog:
+		if logger := r.Logger(); logger != nil {
+			logger.Warning(err.Error(), "method", method, "path", path)
+		}
+	}
+	return nil
+}
+
+// findConflict tìm route đã đăng ký xung đột với method và path.
+func (r *DefaultRouter) findConflict(method, path string) *Route {
+	if r.enableTrie && r.trie != nil {
+		return r.trie.Conflict(method, path)
+	}
+
+	shape := routeShape(path)
+	for _, route := range r.allRoutes() {
+		if route.Method == method && routeShape(route.Path) == shape {
+			return &route
+		}
+	}
+	return nil
+}
+
+// routeShape trả về dạng chuẩn hóa của pattern, bỏ tên tham số và wildcard.
+func routeShape(path string) string {
+	var rt RouteTrie
+	shape := ""
+	for _, segment := range rt.splitPath(path) {
+		key, _ := rt.processSegment(segment)
+		shape += "/" + key
+	}
+	return shape
+}
@@ -0,0 +1,140 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+// warningLogger ghi lại các message warning
+type warningLogger struct {
+	warnings []string
+}
+
+func (l *warningLogger) Debug(string, ...interface{}) {}
+func (l *warningLogger) Info(string, ...interface{})  {}
+func (l *warningLogger) Error(string, ...interface{}) {}
+
+func (l *warningLogger) Warning(message string, _ ...interface{}) {
+	l.warnings = append(l.warnings, message)
+}
+
+func TestRouteTrie_Conflict(t *testing.T) {
+	trie := NewRouteTrie()
+	trie.Insert("GET", "/users/:id", func(ctx context.Context) {})
+	trie.Insert("GET", "/files/*filepath", func(ctx context.Context) {})
+
+	tests := []struct {
+		method   string
+		path     string
+		conflict string
+	}{
+		{"GET", "/users/:name", "/users/:id"},
+		{"GET", "/users/:id", "/users/:id"},
+		{"GET", "/files/*path", "/files/*filepath"},
+		{"POST", "/users/:name", ""},
+		{"GET", "/users/me", ""},
+		{"GET", "/users/:id<\\d+>", ""},
+		{"GET", "/users/:id/posts", ""},
+	}
+	for _, tt := range tests {
+		route := trie.Conflict(tt.method, tt.path)
+		got := ""
+		if route != nil {
+			got = route.Path
+		}
+		if got != tt.conflict {
+			t.Errorf("%s %s: expected conflict %q, got %q", tt.method, tt.path, tt.conflict, got)
+		}
+	}
+}
+
+func TestDefaultRouter_ConflictPolicies(t *testing.T) {
+	handler := func(body string) HandlerFunc {
+		return func(ctx context.Context) { ctx.String(http.StatusOK, body) }
+	}
+
+	t.Run("ignore", func(t *testing.T) {
+		r := NewRouter().(*DefaultRouter)
+		r.Handle("GET", "/users/:id", handler("id"))
+		if b := r.Handle("GET", "/users/:name", handler("name")); b.Err() != nil {
+			t.Errorf("Expected no error with ConflictIgnore, got %v", b.Err())
+		}
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/users/7", nil))
+		if w.Body.String() != "id" {
+			t.Errorf("Expected first route to win, got %q", w.Body.String())
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		r := NewRouter().(*DefaultRouter)
+		r.SetConflictPolicy(ConflictPanic)
+		r.Handle("GET", "/users/:id", handler("id"))
+
+		defer func() {
+			rec := recover()
+			err, ok := rec.(error)
+			if !ok || !errors.Is(err, ErrRouteConflict) {
+				t.Errorf("Expected ErrRouteConflict panic, got %v", rec)
+			}
+		}()
+		r.Group("/users").Handle("GET", "/:name", handler("name"))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r := NewRouter().(*DefaultRouter)
+		r.SetConflictPolicy(ConflictError)
+		r.Handle("GET", "/users/:id", handler("id"))
+		r.Handle("POST", "/users/:name", handler("post"))
+
+		b := r.Group("/users").Handle("GET", "/:name", handler("name"))
+		if !errors.Is(b.Err(), ErrRouteConflict) {
+			t.Fatalf("Expected ErrRouteConflict, got %v", b.Err())
+		}
+		if !strings.Contains(b.Err().Error(), "GET /users/:name conflicts with GET /users/:id") {
+			t.Errorf("Unexpected error message: %v", b.Err())
+		}
+		if !errors.Is(r.Err(), ErrRouteConflict) {
+			t.Errorf("Expected router Err to include the conflict, got %v", r.Err())
+		}
+		if len(r.Routes()) != 2 {
+			t.Errorf("Expected conflicting route to be rejected, got %d routes", len(r.Routes()))
+		}
+	})
+
+	t.Run("log", func(t *testing.T) {
+		logger := &warningLogger{}
+		r := NewRouter().(*DefaultRouter)
+		r.SetLogger(logger)
+		r.SetConflictPolicy(ConflictLog)
+		r.Handle("GET", "/users", handler("a"))
+		r.Handle("GET", "/users", handler("b"))
+
+		if len(logger.warnings) != 1 || !strings.Contains(logger.warnings[0], "route conflict: GET /users conflicts with GET /users") {
+			t.Errorf("Expected conflict to be logged through the router logger, got %q", logger.warnings)
+		}
+
+		// Không có logger thì xung đột được bỏ qua mà không panic
+		quiet := NewRouter().(*DefaultRouter)
+		quiet.SetConflictPolicy(ConflictLog)
+		quiet.Handle("GET", "/users", handler("a"))
+		if b := quiet.Handle("GET", "/users", handler("b")); b.Err() != nil {
+			t.Errorf("Expected no error without logger, got %v", b.Err())
+		}
+	})
+
+	t.Run("linear", func(t *testing.T) {
+		r := &DefaultRouter{}
+		r.SetConflictPolicy(ConflictError)
+		r.Handle("GET", "/users/:id", handler("id"))
+		if b := r.Handle("GET", "/users/:name", handler("name")); !errors.Is(b.Err(), ErrRouteConflict) {
+			t.Errorf("Expected conflict without trie, got %v", b.Err())
+		}
+	})
+}
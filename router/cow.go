@@ -0,0 +1,34 @@
+package router
+
+import "sync/atomic"
+
+// cowSlice là slice copy-on-write của bảng route. Requests đọc snapshot hiện tại bằng load
+// mà không cần khóa; thao tác đăng ký giữ khóa ghi của router gốc (writeLock) và công bố
+// slice mới bằng store. Phần tử của snapshot đã công bố không bao giờ bị sửa tại chỗ:
+// add chỉ ghi vào vùng sau độ dài của snapshot, các thao tác sửa/xóa tạo slice mới.
+type cowSlice[T any] struct {
+	p atomic.Pointer[[]T]
+}
+
+// load trả về snapshot hiện tại của slice.
+func (s *cowSlice[T]) load() []T {
+	if p := s.p.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// store công bố slice mới. Caller phải giữ khóa ghi và không sửa slice sau khi công bố.
+func (s *cowSlice[T]) store(items []T) {
+	s.p.Store(&items)
+}
+
+// add thêm phần tử vào cuối slice và công bố slice mới. Caller phải giữ khóa ghi.
+func (s *cowSlice[T]) add(items ...T) {
+	s.store(append(s.load(), items...))
+}
+
+// without trả về bản sao của slice đã bỏ phần tử ở vị trí i.
+func without[T any](items []T, i int) []T {
+	return append(items[:i:i], items[i+1:]...)
+}
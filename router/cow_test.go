@@ -0,0 +1,90 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+// TestDefaultRouter_ConcurrentRegistration đăng ký và gỡ routes trong khi router đang phục vụ
+// requests; chạy với -race để phát hiện truy cập không đồng bộ vào bảng route.
+func TestDefaultRouter_ConcurrentRegistration(t *testing.T) {
+	for _, enableTrie := range []bool{true, false} {
+		t.Run(fmt.Sprintf("trie=%v", enableTrie), func(t *testing.T) {
+			r := NewRouter().(*DefaultRouter)
+			r.enableTrie = enableTrie
+			r.Handle("GET", "/ping", func(ctx context.Context) { ctx.String(http.StatusOK, "pong") })
+
+			stop := make(chan struct{})
+			var wg sync.WaitGroup
+			for i := 0; i < 4; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for {
+						select {
+						case <-stop:
+							return
+						default:
+						}
+						for _, path := range []string{"/ping", "/plugin1/items", "/plugin2/missing", "/plugin3/items"} {
+							w := httptest.NewRecorder()
+							r.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+							if path == "/ping" && w.Body.String() != "pong" {
+								t.Errorf("Expected existing route to keep serving, got %d %q", w.Code, w.Body.String())
+							}
+						}
+						_ = r.Routes()
+					}
+				}()
+			}
+
+			for i := 0; i < 50; i++ {
+				plugin := r.Group(fmt.Sprintf("/plugin%d", i))
+				plugin.Use(func(ctx context.Context) { ctx.Next() })
+				plugin.NoRoute(func(ctx context.Context) { ctx.String(http.StatusNotFound, "plugin 404") })
+				plugin.Handle("GET", "/items", func(ctx context.Context) {
+					ctx.String(http.StatusOK, "items")
+				}).Use(func(ctx context.Context) { ctx.Next() }).Meta("plugin", i).Priority(1)
+				r.Host(fmt.Sprintf("plugin%d.example.com", i)).Handle("GET", "/items", func(ctx context.Context) {})
+				if i%5 == 4 {
+					r.RemoveGroup(fmt.Sprintf("/plugin%d", i))
+				}
+			}
+			close(stop)
+			wg.Wait()
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest("GET", "/plugin3/items", nil))
+			if w.Body.String() != "items" {
+				t.Errorf("Expected route registered at runtime, got %d %q", w.Code, w.Body.String())
+			}
+			w = httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest("GET", "/plugin4/items", nil))
+			if w.Code != http.StatusNotFound {
+				t.Errorf("Expected removed group to stop serving, got %d %q", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestCowSliceSnapshot(t *testing.T) {
+	var s cowSlice[int]
+	s.add(1, 2, 3)
+	snapshot := s.load()
+
+	s.add(4)
+	s.store(without(s.load(), 0))
+	s.add(5)
+
+	if len(snapshot) != 3 || snapshot[0] != 1 || snapshot[2] != 3 {
+		t.Errorf("Expected published snapshot to stay unchanged, got %v", snapshot)
+	}
+	if got := fmt.Sprint(s.load()); got != "[2 3 4 5]" {
+		t.Errorf("Expected [2 3 4 5], got %s", got)
+	}
+}
@@ -0,0 +1,17 @@
+package router
+
+// GroupOption tùy biến router group được tạo bởi Group.
+type GroupOption func(group *DefaultRouter)
+
+// WithoutParentMiddleware tạo group không kế thừa middlewares của router cha,
+// ví dụ group "/webhooks" công khai không đi qua middleware xác thực của ứng dụng.
+// Lưu ý: mọi middleware được thêm vào router cha trước khi tạo group đều bị bỏ qua,
+// kể cả middlewares do WebApp cài đặt (template engine, phân trang...).
+//
+// Returns:
+//   - GroupOption: Option cho Group
+func WithoutParentMiddleware() GroupOption {
+	return func(group *DefaultRouter) {
+		group.middlewares.store(nil)
+	}
+}
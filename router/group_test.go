@@ -0,0 +1,48 @@
+package router
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_GroupWithoutParentMiddleware(t *testing.T) {
+	var order []string
+	mw := func(name string) HandlerFunc {
+		return func(ctx context.Context) {
+			order = append(order, name)
+			ctx.Next()
+		}
+	}
+	handler := func(ctx context.Context) { order = append(order, "handler") }
+
+	r := NewRouter()
+	r.Use(mw("auth"))
+	api := r.Group("/api")
+	api.Use(mw("api"))
+
+	webhooks := api.Group("/webhooks", WithoutParentMiddleware(), nil)
+	webhooks.Use(mw("signature"))
+	webhooks.Handle("POST", "/stripe", handler)
+	webhooks.Group("/v2").Handle("POST", "/stripe", handler)
+	api.Handle("GET", "/users", handler)
+
+	tests := []struct {
+		method string
+		path   string
+		order  []string
+	}{
+		{"POST", "/api/webhooks/stripe", []string{"signature", "handler"}},
+		{"POST", "/api/webhooks/v2/stripe", []string{"signature", "handler"}},
+		{"GET", "/api/users", []string{"auth", "api", "handler"}},
+	}
+	for _, tt := range tests {
+		order = nil
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.path, nil))
+		if !reflect.DeepEqual(order, tt.order) {
+			t.Errorf("%s %s: expected %v, got %v", tt.method, tt.path, tt.order, order)
+		}
+	}
+}
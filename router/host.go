@@ -0,0 +1,261 @@
+package router
+
+import (
+	"net"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// hostPattern là mẫu host đã được phân tích thành các nhãn, ví dụ ":tenant.example.com".
+type hostPattern struct {
+	// raw là mẫu host gốc
+	raw string
+
+	// labels là các nhãn của mẫu, nhãn bắt đầu bằng ":" là tham số
+	labels []string
+}
+
+// parseHostPattern phân tích mẫu host. Port (nếu có) bị bỏ qua vì host của request
+// được so khớp không kèm port.
+//
+// Parameters:
+//   - pattern: Mẫu host (ví dụ: "api.example.com", ":tenant.example.com")
+//
+// Returns:
+//   - *hostPattern: Mẫu host đã phân tích
+func parseHostPattern(pattern string) *hostPattern {
+	host := strings.ToLower(pattern)
+	if i := strings.LastIndexByte(host, ':'); i > 0 && isPort(host[i+1:]) {
+		host = host[:i]
+	}
+	host = strings.TrimSuffix(host, ".")
+	return &hostPattern{raw: pattern, labels: strings.Split(host, ".")}
+}
+
+// match so khớp host của request với mẫu và trả về giá trị các tham số.
+//
+// Parameters:
+//   - host: Host của request, đã chuẩn hóa bởi requestHost
+//
+// Returns:
+//   - map[string]string: Giá trị tham số theo tên
+//   - bool: true nếu host khớp mẫu
+func (p *hostPattern) match(host string) (map[string]string, bool) {
+	labels := strings.Split(host, ".")
+	if len(labels) != len(p.labels) {
+		return nil, false
+	}
+
+	var params map[string]string
+	for i, label := range p.labels {
+		if name, ok := strings.CutPrefix(label, ":"); ok {
+			if labels[i] == "" {
+				return nil, false
+			}
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params[name] = labels[i]
+			continue
+		}
+		if label != labels[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// isPort kiểm tra chuỗi có phải là số port hay không.
+func isPort(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestHost trả về host của request ở dạng chữ thường, không kèm port.
+func requestHost(ctx forkCtx.Context) string {
+	host := strings.ToLower(ctx.Request().Request().Host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+// Host tạo một router group chỉ khớp request có host phù hợp với mẫu.
+// Nhãn bắt đầu bằng ":" là tham số, ví dụ ":tenant.example.com" khớp "acme.example.com"
+// và ctx.Param("tenant") trả về "acme". So khớp không phân biệt hoa thường và bỏ qua port.
+// Routes của host group được ưu tiên hơn routes không ràng buộc host có cùng path;
+// các host group được thử theo thứ tự đăng ký.
+//
+// Parameters:
+//   - pattern: Mẫu host (ví dụ: "api.example.com", ":tenant.example.com")
+//
+// Returns:
+//   - Router: Router group ràng buộc theo host
+func (r *DefaultRouter) Host(pattern string) Router {
+	return r.Group("", WithHost(pattern))
+}
+
+// WithHost ràng buộc group theo host, kết hợp với prefix của group: Group("/api",
+// WithHost("admin.example.com")) chỉ khớp "admin.example.com/api/...". Nhờ đó nhiều
+// tenant có thể dùng cùng prefix với các cây route khác nhau trong một WebApp.
+// Mẫu host có cú pháp như Host.
+//
+// Parameters:
+//   - pattern: Mẫu host (ví dụ: "admin.example.com", ":tenant.example.com")
+//
+// Returns:
+//   - GroupOption: Option cho Group
+func WithHost(pattern string) GroupOption {
+	return func(group *DefaultRouter) {
+		group.host = parseHostPattern(pattern)
+	}
+}
+
+// scoped báo cáo router có phải là group ràng buộc request ngoài path (host group
+// hoặc version group theo header) hay không. Routes của group ràng buộc không được
+// thêm vào trie của các router phía trên group.
+func (r *DefaultRouter) scoped() bool {
+	return r.host != nil || r.version != ""
+}
+
+// addScope đăng ký group ràng buộc vào router gốc. Group được thử trước group ràng buộc
+// gần nhất chứa nó, các group không lồng nhau được thử theo thứ tự đăng ký.
+// Caller phải giữ khóa ghi.
+//
+// Parameters:
+//   - group: Group ràng buộc mới, là group con của r
+func (r *DefaultRouter) addScope(group *DefaultRouter) {
+	root := r.root()
+	scopes := root.scopes.load()
+	for i, scope := range scopes {
+		if scope.contains(group) {
+			next := make([]*DefaultRouter, 0, len(scopes)+1)
+			next = append(next, scopes[:i]...)
+			next = append(next, group)
+			root.scopes.store(append(next, scopes[i:]...))
+			return
+		}
+	}
+	root.scopes.add(group)
+}
+
+// matchOwnScope kiểm tra request có thỏa ràng buộc của riêng group hay không.
+//
+// Parameters:
+//   - host: Host của request, đã chuẩn hóa bởi requestHost
+//   - version: Version của request theo header, đã chuẩn hóa bởi requestVersion
+//
+// Returns:
+//   - map[string]string: Giá trị tham số của host
+//   - bool: true nếu request thỏa ràng buộc
+func (r *DefaultRouter) matchOwnScope(host, version string) (map[string]string, bool) {
+	if r.version != "" && r.version != version {
+		return nil, false
+	}
+	if r.host == nil {
+		return nil, true
+	}
+	return r.host.match(host)
+}
+
+// matchScope kiểm tra request có thỏa ràng buộc của group và mọi group chứa nó.
+//
+// Parameters:
+//   - host: Host của request
+//   - version: Version của request theo header
+//
+// Returns:
+//   - map[string]string: Giá trị tham số của các host
+//   - bool: true nếu request thỏa mọi ràng buộc
+func (r *DefaultRouter) matchScope(host, version string) (map[string]string, bool) {
+	var params map[string]string
+	for _, g := range r.scopeChain {
+		hostParams, ok := g.matchOwnScope(host, version)
+		if !ok {
+			return nil, false
+		}
+		for k, v := range hostParams {
+			if params == nil {
+				params = make(map[string]string)
+			}
+			if _, exists := params[k]; !exists {
+				params[k] = v
+			}
+		}
+	}
+	return params, true
+}
+
+// findScopedRoute tìm route trong các group ràng buộc (host, version) khớp với request.
+//
+// Parameters:
+//   - method: HTTP method của request
+//   - host: Host của request
+//   - version: Version của request theo header
+//   - path: URL path của request
+//
+// Returns:
+//   - *Route: Route tìm thấy hoặc nil
+//   - map[string]string: Tham số của host và path
+func (r *DefaultRouter) findScopedRoute(method, host, version, path string) (*Route, map[string]string) {
+	for _, group := range r.scopes.load() {
+		hostParams, ok := group.matchScope(host, version)
+		if !ok {
+			continue
+		}
+		route, params := group.findRoute(method, path)
+		if route == nil {
+			continue
+		}
+		if len(hostParams) > 0 {
+			merged := make(map[string]string, len(hostParams)+len(params))
+			for k, v := range hostParams {
+				merged[k] = v
+			}
+			for k, v := range params {
+				merged[k] = v
+			}
+			params = merged
+		}
+		return route, params
+	}
+	return nil, nil
+}
+
+// removeScopes gỡ các group ràng buộc thuộc cây của group khỏi danh sách của router gốc.
+// Caller phải giữ khóa ghi.
+//
+// Parameters:
+//   - group: Group đang bị gỡ khỏi router
+func (r *DefaultRouter) removeScopes(group *DefaultRouter) {
+	scopes := r.scopes.load()
+	if len(scopes) == 0 {
+		return
+	}
+
+	var next []*DefaultRouter
+	for _, scope := range scopes {
+		if !group.contains(scope) {
+			next = append(next, scope)
+		}
+	}
+	r.scopes.store(next)
+}
+
+// contains kiểm tra target có phải là r hoặc một group con của r hay không.
+func (r *DefaultRouter) contains(target *DefaultRouter) bool {
+	for g := target; g != nil; g = g.parent {
+		if g == r {
+			return true
+		}
+	}
+	return false
+}
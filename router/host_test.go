@@ -0,0 +1,178 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func serveHost(r Router, method, host, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Host = host
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestHostPatternMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		host    string
+		match   bool
+		params  map[string]string
+	}{
+		{"api.example.com", "api.example.com", true, nil},
+		{"API.Example.com", "api.example.com", true, nil},
+		{"api.example.com:8080", "api.example.com", true, nil},
+		{"api.example.com", "www.example.com", false, nil},
+		{":tenant.example.com", "acme.example.com", true, map[string]string{"tenant": "acme"}},
+		{":tenant.example.com", "example.com", false, nil},
+		{":tenant.example.com", "a.b.example.com", false, nil},
+		{":tenant.:region.example.com", "acme.eu.example.com", true, map[string]string{"tenant": "acme", "region": "eu"}},
+	}
+
+	for _, tt := range tests {
+		params, ok := parseHostPattern(tt.pattern).match(tt.host)
+		if ok != tt.match {
+			t.Errorf("%s ~ %s: expected match %v, got %v", tt.pattern, tt.host, tt.match, ok)
+			continue
+		}
+		if len(params) != len(tt.params) {
+			t.Errorf("%s ~ %s: expected params %v, got %v", tt.pattern, tt.host, tt.params, params)
+		}
+		for k, v := range tt.params {
+			if params[k] != v {
+				t.Errorf("%s ~ %s: expected %s=%s, got %s", tt.pattern, tt.host, k, v, params[k])
+			}
+		}
+	}
+}
+
+func TestDefaultRouter_Host(t *testing.T) {
+	r := NewRouter()
+	r.Handle("GET", "/", func(ctx context.Context) { ctx.String(http.StatusOK, "main") })
+	r.Handle("GET", "/status", func(ctx context.Context) { ctx.String(http.StatusOK, "status") })
+
+	api := r.Host("api.example.com")
+	api.Handle("GET", "/", func(ctx context.Context) { ctx.String(http.StatusOK, "api") })
+	api.Group("/v1").Handle("GET", "/users/:id", func(ctx context.Context) {
+		ctx.String(http.StatusOK, "api user "+ctx.Param("id"))
+	})
+
+	tenant := r.Host(":tenant.example.com")
+	tenant.Handle("GET", "/", func(ctx context.Context) {
+		ctx.String(http.StatusOK, "tenant "+ctx.Param("tenant"))
+	})
+
+	tests := []struct {
+		host string
+		path string
+		code int
+		body string
+	}{
+		{"example.com", "/", http.StatusOK, "main"},
+		{"api.example.com", "/", http.StatusOK, "api"},
+		{"API.example.com:8443", "/", http.StatusOK, "api"},
+		{"api.example.com", "/v1/users/7", http.StatusOK, "api user 7"},
+		{"example.com", "/v1/users/7", http.StatusNotFound, "404 page not found"},
+		{"acme.example.com", "/", http.StatusOK, "tenant acme"},
+		{"acme.example.com", "/status", http.StatusOK, "status"},
+		{"acme.other.com", "/", http.StatusOK, "main"},
+	}
+	for _, tt := range tests {
+		w := serveHost(r, "GET", tt.host, tt.path)
+		if w.Code != tt.code || w.Body.String() != tt.body {
+			t.Errorf("%s%s: expected %d %q, got %d %q", tt.host, tt.path, tt.code, tt.body, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestDefaultRouter_HostMiddlewareAndNoRoute(t *testing.T) {
+	r := NewRouter()
+	r.NoRoute(func(ctx context.Context) { ctx.String(http.StatusNotFound, "main 404") })
+
+	api := r.Host("api.example.com")
+	api.Use(func(ctx context.Context) {
+		ctx.Header("X-API", "1")
+		ctx.Next()
+	})
+	api.Handle("GET", "/ping", func(ctx context.Context) { ctx.String(http.StatusOK, "pong") })
+	api.NoRoute(func(ctx context.Context) { ctx.String(http.StatusNotFound, "api 404") })
+
+	w := serveHost(r, "GET", "api.example.com", "/ping")
+	if w.Code != http.StatusOK || w.Header().Get("X-API") != "1" {
+		t.Errorf("Expected host group middleware to run, got %d X-API=%q", w.Code, w.Header().Get("X-API"))
+	}
+	if w := serveHost(r, "GET", "api.example.com", "/missing"); w.Body.String() != "api 404" {
+		t.Errorf("Expected host NoRoute, got %q", w.Body.String())
+	}
+	if w := serveHost(r, "GET", "www.example.com", "/missing"); w.Body.String() != "main 404" {
+		t.Errorf("Expected root NoRoute for other hosts, got %q", w.Body.String())
+	}
+}
+
+func TestDefaultRouter_HostClearKeepsParentRoutes(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	r.Handle("GET", "/", func(ctx context.Context) { ctx.String(http.StatusOK, "main") })
+
+	admin := r.Group("/admin").(*DefaultRouter)
+	host := admin.Host("admin.example.com")
+	host.Handle("GET", "/", func(ctx context.Context) { ctx.String(http.StatusOK, "admin host") })
+
+	if w := serveHost(r, "GET", "admin.example.com", "/admin"); w.Body.String() != "admin host" {
+		t.Errorf("Expected host route under group prefix, got %q", w.Body.String())
+	}
+	if w := serveHost(r, "GET", "example.com", "/admin"); w.Code != http.StatusNotFound {
+		t.Errorf("Expected host route to stay out of parent tries, got %d", w.Code)
+	}
+
+	if !r.RemoveGroup("/admin") {
+		t.Fatal("Expected group to be removed")
+	}
+	if len(r.scopes.load()) != 0 {
+		t.Errorf("Expected host groups to be unregistered, got %d", len(r.scopes.load()))
+	}
+	if w := serveHost(r, "GET", "example.com", "/"); w.Body.String() != "main" {
+		t.Errorf("Expected root route to survive, got %q", w.Body.String())
+	}
+}
+
+func TestDefaultRouter_GroupWithHost(t *testing.T) {
+	r := NewRouter()
+	r.Handle("GET", "/api/users", func(ctx context.Context) { ctx.String(http.StatusOK, "public users") })
+
+	admin := r.Group("/api", WithHost("admin.example.com"))
+	admin.Handle("GET", "/users", func(ctx context.Context) { ctx.String(http.StatusOK, "admin users") })
+
+	app := r.Group("/api", WithHost("app.example.com"))
+	app.Handle("GET", "/users", func(ctx context.Context) { ctx.String(http.StatusOK, "app users") })
+	app.Group("/v1", WithHost(":tenant.example.com")).Handle("GET", "/me", func(ctx context.Context) {
+		ctx.String(http.StatusOK, "tenant "+ctx.Param("tenant"))
+	})
+
+	tests := []struct {
+		host string
+		path string
+		code int
+		body string
+	}{
+		{"admin.example.com", "/api/users", http.StatusOK, "admin users"},
+		{"app.example.com", "/api/users", http.StatusOK, "app users"},
+		{"www.example.com", "/api/users", http.StatusOK, "public users"},
+		{"admin.example.com", "/users", http.StatusNotFound, "404 page not found"},
+		{"app.example.com", "/api/v1/me", http.StatusOK, "tenant app"},
+		{"acme.example.com", "/api/v1/me", http.StatusNotFound, "404 page not found"},
+	}
+	for _, tt := range tests {
+		w := serveHost(r, "GET", tt.host, tt.path)
+		if w.Code != tt.code || w.Body.String() != tt.body {
+			t.Errorf("%s%s: expected %d %q, got %d %q", tt.host, tt.path, tt.code, tt.body, w.Code, w.Body.String())
+		}
+	}
+
+	if err := r.(*DefaultRouter).Err(); err != nil {
+		t.Errorf("Expected routes on different hosts not to conflict, got %v", err)
+	}
+}
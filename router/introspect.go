@@ -0,0 +1,45 @@
+package router
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// describe điền tên handlers và số middlewares của route từ chuỗi handlers hiện tại,
+// nên kết quả phản ánh cả middlewares được thêm bằng RouteBuilder.Use sau khi đăng ký.
+func (route *Route) describe() {
+	if route.chain == nil {
+		return
+	}
+
+	state := route.chain.load()
+	names := make([]string, len(state.handlers))
+	for i, handler := range state.handlers {
+		names[i] = handlerName(handler)
+	}
+	route.HandlerNames = names
+	if len(names) > 0 {
+		route.HandlerName = names[len(names)-1]
+	}
+	route.Middlewares = state.insertAt
+}
+
+// handlerName trả về tên đầy đủ của function handler, ví dụ "main.listUsers"
+// hoặc "main.main.func1" với closure.
+//
+// Parameters:
+//   - handler: Handler cần lấy tên
+//
+// Returns:
+//   - string: Tên function, rỗng nếu handler là nil
+func handlerName(handler HandlerFunc) string {
+	if handler == nil {
+		return ""
+	}
+	fn := runtime.FuncForPC(reflect.ValueOf(handler).Pointer())
+	if fn == nil {
+		return ""
+	}
+	return strings.TrimSuffix(fn.Name(), "-fm")
+}
@@ -0,0 +1,71 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func listUsers(ctx context.Context) { ctx.String(http.StatusOK, "users") }
+
+func auditMiddleware(ctx context.Context) { ctx.Next() }
+
+func TestDefaultRouter_RoutesIntrospection(t *testing.T) {
+	r := NewRouter()
+	r.Use(auditMiddleware)
+	api := r.Group("/api")
+	api.Use(func(ctx context.Context) { ctx.Next() })
+	api.Handle("GET", "/users", listUsers).Use(auditMiddleware).Meta("doc", "List users")
+	r.Handle("GET", "/health", func(ctx context.Context) {})
+
+	routes := r.Routes()
+	if len(routes) != 2 {
+		t.Fatalf("Expected 2 routes, got %d", len(routes))
+	}
+
+	byPath := make(map[string]Route)
+	for _, route := range routes {
+		byPath[route.Path] = route
+	}
+
+	users := byPath["/api/users"]
+	if users.Group != "/api" {
+		t.Errorf("Expected group /api, got %q", users.Group)
+	}
+	if !strings.HasSuffix(users.HandlerName, ".listUsers") {
+		t.Errorf("Expected handler name listUsers, got %q", users.HandlerName)
+	}
+	if users.Middlewares != 3 || len(users.HandlerNames) != 4 {
+		t.Errorf("Expected 3 middlewares in a chain of 4, got %d in %v", users.Middlewares, users.HandlerNames)
+	}
+	if !strings.HasSuffix(users.HandlerNames[0], ".auditMiddleware") {
+		t.Errorf("Expected chain to start with auditMiddleware, got %v", users.HandlerNames)
+	}
+	if users.Meta["doc"] != "List users" {
+		t.Errorf("Expected metadata, got %v", users.Meta)
+	}
+
+	health := byPath["/health"]
+	if health.Group != "" || health.Middlewares != 1 {
+		t.Errorf("Expected root route with 1 middleware, got group %q, %d", health.Group, health.Middlewares)
+	}
+	if !strings.Contains(health.HandlerName, "TestDefaultRouter_RoutesIntrospection.func") {
+		t.Errorf("Expected closure handler name, got %q", health.HandlerName)
+	}
+}
+
+func TestDefaultRouter_RoutesDoesNotAlias(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	for _, path := range []string{"/a", "/b", "/c"} {
+		r.Handle("GET", path, func(ctx context.Context) {})
+	}
+	r.Group("/g").Handle("GET", "/x", func(ctx context.Context) {})
+
+	routes := r.Routes()
+	r.Handle("GET", "/d", func(ctx context.Context) {})
+	if routes[3].Path != "/g/x" {
+		t.Errorf("Expected earlier Routes() result to be unchanged, got %q", routes[3].Path)
+	}
+}
@@ -0,0 +1,30 @@
+package router
+
+import (
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// SetLogger thiết lập logger của ứng dụng, được gắn vào context của mọi request mà router
+// phục vụ làm logger gốc cho ctx.Logger(). Chỉ có hiệu lực ở router gốc.
+// An toàn khi gọi đồng thời với các request đang được xử lý.
+//
+// Parameters:
+//   - logger: Logger của ứng dụng (thường là log.Manager), nil để context dùng slog.Default
+func (r *DefaultRouter) SetLogger(logger forkCtx.Logger) {
+	if logger == nil {
+		r.root().logger.Store(nil)
+		return
+	}
+	r.root().logger.Store(&logger)
+}
+
+// Logger trả về logger được thiết lập qua SetLogger.
+//
+// Returns:
+//   - forkCtx.Logger: Logger của router, nil nếu chưa thiết lập
+func (r *DefaultRouter) Logger() forkCtx.Logger {
+	if logger := r.root().logger.Load(); logger != nil {
+		return *logger
+	}
+	return nil
+}
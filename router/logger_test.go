@@ -0,0 +1,81 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+type countingLogger struct {
+	infos []interface{}
+}
+
+func (l *countingLogger) Debug(string, ...interface{})   {}
+func (l *countingLogger) Warning(string, ...interface{}) {}
+func (l *countingLogger) Error(string, ...interface{})   {}
+
+func (l *countingLogger) Info(_ string, args ...interface{}) {
+	l.infos = append(l.infos, args...)
+}
+
+func TestDefaultRouter_Logger(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	logger := &countingLogger{}
+	r.Group("/api").(*DefaultRouter).SetLogger(logger)
+	if r.Logger() != logger {
+		t.Fatal("Expected logger to be set on the root router")
+	}
+
+	r.Handle("GET", "/users/:id", func(c context.Context) {
+		c.Logger().Info("hit")
+		c.Status(http.StatusNoContent)
+	})
+	req := httptest.NewRequest("GET", "/users/7", nil)
+	req.Header.Set("X-Request-ID", "abc")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	expected := []interface{}{"request_id", "abc", "method", "GET", "path", "/users/7", "route", "/users/:id"}
+	if len(logger.infos) != len(expected) {
+		t.Fatalf("Expected fields %v, got %v", expected, logger.infos)
+	}
+	for i := range expected {
+		if logger.infos[i] != expected[i] {
+			t.Errorf("Field %d: expected %v, got %v", i, expected[i], logger.infos[i])
+		}
+	}
+}
+
+func TestDefaultRouter_RequestID(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	var seen string
+	r.Handle("GET", "/", func(c context.Context) {
+		seen = c.RequestID()
+	})
+	r.Handle("GET", "/quiet", func(c context.Context) {})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	if seen == "" || w.Header().Get("X-Request-ID") != seen {
+		t.Errorf("Expected generated request ID %q in response header, got %q", seen, w.Header().Get("X-Request-ID"))
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/quiet", nil))
+	if got := w.Header().Get("X-Request-ID"); got != "" {
+		t.Errorf("Expected no request ID header by default, got %q", got)
+	}
+
+	r.Group("/api").(*DefaultRouter).SetEagerRequestID(true)
+	if !r.EagerRequestID() {
+		t.Fatal("Expected SetEagerRequestID on a group to apply to the root router")
+	}
+	for _, path := range []string{"/quiet", "/missing"} {
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if w.Header().Get("X-Request-ID") == "" {
+			t.Errorf("Expected request ID header on %s when eager generation is enabled", path)
+		}
+	}
+}
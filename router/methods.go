@@ -0,0 +1,85 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidMethod được trả về khi route được đăng ký với HTTP method không hợp lệ
+// hoặc nằm ngoài tập method được cho phép.
+var ErrInvalidMethod = errors.New("router: invalid method")
+
+// Match đăng ký cùng chuỗi handlers cho nhiều HTTP method trên một path.
+//
+// Parameters:
+//   - methods: Danh sách HTTP method (ví dụ: []string{"GET", "POST"})
+//   - path: URL path pattern cho route
+//   - handlers: Danh sách các handlers xử lý request
+//
+// Returns:
+//   - []*RouteBuilder: Builder của từng route theo thứ tự methods
+func (r *DefaultRouter) Match(methods []string, path string, handlers ...HandlerFunc) []*RouteBuilder {
+	builders := make([]*RouteBuilder, 0, len(methods))
+	for _, method := range methods {
+		builders = append(builders, r.Handle(method, path, handlers...))
+	}
+	return builders
+}
+
+// SetAllowedMethods giới hạn các HTTP method được phép đăng ký route. Mặc định mọi method
+// là token HTTP hợp lệ đều được chấp nhận, kể cả method không chuẩn như PROPFIND hay REPORT.
+// Tập method của router gốc áp dụng cho router và mọi group của nó; với host group,
+// tập method của host group áp dụng cho routes bên trong host group.
+//
+// Parameters:
+//   - methods: Các method được phép, rỗng để chấp nhận mọi method hợp lệ
+func (r *DefaultRouter) SetAllowedMethods(methods ...string) {
+	if len(methods) == 0 {
+		r.allowedMethods = nil
+		return
+	}
+	r.allowedMethods = make(map[string]bool, len(methods))
+	for _, method := range methods {
+		r.allowedMethods[method] = true
+	}
+}
+
+// checkMethod kiểm tra method của route mới. Route có method không hợp lệ không được
+// đăng ký; lỗi được ghi nhận để đọc qua RouteBuilder.Err hoặc DefaultRouter.Err.
+//
+// Parameters:
+//   - method: HTTP method của route mới
+//   - path: Đường dẫn tuyệt đối của route mới
+//
+// Returns:
+//   - error: Lỗi nếu method không hợp lệ, nil nếu tiếp tục đăng ký
+func (r *DefaultRouter) checkMethod(method, path string) error {
+	var err error
+	if !validMethod(method) {
+		err = fmt.Errorf("%w: %s %s: method is not a valid HTTP token", ErrInvalidMethod, method, path)
+	} else if allowed := r.namespace().allowedMethods; allowed != nil && !allowed[method] {
+		err = fmt.Errorf("%w: %s %s: method is not allowed", ErrInvalidMethod, method, path)
+	}
+	if err != nil {
+		r.conflicts.add(err)
+	}
+	return err
+}
+
+// validMethod báo cáo method có phải là token HTTP hợp lệ (RFC 9110) hay không.
+func validMethod(method string) bool {
+	if method == "" {
+		return false
+	}
+	for i := 0; i < len(method); i++ {
+		c := method[i]
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			continue
+		}
+		if !strings.ContainsRune("!#$%&'*+-.^_`|~", rune(c)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_Match(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	var matched string
+	builders := r.Match([]string{"GET", "POST", "PROPFIND"}, "/dav/:name", func(ctx context.Context) {
+		matched = ctx.Method() + " " + ctx.Param("name")
+	})
+
+	if len(builders) != 3 {
+		t.Fatalf("Expected 3 builders, got %d", len(builders))
+	}
+	for i, method := range []string{"GET", "POST", "PROPFIND"} {
+		if builders[i].Method() != method || builders[i].Err() != nil {
+			t.Errorf("Builder %d: expected %s without error, got %s (%v)", i, method, builders[i].Method(), builders[i].Err())
+		}
+
+		matched = ""
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, "/dav/notes", nil))
+		if matched != method+" notes" {
+			t.Errorf("Expected %s route to match, got %q", method, matched)
+		}
+	}
+
+	if got := r.AllowedMethods("/dav/notes"); len(got) != 3 || got[2] != "PROPFIND" {
+		t.Errorf("Expected custom method in allowed methods, got %v", got)
+	}
+}
+
+func TestDefaultRouter_AllowedMethods(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	r.SetAllowedMethods("GET", "REPORT")
+	api := r.Group("/api")
+	handler := func(ctx context.Context) { ctx.Status(http.StatusOK) }
+
+	tests := []struct {
+		method string
+		valid  bool
+	}{
+		{"GET", true},
+		{"REPORT", true},
+		{"POST", false},
+		{"BAD METHOD", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		err := api.Handle(tt.method, "/reports", handler).Err()
+		if tt.valid && err != nil {
+			t.Errorf("%q: unexpected error %v", tt.method, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidMethod) {
+			t.Errorf("%q: expected ErrInvalidMethod, got %v", tt.method, err)
+		}
+	}
+
+	if got := len(r.Routes()); got != 2 {
+		t.Errorf("Expected only valid routes to be registered, got %d", got)
+	}
+	if err := r.Err(); !errors.Is(err, ErrInvalidMethod) {
+		t.Errorf("Expected router error to include invalid methods, got %v", err)
+	}
+
+	// Không giới hạn: mọi token hợp lệ được chấp nhận
+	r.SetAllowedMethods()
+	if err := r.Handle("PURGE", "/cache", handler).Err(); err != nil {
+		t.Errorf("Expected PURGE to be accepted, got %v", err)
+	}
+}
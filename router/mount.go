@@ -0,0 +1,95 @@
+package router
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// mountMethods là các HTTP method được chuyển tới handler đã mount.
+var mountMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+	http.MethodDelete, http.MethodOptions, http.MethodConnect, http.MethodTrace,
+}
+
+// Mount gắn một http.Handler (http.ServeMux, router của thư viện khác...) dưới prefix.
+// Mọi method tới prefix và các path con được chuyển tới handler sau khi chạy middlewares
+// của router; handler nhận request với prefix đã được cắt khỏi URL.Path (giống
+// http.StripPrefix), ví dụ "/legacy/users" với prefix "/legacy" thành "/users".
+//
+// Parameters:
+//   - prefix: Tiền tố đường dẫn (ví dụ: "/legacy")
+//   - h: Handler được gắn
+func (r *DefaultRouter) Mount(prefix string, h http.Handler) {
+	r.mount(prefix, func(ctx forkCtx.Context, req *http.Request) {
+		h.ServeHTTP(ctx.Response(), req)
+	})
+}
+
+// mount đăng ký serve cho mọi method tới prefix và các path con; serve nhận request
+// với prefix đã được cắt khỏi URL.Path.
+//
+// Parameters:
+//   - prefix: Tiền tố đường dẫn
+//   - serve: Hàm xử lý request đã cắt prefix
+func (r *DefaultRouter) mount(prefix string, serve func(ctx forkCtx.Context, req *http.Request)) {
+	absolutePrefix := strings.TrimSuffix(r.calculateAbsolutePath(prefix), "/")
+
+	handler := func(ctx forkCtx.Context) {
+		serve(ctx, stripPrefix(ctx.Request().Request(), absolutePrefix))
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	for _, method := range mountMethods {
+		if prefix != "" {
+			r.Handle(method, prefix, handler)
+		}
+		r.Handle(method, prefix+"/*mountpath", handler)
+	}
+}
+
+// MountRouter gắn một Router khác dưới prefix. Router được gắn xử lý request với prefix
+// đã được cắt khỏi path, bằng routes, middlewares, NoRoute và NoMethod của chính nó;
+// middlewares của router hiện tại chạy trước. Router được gắn tạo context riêng nên
+// giá trị đặt bằng ctx.Set ở middlewares phía trước không được chuyển sang.
+//
+// Parameters:
+//   - prefix: Tiền tố đường dẫn (ví dụ: "/admin")
+//   - sub: Router được gắn
+func (r *DefaultRouter) MountRouter(prefix string, sub Router) {
+	r.Mount(prefix, sub)
+}
+
+// stripPrefix tạo bản sao của request với prefix đã được cắt khỏi URL.Path và URL.RawPath.
+//
+// Parameters:
+//   - req: Request gốc
+//   - prefix: Tiền tố cần cắt, không có "/" ở cuối
+//
+// Returns:
+//   - *http.Request: Request mới với path tương đối so với prefix
+func stripPrefix(req *http.Request, prefix string) *http.Request {
+	if prefix == "" {
+		return req
+	}
+
+	r2 := new(http.Request)
+	*r2 = *req
+	r2.URL = new(url.URL)
+	*r2.URL = *req.URL
+	r2.URL.Path = ensureLeadingSlash(strings.TrimPrefix(req.URL.Path, prefix))
+	if req.URL.RawPath != "" {
+		r2.URL.RawPath = ensureLeadingSlash(strings.TrimPrefix(req.URL.RawPath, prefix))
+	}
+	return r2
+}
+
+// ensureLeadingSlash thêm "/" vào đầu path nếu chưa có.
+func ensureLeadingSlash(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		return "/" + path
+	}
+	return path
+}
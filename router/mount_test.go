@@ -0,0 +1,93 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_Mount(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, "mux %s %s?%s", req.Method, req.URL.Path, req.URL.RawQuery)
+	})
+
+	r := NewRouter()
+	r.Use(func(ctx context.Context) {
+		ctx.Header("X-Middleware", "on")
+		ctx.Next()
+	})
+	r.Handle("GET", "/users", func(ctx context.Context) { ctx.String(http.StatusOK, "users") })
+	r.Group("/api").Mount("/legacy", mux)
+
+	tests := []struct {
+		method string
+		path   string
+		code   int
+		body   string
+	}{
+		{"GET", "/api/legacy/users?page=2", http.StatusOK, "mux GET /users?page=2"},
+		{"POST", "/api/legacy/a/b", http.StatusOK, "mux POST /a/b?"},
+		{"DELETE", "/api/legacy", http.StatusOK, "mux DELETE /?"},
+		{"GET", "/api/legacy/", http.StatusOK, "mux GET /?"},
+		{"GET", "/api/legacyx", http.StatusNotFound, "404 page not found"},
+		{"GET", "/users", http.StatusOK, "users"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+		if w.Code != tt.code || w.Body.String() != tt.body {
+			t.Errorf("%s %s: expected %d %q, got %d %q", tt.method, tt.path, tt.code, tt.body, w.Code, w.Body.String())
+		}
+		if tt.code == http.StatusOK && w.Header().Get("X-Middleware") != "on" {
+			t.Errorf("%s %s: expected middleware to run", tt.method, tt.path)
+		}
+	}
+}
+
+func TestDefaultRouter_MountRouter(t *testing.T) {
+	admin := NewRouter()
+	admin.Use(func(ctx context.Context) {
+		ctx.Header("X-Admin", "1")
+		ctx.Next()
+	})
+	admin.Handle("GET", "/users/:id", func(ctx context.Context) {
+		ctx.String(http.StatusOK, "admin user "+ctx.Param("id")+" "+ctx.Path())
+	})
+	admin.NoRoute(func(ctx context.Context) { ctx.String(http.StatusNotFound, "admin 404") })
+
+	r := NewRouter()
+	r.MountRouter("/admin", admin)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/admin/users/7", nil))
+	if w.Code != http.StatusOK || w.Body.String() != "admin user 7 /users/7" {
+		t.Errorf("Expected mounted route, got %d %q", w.Code, w.Body.String())
+	}
+	if w.Header().Get("X-Admin") != "1" {
+		t.Error("Expected mounted router middleware to run")
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/admin/missing", nil))
+	if w.Code != http.StatusNotFound || w.Body.String() != "admin 404" {
+		t.Errorf("Expected mounted router NoRoute, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestStripPrefix(t *testing.T) {
+	req := httptest.NewRequest("GET", "/static/a%2Fb", nil)
+	stripped := stripPrefix(req, "/static")
+	if stripped.URL.Path != "/a/b" || stripped.URL.RawPath != "/a%2Fb" {
+		t.Errorf("Unexpected stripped URL: Path=%q RawPath=%q", stripped.URL.Path, stripped.URL.RawPath)
+	}
+	if req.URL.Path != "/static/a/b" {
+		t.Errorf("Expected original request to be unchanged, got %q", req.URL.Path)
+	}
+	if stripPrefix(req, "") != req {
+		t.Error("Expected empty prefix to return the original request")
+	}
+}
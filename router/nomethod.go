@@ -0,0 +1,63 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// SetMethodNotAllowed bật hoặc tắt phản hồi 405 Method Not Allowed (mặc định: tắt).
+// Khi bật, request tới path có routes với method khác nhận 405 kèm header Allow
+// thay vì 404.
+//
+// Parameters:
+//   - enabled: true để trả về 405 cho method không được hỗ trợ
+func (r *DefaultRouter) SetMethodNotAllowed(enabled bool) {
+	r.methodNotAllowed = enabled
+}
+
+// NoMethod thiết lập chuỗi handlers xử lý request có path khớp route nhưng method
+// không được hỗ trợ. Đăng ký NoMethod bật phản hồi 405 cho các path nằm dưới prefix
+// của router kể cả khi SetMethodNotAllowed chưa được gọi. Header Allow đã được thiết lập
+// trước khi handlers chạy; handlers chạy sau middlewares của router như NoRoute.
+// Nếu chuỗi handlers không ghi response, router trả về "405 method not allowed".
+//
+// Parameters:
+//   - handlers: Chuỗi handlers xử lý 405, rỗng để dùng phản hồi mặc định
+func (r *DefaultRouter) NoMethod(handlers ...HandlerFunc) {
+	defer r.writeLock()()
+	r.noMethod.store(handlers)
+}
+
+// serveMethodNotAllowed trả lời request có path khớp route với method khác.
+//
+// Parameters:
+//   - ctx: Context của HTTP request/response
+//   - path: Path dùng để so khớp route (xem matchPath)
+//
+// Returns:
+//   - bool: true nếu request đã được xử lý
+func (r *DefaultRouter) serveMethodNotAllowed(ctx forkCtx.Context, path string) bool {
+	owner := r.fallbackOwner(ctx.Path(), requestHost(ctx), r.requestVersion(ctx), func(g *DefaultRouter) []HandlerFunc { return g.noMethod.load() })
+	if !r.methodNotAllowed && owner == nil {
+		return false
+	}
+	methods := r.AllowedMethods(path)
+	if len(methods) == 0 {
+		return false
+	}
+
+	if !r.disableAutoOptions {
+		methods = sortedMethods(append(methods, http.MethodOptions))
+	}
+	ctx.Header("Allow", strings.Join(methods, ", "))
+
+	if owner != nil && runFallback(ctx, owner, owner.noMethod.load()) {
+		return true
+	}
+
+	ctx.Status(http.StatusMethodNotAllowed)
+	ctx.String(http.StatusMethodNotAllowed, "405 method not allowed")
+	return true
+}
@@ -0,0 +1,93 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_MethodNotAllowed(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	r.Handle("GET", "/users/:id", func(ctx context.Context) { ctx.String(http.StatusOK, "user") })
+	r.Handle("PUT", "/users/:id", func(ctx context.Context) { ctx.String(http.StatusOK, "updated") })
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	// Mặc định: method không được hỗ trợ nhận 404
+	if w := serve("DELETE", "/users/7"); w.Code != http.StatusNotFound {
+		t.Fatalf("Expected 404 by default, got %d", w.Code)
+	}
+
+	r.SetMethodNotAllowed(true)
+	w := serve("DELETE", "/users/7")
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405, got %d", w.Code)
+	}
+	if w.Body.String() != "405 method not allowed" {
+		t.Errorf("Expected default 405 body, got %q", w.Body.String())
+	}
+	if got := w.Header().Get("Allow"); got != "GET, OPTIONS, PUT" {
+		t.Errorf("Expected Allow %q, got %q", "GET, OPTIONS, PUT", got)
+	}
+
+	if w := serve("DELETE", "/missing"); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown path, got %d", w.Code)
+	}
+	if w := serve("OPTIONS", "/users/7"); w.Code != http.StatusNoContent {
+		t.Errorf("Expected auto OPTIONS to take precedence, got %d", w.Code)
+	}
+
+	r.SetAutoOptions(false)
+	if got := serve("DELETE", "/users/7").Header().Get("Allow"); got != "GET, PUT" {
+		t.Errorf("Expected Allow without OPTIONS, got %q", got)
+	}
+}
+
+func TestDefaultRouter_NoMethod(t *testing.T) {
+	r := NewRouter()
+	r.Use(func(ctx context.Context) {
+		ctx.Header("X-Request-ID", "abc")
+		ctx.Next()
+	})
+	r.Handle("GET", "/items", func(ctx context.Context) {})
+
+	api := r.Group("/api")
+	api.Handle("GET", "/items", func(ctx context.Context) {})
+	api.NoMethod(func(ctx context.Context) {
+		ctx.JSON(http.StatusMethodNotAllowed, map[string]string{"allow": ctx.Response().Header().Get("Allow")})
+	})
+
+	// NoMethod của group bật 405 cho path dưới prefix của group
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/api/items", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405, got %d", w.Code)
+	}
+	if got := w.Header().Get("X-Request-ID"); got != "abc" {
+		t.Errorf("Expected middleware header, got %q", got)
+	}
+	if want := `{"allow":"GET, OPTIONS"}`; w.Body.String() != want && w.Body.String() != want+"\n" {
+		t.Errorf("Unexpected body %q", w.Body.String())
+	}
+
+	// Path ngoài group giữ hành vi mặc định
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/items", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 outside the group, got %d", w.Code)
+	}
+
+	// Handler không ghi response: dùng phản hồi 405 mặc định
+	r.NoMethod(func(ctx context.Context) {})
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/items", nil))
+	if w.Code != http.StatusMethodNotAllowed || w.Body.String() != "405 method not allowed" {
+		t.Errorf("Expected default 405, got %d %q", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,106 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// NoRoute thiết lập chuỗi handlers xử lý request không khớp route nào.
+// Handlers chạy sau middlewares của router (kể cả middlewares được thêm sau lời gọi này)
+// và có thể dùng ctx.Next() như handlers của route thông thường.
+// Với group, handlers chỉ áp dụng cho path nằm dưới prefix của group; group sâu nhất
+// có NoRoute được ưu tiên. Nếu chuỗi handlers không ghi response, router trả về
+// "404 page not found" như mặc định.
+//
+// Parameters:
+//   - handlers: Chuỗi handlers xử lý 404, rỗng để dùng phản hồi mặc định
+func (r *DefaultRouter) NoRoute(handlers ...HandlerFunc) {
+	defer r.writeLock()()
+	r.noRoute.store(handlers)
+}
+
+// serveNotFound trả lời request không khớp route bằng handlers của NoRoute
+// hoặc phản hồi 404 mặc định.
+//
+// Parameters:
+//   - ctx: Context của HTTP request/response
+func (r *DefaultRouter) serveNotFound(ctx forkCtx.Context) {
+	owner := r.fallbackOwner(ctx.Path(), requestHost(ctx), r.requestVersion(ctx), func(g *DefaultRouter) []HandlerFunc { return g.noRoute.load() })
+	if owner != nil && runFallback(ctx, owner, owner.noRoute.load()) {
+		return
+	}
+
+	ctx.Status(http.StatusNotFound)
+	ctx.String(http.StatusNotFound, "404 page not found")
+}
+
+// fallbackOwner tìm router sâu nhất có handlers dự phòng (NoRoute, NoMethod)
+// với prefix chứa path; group ràng buộc chỉ được xét khi host, version của request khớp.
+//
+// Parameters:
+//   - path: URL path của request
+//   - host: Host của request
+//   - version: Version của request theo header
+//   - handlers: Hàm lấy handlers dự phòng của một router
+//
+// Returns:
+//   - *DefaultRouter: Router sở hữu handlers, nil nếu không có
+func (r *DefaultRouter) fallbackOwner(path, host, version string, handlers func(*DefaultRouter) []HandlerFunc) *DefaultRouter {
+	var owner *DefaultRouter
+	if len(handlers(r)) > 0 {
+		owner = r
+	}
+
+	for _, group := range r.groups.load() {
+		if !hasPathPrefix(path, group.basePath) {
+			continue
+		}
+		if _, ok := group.matchOwnScope(host, version); !ok {
+			continue
+		}
+		if found := group.fallbackOwner(path, host, version, handlers); found != nil {
+			return found
+		}
+	}
+	return owner
+}
+
+// runFallback chạy handlers dự phòng sau middlewares của owner.
+//
+// Parameters:
+//   - ctx: Context của HTTP request/response
+//   - owner: Router sở hữu handlers
+//   - handlers: Handlers dự phòng
+//
+// Returns:
+//   - bool: true nếu chuỗi handlers đã ghi response
+func runFallback(ctx forkCtx.Context, owner *DefaultRouter, handlers []HandlerFunc) bool {
+	chain := owner.combineHandlers(handlers)
+	contextHandlers := make([]func(forkCtx.Context), len(chain))
+	for i, h := range chain {
+		contextHandlers[i] = h
+	}
+	ctx.SetHandlers(contextHandlers)
+	ctx.Next()
+
+	return ctx.Response().Written()
+}
+
+// hasPathPrefix kiểm tra prefix có khớp trọn các segment đầu của path hay không.
+//
+// Parameters:
+//   - path: URL path của request
+//   - prefix: Tiền tố path của group
+//
+// Returns:
+//   - bool: true nếu path bằng prefix hoặc nằm dưới prefix
+func hasPathPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+	rest, ok := strings.CutPrefix(path, prefix)
+	return ok && (rest == "" || rest[0] == '/')
+}
@@ -0,0 +1,108 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_NoRouteDefault(t *testing.T) {
+	r := NewRouter()
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/missing", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+	if w.Body.String() != "404 page not found" {
+		t.Errorf("Expected default body, got %q", w.Body.String())
+	}
+}
+
+func TestDefaultRouter_NoRouteRunsMiddleware(t *testing.T) {
+	r := NewRouter()
+	r.NoRoute(func(ctx context.Context) {
+		ctx.JSON(http.StatusNotFound, map[string]string{"error": "not found", "path": ctx.Path()})
+	})
+	// Middleware thêm sau NoRoute vẫn được áp dụng
+	r.Use(func(ctx context.Context) {
+		ctx.Header("X-Request-ID", "abc")
+		ctx.Next()
+	})
+	r.Handle("GET", "/users", func(ctx context.Context) { ctx.String(http.StatusOK, "users") })
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/missing", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+	if got := w.Header().Get("X-Request-ID"); got != "abc" {
+		t.Errorf("Expected middleware header, got %q", got)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/json" && got != "application/json; charset=utf-8" {
+		t.Errorf("Expected JSON response, got Content-Type %q", got)
+	}
+	if want := `{"error":"not found","path":"/missing"}`; w.Body.String() != want && w.Body.String() != want+"\n" {
+		t.Errorf("Unexpected body %q", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/users", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected matched route to be unaffected, got %d", w.Code)
+	}
+}
+
+func TestDefaultRouter_NoRouteFallsBackWhenNothingWritten(t *testing.T) {
+	r := NewRouter()
+	called := false
+	r.NoRoute(func(ctx context.Context) {
+		called = true
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/missing", nil))
+	if !called {
+		t.Error("Expected NoRoute handler to be called")
+	}
+	if w.Code != http.StatusNotFound || w.Body.String() != "404 page not found" {
+		t.Errorf("Expected default 404, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestDefaultRouter_NoRouteGroups(t *testing.T) {
+	r := NewRouter()
+	r.NoRoute(func(ctx context.Context) { ctx.String(http.StatusNotFound, "root") })
+
+	api := r.Group("/api")
+	api.Use(func(ctx context.Context) {
+		ctx.Header("X-API", "1")
+		ctx.Next()
+	})
+	api.NoRoute(func(ctx context.Context) { ctx.String(http.StatusNotFound, "api") })
+	api.Group("/v1") // group không có NoRoute dùng handlers của group cha
+
+	tests := []struct {
+		path string
+		body string
+		api  bool
+	}{
+		{"/missing", "root", false},
+		{"/apis", "root", false},
+		{"/api", "api", true},
+		{"/api/missing", "api", true},
+		{"/api/v1/missing", "api", true},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", tt.path, nil))
+		if w.Code != http.StatusNotFound || w.Body.String() != tt.body {
+			t.Errorf("%s: expected 404 %q, got %d %q", tt.path, tt.body, w.Code, w.Body.String())
+		}
+		if got := w.Header().Get("X-API") == "1"; got != tt.api {
+			t.Errorf("%s: expected group middleware applied=%v, got %v", tt.path, tt.api, got)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package router
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// OptionsHandler xử lý request OPTIONS tự động cho path có routes đã đăng ký.
+// Header Allow đã được thiết lập trước khi handler được gọi; nếu handler không ghi response,
+// router trả về 204 No Content.
+type OptionsHandler func(ctx forkCtx.Context, allowed []string)
+
+// SetAutoOptions bật hoặc tắt phản hồi OPTIONS tự động (mặc định: bật).
+// Khi bật, request OPTIONS tới path không có route OPTIONS riêng nhưng có routes với
+// method khác nhận 204 No Content với header Allow liệt kê các method được hỗ trợ.
+//
+// Parameters:
+//   - enabled: false để trả về 404 như các request không khớp route khác
+func (r *DefaultRouter) SetAutoOptions(enabled bool) {
+	r.disableAutoOptions = !enabled
+}
+
+// OnOptions thiết lập handler tùy biến phản hồi OPTIONS tự động, ví dụ để trả lời
+// CORS preflight. Middleware của router không chạy cho phản hồi tự động vì request
+// không khớp route nào, nên CORS headers cần được thiết lập trong handler này.
+//
+// Parameters:
+//   - handler: Handler nhận context và danh sách method được hỗ trợ, nil để dùng mặc định
+func (r *DefaultRouter) OnOptions(handler OptionsHandler) {
+	r.optionsHandler = handler
+}
+
+// AllowedMethods trả về các HTTP method có route khớp với path, kể cả routes của groups.
+//
+// Parameters:
+//   - path: URL path của request
+//
+// Returns:
+//   - []string: Danh sách method đã sắp xếp, rỗng nếu path không khớp route nào
+func (r *DefaultRouter) AllowedMethods(path string) []string {
+	if r.enableTrie && r.trie != nil {
+		return r.trie.Methods(path)
+	}
+
+	seen := make(map[string]bool)
+	var methods []string
+	for _, route := range r.allRoutes() {
+		if !seen[route.Method] && r.pathMatch(route.Path, path) {
+			seen[route.Method] = true
+			methods = append(methods, route.Method)
+		}
+	}
+	return sortedMethods(methods)
+}
+
+// serveAutoOptions trả lời request OPTIONS không có route riêng.
+//
+// Parameters:
+//   - ctx: Context của request OPTIONS
+//   - path: Path dùng để so khớp route (xem matchPath)
+//
+// Returns:
+//   - bool: true nếu request đã được xử lý
+func (r *DefaultRouter) serveAutoOptions(ctx forkCtx.Context, path string) bool {
+	if r.disableAutoOptions {
+		return false
+	}
+	methods := r.AllowedMethods(path)
+	if len(methods) == 0 {
+		return false
+	}
+
+	allowed := sortedMethods(append(methods, http.MethodOptions))
+	ctx.Header("Allow", strings.Join(allowed, ", "))
+	if r.optionsHandler != nil {
+		r.optionsHandler(ctx, allowed)
+	}
+	if !ctx.Response().Written() {
+		ctx.Status(http.StatusNoContent)
+	}
+	return true
+}
+
+// sortedMethods sắp xếp và loại bỏ method trùng lặp.
+func sortedMethods(methods []string) []string {
+	sort.Strings(methods)
+	unique := methods[:0]
+	for i, method := range methods {
+		if i == 0 || method != methods[i-1] {
+			unique = append(unique, method)
+		}
+	}
+	return unique
+}
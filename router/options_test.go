@@ -0,0 +1,95 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_AutoOptions(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	handler := func(ctx context.Context) { ctx.String(http.StatusOK, "ok") }
+	r.Handle("GET", "/users/:id", handler)
+	r.Handle("DELETE", "/users/:id", handler)
+	r.Group("/api").Handle("POST", "/users/:id", handler)
+	r.Handle("OPTIONS", "/custom", func(ctx context.Context) { ctx.String(http.StatusOK, "custom") })
+	r.Handle("GET", "/custom", handler)
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	w := serve("OPTIONS", "/users/7")
+	if w.Code != http.StatusNoContent {
+		t.Errorf("Expected 204, got %d", w.Code)
+	}
+	if got := w.Header().Get("Allow"); got != "DELETE, GET, OPTIONS" {
+		t.Errorf("Expected Allow 'DELETE, GET, OPTIONS', got %q", got)
+	}
+	if got := serve("OPTIONS", "/api/users/7").Header().Get("Allow"); got != "OPTIONS, POST" {
+		t.Errorf("Expected Allow for group route, got %q", got)
+	}
+
+	// Route OPTIONS riêng được ưu tiên
+	if w := serve("OPTIONS", "/custom"); w.Body.String() != "custom" {
+		t.Errorf("Expected explicit OPTIONS route, got %q", w.Body.String())
+	}
+
+	// Path không có route vẫn trả về 404
+	if w := serve("OPTIONS", "/missing"); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for unknown path, got %d", w.Code)
+	}
+
+	if got := r.AllowedMethods("/users/7"); !reflect.DeepEqual(got, []string{"DELETE", "GET"}) {
+		t.Errorf("Expected AllowedMethods [DELETE GET], got %v", got)
+	}
+
+	r.SetAutoOptions(false)
+	if w := serve("OPTIONS", "/users/7"); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 when auto OPTIONS is disabled, got %d", w.Code)
+	}
+}
+
+func TestDefaultRouter_OnOptions(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	r.Handle("PUT", "/items/:id", func(ctx context.Context) {})
+
+	var allowed []string
+	r.OnOptions(func(ctx context.Context, methods []string) {
+		allowed = methods
+		if ctx.GetHeader("Access-Control-Request-Method") == "" {
+			return
+		}
+		ctx.Header("Access-Control-Allow-Origin", ctx.GetHeader("Origin"))
+		ctx.Header("Access-Control-Allow-Methods", ctx.Response().Header().Get("Allow"))
+		ctx.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("OPTIONS", "/items/1", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", "PUT")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected hook status 200, got %d", w.Code)
+	}
+	if got := w.Header().Get("Access-Control-Allow-Methods"); got != "OPTIONS, PUT" {
+		t.Errorf("Expected CORS methods from Allow, got %q", got)
+	}
+	if !reflect.DeepEqual(allowed, []string{"OPTIONS", "PUT"}) {
+		t.Errorf("Expected hook to receive [OPTIONS PUT], got %v", allowed)
+	}
+
+	// Hook không ghi response: router trả về 204
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("OPTIONS", "/items/1", nil))
+	if w.Code != http.StatusNoContent {
+		t.Errorf("Expected default 204 when hook writes nothing, got %d", w.Code)
+	}
+}
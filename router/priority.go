@@ -0,0 +1,63 @@
+package router
+
+// RoutePrecedence xác định route nào được chọn khi nhiều route cùng khớp một request
+// và có cùng Priority.
+type RoutePrecedence int
+
+const (
+	// PrecedenceSpecificity chọn route cụ thể hơn tại mỗi segment: segment tĩnh,
+	// tham số có regex constraint, tham số thường, tham số optional rồi tới wildcard (mặc định)
+	PrecedenceSpecificity RoutePrecedence = iota
+
+	// PrecedenceRegistration chọn route được đăng ký trước
+	PrecedenceRegistration
+)
+
+// SetRoutePrecedence thiết lập thứ tự chọn route khi nhiều route cùng khớp
+// (mặc định: PrecedenceSpecificity). Precedence áp dụng cho router, các groups hiện có
+// và các groups được tạo sau đó. Route có Priority cao hơn luôn được chọn trước,
+// precedence chỉ phân định các route có cùng Priority.
+//
+// Parameters:
+//   - precedence: Thứ tự chọn route
+func (r *DefaultRouter) SetRoutePrecedence(precedence RoutePrecedence) {
+	r.precedence = precedence
+	if r.trie != nil {
+		r.trie.setPrecedence(precedence)
+	}
+	for _, group := range r.groups.load() {
+		group.SetRoutePrecedence(precedence)
+	}
+}
+
+// Priority đặt độ ưu tiên cho route: khi nhiều route cùng khớp một request, route có
+// Priority cao hơn được chọn bất kể precedence của router. Mặc định là 0; giá trị âm
+// đặt route sau các route mặc định. Priority chỉ có hiệu lực khi trie được bật (mặc định).
+//
+// Parameters:
+//   - priority: Độ ưu tiên của route
+//
+// Returns:
+//   - *RouteBuilder: Chính builder để gọi nối tiếp
+func (b *RouteBuilder) Priority(priority int) *RouteBuilder {
+	if b.router != nil {
+		defer b.writeLock()()
+		b.router.setRoutePriority(b.method, b.path, priority)
+	}
+	return b
+}
+
+// setRoutePriority cập nhật Priority của route trong router và trie của các router cha.
+// Caller phải giữ khóa ghi.
+func (r *DefaultRouter) setRoutePriority(method, path string, priority int) {
+	r.updateRoute(method, path, func(route *Route) { route.Priority = priority })
+
+	for owner := r; owner != nil; owner = owner.parent {
+		if owner.enableTrie && owner.trie != nil {
+			owner.trie.setPriority(method, path, priority)
+		}
+		if owner.scoped() {
+			break
+		}
+	}
+}
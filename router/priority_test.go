@@ -0,0 +1,105 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_RoutePriority(t *testing.T) {
+	router := NewRouter().(*DefaultRouter)
+	var matched string
+	handler := func(name string) HandlerFunc {
+		return func(ctx context.Context) { matched = name }
+	}
+
+	files := router.Handle("GET", "/files/*filepath", handler("files")).Priority(10)
+	router.Handle("GET", "/files/:name", handler("file"))
+	api := router.Group("/api")
+	api.Handle("GET", "/users/me", handler("me"))
+	api.Handle("GET", "/users/:id", handler("user")).Priority(5)
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/files/readme.md", "files"},
+		{"/api/users/me", "user"},
+		{"/api/users/7", "user"},
+	}
+	for _, tt := range tests {
+		matched = ""
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", tt.path, nil))
+		if matched != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.path, tt.expected, matched)
+		}
+	}
+
+	// Routes() phản ánh Priority của route
+	priorities := make(map[string]int)
+	for _, route := range router.Routes() {
+		priorities[route.Path] = route.Priority
+	}
+	if priorities["/files/*filepath"] != 10 || priorities["/api/users/:id"] != 5 || priorities["/files/:name"] != 0 {
+		t.Errorf("Unexpected route priorities: %v", priorities)
+	}
+
+	// Bỏ Priority trả lại thứ tự mặc định
+	files.Priority(0)
+	matched = ""
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/files/readme.md", nil))
+	if matched != "file" {
+		t.Errorf("Expected param route after resetting priority, got %q", matched)
+	}
+	if router.trie.prioritized != 1 {
+		t.Errorf("Expected 1 prioritized route, got %d", router.trie.prioritized)
+	}
+}
+
+func TestDefaultRouter_RoutePrecedence(t *testing.T) {
+	router := NewRouter().(*DefaultRouter)
+	router.SetRoutePrecedence(PrecedenceRegistration)
+	var matched string
+	handler := func(name string) HandlerFunc {
+		return func(ctx context.Context) { matched = name }
+	}
+
+	router.Handle("GET", "/users/:id", handler("user"))
+	router.Handle("GET", "/users/me", handler("me"))
+	api := router.Group("/api")
+	api.Handle("GET", "/*path", handler("catch-all"))
+	api.Handle("GET", "/health", handler("health"))
+	api.Handle("GET", "/status", handler("status")).Priority(1)
+
+	tests := []struct {
+		path     string
+		expected string
+		code     int
+	}{
+		{"/users/me", "user", http.StatusOK},
+		{"/api/health", "catch-all", http.StatusOK},
+		{"/api/status", "status", http.StatusOK},
+	}
+	for _, tt := range tests {
+		matched = ""
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", tt.path, nil))
+		if matched != tt.expected || w.Code != tt.code {
+			t.Errorf("%s: expected %q (%d), got %q (%d)", tt.path, tt.expected, tt.code, matched, w.Code)
+		}
+	}
+
+	// Group lookup dùng cùng precedence với router cha
+	if api.(*DefaultRouter).trie.precedence != PrecedenceRegistration {
+		t.Error("Expected group to inherit route precedence")
+	}
+
+	router.SetRoutePrecedence(PrecedenceSpecificity)
+	matched = ""
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/users/me", nil))
+	if matched != "me" {
+		t.Errorf("Expected static route with specificity precedence, got %q", matched)
+	}
+}
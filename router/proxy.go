@@ -0,0 +1,172 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+)
+
+// ProxyOption tùy biến route reverse proxy được tạo bởi Proxy.
+type ProxyOption func(config *proxyConfig)
+
+// proxyConfig là cấu hình của route reverse proxy.
+type proxyConfig struct {
+	// rewrite đổi path đã cắt prefix trước khi ghép với path của target
+	rewrite func(path string) string
+
+	// headers là các header được thiết lập trên request gửi tới target
+	headers map[string]string
+
+	// preserveHost giữ nguyên header Host của request gốc
+	preserveHost bool
+
+	// transport là RoundTripper gửi request tới target, nil để dùng http.DefaultTransport
+	transport http.RoundTripper
+
+	// flushInterval là chu kỳ flush response body, giá trị âm để flush sau mỗi lần ghi
+	flushInterval time.Duration
+}
+
+// WithProxyRewrite đổi path của request trước khi gửi tới target. Hàm nhận path đã
+// cắt prefix (ví dụ "/users/1" với prefix "/api") và trả về path mới; kết quả được
+// ghép với path của target.
+//
+// Parameters:
+//   - rewrite: Hàm đổi path
+//
+// Returns:
+//   - ProxyOption: Option cho Proxy
+func WithProxyRewrite(rewrite func(path string) string) ProxyOption {
+	return func(config *proxyConfig) {
+		config.rewrite = rewrite
+	}
+}
+
+// WithProxyHeader thiết lập header trên request gửi tới target, ví dụ khóa API nội bộ.
+//
+// Parameters:
+//   - key: Tên header
+//   - value: Giá trị header
+//
+// Returns:
+//   - ProxyOption: Option cho Proxy
+func WithProxyHeader(key, value string) ProxyOption {
+	return func(config *proxyConfig) {
+		if config.headers == nil {
+			config.headers = make(map[string]string)
+		}
+		config.headers[key] = value
+	}
+}
+
+// WithProxyPreserveHost giữ nguyên header Host của request gốc thay vì dùng host của target.
+//
+// Returns:
+//   - ProxyOption: Option cho Proxy
+func WithProxyPreserveHost() ProxyOption {
+	return func(config *proxyConfig) {
+		config.preserveHost = true
+	}
+}
+
+// WithProxyTransport thiết lập RoundTripper gửi request tới target (timeout, TLS, connection pool).
+//
+// Parameters:
+//   - transport: RoundTripper, nil để dùng http.DefaultTransport
+//
+// Returns:
+//   - ProxyOption: Option cho Proxy
+func WithProxyTransport(transport http.RoundTripper) ProxyOption {
+	return func(config *proxyConfig) {
+		config.transport = transport
+	}
+}
+
+// WithProxyFlushInterval thiết lập chu kỳ flush response body tới client. Mặc định response
+// được flush ngay với Server-Sent Events và response không có Content-Length.
+//
+// Parameters:
+//   - interval: Chu kỳ flush, giá trị âm để flush sau mỗi lần ghi
+//
+// Returns:
+//   - ProxyOption: Option cho Proxy
+func WithProxyFlushInterval(interval time.Duration) ProxyOption {
+	return func(config *proxyConfig) {
+		config.flushInterval = interval
+	}
+}
+
+// Proxy đăng ký route chuyển tiếp mọi request tới prefix và các path con sang target.
+// Prefix được cắt khỏi path rồi ghép với path của target, ví dụ "/api/users" với prefix
+// "/api" và target "http://users:8080/v1" thành "http://users:8080/v1/users". Request và
+// response body được truyền dạng stream; header X-Forwarded-For, X-Forwarded-Host và
+// X-Forwarded-Proto được thiết lập. Lỗi kết nối tới target trả về HttpError 502 Bad Gateway
+// (504 Gateway Timeout khi hết thời gian chờ). Middlewares của router chạy trước khi chuyển tiếp.
+//
+// Parameters:
+//   - prefix: Tiền tố đường dẫn (ví dụ: "/api")
+//   - target: URL của upstream
+//   - opts: Các option của proxy (rewrite path, header, transport...)
+func (r *DefaultRouter) Proxy(prefix string, target *url.URL, opts ...ProxyOption) {
+	config := &proxyConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(config)
+		}
+	}
+
+	rewrite := func(pr *httputil.ProxyRequest) {
+		if config.rewrite != nil {
+			pr.Out.URL.Path = ensureLeadingSlash(config.rewrite(pr.Out.URL.Path))
+			pr.Out.URL.RawPath = ""
+		}
+		pr.SetURL(target)
+
+		// Giữ chuỗi X-Forwarded-For từ các proxy phía trước
+		pr.Out.Header["X-Forwarded-For"] = pr.In.Header["X-Forwarded-For"]
+		pr.SetXForwarded()
+		if config.preserveHost {
+			pr.Out.Host = pr.In.Host
+		}
+		for key, value := range config.headers {
+			pr.Out.Header.Set(key, value)
+		}
+	}
+
+	r.mount(prefix, func(ctx forkCtx.Context, req *http.Request) {
+		proxy := &httputil.ReverseProxy{
+			Rewrite:       rewrite,
+			Transport:     config.transport,
+			FlushInterval: config.flushInterval,
+			ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+				proxyError(ctx, err)
+			},
+		}
+		proxy.ServeHTTP(ctx.Response(), req)
+	})
+}
+
+// proxyError trả về HttpError khi không nhận được response từ target.
+// Địa chỉ của target không được đưa vào response để tránh lộ hạ tầng nội bộ.
+//
+// Parameters:
+//   - ctx: Context của request
+//   - err: Lỗi khi gửi request tới target
+func proxyError(ctx forkCtx.Context, err error) {
+	if ctx.Response().Written() {
+		return
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		httpError := forkerrors.NewGatewayTimeout("Upstream timed out", nil, err)
+		ctx.JSON(httpError.StatusCode, httpError)
+		return
+	}
+	httpError := forkerrors.NewBadGateway("Upstream unavailable", nil, err)
+	ctx.JSON(httpError.StatusCode, httpError)
+}
@@ -0,0 +1,97 @@
+package router
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_Proxy(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"path":   req.URL.RequestURI(),
+			"host":   req.Host,
+			"body":   string(body),
+			"xff":    req.Header.Get("X-Forwarded-For"),
+			"xfhost": req.Header.Get("X-Forwarded-Host"),
+			"key":    req.Header.Get("X-Internal-Key"),
+		})
+	}))
+	defer upstream.Close()
+	target, _ := url.Parse(upstream.URL + "/v1")
+
+	r := NewRouter()
+	var middlewareRan bool
+	r.Use(func(ctx context.Context) {
+		middlewareRan = true
+		ctx.Next()
+	})
+	r.Proxy("/api", target, WithProxyHeader("X-Internal-Key", "secret"))
+	r.Group("/legacy").Proxy("/", target,
+		WithProxyRewrite(func(path string) string { return "/compat" + path }),
+		WithProxyPreserveHost(),
+	)
+
+	tests := []struct {
+		method   string
+		target   string
+		body     string
+		expected map[string]string
+	}{
+		{"POST", "/api/users?page=2", "payload", map[string]string{
+			"path": "/v1/users?page=2", "body": "payload", "xfhost": "example.com", "key": "secret",
+		}},
+		{"GET", "/api", "", map[string]string{"path": "/v1/"}},
+		{"GET", "/legacy/users/1", "", map[string]string{
+			"path": "/v1/compat/users/1", "host": "example.com", "key": "",
+		}},
+	}
+	for _, tt := range tests {
+		middlewareRan = false
+		req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+		req.Header.Set("X-Forwarded-For", "10.0.0.1")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var got map[string]string
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Fatalf("%s %s: invalid upstream response %q (%d)", tt.method, tt.target, w.Body.String(), w.Code)
+		}
+		for key, want := range tt.expected {
+			if got[key] != want {
+				t.Errorf("%s %s: expected %s=%q, got %q", tt.method, tt.target, key, want, got[key])
+			}
+		}
+		if !strings.HasPrefix(got["xff"], "10.0.0.1, ") {
+			t.Errorf("%s %s: expected X-Forwarded-For chain, got %q", tt.method, tt.target, got["xff"])
+		}
+		if !middlewareRan {
+			t.Errorf("%s %s: expected router middleware to run", tt.method, tt.target)
+		}
+	}
+}
+
+func TestDefaultRouter_ProxyUnavailable(t *testing.T) {
+	upstream := httptest.NewServer(http.NotFoundHandler())
+	target, _ := url.Parse(upstream.URL)
+	upstream.Close()
+
+	r := NewRouter()
+	r.Proxy("/api", target)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/api/users", nil))
+	if w.Code != http.StatusBadGateway {
+		t.Errorf("Expected 502, got %d", w.Code)
+	}
+	if strings.Contains(w.Body.String(), target.Host) {
+		t.Errorf("Expected upstream address to be hidden, got %q", w.Body.String())
+	}
+}
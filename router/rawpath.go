@@ -0,0 +1,82 @@
+package router
+
+import (
+	"net/url"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// SetUseRawPath bật hoặc tắt so khớp route theo path chưa decode của request (mặc định: tắt).
+// Mặc định route được so khớp với ctx.Path() đã decode, nên "%2F" trong path trở thành dấu "/"
+// và tách segment: "/files/a%2Fb" không khớp "/files/:name". Khi bật, route được so khớp với
+// path gốc (URL.EscapedPath), "%2F" nằm trong một segment và giá trị tham số được decode
+// trước khi đưa vào context, nên ctx.Param("name") trả về "a/b".
+// Lưu ý: segment tĩnh được so khớp với path gốc, nên route có ký tự cần encode
+// (ví dụ "/café") phải được đăng ký ở dạng đã encode.
+//
+// Parameters:
+//   - enabled: true để so khớp theo path chưa decode
+func (r *DefaultRouter) SetUseRawPath(enabled bool) {
+	r.useRawPath = enabled
+}
+
+// SetAllowEncodedSlash cho phép hoặc từ chối request có "%2F" trong path (mặc định: cho phép).
+// Khi từ chối, request như "/files/a%2Fb" không khớp route nào và nhận 404 (hoặc NoRoute),
+// tránh việc giá trị tham số bị hiểu khác nhau giữa các tầng xử lý path.
+//
+// Parameters:
+//   - allowed: false để từ chối request có "%2F" trong path
+func (r *DefaultRouter) SetAllowEncodedSlash(allowed bool) {
+	r.rejectEncodedSlash = !allowed
+}
+
+// matchPath trả về path dùng để so khớp route theo cấu hình của router.
+//
+// Parameters:
+//   - ctx: Context của HTTP request
+//
+// Returns:
+//   - string: Path đã decode, hoặc path gốc nếu SetUseRawPath được bật
+//   - bool: false nếu path chứa "%2F" và bị từ chối bởi SetAllowEncodedSlash
+func (r *DefaultRouter) matchPath(ctx forkCtx.Context) (string, bool) {
+	if !r.useRawPath && !r.rejectEncodedSlash {
+		return ctx.Path(), true
+	}
+
+	rawPath := ctx.Request().Request().URL.EscapedPath()
+	if r.rejectEncodedSlash && hasEncodedSlash(rawPath) {
+		return rawPath, false
+	}
+	if r.useRawPath {
+		return rawPath, true
+	}
+	return ctx.Path(), true
+}
+
+// hasEncodedSlash kiểm tra path gốc có chứa "%2F" (không phân biệt hoa thường) hay không.
+func hasEncodedSlash(rawPath string) bool {
+	for {
+		i := strings.IndexByte(rawPath, '%')
+		if i < 0 || i+2 >= len(rawPath) {
+			return false
+		}
+		if rawPath[i+1] == '2' && (rawPath[i+2] == 'F' || rawPath[i+2] == 'f') {
+			return true
+		}
+		rawPath = rawPath[i+1:]
+	}
+}
+
+// unescapeParams decode giá trị tham số được trích từ path gốc.
+// Giá trị không decode được được giữ nguyên.
+func unescapeParams(params map[string]string) {
+	for name, value := range params {
+		if !strings.Contains(value, "%") {
+			continue
+		}
+		if unescaped, err := url.PathUnescape(value); err == nil {
+			params[name] = unescaped
+		}
+	}
+}
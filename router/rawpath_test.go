@@ -0,0 +1,79 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestHasEncodedSlash(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/files/a%2Fb", true},
+		{"/files/a%2fb", true},
+		{"/files/%2F", true},
+		{"/files/a%20b", false},
+		{"/files/a%2", false},
+		{"/files/a/b", false},
+	}
+	for _, tt := range tests {
+		if got := hasEncodedSlash(tt.path); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.path, tt.want, got)
+		}
+	}
+}
+
+func TestDefaultRouter_RawPath(t *testing.T) {
+	newRouter := func(useRawPath, allowEncodedSlash bool) *DefaultRouter {
+		r := NewRouter().(*DefaultRouter)
+		r.SetUseRawPath(useRawPath)
+		r.SetAllowEncodedSlash(allowEncodedSlash)
+		r.Handle("GET", "/files/:name", func(ctx context.Context) { ctx.String(http.StatusOK, "file "+ctx.Param("name")) })
+		r.Handle("GET", "/files/:dir/:name", func(ctx context.Context) {
+			ctx.String(http.StatusOK, "dir "+ctx.Param("dir")+" file "+ctx.Param("name"))
+		})
+		return r
+	}
+
+	tests := []struct {
+		name              string
+		useRawPath        bool
+		allowEncodedSlash bool
+		path              string
+		code              int
+		body              string
+	}{
+		{"decoded splits encoded slash", false, true, "/files/a%2Fb", http.StatusOK, "dir a file b"},
+		{"raw keeps encoded slash in param", true, true, "/files/a%2Fb", http.StatusOK, "file a/b"},
+		{"raw unescapes params", true, true, "/files/a%20b", http.StatusOK, "file a b"},
+		{"raw still splits real slash", true, true, "/files/a/b", http.StatusOK, "dir a file b"},
+		{"decoded rejects encoded slash", false, false, "/files/a%2Fb", http.StatusNotFound, "404 page not found"},
+		{"raw rejects encoded slash", true, false, "/files/a%2Fb", http.StatusNotFound, "404 page not found"},
+		{"reject keeps other escapes", false, false, "/files/a%20b", http.StatusOK, "file a b"},
+	}
+	for _, tt := range tests {
+		r := newRouter(tt.useRawPath, tt.allowEncodedSlash)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", tt.path, nil))
+		if w.Code != tt.code || w.Body.String() != tt.body {
+			t.Errorf("%s: expected %d %q, got %d %q", tt.name, tt.code, tt.body, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestDefaultRouter_RawPathLinear(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	r.enableTrie = false
+	r.SetUseRawPath(true)
+	r.Handle("GET", "/files/:name", func(ctx context.Context) { ctx.String(http.StatusOK, "file "+ctx.Param("name")) })
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/files/a%2Fb", nil))
+	if w.Body.String() != "file a/b" {
+		t.Errorf("Expected raw path match with linear search, got %d %q", w.Code, w.Body.String())
+	}
+}
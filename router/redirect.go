@@ -0,0 +1,164 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// SetRedirectTrailingSlash bật hoặc tắt chuyển hướng dấu "/" cuối (mặc định: tắt).
+// Khi bật, request khớp route nhưng khác route ở dấu "/" cuối (ví dụ "/users/" với route
+// "/users") được chuyển hướng tới path chuẩn thay vì được phục vụ trực tiếp.
+// Routes có wildcard không bị ảnh hưởng.
+//
+// Parameters:
+//   - enabled: true để chuyển hướng về path chuẩn
+func (r *DefaultRouter) SetRedirectTrailingSlash(enabled bool) {
+	r.redirectTrailingSlash = enabled
+}
+
+// SetRedirectFixedPath bật hoặc tắt chuyển hướng path đã sửa (mặc định: tắt).
+// Khi bật, request không khớp route nào được thử lại với path đã làm sạch
+// (loại bỏ "..", "." và "//") và so khớp không phân biệt hoa thường ở các segment tĩnh;
+// nếu tìm thấy route, client được chuyển hướng tới path đã sửa. Yêu cầu trie được bật.
+//
+// Parameters:
+//   - enabled: true để chuyển hướng tới path đã sửa
+func (r *DefaultRouter) SetRedirectFixedPath(enabled bool) {
+	r.redirectFixedPath = enabled
+}
+
+// trailingSlashTarget trả về path chuẩn khi path request khác pattern của route ở dấu "/" cuối.
+//
+// Parameters:
+//   - pattern: Pattern của route đã khớp
+//   - requestPath: Path của request
+//
+// Returns:
+//   - string: Path cần chuyển hướng tới
+//   - bool: true nếu cần chuyển hướng
+func trailingSlashTarget(pattern, requestPath string) (string, bool) {
+	if requestPath == "/" || strings.Contains(pattern, "*") {
+		return "", false
+	}
+
+	wantSlash := pattern != "/" && strings.HasSuffix(pattern, "/")
+	hasSlash := strings.HasSuffix(requestPath, "/")
+	switch {
+	case wantSlash && !hasSlash:
+		return requestPath + "/", true
+	case !wantSlash && hasSlash:
+		target := strings.TrimRight(requestPath, "/")
+		if target == "" {
+			target = "/"
+		}
+		return target, true
+	}
+	return "", false
+}
+
+// fixedPath tìm path đã sửa cho request không khớp route nào.
+//
+// Parameters:
+//   - method: HTTP method của request
+//   - requestPath: Path của request
+//
+// Returns:
+//   - string: Path đã sửa
+//   - bool: true nếu path đã sửa khớp route và khác path request
+func (r *DefaultRouter) fixedPath(method, requestPath string) (string, bool) {
+	if !r.enableTrie || r.trie == nil {
+		return "", false
+	}
+
+	cleaned := path.Clean("/" + requestPath)
+	fixed, ok := r.trie.FindCaseInsensitive(method, cleaned)
+	if !ok || fixed == requestPath {
+		return "", false
+	}
+	return fixed, true
+}
+
+// redirect chuyển hướng request tới path mới, giữ nguyên query string.
+// GET và HEAD dùng 301 Moved Permanently; các method khác dùng 307 Temporary Redirect
+// để client gửi lại đúng method và body.
+//
+// Parameters:
+//   - ctx: Context của request
+//   - target: Path đích
+func (r *DefaultRouter) redirect(ctx forkCtx.Context, target string) {
+	code := http.StatusTemporaryRedirect
+	if method := ctx.Method(); method == http.MethodGet || method == http.MethodHead {
+		code = http.StatusMovedPermanently
+	}
+
+	location := (&url.URL{Path: target, RawQuery: ctx.Request().URL().RawQuery}).String()
+	ctx.Redirect(code, location)
+}
+
+// Redirect đăng ký route chỉ chuyển hướng, ví dụ cho URL cũ. Tham số trong targetPath
+// (":id", "*path") được thay bằng giá trị của tham số cùng tên trong path; query string
+// của request được giữ nguyên nếu targetPath không có query riêng. Với 301, 302 và 303
+// route được đăng ký cho GET và HEAD; với 307 và 308 route được đăng ký cho mọi method
+// vì client gửi lại đúng method và body.
+//
+// Parameters:
+//   - path: URL path pattern cần chuyển hướng (ví dụ: "/old/users/:id")
+//   - targetPath: Path hoặc URL đích (ví dụ: "/users/:id/profile")
+//   - code: Mã chuyển hướng 301, 302, 303, 307 hoặc 308; 0 để dùng 301
+func (r *DefaultRouter) Redirect(path string, targetPath string, code int) {
+	methods := []string{http.MethodGet, http.MethodHead}
+	switch code {
+	case 0:
+		code = http.StatusMovedPermanently
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther:
+	case http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		methods = mountMethods
+	default:
+		panic(fmt.Sprintf("router: invalid redirect code %d for %s", code, path))
+	}
+
+	// Query riêng của target không chứa tham số cần thay
+	target, targetQuery, hasQuery := strings.Cut(targetPath, "?")
+
+	handler := func(ctx forkCtx.Context) {
+		location := interpolatePath(target, ctx.Param)
+		if hasQuery {
+			location += "?" + targetQuery
+		} else if query := ctx.Request().URL().RawQuery; query != "" {
+			location += "?" + query
+		}
+		ctx.Redirect(code, location)
+	}
+	for _, method := range methods {
+		r.Handle(method, path, handler)
+	}
+}
+
+// interpolatePath thay các segment tham số (":name", "*name") trong target bằng giá trị
+// của tham số cùng tên. Giá trị được escape theo từng segment.
+//
+// Parameters:
+//   - target: Path hoặc URL đích chứa tham số
+//   - param: Hàm trả về giá trị tham số theo tên
+//
+// Returns:
+//   - string: Target đã thay tham số
+func interpolatePath(target string, param func(name string) string) string {
+	segments := strings.Split(target, "/")
+	for i, segment := range segments {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+		parts := strings.Split(param(segment[1:]), "/")
+		for j, part := range parts {
+			parts[j] = url.PathEscape(part)
+		}
+		segments[i] = strings.Join(parts, "/")
+	}
+	return strings.Join(segments, "/")
+}
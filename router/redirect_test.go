@@ -0,0 +1,131 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func newRedirectRouter() *DefaultRouter {
+	r := NewRouter().(*DefaultRouter)
+	handler := func(ctx context.Context) { ctx.String(http.StatusOK, "ok:"+ctx.Param("id")) }
+	r.Handle("GET", "/users", handler)
+	r.Handle("POST", "/users", handler)
+	r.Handle("GET", "/docs/", handler)
+	r.Handle("GET", "/Users/:id/Profile", handler)
+	r.Handle("GET", "/files/*filepath", handler)
+	return r
+}
+
+func TestDefaultRouter_RedirectTrailingSlash(t *testing.T) {
+	r := newRedirectRouter()
+
+	// Mặc định: dấu "/" cuối được bỏ qua khi so khớp
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/users/", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200 without redirect option, got %d", w.Code)
+	}
+
+	r.SetRedirectTrailingSlash(true)
+	tests := []struct {
+		method   string
+		path     string
+		code     int
+		location string
+	}{
+		{"GET", "/users/?page=2", http.StatusMovedPermanently, "/users?page=2"},
+		{"POST", "/users/", http.StatusTemporaryRedirect, "/users"},
+		{"GET", "/docs", http.StatusMovedPermanently, "/docs/"},
+		{"GET", "/users", http.StatusOK, ""},
+		{"GET", "/docs/", http.StatusOK, ""},
+		{"GET", "/files/a/", http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+		if w.Code != tt.code {
+			t.Errorf("%s %s: expected status %d, got %d", tt.method, tt.path, tt.code, w.Code)
+		}
+		if got := w.Header().Get("Location"); got != tt.location {
+			t.Errorf("%s %s: expected Location %q, got %q", tt.method, tt.path, tt.location, got)
+		}
+	}
+}
+
+func TestDefaultRouter_RedirectFixedPath(t *testing.T) {
+	r := newRedirectRouter()
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/USERS", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("Expected 404 without fixed path option, got %d", w.Code)
+	}
+
+	r.SetRedirectFixedPath(true)
+	tests := []struct {
+		method   string
+		path     string
+		code     int
+		location string
+	}{
+		{"GET", "/USERS", http.StatusMovedPermanently, "/users"},
+		{"POST", "/Users", http.StatusTemporaryRedirect, "/users"},
+		{"GET", "/users/7/profile", http.StatusMovedPermanently, "/Users/7/Profile"},
+		{"GET", "/users/AbC/PROFILE", http.StatusMovedPermanently, "/Users/AbC/Profile"},
+		{"GET", "/admin/../users", http.StatusMovedPermanently, "/users"},
+		{"GET", "/DOCS", http.StatusMovedPermanently, "/docs/"},
+		{"GET", "/missing", http.StatusNotFound, ""},
+		{"DELETE", "/users", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+		if w.Code != tt.code {
+			t.Errorf("%s %s: expected status %d, got %d", tt.method, tt.path, tt.code, w.Code)
+		}
+		if got := w.Header().Get("Location"); got != tt.location {
+			t.Errorf("%s %s: expected Location %q, got %q", tt.method, tt.path, tt.location, got)
+		}
+	}
+}
+
+func TestDefaultRouter_Redirect(t *testing.T) {
+	r := NewRouter()
+	r.Redirect("/old/users/:id", "/users/:id/profile", http.StatusMovedPermanently)
+	r.Redirect("/docs/*page", "https://docs.example.com/v2/*page?ref=old", http.StatusFound)
+	r.Redirect("/api/v1/*path", "/api/v2/*path", http.StatusPermanentRedirect)
+	r.Redirect("/home", "/", 0)
+
+	tests := []struct {
+		method   string
+		path     string
+		code     int
+		location string
+	}{
+		{"GET", "/old/users/42?tab=posts", http.StatusMovedPermanently, "/users/42/profile?tab=posts"},
+		{"GET", "/old/users/a%20b", http.StatusMovedPermanently, "/users/a%20b/profile"},
+		{"POST", "/old/users/42", http.StatusNotFound, ""},
+		{"GET", "/docs/guide/intro?x=1", http.StatusFound, "https://docs.example.com/v2/guide/intro?ref=old"},
+		{"POST", "/api/v1/orders/7", http.StatusPermanentRedirect, "/api/v2/orders/7"},
+		{"HEAD", "/home", http.StatusMovedPermanently, "/"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+		if w.Code != tt.code || w.Header().Get("Location") != tt.location {
+			t.Errorf("%s %s: expected %d %q, got %d %q", tt.method, tt.path, tt.code, tt.location, w.Code, w.Header().Get("Location"))
+		}
+	}
+}
+
+func TestDefaultRouter_RedirectInvalidCode(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for invalid redirect code")
+		}
+	}()
+	NewRouter().Redirect("/old", "/new", http.StatusOK)
+}
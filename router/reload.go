@@ -0,0 +1,224 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// RouteSpec mô tả một route khai báo từ cấu hình hoặc plugin.
+// Handler và Middleware là tên được tra cứu trong HandlerRegistry.
+type RouteSpec struct {
+	// Method là HTTP method của route
+	Method string `mapstructure:"method" yaml:"method"`
+
+	// Path là URL path pattern của route
+	Path string `mapstructure:"path" yaml:"path"`
+
+	// Handler là tên handler trong registry
+	Handler string `mapstructure:"handler" yaml:"handler"`
+
+	// Middleware là tên các middleware trong registry, chạy theo thứ tự trước handler
+	Middleware []string `mapstructure:"middleware" yaml:"middleware"`
+}
+
+// HandlerRegistry ánh xạ tên dùng trong RouteSpec tới handler hoặc middleware.
+type HandlerRegistry map[string]HandlerFunc
+
+// BuildRoutes tạo router mới từ danh sách RouteSpec sau khi kiểm tra hợp lệ.
+//
+// Parameters:
+//   - specs: Danh sách route khai báo
+//   - registry: Registry chứa handlers và middleware được tham chiếu
+//
+// Returns:
+//   - Router: Router mới chứa các routes đã khai báo
+//   - error: Lỗi tổng hợp nếu có spec không hợp lệ
+func BuildRoutes(specs []RouteSpec, registry HandlerRegistry) (Router, error) {
+	var errs []error
+	for i, spec := range specs {
+		if spec.Handler == "" {
+			errs = append(errs, fmt.Errorf("route %d (%s %s): handler is required", i, spec.Method, spec.Path))
+		} else if registry[spec.Handler] == nil {
+			errs = append(errs, fmt.Errorf("route %d (%s %s): unknown handler %q", i, spec.Method, spec.Path, spec.Handler))
+		}
+		for _, name := range spec.Middleware {
+			if registry[name] == nil {
+				errs = append(errs, fmt.Errorf("route %d (%s %s): unknown middleware %q", i, spec.Method, spec.Path, name))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	r := NewRouter()
+	for _, spec := range specs {
+		handlers := make([]HandlerFunc, 0, len(spec.Middleware)+1)
+		for _, name := range spec.Middleware {
+			handlers = append(handlers, registry[name])
+		}
+		handlers = append(handlers, registry[spec.Handler])
+		r.Handle(strings.ToUpper(spec.Method), spec.Path, handlers...)
+	}
+
+	if err := ValidateRoutes(r.Routes()); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ValidateRoutes kiểm tra bảng route trước khi đưa vào sử dụng: method hợp lệ, path bắt đầu
+// bằng "/", regex constraint biên dịch được, mỗi route có tối đa một wildcard và không có route trùng lặp.
+//
+// Parameters:
+//   - routes: Danh sách routes cần kiểm tra
+//
+// Returns:
+//   - error: Lỗi tổng hợp của mọi route không hợp lệ, nil nếu hợp lệ
+func ValidateRoutes(routes []Route) error {
+	var errs []error
+	seen := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		name := route.Method + " " + route.Path
+		if !validMethod(route.Method) {
+			errs = append(errs, fmt.Errorf("route %s: invalid method %q", name, route.Method))
+		}
+		if !strings.HasPrefix(route.Path, "/") {
+			errs = append(errs, fmt.Errorf("route %s: path must start with /", name))
+		}
+		if route.Handler == nil {
+			errs = append(errs, fmt.Errorf("route %s: handler is nil", name))
+		}
+		if seen[name] {
+			errs = append(errs, fmt.Errorf("route %s: duplicate route", name))
+		}
+		seen[name] = true
+
+		segments := strings.Split(strings.Trim(route.Path, "/"), "/")
+		wildcards := 0
+		for _, segment := range segments {
+			if strings.HasPrefix(segment, "*") {
+				if wildcards++; wildcards == 2 {
+					errs = append(errs, fmt.Errorf("route %s: only one wildcard segment is allowed", name))
+				}
+			}
+			if strings.HasPrefix(segment, ":") {
+				if start := strings.Index(segment, "<"); start >= 0 {
+					end := strings.LastIndex(segment, ">")
+					if end < start {
+						errs = append(errs, fmt.Errorf("route %s: unterminated constraint in %s", name, segment))
+					} else if _, err := compileRegex(segment[start+1 : end]); err != nil {
+						errs = append(errs, fmt.Errorf("route %s: invalid constraint in %s: %w", name, segment, err))
+					}
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// routeTable là một phiên bản của bảng route đang được phục vụ.
+type routeTable struct {
+	router  Router
+	version uint64
+}
+
+// ReloadableRouter phục vụ một bảng route có thể được thay thế nguyên tử lúc runtime.
+// Bảng mới được xây dựng và kiểm tra trước, sau đó con trỏ được hoán đổi; request đang xử lý
+// tiếp tục dùng bảng cũ cho tới khi hoàn tất nên không bị gián đoạn.
+//
+// Ví dụ:
+//
+//	routes := router.NewReloadableRouter(initial)
+//	adapter.SetHandler(routes)
+//	// Khi cấu hình thay đổi:
+//	if err := routes.Reload(specs, registry); err != nil {
+//		log.Printf("giữ bảng route cũ: %v", err)
+//	}
+type ReloadableRouter struct {
+	current atomic.Pointer[routeTable]
+	mu      sync.Mutex
+}
+
+// NewReloadableRouter tạo ReloadableRouter phục vụ router ban đầu.
+//
+// Parameters:
+//   - initial: Router ban đầu, nil để bắt đầu với router rỗng
+//
+// Returns:
+//   - *ReloadableRouter: Router có thể hoán đổi bảng route
+func NewReloadableRouter(initial Router) *ReloadableRouter {
+	if initial == nil {
+		initial = NewRouter()
+	}
+	r := &ReloadableRouter{}
+	r.current.Store(&routeTable{router: initial, version: 1})
+	return r
+}
+
+// ServeHTTP chuyển request tới bảng route hiện tại.
+//
+// Parameters:
+//   - w: HTTP response writer
+//   - req: HTTP request
+func (r *ReloadableRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.current.Load().router.ServeHTTP(w, req)
+}
+
+// Current trả về bảng route đang được phục vụ.
+//
+// Returns:
+//   - Router: Router hiện tại
+func (r *ReloadableRouter) Current() Router {
+	return r.current.Load().router
+}
+
+// Version trả về phiên bản của bảng route, tăng mỗi lần hoán đổi thành công.
+//
+// Returns:
+//   - uint64: Phiên bản hiện tại, bắt đầu từ 1
+func (r *ReloadableRouter) Version() uint64 {
+	return r.current.Load().version
+}
+
+// Swap kiểm tra router mới bằng ValidateRoutes rồi hoán đổi nguyên tử.
+// Nếu kiểm tra thất bại, bảng route hiện tại được giữ nguyên.
+//
+// Parameters:
+//   - next: Router mới
+//
+// Returns:
+//   - error: Lỗi nếu router mới nil hoặc không hợp lệ
+func (r *ReloadableRouter) Swap(next Router) error {
+	if next == nil {
+		return errors.New("router: cannot swap to a nil router")
+	}
+	if err := ValidateRoutes(next.Routes()); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.current.Store(&routeTable{router: next, version: r.current.Load().version + 1})
+	return nil
+}
+
+// Reload xây dựng bảng route mới từ RouteSpec và hoán đổi khi hợp lệ.
+//
+// Parameters:
+//   - specs: Danh sách route khai báo
+//   - registry: Registry chứa handlers và middleware được tham chiếu
+//
+// Returns:
+//   - error: Lỗi nếu có spec không hợp lệ; bảng route hiện tại được giữ nguyên
+func (r *ReloadableRouter) Reload(specs []RouteSpec, registry HandlerRegistry) error {
+	next, err := BuildRoutes(specs, registry)
+	if err != nil {
+		return err
+	}
+	return r.Swap(next)
+}
@@ -0,0 +1,160 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func testRegistry(release <-chan struct{}, started chan<- struct{}) HandlerRegistry {
+	return HandlerRegistry{
+		"v1": func(ctx context.Context) { ctx.String(http.StatusOK, "v1:%s", ctx.Param("id")) },
+		"v2": func(ctx context.Context) { ctx.String(http.StatusOK, "v2:%s", ctx.Param("id")) },
+		"slow": func(ctx context.Context) {
+			started <- struct{}{}
+			<-release
+			ctx.String(http.StatusOK, "slow-v1")
+		},
+		"tag": func(ctx context.Context) {
+			ctx.Header("X-Tag", "on")
+			ctx.Next()
+		},
+	}
+}
+
+func get(h http.Handler, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestBuildRoutes(t *testing.T) {
+	r, err := BuildRoutes([]RouteSpec{
+		{Method: "get", Path: "/users/:id", Handler: "v1", Middleware: []string{"tag"}},
+	}, testRegistry(nil, nil))
+	if err != nil {
+		t.Fatalf("Expected routes to build, got %v", err)
+	}
+
+	w := get(r, "/users/7")
+	if w.Body.String() != "v1:7" || w.Header().Get("X-Tag") != "on" {
+		t.Errorf("Expected middleware and handler to run, got %q %v", w.Body.String(), w.Header())
+	}
+}
+
+func TestBuildRoutesValidation(t *testing.T) {
+	_, err := BuildRoutes([]RouteSpec{
+		{Method: "GET", Path: "/a", Handler: "missing"},
+		{Method: "GET", Path: "/b", Handler: "v1", Middleware: []string{"nope"}},
+		{Method: "GET", Path: "/c"},
+	}, testRegistry(nil, nil))
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+	for _, expected := range []string{`unknown handler "missing"`, `unknown middleware "nope"`, "handler is required"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error to contain %q, got %v", expected, err)
+		}
+	}
+}
+
+func TestValidateRoutes(t *testing.T) {
+	h := func(ctx context.Context) {}
+	tests := []struct {
+		routes   []Route
+		expected string
+	}{
+		{[]Route{{Method: "GET", Path: "/users/:id<\\d+>", Handler: h}, {Method: "GET", Path: "/files/*path", Handler: h}}, ""},
+		{[]Route{{Method: "GET", Path: "users", Handler: h}}, "must start with /"},
+		{[]Route{{Method: "", Path: "/x", Handler: h}}, "invalid method"},
+		{[]Route{{Method: "GET", Path: "/x", Handler: nil}}, "handler is nil"},
+		{[]Route{{Method: "GET", Path: "/x", Handler: h}, {Method: "GET", Path: "/x", Handler: h}}, "duplicate route"},
+		{[]Route{{Method: "GET", Path: "/files/*path/edit", Handler: h}}, ""},
+		{[]Route{{Method: "GET", Path: "/files/*path/*rest", Handler: h}}, "only one wildcard"},
+		{[]Route{{Method: "GET", Path: "/users/:id<[0-9>", Handler: h}}, "invalid constraint"},
+		{[]Route{{Method: "GET", Path: "/users/:id<\\d+", Handler: h}}, "unterminated constraint"},
+	}
+
+	for _, tt := range tests {
+		err := ValidateRoutes(tt.routes)
+		if tt.expected == "" {
+			if err != nil {
+				t.Errorf("Expected routes to be valid, got %v", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("Expected error containing %q, got %v", tt.expected, err)
+		}
+	}
+}
+
+func TestReloadableRouterReload(t *testing.T) {
+	registry := testRegistry(nil, nil)
+	routes := NewReloadableRouter(nil)
+	if routes.Version() != 1 || get(routes, "/users/1").Code != http.StatusNotFound {
+		t.Fatal("Expected empty initial route table")
+	}
+
+	if err := routes.Reload([]RouteSpec{{Method: "GET", Path: "/users/:id", Handler: "v1"}}, registry); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if body := get(routes, "/users/1").Body.String(); body != "v1:1" || routes.Version() != 2 {
+		t.Errorf("Expected v1 table at version 2, got %q at %d", body, routes.Version())
+	}
+
+	// Bảng không hợp lệ không được hoán đổi
+	if err := routes.Reload([]RouteSpec{{Method: "GET", Path: "/users/:id", Handler: "v3"}}, registry); err == nil {
+		t.Error("Expected invalid reload to fail")
+	}
+	if body := get(routes, "/users/1").Body.String(); body != "v1:1" || routes.Version() != 2 {
+		t.Errorf("Expected previous table to stay active, got %q at %d", body, routes.Version())
+	}
+
+	if err := routes.Swap(nil); err == nil {
+		t.Error("Expected error when swapping to nil router")
+	}
+	invalid := NewRouter()
+	invalid.Handle("GET", "/a", func(ctx context.Context) {})
+	invalid.Handle("GET", "/a", func(ctx context.Context) {})
+	if err := routes.Swap(invalid); err == nil || routes.Version() != 2 {
+		t.Errorf("Expected duplicate routes to be rejected, got %v", err)
+	}
+}
+
+func TestReloadableRouterKeepsInFlightRequests(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	registry := testRegistry(release, started)
+
+	routes := NewReloadableRouter(nil)
+	if err := routes.Reload([]RouteSpec{{Method: "GET", Path: "/slow", Handler: "slow"}}, registry); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	var inFlight *httptest.ResponseRecorder
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		inFlight = get(routes, "/slow")
+	}()
+	<-started
+
+	if err := routes.Reload([]RouteSpec{{Method: "GET", Path: "/slow", Handler: "v2"}}, registry); err != nil {
+		t.Fatal(err)
+	}
+	if body := get(routes, "/slow").Body.String(); body != "v2:" {
+		t.Errorf("Expected new requests to use the new table, got %q", body)
+	}
+
+	close(release)
+	wg.Wait()
+	if inFlight.Body.String() != "slow-v1" {
+		t.Errorf("Expected in-flight request to finish on the old table, got %q", inFlight.Body.String())
+	}
+}
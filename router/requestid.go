@@ -0,0 +1,20 @@
+package router
+
+// SetEagerRequestID bật hoặc tắt việc gán request ID cho mọi request ngay khi router nhận request,
+// để mọi response (kể cả 404/405) đều có header X-Request-ID. Khi tắt (mặc định), request ID
+// chỉ được sinh khi handler hoặc ctx.Logger() gọi ctx.RequestID(). Chỉ có hiệu lực ở router gốc.
+// An toàn khi gọi đồng thời với các request đang được xử lý.
+//
+// Parameters:
+//   - enabled: true để sinh request ID cho mọi request
+func (r *DefaultRouter) SetEagerRequestID(enabled bool) {
+	r.root().eagerRequestID.Store(enabled)
+}
+
+// EagerRequestID cho biết router có gán request ID cho mọi request hay không.
+//
+// Returns:
+//   - bool: true nếu SetEagerRequestID đã được bật
+func (r *DefaultRouter) EagerRequestID() bool {
+	return r.root().eagerRequestID.Load()
+}
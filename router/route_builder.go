@@ -0,0 +1,222 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+)
+
+// RouteBuilder cấu hình thêm cho route vừa được đăng ký bằng Handle,
+// ví dụ gắn middleware chỉ áp dụng cho route đó.
+//
+// Ví dụ:
+//
+//	r.Handle("POST", "/users", createUser).Use(auth, rateLimit)
+type RouteBuilder struct {
+	// method là HTTP method của route
+	method string
+
+	// path là đường dẫn tuyệt đối của route
+	path string
+
+	// chain là chuỗi handlers dùng chung với handler đã đăng ký của route
+	chain *routeChain
+
+	// meta là metadata dùng chung với route đã đăng ký
+	meta map[string]interface{}
+
+	// router là router đã đăng ký route, nil nếu route không được đăng ký
+	router *DefaultRouter
+
+	// err là lỗi khiến route không được đăng ký (ConflictError)
+	err error
+}
+
+// routeChain là chuỗi handlers của một route:
+// middlewares của router, middlewares của route rồi tới handlers của route.
+// Cấu hình là snapshot bất biến được hoán đổi nguyên tử khi RouteBuilder thay đổi route,
+// nên route có thể được cấu hình trong khi router đang phục vụ requests.
+type routeChain struct {
+	state atomic.Pointer[chainState]
+}
+
+// chainState là một snapshot cấu hình của routeChain.
+type chainState struct {
+	// handlers là toàn bộ chuỗi handlers theo thứ tự thực thi
+	handlers []HandlerFunc
+
+	// insertAt là vị trí chèn middleware tiếp theo của route
+	insertAt int
+
+	// timeout là thời gian tối đa xử lý request của route, 0 nếu không giới hạn
+	timeout time.Duration
+
+	// bodyLimit là kích thước tối đa (bytes) của body request, 0 nếu không giới hạn
+	bodyLimit int64
+}
+
+// newRouteChain tạo chuỗi handlers với cấu hình ban đầu.
+func newRouteChain(state chainState) *routeChain {
+	chain := &routeChain{}
+	chain.state.Store(&state)
+	return chain
+}
+
+// load trả về snapshot cấu hình hiện tại của chuỗi.
+func (c *routeChain) load() *chainState {
+	return c.state.Load()
+}
+
+// update sửa bản sao của cấu hình hiện tại rồi công bố bản sao đó.
+// Caller phải giữ khóa ghi của router nếu route đã được đăng ký.
+func (c *routeChain) update(fn func(state *chainState)) {
+	next := *c.state.Load()
+	fn(&next)
+	c.state.Store(&next)
+}
+
+// Method trả về HTTP method của route.
+//
+// Returns:
+//   - string: HTTP method
+func (b *RouteBuilder) Method() string {
+	return b.method
+}
+
+// Path trả về đường dẫn tuyệt đối của route.
+//
+// Returns:
+//   - string: Path pattern đã kết hợp với prefix của group
+func (b *RouteBuilder) Path() string {
+	return b.path
+}
+
+// Meta gắn metadata cho route, ví dụ quyền truy cập cho policy middleware hoặc mô tả
+// cho trình sinh tài liệu. Middleware đọc metadata bằng ctx.RouteMeta; Routes() trả về
+// metadata trong Route.Meta. Meta cần được gọi trong lúc thiết lập routes.
+//
+// Parameters:
+//   - key: Tên metadata
+//   - value: Giá trị metadata
+//
+// Returns:
+//   - *RouteBuilder: Chính builder để gọi nối tiếp
+func (b *RouteBuilder) Meta(key string, value interface{}) *RouteBuilder {
+	defer b.writeLock()()
+
+	// Tạo map mới thay vì sửa map mà requests đang đọc
+	meta := make(map[string]interface{}, len(b.meta)+1)
+	for k, v := range b.meta {
+		meta[k] = v
+	}
+	meta[key] = value
+	b.meta = meta
+
+	if b.router != nil {
+		b.router.setRouteMeta(b.method, b.path, meta)
+	}
+	return b
+}
+
+// setRouteMeta thay metadata của route trong router và trie của các router cha.
+// Caller phải giữ khóa ghi.
+func (r *DefaultRouter) setRouteMeta(method, path string, meta map[string]interface{}) {
+	r.updateRoute(method, path, func(route *Route) { route.Meta = meta })
+
+	for owner := r; owner != nil; owner = owner.parent {
+		if owner.enableTrie && owner.trie != nil {
+			owner.trie.setMeta(method, path, meta)
+		}
+		if owner.scoped() {
+			break
+		}
+	}
+}
+
+// Timeout đặt thời gian xử lý tối đa cho route. Chuỗi handlers của route (kể cả middlewares)
+// chạy với ctx.Context() có deadline; khi deadline qua mà response chưa được ghi,
+// router trả về HttpError 504 Gateway Timeout. Handlers cần dừng khi ctx.Context().Done()
+// được đóng (ví dụ truyền ctx.Context() cho truy vấn database) để timeout có hiệu lực đúng hạn.
+//
+// Parameters:
+//   - d: Thời gian tối đa, 0 để bỏ giới hạn
+//
+// Returns:
+//   - *RouteBuilder: Chính builder để gọi nối tiếp
+func (b *RouteBuilder) Timeout(d time.Duration) *RouteBuilder {
+	defer b.writeLock()()
+	b.chain.update(func(state *chainState) { state.timeout = d })
+	return b
+}
+
+// Err trả về lỗi khiến route không được đăng ký, ví dụ ErrRouteConflict
+// khi router dùng ConflictError.
+//
+// Returns:
+//   - error: Lỗi đăng ký, nil nếu route đã được đăng ký
+func (b *RouteBuilder) Err() error {
+	return b.err
+}
+
+// Use gắn middleware chỉ áp dụng cho route này. Middleware của route chạy sau
+// middlewares của router/group và trước handlers của route, theo thứ tự được thêm.
+// Use cần được gọi trong lúc thiết lập routes, trước khi router phục vụ requests.
+//
+// Parameters:
+//   - middleware: Danh sách middleware functions để thêm
+//
+// Returns:
+//   - *RouteBuilder: Chính builder để gọi nối tiếp
+func (b *RouteBuilder) Use(middleware ...HandlerFunc) *RouteBuilder {
+	if len(middleware) == 0 {
+		return b
+	}
+
+	defer b.writeLock()()
+	b.chain.update(func(state *chainState) {
+		handlers := make([]HandlerFunc, 0, len(state.handlers)+len(middleware))
+		handlers = append(handlers, state.handlers[:state.insertAt]...)
+		handlers = append(handlers, middleware...)
+		handlers = append(handlers, state.handlers[state.insertAt:]...)
+
+		state.handlers = handlers
+		state.insertAt += len(middleware)
+	})
+	return b
+}
+
+// writeLock khóa ghi bảng route của router đã đăng ký route và trả về hàm mở khóa.
+// Route không được đăng ký không cần khóa.
+func (b *RouteBuilder) writeLock() func() {
+	if b.router == nil {
+		return func() {}
+	}
+	return b.router.writeLock()
+}
+
+// runWithTimeout chạy chuỗi handlers với context có deadline và trả về 504
+// nếu deadline qua trước khi response được ghi.
+//
+// Parameters:
+//   - ctx: Context của request
+//   - timeout: Thời gian tối đa
+//   - handlers: Chuỗi handlers của route
+func runWithTimeout(ctx forkCtx.Context, timeout time.Duration, handlers []func(forkCtx.Context)) {
+	deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
+	defer cancel()
+	ctx.WithContext(deadlineCtx)
+
+	ctx.SetHandlers(handlers)
+	ctx.Next()
+
+	if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) && !ctx.Response().Written() {
+		httpError := forkerrors.NewGatewayTimeout("Request timed out", map[string]interface{}{
+			"timeout": timeout.String(),
+		}, nil)
+		ctx.JSON(httpError.StatusCode, httpError)
+	}
+}
@@ -0,0 +1,163 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestRouteBuilder_Use(t *testing.T) {
+	var order []string
+	mw := func(name string) HandlerFunc {
+		return func(ctx context.Context) {
+			order = append(order, name)
+			ctx.Next()
+		}
+	}
+
+	r := NewRouter()
+	r.Use(mw("router"))
+	api := r.Group("/api")
+	api.Use(mw("group"))
+
+	b := api.Handle("POST", "/users", func(ctx context.Context) {
+		order = append(order, "handler")
+		ctx.String(http.StatusCreated, "created")
+	}).Use(mw("route1")).Use(mw("route2"), mw("route3"))
+	api.Handle("GET", "/users", func(ctx context.Context) {
+		order = append(order, "other")
+	})
+
+	if b.Method() != "POST" || b.Path() != "/api/users" {
+		t.Errorf("Unexpected builder route %s %s", b.Method(), b.Path())
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/api/users", nil))
+	if w.Code != http.StatusCreated {
+		t.Errorf("Expected status 201, got %d", w.Code)
+	}
+	expected := []string{"router", "group", "route1", "route2", "route3", "handler"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected order %v, got %v", expected, order)
+	}
+
+	// Middleware của route không áp dụng cho routes khác
+	order = nil
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/users", nil))
+	if expected := []string{"router", "group", "other"}; !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected order %v, got %v", expected, order)
+	}
+}
+
+func TestRouteBuilder_UseAbort(t *testing.T) {
+	r := NewRouter()
+	called := false
+	r.Handle("DELETE", "/items/:id", func(ctx context.Context) {
+		called = true
+	}).Use(func(ctx context.Context) {
+		ctx.String(http.StatusForbidden, "forbidden")
+		ctx.Abort()
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("DELETE", "/items/1", nil))
+	if w.Code != http.StatusForbidden || called {
+		t.Errorf("Expected route middleware to abort, got %d (handler called: %v)", w.Code, called)
+	}
+}
+
+func TestRouteBuilder_Meta(t *testing.T) {
+	requireRole := func(ctx context.Context) {
+		if role, ok := ctx.RouteMeta("auth"); ok && ctx.GetHeader("X-Role") != role {
+			ctx.String(http.StatusForbidden, "forbidden")
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+
+	r := NewRouter()
+	r.Use(requireRole)
+	r.Handle("DELETE", "/users/:id", func(ctx context.Context) {
+		ctx.String(http.StatusOK, "deleted")
+	}).Meta("auth", "admin").Meta("docs", "Xóa user")
+	r.Handle("GET", "/users/:id", func(ctx context.Context) {
+		if _, ok := ctx.RouteMeta("auth"); ok {
+			t.Error("Expected no metadata on route without Meta")
+		}
+		ctx.String(http.StatusOK, "user")
+	})
+
+	serve := func(method, role string) int {
+		req := httptest.NewRequest(method, "/users/7", nil)
+		req.Header.Set("X-Role", role)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+	if code := serve("DELETE", "guest"); code != http.StatusForbidden {
+		t.Errorf("Expected 403 for guest, got %d", code)
+	}
+	if code := serve("DELETE", "admin"); code != http.StatusOK {
+		t.Errorf("Expected 200 for admin, got %d", code)
+	}
+	if code := serve("GET", ""); code != http.StatusOK {
+		t.Errorf("Expected 200 for route without metadata, got %d", code)
+	}
+
+	// Routes() trả về metadata cho trình sinh tài liệu
+	for _, route := range r.Routes() {
+		if route.Method == "DELETE" && route.Meta["docs"] != "Xóa user" {
+			t.Errorf("Expected docs metadata in Routes(), got %v", route.Meta)
+		}
+	}
+}
+
+func TestRouteBuilder_Timeout(t *testing.T) {
+	r := NewRouter()
+	r.Handle("GET", "/slow", func(ctx context.Context) {
+		select {
+		case <-ctx.Context().Done():
+		case <-time.After(time.Second):
+			ctx.String(http.StatusOK, "late")
+		}
+	}).Timeout(20 * time.Millisecond)
+	r.Handle("GET", "/fast", func(ctx context.Context) {
+		if _, ok := ctx.Context().Deadline(); !ok {
+			t.Error("Expected route context to carry a deadline")
+		}
+		ctx.String(http.StatusOK, "fast")
+	}).Timeout(time.Second)
+	r.Handle("GET", "/plain", func(ctx context.Context) {
+		if _, ok := ctx.Context().Deadline(); ok {
+			t.Error("Expected no deadline without Timeout")
+		}
+	})
+
+	start := time.Now()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/slow", nil))
+	if w.Code != http.StatusGatewayTimeout {
+		t.Errorf("Expected 504, got %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "Request timed out") {
+		t.Errorf("Expected timeout error body, got %q", w.Body.String())
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected handler to stop at the deadline, took %v", elapsed)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/fast", nil))
+	if w.Code != http.StatusOK || w.Body.String() != "fast" {
+		t.Errorf("Expected fast route to succeed, got %d %q", w.Code, w.Body.String())
+	}
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/plain", nil))
+}
@@ -1,7 +1,10 @@
 package router
 
 import (
+	"io/fs"
 	"net/http"
+	"net/url"
+	"os"
 	"regexp"
 	"strings"
 	"sync"
@@ -25,17 +28,21 @@ type Router interface {
 	//   - method: HTTP method (GET, POST, PUT, DELETE, v.v.)
 	//   - path: URL path pattern để khớp với requests
 	//   - handlers: Chuỗi các handlers xử lý request
-	Handle(method string, path string, handlers ...HandlerFunc)
+	//
+	// Returns:
+	//   - *RouteBuilder: Builder để cấu hình thêm cho route (ví dụ: middleware riêng)
+	Handle(method string, path string, handlers ...HandlerFunc) *RouteBuilder
 
 	// Group tạo một router group mới với prefix đường dẫn.
 	// Group cho phép tổ chức routes theo cấu trúc và áp dụng middleware cho nhóm routes.
 	//
 	// Parameters:
 	//   - prefix: Tiền tố đường dẫn cho group
+	//   - opts: Các option của group (ví dụ: WithoutParentMiddleware)
 	//
 	// Returns:
 	//   - Router: Router mới đã được tạo với prefix
-	Group(prefix string) Router
+	Group(prefix string, opts ...GroupOption) Router
 
 	// Use thêm middleware vào router.
 	// Middleware sẽ được thực thi cho tất cả routes trong router này và các sub-groups.
@@ -50,7 +57,16 @@ type Router interface {
 	// Parameters:
 	//   - prefix: Tiền tố URL để phục vụ files (ví dụ: "/static")
 	//   - root: Đường dẫn tới thư mục chứa static files
-	Static(prefix string, root string)
+	//   - config: Cấu hình tùy chọn (index file, directory listing, 404), mặc định DefaultStaticConfig
+	Static(prefix string, root string, config ...StaticConfig)
+
+	// StaticFS phục vụ static files từ một fs.FS (ví dụ: embed.FS).
+	//
+	// Parameters:
+	//   - prefix: Tiền tố URL để phục vụ files (ví dụ: "/assets")
+	//   - fsys: Filesystem chứa static files
+	//   - config: Cấu hình tùy chọn, mặc định DefaultStaticConfig
+	StaticFS(prefix string, fsys fs.FS, config ...StaticConfig)
 
 	// Routes trả về tất cả routes đã đăng ký.
 	// Phương thức này thu thập tất cả routes từ router hiện tại và tất cả các sub-groups.
@@ -77,6 +93,85 @@ type Router interface {
 	// Returns:
 	//   - HandlerFunc: Handler cho route được tìm thấy hoặc nil nếu không tìm thấy
 	Find(method, path string) HandlerFunc
+
+	// Match đăng ký cùng chuỗi handlers cho nhiều HTTP method trên một path.
+	//
+	// Parameters:
+	//   - methods: Danh sách HTTP method (ví dụ: []string{"GET", "POST"})
+	//   - path: URL path pattern cho route
+	//   - handlers: Danh sách các handlers xử lý request
+	//
+	// Returns:
+	//   - []*RouteBuilder: Builder của từng route theo thứ tự methods
+	Match(methods []string, path string, handlers ...HandlerFunc) []*RouteBuilder
+
+	// NoRoute thiết lập chuỗi handlers xử lý request không khớp route nào.
+	// Handlers chạy qua middlewares của router, cho phép trả về trang 404 tùy biến.
+	//
+	// Parameters:
+	//   - handlers: Chuỗi handlers xử lý 404, rỗng để dùng phản hồi mặc định
+	NoRoute(handlers ...HandlerFunc)
+
+	// NoMethod thiết lập chuỗi handlers xử lý request có path khớp route nhưng
+	// method không được hỗ trợ (405 Method Not Allowed).
+	//
+	// Parameters:
+	//   - handlers: Chuỗi handlers xử lý 405, rỗng để dùng phản hồi mặc định
+	NoMethod(handlers ...HandlerFunc)
+
+	// Host tạo một router group chỉ khớp request có host phù hợp với mẫu.
+	// Nhãn bắt đầu bằng ":" là tham số (ví dụ: ":tenant.example.com").
+	//
+	// Parameters:
+	//   - pattern: Mẫu host
+	//
+	// Returns:
+	//   - Router: Router group ràng buộc theo host
+	Host(pattern string) Router
+
+	// Version tạo một router group cho API version, chọn theo tiền tố path
+	// (VersionPath) hoặc header Accept (VersionHeader).
+	//
+	// Parameters:
+	//   - version: API version (ví dụ: "2")
+	//   - strategy: Cách request chọn version
+	//
+	// Returns:
+	//   - Router: Router group của version
+	Version(version string, strategy VersionStrategy) Router
+
+	// Mount gắn một http.Handler dưới prefix; handler nhận request với prefix
+	// đã được cắt khỏi path.
+	//
+	// Parameters:
+	//   - prefix: Tiền tố đường dẫn
+	//   - h: Handler được gắn
+	Mount(prefix string, h http.Handler)
+
+	// MountRouter gắn một Router khác dưới prefix.
+	//
+	// Parameters:
+	//   - prefix: Tiền tố đường dẫn
+	//   - sub: Router được gắn
+	MountRouter(prefix string, sub Router)
+
+	// Proxy đăng ký route chuyển tiếp mọi request tới prefix và các path con sang target,
+	// với prefix được cắt khỏi path trước khi ghép với path của target.
+	//
+	// Parameters:
+	//   - prefix: Tiền tố đường dẫn (ví dụ: "/api")
+	//   - target: URL của upstream
+	//   - opts: Các option của proxy (rewrite path, header, transport...)
+	Proxy(prefix string, target *url.URL, opts ...ProxyOption)
+
+	// Redirect đăng ký route chỉ chuyển hướng tới targetPath, với tham số trong
+	// targetPath được thay bằng giá trị của tham số cùng tên trong path.
+	//
+	// Parameters:
+	//   - path: URL path pattern cần chuyển hướng (ví dụ: "/old/users/:id")
+	//   - targetPath: Path hoặc URL đích (ví dụ: "/users/:id/profile")
+	//   - code: Mã chuyển hướng 301, 302, 303, 307 hoặc 308; 0 để dùng 301
+	Redirect(path string, targetPath string, code int)
 }
 
 // Route định nghĩa một HTTP route đã đăng ký.
@@ -90,29 +185,142 @@ type Route struct {
 
 	// Handler là function xử lý requests khớp với route này
 	Handler HandlerFunc
+
+	// Meta là metadata tùy ý của route (ví dụ: quyền truy cập, tài liệu),
+	// được thiết lập qua RouteBuilder.Meta và đọc bằng ctx.RouteMeta
+	Meta map[string]interface{}
+
+	// Priority là độ ưu tiên của route khi nhiều route cùng khớp một request,
+	// được thiết lập qua RouteBuilder.Priority
+	Priority int
+
+	// Group là prefix của router group đã đăng ký route, rỗng với router gốc
+	Group string
+
+	// HandlerName là tên function của handler cuối cùng trong chuỗi handlers,
+	// chỉ được điền trong kết quả của Routes()
+	HandlerName string
+
+	// HandlerNames là tên function của toàn bộ chuỗi handlers theo thứ tự thực thi
+	// (middlewares trước, handlers của route sau), chỉ được điền trong kết quả của Routes()
+	HandlerNames []string
+
+	// Middlewares là số middlewares chạy trước handlers của route (của router, group
+	// và RouteBuilder.Use), chỉ được điền trong kết quả của Routes()
+	Middlewares int
+
+	// chain là chuỗi handlers của route, dùng để mô tả route trong Routes()
+	chain *routeChain
 }
 
+// MatchObserver được gọi mỗi khi request khớp với một route đã đăng ký,
+// trước khi chuỗi handler của route được thực thi.
+type MatchObserver func(ctx forkCtx.Context, route Route)
+
 // DefaultRouter là implementation mặc định của Router interface.
 // Nó cung cấp cơ chế routing dựa trên path patterns với hỗ trợ cho parameters,
-// wildcards, và regex patterns. Sử dụng trie structure để tối ưu hiệu suất.
+// wildcards, và regex patterns. Trie của mỗi router chứa cả routes của các sub-groups
+// nên việc tìm route chỉ cần một lần duyệt trie, tỉ lệ với độ dài path.
 type DefaultRouter struct {
 	// basePath là tiền tố đường dẫn cho tất cả routes trong router này
 	basePath string
 
 	// routes là danh sách các routes đã đăng ký
-	routes []Route
+	routes cowSlice[Route]
 
 	// middlewares là danh sách các middleware functions áp dụng cho tất cả routes
-	middlewares []HandlerFunc
+	middlewares cowSlice[HandlerFunc]
 
 	// groups là danh sách các sub-routers (groups) của router này
-	groups []*DefaultRouter
+	groups cowSlice[*DefaultRouter]
+
+	// parent là router cha của group, nil với router gốc
+	parent *DefaultRouter
+
+	// mu là khóa ghi bảng route của cả cây router, chỉ được dùng ở router gốc (xem writeLock)
+	mu sync.Mutex
 
 	// trie cho việc tìm kiếm route nhanh chóng
 	trie *RouteTrie
 
 	// enableTrie bật/tắt việc sử dụng trie (mặc định: true)
 	enableTrie bool
+
+	// observers nhận thông báo khi request khớp route
+	observers []MatchObserver
+
+	// disableAutoOptions tắt phản hồi OPTIONS tự động (mặc định: bật)
+	disableAutoOptions bool
+
+	// useRawPath so khớp route theo path chưa decode của request (mặc định: tắt)
+	useRawPath bool
+
+	// rejectEncodedSlash từ chối request có "%2F" trong path (mặc định: tắt)
+	rejectEncodedSlash bool
+
+	// optionsHandler tùy biến phản hồi OPTIONS tự động, ví dụ cho CORS preflight
+	optionsHandler OptionsHandler
+
+	// redirectTrailingSlash chuyển hướng request khác route ở dấu "/" cuối (mặc định: tắt)
+	redirectTrailingSlash bool
+
+	// redirectFixedPath chuyển hướng tới path đã làm sạch và sửa hoa thường (mặc định: tắt)
+	redirectFixedPath bool
+
+	// noRoute là chuỗi handlers cho request không khớp route (mặc định: 404 dạng text)
+	noRoute cowSlice[HandlerFunc]
+
+	// methodNotAllowed trả về 405 cho path khớp route với method khác (mặc định: tắt)
+	methodNotAllowed bool
+
+	// noMethod là chuỗi handlers cho phản hồi 405 (mặc định: 405 dạng text)
+	noMethod cowSlice[HandlerFunc]
+
+	// host là mẫu host của host group, nil nếu group không ràng buộc host
+	host *hostPattern
+
+	// version là API version của version group theo header, rỗng nếu group không ràng buộc version
+	version string
+
+	// defaultVersion là version áp dụng cho request không khai báo version (chỉ dùng ở router gốc)
+	defaultVersion string
+
+	// scopes là danh sách group ràng buộc (host groups, version groups theo header),
+	// chỉ được dùng ở router gốc
+	scopes cowSlice[*DefaultRouter]
+
+	// scopeChain là group ràng buộc này và các group ràng buộc chứa nó, từ trong ra ngoài;
+	// được tính khi tạo group để so khớp request không cần duyệt parent
+	scopeChain []*DefaultRouter
+
+	// conflictPolicy xác định cách xử lý route xung đột (mặc định: ConflictIgnore)
+	conflictPolicy ConflictPolicy
+
+	// precedence xác định route được chọn khi nhiều route cùng khớp (mặc định: PrecedenceSpecificity)
+	precedence RoutePrecedence
+
+	// conflicts là lỗi của các route bị từ chối khi đăng ký (ConflictError, method không hợp lệ)
+	conflicts cowSlice[error]
+
+	// allowedMethods là tập HTTP method được phép đăng ký, nil để chấp nhận mọi method hợp lệ
+	allowedMethods map[string]bool
+
+	// matchTrace ghi lại quá trình so khớp route của mỗi request (mặc định: tắt)
+	matchTrace bool
+
+	// bodyLimit là kích thước tối đa (bytes) của body request cho routes của router, 0 nếu không giới hạn
+	bodyLimit int64
+
+	// validator là validator của ứng dụng được gắn vào context của mỗi request (chỉ dùng ở router gốc).
+	// Requests đọc không cần khóa nên được lưu qua atomic.Pointer
+	validator atomic.Pointer[forkCtx.Validator]
+
+	// logger là logger của ứng dụng được gắn vào context của mỗi request (chỉ dùng ở router gốc)
+	logger atomic.Pointer[forkCtx.Logger]
+
+	// eagerRequestID bật việc gán request ID cho mọi request trước khi vào handler
+	// (chỉ dùng ở router gốc, mặc định: tắt)
+	eagerRequestID atomic.Bool
 }
 
 // NewRouter tạo một instance mới của DefaultRouter.
@@ -122,12 +330,9 @@ type DefaultRouter struct {
 //   - Router: Instance mới của DefaultRouter đã được khởi tạo
 func NewRouter() Router {
 	return &DefaultRouter{
-		basePath:    "",
-		routes:      make([]Route, 0),
-		middlewares: make([]HandlerFunc, 0),
-		groups:      make([]*DefaultRouter, 0),
-		trie:        NewRouteTrie(),
-		enableTrie:  true,
+		basePath:   "",
+		trie:       NewRouteTrie(),
+		enableTrie: true,
 	}
 }
 
@@ -139,38 +344,88 @@ func NewRouter() Router {
 //   - method: HTTP method (GET, POST, PUT, DELETE, v.v.)
 //   - path: URL path pattern cho route
 //   - handlers: Danh sách các handlers xử lý request
-func (r *DefaultRouter) Handle(method string, path string, handlers ...HandlerFunc) {
+//
+// Returns:
+//   - *RouteBuilder: Builder để cấu hình thêm cho route (ví dụ: middleware riêng)
+func (r *DefaultRouter) Handle(method string, path string, handlers ...HandlerFunc) *RouteBuilder {
+	// Khóa ghi để đăng ký an toàn trong khi router đang phục vụ requests
+	defer r.writeLock()()
+
 	// Tính toán đường dẫn tuyệt đối bằng cách kết hợp basePath và path
 	absolutePath := r.calculateAbsolutePath(path)
 
-	// Kết hợp middlewares của router với handlers được cung cấp
-	finalHandlers := r.combineHandlers(handlers)
+	// Kết hợp middlewares của router với handlers được cung cấp;
+	// middlewares của route (RouteBuilder.Use) được chèn giữa hai phần này
+	chain := newRouteChain(chainState{
+		handlers:  r.combineHandlers(handlers),
+		insertAt:  len(r.middlewares.load()),
+		bodyLimit: r.bodyLimit,
+	})
 
 	// Tạo một handler duy nhất gọi chuỗi handlers
 	finalHandler := func(ctx forkCtx.Context) {
 		// Thiết lập handlers trong context để sử dụng với Next()
 		// Convert the HandlerFunc to the expected func(context.Context) type
-		contextHandlers := make([]func(forkCtx.Context), len(finalHandlers))
-		for i, h := range finalHandlers {
+		state := chain.load()
+		contextHandlers := make([]func(forkCtx.Context), len(state.handlers))
+		for i, h := range state.handlers {
 			contextHandlers[i] = h
 		}
 
+		// Route có giới hạn body từ chối request quá lớn trước khi handlers chạy
+		if state.bodyLimit > 0 {
+			body, ok := limitBody(ctx, state.bodyLimit)
+			if !ok {
+				return
+			}
+			defer body.finish(ctx)
+		}
+
+		// Route có timeout chạy chuỗi handlers với context có deadline
+		if state.timeout > 0 {
+			runWithTimeout(ctx, state.timeout, contextHandlers)
+			return
+		}
+
 		ctx.SetHandlers(contextHandlers)
 		// Bắt đầu chuỗi xử lý
 		ctx.Next()
 	}
 
+	// Metadata dùng chung giữa route đã đăng ký và RouteBuilder
+	meta := make(map[string]interface{})
+
+	// Kiểm tra method và xung đột với routes đã đăng ký theo ConflictPolicy
+	if err := r.checkMethod(method, absolutePath); err != nil {
+		return &RouteBuilder{method: method, path: absolutePath, chain: chain, meta: meta, err: err}
+	}
+	if err := r.checkConflict(method, absolutePath); err != nil {
+		return &RouteBuilder{method: method, path: absolutePath, chain: chain, meta: meta, err: err}
+	}
+
 	// Thêm route mới vào danh sách routes
-	r.routes = append(r.routes, Route{
+	route := Route{
 		Method:  method,
 		Path:    absolutePath,
 		Handler: finalHandler,
-	})
+		Meta:    meta,
+		Group:   r.basePath,
+		chain:   chain,
+	}
+	r.routes.add(route)
 
-	// Thêm route vào trie để tối ưu hóa tìm kiếm (nếu trie được bật)
-	if r.enableTrie && r.trie != nil {
-		r.trie.Insert(method, absolutePath, finalHandler)
+	// Thêm route vào trie của router này và của các router cha (nếu trie được bật).
+	// Routes của group ràng buộc (host, version) không được thêm vào trie của các router phía trên group.
+	for owner := r; owner != nil; owner = owner.parent {
+		if owner.enableTrie && owner.trie != nil {
+			owner.trie.insertRoute(route)
+		}
+		if owner.scoped() {
+			break
+		}
 	}
+
+	return &RouteBuilder{method: method, path: absolutePath, chain: chain, meta: meta, router: r}
 }
 
 // Group tạo một router group mới với prefix đường dẫn.
@@ -178,24 +433,44 @@ func (r *DefaultRouter) Handle(method string, path string, handlers ...HandlerFu
 //
 // Parameters:
 //   - prefix: Tiền tố đường dẫn cho group
+//   - opts: Các option của group (ví dụ: WithoutParentMiddleware)
 //
 // Returns:
 //   - Router: Router mới đã được tạo với prefix
-func (r *DefaultRouter) Group(prefix string) Router {
+func (r *DefaultRouter) Group(prefix string, opts ...GroupOption) Router {
 	group := &DefaultRouter{
-		basePath:    r.calculateAbsolutePath(prefix),
-		routes:      make([]Route, 0),
-		middlewares: make([]HandlerFunc, 0),
-		groups:      make([]*DefaultRouter, 0),
-		trie:        NewRouteTrie(),
-		enableTrie:  r.enableTrie,
+		basePath:   r.calculateAbsolutePath(prefix),
+		parent:     r,
+		trie:       NewRouteTrie(),
+		enableTrie: r.enableTrie,
+		precedence: r.precedence,
+		bodyLimit:  r.bodyLimit,
+	}
+	group.trie.setPrecedence(group.precedence)
+
+	// Sao chép middlewares hiện tại vào group
+	group.middlewares.store(append([]HandlerFunc(nil), r.middlewares.load()...))
+
+	// Options cấu hình group trước khi group được công bố cho requests
+	for _, opt := range opts {
+		if opt != nil {
+			opt(group)
+		}
+	}
+	if group.scoped() {
+		for g := group; g != nil; g = g.parent {
+			if g.scoped() {
+				group.scopeChain = append(group.scopeChain, g)
+			}
+		}
 	}
 
-	// Thêm middlewares hiện tại vào group
-	group.middlewares = append(group.middlewares, r.middlewares...)
-
-	// Thêm group vào router cha
-	r.groups = append(r.groups, group)
+	// Thêm group vào router cha; group ràng buộc được đăng ký ở router gốc
+	defer r.writeLock()()
+	r.groups.add(group)
+	if group.scoped() {
+		r.addScope(group)
+	}
 
 	return group
 }
@@ -211,28 +486,95 @@ func (r *DefaultRouter) Group(prefix string) Router {
 func (r *DefaultRouter) RemoveGroup(prefix string) bool {
 	absolutePrefix := r.calculateAbsolutePath(prefix)
 
-	for i, group := range r.groups {
+	defer r.writeLock()()
+	groups := r.groups.load()
+	for i, group := range groups {
 		if group.basePath == absolutePrefix {
-			// Clear the group's resources before removing
-			group.Clear()
+			// Unpublish the group first so new requests no longer reach it,
+			// then clear its resources
+			r.groups.store(without(groups, i))
+			r.root().removeScopes(group)
+			group.clear()
+			group.parent = nil
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveRoute gỡ route đã đăng ký khỏi router hoặc sub-group sở hữu nó, đồng thời
+// gỡ route khỏi trie của các router cha. Dùng để hủy đăng ký endpoints lúc runtime,
+// ví dụ khi plugin bị vô hiệu hóa.
+//
+// Parameters:
+//   - method: HTTP method của route
+//   - path: URL path pattern của route, tương đối với router hiện tại
+//
+// Returns:
+//   - bool: true nếu route tồn tại và đã được gỡ
+func (r *DefaultRouter) RemoveRoute(method string, path string) bool {
+	defer r.writeLock()()
+	return r.removeRoute(method, r.calculateAbsolutePath(path))
+}
+
+// removeRoute tìm và gỡ route theo đường dẫn tuyệt đối trong router và các sub-groups.
+// Caller phải giữ khóa ghi.
+func (r *DefaultRouter) removeRoute(method, absolutePath string) bool {
+	routes := r.routes.load()
+	for i, route := range routes {
+		if route.Method != method || route.Path != absolutePath {
+			continue
+		}
+
+		// Gỡ route khỏi trie của router này và của các router cha như khi đăng ký
+		for owner := r; owner != nil; owner = owner.parent {
+			if owner.enableTrie && owner.trie != nil {
+				owner.trie.Remove(method, absolutePath)
+			}
+			if owner.scoped() {
+				break
+			}
+		}
 
-			// Remove from slice efficiently
-			r.groups[i] = r.groups[len(r.groups)-1]
-			r.groups[len(r.groups)-1] = nil
-			r.groups = r.groups[:len(r.groups)-1]
+		r.routes.store(without(routes, i))
+		return true
+	}
+
+	for _, group := range r.groups.load() {
+		if group.removeRoute(method, absolutePath) {
 			return true
 		}
 	}
 	return false
 }
 
+// updateRoute cập nhật route đã đăng ký của router trên bản sao của danh sách routes
+// rồi công bố bản sao đó. Caller phải giữ khóa ghi.
+//
+// Parameters:
+//   - method: HTTP method của route
+//   - path: Đường dẫn tuyệt đối của route
+//   - update: Hàm sửa route
+func (r *DefaultRouter) updateRoute(method, path string, update func(route *Route)) {
+	routes := r.routes.load()
+	for i := range routes {
+		if routes[i].Method == method && routes[i].Path == path {
+			next := append([]Route(nil), routes...)
+			update(&next[i])
+			r.routes.store(next)
+			return
+		}
+	}
+}
+
 // Use thêm middleware vào router.
 // Middleware sẽ được thực thi cho tất cả routes trong router này và các sub-groups.
 //
 // Parameters:
 //   - middleware: Danh sách các middleware functions để thêm
 func (r *DefaultRouter) Use(middleware ...HandlerFunc) {
-	r.middlewares = append(r.middlewares, middleware...)
+	defer r.writeLock()()
+	r.middlewares.add(middleware...)
 }
 
 // Static phục vụ static files từ thư mục root.
@@ -241,85 +583,84 @@ func (r *DefaultRouter) Use(middleware ...HandlerFunc) {
 // Parameters:
 //   - prefix: Tiền tố URL để phục vụ files (ví dụ: "/static")
 //   - root: Đường dẫn tới thư mục chứa static files
-func (r *DefaultRouter) Static(prefix string, root string) {
-	absolutePath := r.calculateAbsolutePath(prefix)
-	handler := func(ctx forkCtx.Context) {
-		path := ctx.Path()
-		if strings.HasPrefix(path, absolutePath) {
-			// Clean the file path to prevent path traversal attacks
-			relativePath := strings.TrimPrefix(path, absolutePath)
-
-			// Prevent path traversal by rejecting paths with ".."
-			if strings.Contains(relativePath, "..") {
-				ctx.Status(http.StatusForbidden)
-				ctx.String(http.StatusForbidden, "403 Forbidden")
-				return
-			}
-
-			// Ensure the path starts with /
-			if !strings.HasPrefix(relativePath, "/") {
-				relativePath = "/" + relativePath
-			}
-
-			// Join paths safely using filepath.Join equivalent logic
-			filePath := root + relativePath
-
-			// Additional security check: ensure final path is within root
-			if !strings.HasPrefix(filePath, root) {
-				ctx.Status(http.StatusForbidden)
-				ctx.String(http.StatusForbidden, "403 Forbidden")
-				return
-			}
-
-			ctx.File(filePath)
-		}
-	}
-	r.Handle("GET", prefix+"/*filepath", handler)
+//   - config: Cấu hình tùy chọn (index file, directory listing, 404), mặc định DefaultStaticConfig
+func (r *DefaultRouter) Static(prefix string, root string, config ...StaticConfig) {
+	r.StaticFS(prefix, os.DirFS(root), config...)
 }
 
 // Clear clears all routes, middlewares, and groups from the router
 // This method helps prevent memory leaks by properly cleaning up resources
 func (r *DefaultRouter) Clear() {
+	defer r.writeLock()()
+	r.clear()
+}
+
+// clear implements Clear; the caller must hold the write lock
+func (r *DefaultRouter) clear() {
 	// Clear all child groups first
-	for _, group := range r.groups {
+	for _, group := range r.groups.load() {
 		if group != nil {
-			group.Clear()
+			group.clear()
+		}
+	}
+
+	// Remove this router's routes from the parent tries
+	for _, route := range r.routes.load() {
+		for child := r; !child.scoped() && child.parent != nil; child = child.parent {
+			if child.parent.trie != nil {
+				child.parent.trie.Remove(route.Method, route.Path)
+			}
 		}
 	}
 
-	// Clear slices and set to nil to help GC
-	r.routes = nil
-	r.middlewares = nil
-	r.groups = nil
+	// Publish empty slices; requests still holding the old snapshots finish normally
+	r.routes.store(nil)
+	r.middlewares.store(nil)
+	r.groups.store(nil)
 
-	// Clear trie if it exists
+	// Clear trie if it exists; the trie is kept so requests never see a nil trie
 	if r.trie != nil {
 		r.trie.Clear()
-		r.trie = nil
 	}
 }
 
 // GetGroupCount returns the number of groups for monitoring memory usage
 func (r *DefaultRouter) GetGroupCount() int {
-	count := len(r.groups)
-	for _, group := range r.groups {
+	groups := r.groups.load()
+	count := len(groups)
+	for _, group := range groups {
 		count += group.GetGroupCount()
 	}
 	return count
 }
 
 // Routes trả về tất cả routes đã đăng ký.
-// Phương thức này thu thập tất cả routes từ router hiện tại và tất cả các sub-groups.
+// Phương thức này thu thập tất cả routes từ router hiện tại và tất cả các sub-groups,
+// kèm tên handlers, group và số middlewares của mỗi route để dựng bảng route.
 //
 // Returns:
 //   - []Route: Danh sách tất cả routes đã đăng ký
 func (r *DefaultRouter) Routes() []Route {
-	routes := r.routes
+	routes := r.allRoutes()
+	for i := range routes {
+		routes[i].describe()
+	}
+	return routes
+}
+
+// allRoutes thu thập routes của router và các sub-groups mà không mô tả handlers,
+// dùng cho các tra cứu nội bộ không cần tên handlers.
+//
+// Returns:
+//   - []Route: Bản sao danh sách routes đã đăng ký
+func (r *DefaultRouter) allRoutes() []Route {
+	own := r.routes.load()
+	routes := make([]Route, len(own))
+	copy(routes, own)
 
 	// Thêm routes từ groups
-	for _, group := range r.groups {
-		groupRoutes := group.Routes()
-		routes = append(routes, groupRoutes...)
+	for _, group := range r.groups.load() {
+		routes = append(routes, group.allRoutes()...)
 	}
 
 	return routes
@@ -332,11 +673,46 @@ func (r *DefaultRouter) Routes() []Route {
 //   - w: HTTP response writer
 //   - req: HTTP request
 func (r *DefaultRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	// Tạo context mới từ request và response
-	ctx := forkCtx.NewContext(w, req)
+	// Lấy context từ pool, trả lại sau khi request được xử lý xong
+	ctx := forkCtx.AcquireContext(w, req)
+	root := r.root()
+	if v := root.validator.Load(); v != nil {
+		ctx.SetValidator(*v)
+	}
+	if logger := root.logger.Load(); logger != nil {
+		ctx.SetLogger(*logger)
+	}
+	// Request ID được sinh khi handler/logger cần tới, trừ khi bật SetEagerRequestID
+	if root.eagerRequestID.Load() {
+		ctx.RequestID()
+	}
 
 	// Chuyển request đến handler phù hợp
 	r.handleRequest(ctx)
+
+	forkCtx.ReleaseContext(ctx)
+}
+
+// root trả về router gốc của cây router chứa r.
+func (r *DefaultRouter) root() *DefaultRouter {
+	root := r
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root
+}
+
+// writeLock khóa ghi bảng route của cả cây router và trả về hàm mở khóa.
+// Mọi thao tác thay đổi routes, groups, middlewares hoặc handlers dự phòng giữ khóa này
+// và công bố slice mới (copy-on-write), nên requests đang được phục vụ đọc bảng route
+// mà không cần khóa và đăng ký route lúc runtime (ví dụ từ plugin) là an toàn.
+//
+// Returns:
+//   - func(): Hàm mở khóa
+func (r *DefaultRouter) writeLock() func() {
+	root := r.root()
+	root.mu.Lock()
+	return root.mu.Unlock
 }
 
 // calculateAbsolutePath tính toán đường dẫn tuyệt đối từ đường dẫn tương đối.
@@ -369,10 +745,11 @@ func (r *DefaultRouter) calculateAbsolutePath(relativePath string) string {
 // Returns:
 //   - []HandlerFunc: Mảng đã kết hợp các middlewares và handlers
 func (r *DefaultRouter) combineHandlers(handlers []HandlerFunc) []HandlerFunc {
-	finalSize := len(r.middlewares) + len(handlers)
+	middlewares := r.middlewares.load()
+	finalSize := len(middlewares) + len(handlers)
 	mergedHandlers := make([]HandlerFunc, finalSize)
-	copy(mergedHandlers, r.middlewares)
-	copy(mergedHandlers[len(r.middlewares):], handlers)
+	copy(mergedHandlers, middlewares)
+	copy(mergedHandlers[len(middlewares):], handlers)
 	return mergedHandlers
 }
 
@@ -382,36 +759,95 @@ func (r *DefaultRouter) combineHandlers(handlers []HandlerFunc) []HandlerFunc {
 // Parameters:
 //   - ctx: Context của HTTP request/response
 func (r *DefaultRouter) handleRequest(ctx forkCtx.Context) {
-	// Tìm route phù hợp với method và path
-	route := r.findRoute(ctx.Method(), ctx.Path())
+	// Tìm route phù hợp với host, version, method và path; routes của group ràng buộc được ưu tiên
+	var route *Route
+	var params map[string]string
+	path, valid := r.matchPath(ctx)
+	if valid && len(r.scopes.load()) > 0 {
+		route, params = r.findScopedRoute(ctx.Method(), requestHost(ctx), r.requestVersion(ctx), path)
+	}
+	if valid && route == nil {
+		route, params = r.findRoute(ctx.Method(), path)
+	}
+	if r.matchTrace {
+		ctx.Set(MatchTraceKey, r.traceMatch(ctx, path, route))
+	}
 	if route == nil {
+		// Path chứa "%2F" bị từ chối, trả về 404 Not Found
+		if !valid {
+			r.serveNotFound(ctx)
+			return
+		}
+
+		// Chuyển hướng tới path đã sửa nếu path sạch/đúng hoa thường khớp route
+		if r.redirectFixedPath {
+			if target, ok := r.fixedPath(ctx.Method(), path); ok {
+				r.redirect(ctx, target)
+				return
+			}
+		}
+
+		// Trả lời OPTIONS tự động cho path có routes đã đăng ký
+		if ctx.Method() == http.MethodOptions && r.serveAutoOptions(ctx, path) {
+			return
+		}
+
+		// Path khớp route với method khác, trả về 405 Method Not Allowed
+		if r.serveMethodNotAllowed(ctx, path) {
+			return
+		}
+
 		// Không tìm thấy route, trả về 404 Not Found
-		ctx.Status(http.StatusNotFound)
-		ctx.String(http.StatusNotFound, "404 page not found")
+		r.serveNotFound(ctx)
 		return
 	}
 
-	// Thiết lập tham số URL vào context
-	r.setRouteParams(ctx, route.Path, ctx.Path())
+	// Chuyển hướng về path chuẩn nếu khác route ở dấu "/" cuối
+	if r.redirectTrailingSlash {
+		if target, ok := trailingSlashTarget(route.Path, path); ok {
+			r.redirect(ctx, target)
+			return
+		}
+	}
+
+	// Thiết lập tham số URL, pattern và metadata của route vào context
+	if r.useRawPath {
+		unescapeParams(params)
+	}
+	r.setRouteParams(ctx, params)
+	ctx.SetFullPath(route.Path)
+	if len(route.Meta) > 0 {
+		ctx.SetRouteMeta(route.Meta)
+	}
+
+	for _, observer := range r.observers {
+		observer(ctx, *route)
+	}
 
 	// Thực thi handler của route đã tìm thấy
 	route.Handler(ctx)
 }
 
+// Observe đăng ký observer được gọi mỗi khi request khớp route, kể cả routes của groups.
+// Dùng cho các công cụ như đo route coverage trong test; cần đăng ký trước khi phục vụ request.
+//
+// Parameters:
+//   - observer: Hàm nhận context và route đã khớp
+func (r *DefaultRouter) Observe(observer MatchObserver) {
+	if observer != nil {
+		r.observers = append(r.observers, observer)
+	}
+}
+
 // setRouteParams thiết lập route parameters vào context.
-// Trích xuất các tham số từ path pattern và URL path thực tế.
 //
 // Parameters:
 //   - ctx: Context của HTTP request
-//   - pattern: URL path pattern của route
-//   - path: URL path thực tế của request
-func (r *DefaultRouter) setRouteParams(ctx forkCtx.Context, pattern, path string) {
-	// Trích xuất các tham số từ đường dẫn URL
-	params := r.extractParams(pattern, path)
-
+//   - params: Tham số của route đã khớp
+func (r *DefaultRouter) setRouteParams(ctx forkCtx.Context, params map[string]string) {
 	// Lưu trữ các tham số vào context
-	for k, v := range params {
-		ctx.Set("param:"+k, v)
+	if len(params) > 0 {
+		ctx.SetParams(params)
 	}
 }
 
@@ -425,16 +861,16 @@ func (r *DefaultRouter) setRouteParams(ctx forkCtx.Context, pattern, path string
 // Returns:
 //   - HandlerFunc: Handler cho route được tìm thấy hoặc nil nếu không tìm thấy
 func (r *DefaultRouter) Find(method, path string) HandlerFunc {
-	route := r.findRoute(method, path)
+	route, _ := r.findRoute(method, path)
 	if route != nil {
 		return route.Handler
 	}
 	return nil
 }
 
-// findRoute tìm route phù hợp với method và path.
-// Phương thức này tìm kiếm trong tất cả routes đã đăng ký để tìm route khớp với method và path.
-// Sử dụng trie structure để tối ưu hiệu suất khi được bật.
+// findRoute tìm route phù hợp với method và path cùng các tham số của route.
+// Khi trie được bật, route và tham số được lấy trực tiếp từ một lần duyệt trie (O(độ dài path)),
+// trie này chứa cả routes của các sub-groups. Tìm kiếm tuyến tính chỉ được dùng khi trie bị tắt.
 //
 // Parameters:
 //   - method: HTTP method của request
@@ -442,35 +878,30 @@ func (r *DefaultRouter) Find(method, path string) HandlerFunc {
 //
 // Returns:
 //   - *Route: Route được tìm thấy hoặc nil nếu không tìm thấy
-func (r *DefaultRouter) findRoute(method, path string) *Route {
-	// Sử dụng trie search nếu được bật (tối ưu hiệu suất O(log n))
+//   - map[string]string: Tham số của route
+func (r *DefaultRouter) findRoute(method, path string) (*Route, map[string]string) {
 	if r.enableTrie && r.trie != nil {
-		if handler := r.trie.Find(method, path); handler != nil {
-			// Tìm route tương ứng trong danh sách routes để trả về đầy đủ thông tin
-			for _, route := range r.routes {
-				if route.Method == method && r.pathMatch(route.Path, path) {
-					return &route
-				}
-			}
-		}
+		return r.trie.Lookup(method, path)
 	}
 
-	// Fallback to linear search nếu trie không được bật hoặc không tìm thấy
-	// Kiểm tra các routes trong router hiện tại
-	for _, route := range r.routes {
+	// Tìm kiếm tuyến tính khi trie không được bật
+	for _, route := range r.routes.load() {
 		if route.Method == method && r.pathMatch(route.Path, path) {
-			return &route
+			return &route, r.extractParams(route.Path, path)
 		}
 	}
 
-	// Kiểm tra trong các groups
-	for _, group := range r.groups {
-		if route := group.findRoute(method, path); route != nil {
-			return route
+	// Kiểm tra trong các groups (group ràng buộc được tìm riêng theo host, version của request)
+	for _, group := range r.groups.load() {
+		if group.scoped() {
+			continue
+		}
+		if route, params := group.findRoute(method, path); route != nil {
+			return route, params
 		}
 	}
 
-	return nil
+	return nil, nil
 }
 
 // extractParams trích xuất các tham số từ đường dẫn URL.
@@ -593,10 +1024,16 @@ func (r *DefaultRouter) extractParams(pattern, path string) map[string]string {
 	if wildcardIndex >= 0 && wildcardIndex < len(patternSegments) {
 		wildcardName := patternSegments[wildcardIndex][1:] // Bỏ dấu '*'
 
-		// Thu thập tất cả các segments còn lại
-		if wildcardIndex < len(pathSegments) {
-			wildcardValue := strings.Join(pathSegments[wildcardIndex:], "/")
+		// Thu thập các segments còn lại, trừ các segments khớp với phần sau wildcard
+		end := len(pathSegments) - (len(patternSegments) - wildcardIndex - 1)
+		if wildcardIndex < end {
+			wildcardValue := strings.Join(pathSegments[wildcardIndex:end], "/")
 			params[wildcardName] = wildcardValue
+			for i, segment := range patternSegments[wildcardIndex+1:] {
+				if _, paramName := r.segmentMatch(segment, pathSegments[end+i]); paramName != "" {
+					params[paramName] = pathSegments[end+i]
+				}
+			}
 		} else {
 			// Trường hợp wildcard không khớp với segment nào
 			params[wildcardName] = ""
@@ -681,7 +1118,23 @@ func (r *DefaultRouter) pathMatch(pattern, path string) bool {
 			}
 		}
 
-		// Wildcard luôn khớp với phần còn lại của path
+		// Wildcard cuối pattern luôn khớp với phần còn lại của path
+		suffix := patternSegments[wildcardIndex+1:]
+		if len(suffix) == 0 {
+			return true
+		}
+
+		// Wildcard giữa pattern nhận ít nhất một segment, các segments cuối của path
+		// phải khớp với phần sau wildcard
+		start := len(pathSegments) - len(suffix)
+		if start <= wildcardIndex {
+			return false
+		}
+		for i, segment := range suffix {
+			if match, _ := r.segmentMatch(segment, pathSegments[start+i]); !match {
+				return false
+			}
+		}
 		return true
 	}
 	// Xử lý các route không có wildcard
@@ -724,7 +1177,8 @@ func (r *DefaultRouter) pathMatch(pattern, path string) bool {
 }
 
 // specialCaseMatch xử lý các trường hợp đặc biệt cho routes có optional parameters
-// Xử lý các trường hợp như /api/:version?/users khớp với /api/users.
+// Xử lý các trường hợp như /api/:version?/users khớp với /api/users, kể cả khi nhiều
+// optional parameter liên tiếp bị bỏ qua (/api/:a?/:b?/users với /api/users).
 //
 // Parameters:
 //   - pattern: URL path pattern
@@ -733,45 +1187,55 @@ func (r *DefaultRouter) pathMatch(pattern, path string) bool {
 // Returns:
 //   - bool: true nếu path khớp với pattern theo trường hợp đặc biệt, ngược lại là false
 func (r *DefaultRouter) specialCaseMatch(pattern, path string) bool {
-	// Xử lý trường hợp đặc biệt: /api/:version?/users với /api/users
-	// hoặc /optional/:param?/test với /optional/test
-
 	// Chia pattern và path thành các phần (segments)
 	patternSegments := r.splitPath(pattern)
 	pathSegments := r.splitPath(path)
 
-	// Nếu số lượng phân đoạn không chênh lệch 1, không phải trường hợp đặc biệt
-	if len(patternSegments) != len(pathSegments)+1 {
+	// Chỉ xử lý khi pattern dài hơn path (có optional bị bỏ qua)
+	if len(patternSegments) <= len(pathSegments) {
 		return false
 	}
 
-	// Tìm phân đoạn optional
-	optionalIndex := -1
-	for i, segment := range patternSegments {
-		if r.isOptionalSegment(segment) {
-			optionalIndex = i
-			break
+	// Quy hoạch động trên cặp vị trí (pattern, path) để tránh bùng nổ tổ hợp khi
+	// có nhiều optional parameter: memo[i][j] = 0 chưa tính, 1 khớp, 2 không khớp
+	cols := len(pathSegments) + 1
+	memo := make([]uint8, (len(patternSegments)+1)*cols)
+
+	var match func(i, j int) bool
+	match = func(i, j int) bool {
+		if i == len(patternSegments) {
+			return j == len(pathSegments)
+		}
+		if cached := memo[i*cols+j]; cached != 0 {
+			return cached == 1
 		}
-	}
 
-	// Nếu không tìm thấy phân đoạn optional, hoặc phân đoạn optional ở cuối, không phải trường hợp đặc biệt
-	if optionalIndex < 0 || optionalIndex == len(patternSegments)-1 {
-		return false
-	}
+		segment := patternSegments[i]
+		var result bool
+		switch {
+		case strings.HasPrefix(segment, "*"):
+			// Wildcard cuối pattern khớp với toàn bộ phần còn lại của path;
+			// wildcard giữa pattern nhận ít nhất một segment
+			result = i == len(patternSegments)-1
+			for k := len(pathSegments); !result && k > j; k-- {
+				result = match(i+1, k)
+			}
+		case r.isOptionalSegment(segment) && match(i+1, j):
+			// Bỏ qua optional parameter
+			result = true
+		case j < len(pathSegments):
+			ok, _ := r.segmentMatch(segment, pathSegments[j])
+			result = ok && match(i+1, j+1)
+		}
 
-	// Tạo pattern mới bằng cách loại bỏ phân đoạn optional
-	newPatternSegments := make([]string, 0, len(patternSegments)-1)
-	for i, segment := range patternSegments {
-		if i != optionalIndex {
-			newPatternSegments = append(newPatternSegments, segment)
+		memo[i*cols+j] = 2
+		if result {
+			memo[i*cols+j] = 1
 		}
+		return result
 	}
 
-	// Tạo pattern mới
-	newPattern := "/" + strings.Join(newPatternSegments, "/")
-
-	// Kiểm tra pattern mới với path
-	return r.pathMatch(newPattern, path)
+	return match(0, 0)
 }
 
 // isOptionalSegment kiểm tra xem một phân đoạn có phải là optional không.
@@ -0,0 +1,206 @@
+package router
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"go.fork.vn/fork/context"
+)
+
+// routeSeeds là các cặp pattern/path dùng làm corpus khởi đầu cho fuzz targets
+var routeSeeds = [][2]string{
+	{"/", "/"},
+	{"/users", "/users"},
+	{"/users/:id", "/users/123"},
+	{"/users/:id<\\d+>", "/users/abc"},
+	{"/users/:id?", "/users"},
+	{"/api/:version?/users", "/api/users"},
+	{"/api/:version?/users", "/api/v1/users"},
+	{"/files/*filepath", "/files/images/logo.png"},
+	{"/files/*filepath", "/files"},
+	{"/posts/:year<\\d{4}>/:slug?", "/posts/2024"},
+	{"/a/:b/:c?/*d", "/a/x/y/z/w"},
+	{"/unicode/:name", "/unicode/tiếng-việt"},
+	{"/encoded/:segment", "/encoded/a%2Fb"},
+	{"/trailing/", "/trailing"},
+	{"//double//slash", "/double/slash"},
+	{"/:a/:b", "//ab"},
+	{"/:a?/:b?/:c?", "/"},
+	{"/broken/:id<[>", "/broken/1"},
+}
+
+// FuzzPathMatch kiểm tra pathMatch, extractParams và segmentMatch không panic với đầu vào
+// bất kỳ, và pattern tĩnh luôn khớp với chính nó.
+func FuzzPathMatch(f *testing.F) {
+	for _, seed := range routeSeeds {
+		f.Add(seed[0], seed[1])
+	}
+
+	r := NewRouter().(*DefaultRouter)
+	f.Fuzz(func(t *testing.T, pattern, path string) {
+		matched := r.pathMatch(pattern, path)
+		params := r.extractParams(pattern, path)
+
+		if matched {
+			for name := range params {
+				if strings.Contains(name, "/") {
+					t.Errorf("pathMatch(%q, %q): param name %q contains '/'", pattern, path, name)
+				}
+			}
+		}
+
+		if isStaticPattern(pattern) && !r.pathMatch(pattern, pattern) {
+			t.Errorf("Static pattern %q does not match itself", pattern)
+		}
+	})
+}
+
+// FuzzExtractParams sinh route hợp lệ từ các thành phần ngẫu nhiên và kiểm tra giá trị
+// tham số được trích xuất đúng.
+func FuzzExtractParams(f *testing.F) {
+	f.Add("users", "id", "123", "files", "a/b.txt")
+	f.Add("api", "version", "v1", "assets", "")
+	f.Add("ngườidùng", "tên", "giá-trị", "tệp", "thư-mục/ảnh.png")
+	f.Add("encoded", "value", "a%20b", "rest", "x%2Fy")
+
+	r := NewRouter().(*DefaultRouter)
+	f.Fuzz(func(t *testing.T, static, name, value, wildcardStatic, wildcard string) {
+		if !isSegment(static) || !isParamName(name) || !isSegment(value) {
+			t.Skip()
+		}
+
+		pattern := "/" + static + "/:" + name
+		path := "/" + static + "/" + value
+		if !r.pathMatch(pattern, path) {
+			t.Fatalf("pathMatch(%q, %q) = false", pattern, path)
+		}
+		if got := r.extractParams(pattern, path)[name]; got != value {
+			t.Errorf("extractParams(%q, %q)[%q] = %q, expected %q", pattern, path, name, got, value)
+		}
+
+		// Optional parameter có thể bị bỏ qua
+		optional := pattern + "?"
+		if !r.pathMatch(optional, "/"+static) {
+			t.Errorf("pathMatch(%q, %q) = false", optional, "/"+static)
+		}
+		if got, ok := r.extractParams(optional, "/"+static)[name]; !ok || got != "" {
+			t.Errorf("extractParams(%q, %q)[%q] = %q, %v; expected empty value", optional, "/"+static, name, got, ok)
+		}
+
+		// Wildcard nhận toàn bộ phần còn lại của path
+		if !isSegment(wildcardStatic) || wildcardStatic == static {
+			return
+		}
+		segments := splitClean(wildcard)
+		if segments == nil {
+			return
+		}
+		wildcardPattern := "/" + wildcardStatic + "/*" + name
+		wildcardPath := "/" + wildcardStatic + "/" + strings.Join(segments, "/")
+		if !r.pathMatch(wildcardPattern, wildcardPath) {
+			t.Fatalf("pathMatch(%q, %q) = false", wildcardPattern, wildcardPath)
+		}
+		if got := r.extractParams(wildcardPattern, wildcardPath)[name]; got != strings.Join(segments, "/") {
+			t.Errorf("extractParams(%q, %q)[%q] = %q", wildcardPattern, wildcardPath, name, got)
+		}
+	})
+}
+
+// FuzzTrieMatchesPathMatch kiểm tra trie và thuật toán so khớp tuyến tính cho cùng kết quả
+// với các route không chứa regex.
+func FuzzTrieMatchesPathMatch(f *testing.F) {
+	for _, seed := range routeSeeds {
+		f.Add(seed[0], seed[1])
+	}
+
+	handler := func(context.Context) {}
+	f.Fuzz(func(t *testing.T, pattern, path string) {
+		if !isTriePattern(pattern) || !utf8.ValidString(path) {
+			t.Skip()
+		}
+
+		r := NewRouter().(*DefaultRouter)
+		trie := NewRouteTrie()
+		trie.Insert("GET", pattern, handler)
+
+		trieMatch := trie.Find("GET", path) != nil
+		linearMatch := r.pathMatch(pattern, path)
+		if trieMatch != linearMatch {
+			t.Errorf("Pattern %q with path %q: trie match %v, linear match %v", pattern, path, trieMatch, linearMatch)
+		}
+		if !trieMatch {
+			return
+		}
+
+		_, params := trie.Lookup("GET", path)
+		expected := r.extractParams(pattern, path)
+		if len(params) != len(expected) {
+			t.Errorf("Pattern %q with path %q: trie params %v, linear params %v", pattern, path, params, expected)
+		}
+		for name, value := range expected {
+			if params[name] != value {
+				t.Errorf("Pattern %q with path %q: trie params %v, linear params %v", pattern, path, params, expected)
+				break
+			}
+		}
+	})
+}
+
+// isSegment kiểm tra s là một segment path hợp lệ, không rỗng và không chứa ký tự đặc biệt của route.
+func isSegment(s string) bool {
+	return s != "" && utf8.ValidString(s) && !strings.ContainsAny(s, "/:*?<>")
+}
+
+// isParamName kiểm tra s có thể dùng làm tên tham số.
+func isParamName(s string) bool {
+	return isSegment(s) && !strings.ContainsAny(s, " \t\r\n")
+}
+
+// isStaticPattern kiểm tra pattern chỉ gồm các segment tĩnh.
+func isStaticPattern(pattern string) bool {
+	return utf8.ValidString(pattern) && !strings.ContainsAny(pattern, ":*?<>")
+}
+
+// isTriePattern kiểm tra pattern gồm các segment tĩnh, tham số, tham số optional hoặc
+// wildcard ở cuối, không có regex constraint.
+func isTriePattern(pattern string) bool {
+	segments := splitClean(pattern)
+	if segments == nil {
+		return false
+	}
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, "*"):
+			if i != len(segments)-1 || !isParamName(segment[1:]) {
+				return false
+			}
+		case strings.HasPrefix(segment, ":"):
+			if !isParamName(strings.TrimSuffix(segment[1:], "?")) {
+				return false
+			}
+		case !isSegment(segment):
+			return false
+		}
+	}
+	return true
+}
+
+// splitClean chia path thành các segment, trả về nil nếu path có segment rỗng
+// (ví dụ "//") hoặc không phải UTF-8 hợp lệ.
+func splitClean(path string) []string {
+	if !utf8.ValidString(path) || !strings.HasPrefix(path, "/") {
+		return nil
+	}
+	trimmed := strings.Trim(path, "/")
+	if trimmed == "" {
+		return []string{}
+	}
+	segments := strings.Split(trimmed, "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil
+		}
+	}
+	return segments
+}
@@ -25,16 +25,16 @@ func TestNewRouter(t *testing.T) {
 		t.Errorf("Expected empty basePath, got '%s'", r.basePath)
 	}
 
-	if len(r.routes) != 0 {
-		t.Errorf("Expected empty routes, got %d routes", len(r.routes))
+	if len(r.routes.load()) != 0 {
+		t.Errorf("Expected empty routes, got %d routes", len(r.routes.load()))
 	}
 
-	if len(r.middlewares) != 0 {
-		t.Errorf("Expected empty middlewares, got %d middlewares", len(r.middlewares))
+	if len(r.middlewares.load()) != 0 {
+		t.Errorf("Expected empty middlewares, got %d middlewares", len(r.middlewares.load()))
 	}
 
-	if len(r.groups) != 0 {
-		t.Errorf("Expected empty groups, got %d groups", len(r.groups))
+	if len(r.groups.load()) != 0 {
+		t.Errorf("Expected empty groups, got %d groups", len(r.groups.load()))
 	}
 }
 
@@ -51,11 +51,11 @@ func TestDefaultRouter_Handle(t *testing.T) {
 	router.Handle("GET", "/test", handler)
 
 	// Kiểm tra route đã được đăng ký
-	if len(router.routes) != 1 {
-		t.Fatalf("Expected 1 route, got %d", len(router.routes))
+	if len(router.routes.load()) != 1 {
+		t.Fatalf("Expected 1 route, got %d", len(router.routes.load()))
 	}
 
-	route := router.routes[0]
+	route := router.routes.load()[0]
 	if route.Method != "GET" {
 		t.Errorf("Expected method GET, got %s", route.Method)
 	}
@@ -106,8 +106,8 @@ func TestDefaultRouter_Group(t *testing.T) {
 	}
 
 	// Kiểm tra group được thêm vào router
-	if len(router.groups) != 1 {
-		t.Errorf("Expected 1 group, got %d", len(router.groups))
+	if len(router.groups.load()) != 1 {
+		t.Errorf("Expected 1 group, got %d", len(router.groups.load()))
 	}
 
 	// Kiểm tra group hoạt động
@@ -118,11 +118,11 @@ func TestDefaultRouter_Group(t *testing.T) {
 	})
 
 	// Kiểm tra route được thêm vào group
-	if len(g.routes) != 1 {
-		t.Fatalf("Expected 1 route in group, got %d", len(g.routes))
+	if len(g.routes.load()) != 1 {
+		t.Fatalf("Expected 1 route in group, got %d", len(g.routes.load()))
 	}
 
-	groupRoute := g.routes[0]
+	groupRoute := g.routes.load()[0]
 	if groupRoute.Method != "GET" {
 		t.Errorf("Expected method GET, got %s", groupRoute.Method)
 	}
@@ -159,8 +159,8 @@ func TestDefaultRouter_Group(t *testing.T) {
 	}
 
 	// Kiểm tra subgroup được thêm vào group
-	if len(g.groups) != 1 {
-		t.Errorf("Expected 1 subgroup, got %d", len(g.groups))
+	if len(g.groups.load()) != 1 {
+		t.Errorf("Expected 1 subgroup, got %d", len(g.groups.load()))
 	}
 }
 
@@ -175,8 +175,8 @@ func TestDefaultRouter_Use(t *testing.T) {
 	})
 
 	// Kiểm tra middleware được thêm vào
-	if len(router.middlewares) != 1 {
-		t.Fatalf("Expected 1 middleware, got %d", len(router.middlewares))
+	if len(router.middlewares.load()) != 1 {
+		t.Fatalf("Expected 1 middleware, got %d", len(router.middlewares.load()))
 	}
 
 	// Đăng ký handler
@@ -251,11 +251,11 @@ func TestDefaultRouter_Static(t *testing.T) {
 	router.Static("/static", "./testdata")
 
 	// Kiểm tra route đã được đăng ký
-	if len(router.routes) != 1 {
-		t.Fatalf("Expected 1 route, got %d", len(router.routes))
+	if len(router.routes.load()) != 1 {
+		t.Fatalf("Expected 1 route, got %d", len(router.routes.load()))
 	}
 
-	route := router.routes[0]
+	route := router.routes.load()[0]
 	if route.Method != "GET" {
 		t.Errorf("Expected method GET, got %s", route.Method)
 	}
@@ -353,12 +353,11 @@ func TestDefaultRouter_calculateAbsolutePath(t *testing.T) {
 }
 
 func TestDefaultRouter_combineHandlers(t *testing.T) {
-	router := &DefaultRouter{
-		middlewares: []HandlerFunc{
-			func(ctx context.Context) { /* middleware 1 */ },
-			func(ctx context.Context) { /* middleware 2 */ },
-		},
-	}
+	router := &DefaultRouter{}
+	router.Use(
+		func(ctx context.Context) { /* middleware 1 */ },
+		func(ctx context.Context) { /* middleware 2 */ },
+	)
 
 	handlers := []HandlerFunc{
 		func(ctx context.Context) { /* handler 1 */ },
@@ -537,3 +536,200 @@ func TestRouteParamsInContext(t *testing.T) {
 		t.Errorf("Expected body %q, got %q", expected, w.Body.String())
 	}
 }
+
+func TestRouteParamsStoredOutsideStore(t *testing.T) {
+	r := NewRouter()
+	r.Handle("GET", "/users/:id", func(ctx context.Context) {
+		if got := ctx.Params()["id"]; got != "42" {
+			t.Errorf("Expected Params()[id]=42, got %q", got)
+		}
+		if _, exists := ctx.Get("param:id"); exists {
+			t.Error("Expected route params not to be written to the context store")
+		}
+	})
+	r.Handle("GET", "/health", func(ctx context.Context) {
+		if ctx.Params() != nil {
+			t.Errorf("Expected nil Params for route without params, got %v", ctx.Params())
+		}
+	})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/users/42", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/health", nil))
+}
+
+func TestDefaultRouter_Observe(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	var matched []string
+	r.Observe(func(ctx context.Context, route Route) {
+		matched = append(matched, route.Method+" "+route.Path+" id="+ctx.Param("id"))
+	})
+	r.Observe(nil)
+
+	r.Handle("GET", "/users/:id", func(ctx context.Context) {})
+	r.Group("/api").Handle("POST", "/items", func(ctx context.Context) {})
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest("GET", "/users/7", nil),
+		httptest.NewRequest("POST", "/api/items", nil),
+		httptest.NewRequest("GET", "/missing", nil),
+	} {
+		r.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	expected := []string{"GET /users/:id id=7", "POST /api/items id="}
+	if len(matched) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, matched)
+	}
+	for i := range expected {
+		if matched[i] != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], matched[i])
+		}
+	}
+}
+
+func TestDefaultRouter_GroupRoutesInTrie(t *testing.T) {
+	router := NewRouter().(*DefaultRouter)
+	api := router.Group("/api")
+	v1 := api.Group("/v1")
+
+	var matched string
+	handler := func(name string) HandlerFunc {
+		return func(ctx context.Context) {
+			matched = name + ":" + ctx.Param("id")
+		}
+	}
+	router.Handle("GET", "/health", handler("health"))
+	v1.Handle("GET", "/users/:id", handler("v1-user"))
+
+	serve := func(path string) int {
+		matched = ""
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w.Code
+	}
+
+	if serve("/api/v1/users/7"); matched != "v1-user:7" {
+		t.Errorf("Expected nested group route with param, got %q", matched)
+	}
+	if route, _ := router.trie.Lookup("GET", "/api/v1/users/7"); route == nil {
+		t.Error("Expected root trie to contain nested group route")
+	}
+	if api.Find("GET", "/health") != nil {
+		t.Error("Expected group lookup to be scoped to its own routes")
+	}
+
+	// Xóa group gỡ routes khỏi trie của router cha
+	if !router.RemoveGroup("/api") {
+		t.Fatal("Expected group to be removed")
+	}
+	if code := serve("/api/v1/users/7"); code != http.StatusNotFound {
+		t.Errorf("Expected 404 after removing group, got %d", code)
+	}
+	if serve("/health"); matched != "health:" {
+		t.Errorf("Expected root route to remain, got %q", matched)
+	}
+}
+
+func TestDefaultRouter_RemoveRoute(t *testing.T) {
+	router := NewRouter().(*DefaultRouter)
+	api := router.Group("/api").(*DefaultRouter)
+	plugins := api.Group("/plugins").(*DefaultRouter)
+
+	handler := func(ctx context.Context) { ctx.String(http.StatusOK, "ok") }
+	router.Handle("GET", "/health", handler)
+	plugins.Handle("GET", "/reports/:id", handler)
+	plugins.Handle("POST", "/reports/:id", handler)
+
+	serve := func(method, path string) int {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w.Code
+	}
+
+	if router.RemoveRoute("DELETE", "/api/plugins/reports/:id") {
+		t.Error("Expected removing unknown route to return false")
+	}
+
+	// Path tương đối với router gọi RemoveRoute; route của group được tìm từ router gốc
+	if !api.RemoveRoute("GET", "/plugins/reports/:id") {
+		t.Fatal("Expected route to be removed")
+	}
+	if code := serve("GET", "/api/plugins/reports/1"); code != http.StatusNotFound {
+		t.Errorf("Expected 404 after removing route, got %d", code)
+	}
+	if code := serve("POST", "/api/plugins/reports/1"); code != http.StatusOK {
+		t.Errorf("Expected other method to remain, got %d", code)
+	}
+	if route, _ := plugins.trie.Lookup("GET", "/api/plugins/reports/1"); route != nil {
+		t.Error("Expected route to be removed from group trie")
+	}
+	if got := len(router.Routes()); got != 2 {
+		t.Errorf("Expected 2 routes after removal, got %d", got)
+	}
+
+	if !router.RemoveRoute("GET", "/health") {
+		t.Fatal("Expected root route to be removed")
+	}
+	if code := serve("GET", "/health"); code != http.StatusNotFound {
+		t.Errorf("Expected 404 after removing root route, got %d", code)
+	}
+	if router.RemoveRoute("GET", "/health") {
+		t.Error("Expected second removal to return false")
+	}
+}
+
+func TestDefaultRouter_FullPath(t *testing.T) {
+	router := NewRouter()
+	var fullPath string
+	router.Use(func(ctx context.Context) {
+		ctx.Next()
+		fullPath = ctx.FullPath()
+	})
+	router.Group("/api").Handle("GET", "/users/:id", func(ctx context.Context) {})
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/api/users/42", "/api/users/:id"},
+		{"/missing", ""},
+	}
+	for _, tt := range tests {
+		fullPath = ""
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", tt.path, nil))
+		if fullPath != tt.expected {
+			t.Errorf("%s: expected full path %q, got %q", tt.path, tt.expected, fullPath)
+		}
+	}
+}
+
+func TestDefaultRouter_MiddleWildcardLinear(t *testing.T) {
+	router := NewRouter().(*DefaultRouter)
+	router.enableTrie = false
+
+	var got string
+	router.Handle("GET", "/files/*path/meta", func(ctx context.Context) {
+		got = ctx.Param("path")
+	})
+	router.Handle("GET", "/files/*path/versions/:version", func(ctx context.Context) {
+		got = ctx.Param("path") + "@" + ctx.Param("version")
+	})
+
+	tests := []struct {
+		path     string
+		expected string
+		code     int
+	}{
+		{"/files/a/b/meta", "a/b", http.StatusOK},
+		{"/files/a/versions/3", "a@3", http.StatusOK},
+		{"/files/meta", "", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		got = ""
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", tt.path, nil))
+		if w.Code != tt.code || got != tt.expected {
+			t.Errorf("%s: expected %d %q, got %d %q", tt.path, tt.code, tt.expected, w.Code, got)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package router
+
+import (
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// StaticConfig chứa cấu hình phục vụ static files của Static và StaticFS.
+type StaticConfig struct {
+	// Index là tên file được phục vụ khi request trỏ tới một thư mục.
+	// Mặc định: "index.html"
+	Index string
+
+	// Browse bật directory listing cho thư mục không có file Index.
+	// Mặc định: false khi truyền StaticConfig, true với DefaultStaticConfig
+	Browse bool
+
+	// SPA bật chế độ fallback cho single-page app dùng history mode: path không khớp file
+	// và có segment cuối không có phần mở rộng (ví dụ "/users/7") được trả về file Index
+	// ở thư mục gốc; path có phần mở rộng (ví dụ "/app.js") vẫn nhận 404.
+	// Mặc định: false
+	SPA bool
+
+	// NotFound xử lý request tới file không tồn tại (ví dụ: trả về trang 404 riêng
+	// hoặc index.html của single-page app).
+	// Mặc định: nil, trả về "404 page not found"
+	NotFound HandlerFunc
+}
+
+// DefaultStaticConfig trả về cấu hình mặc định của Static khi không truyền StaticConfig:
+// phục vụ index.html của thư mục và liệt kê thư mục không có index.html,
+// giống http.ServeFile.
+//
+// Returns:
+//   - StaticConfig: Cấu hình mặc định
+func DefaultStaticConfig() StaticConfig {
+	return StaticConfig{
+		Index:  "index.html",
+		Browse: true,
+	}
+}
+
+// StaticFS phục vụ static files từ một fs.FS, ví dụ embed.FS của binary build với go:embed.
+// Files được đọc qua fsys nên không cần đường dẫn trên filesystem và hoạt động giống nhau
+// trên mọi adapter. Với embed.FS, dùng fs.Sub để bỏ thư mục gốc của directive go:embed.
+//
+// Parameters:
+//   - prefix: Tiền tố URL để phục vụ files (ví dụ: "/assets")
+//   - fsys: Filesystem chứa static files
+//   - config: Cấu hình tùy chọn (index file, directory listing, 404), mặc định DefaultStaticConfig
+func (r *DefaultRouter) StaticFS(prefix string, fsys fs.FS, config ...StaticConfig) {
+	absolutePath := r.calculateAbsolutePath(prefix)
+	cfg := DefaultStaticConfig()
+	if len(config) > 0 {
+		cfg = config[0]
+		if cfg.Index == "" {
+			cfg.Index = "index.html"
+		}
+	}
+
+	handler := func(ctx forkCtx.Context) {
+		relativePath, ok := strings.CutPrefix(ctx.Path(), absolutePath)
+		if !ok {
+			return
+		}
+
+		// Prevent path traversal by rejecting paths with ".."
+		if strings.Contains(relativePath, "..") {
+			ctx.Status(http.StatusForbidden)
+			ctx.String(http.StatusForbidden, "403 Forbidden")
+			return
+		}
+
+		name := strings.TrimPrefix(path.Clean("/"+relativePath), "/")
+		if name == "" {
+			name = "."
+		}
+		serveStatic(ctx, fsys, name, cfg)
+	}
+	r.Handle("GET", prefix+"/*filepath", handler)
+}
+
+// serveStatic phục vụ file hoặc thư mục theo StaticConfig.
+//
+// Parameters:
+//   - ctx: Context của request
+//   - fsys: Filesystem chứa static files
+//   - name: Tên file trong fsys, hợp lệ theo fs.ValidPath
+//   - config: Cấu hình static
+func serveStatic(ctx forkCtx.Context, fsys fs.FS, name string, config StaticConfig) {
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		staticNotFound(ctx, fsys, name, config)
+		return
+	}
+
+	req := ctx.Request().Request()
+	if !info.IsDir() {
+		http.ServeFileFS(ctx.Response(), req, fsys, name)
+		return
+	}
+
+	// Thư mục cần "/" cuối để đường dẫn tương đối trong trang index hoạt động đúng
+	if !strings.HasSuffix(req.URL.Path, "/") {
+		target := url.URL{Path: req.URL.Path + "/", RawQuery: req.URL.RawQuery}
+		ctx.Redirect(http.StatusMovedPermanently, target.String())
+		return
+	}
+
+	index := path.Join(name, config.Index)
+	if indexInfo, err := fs.Stat(fsys, index); err == nil && !indexInfo.IsDir() {
+		http.ServeFileFS(ctx.Response(), req, fsys, index)
+		return
+	}
+
+	if config.Browse {
+		http.ServeFileFS(ctx.Response(), req, fsys, name)
+		return
+	}
+	staticNotFound(ctx, fsys, name, config)
+}
+
+// staticNotFound trả lời request tới file không tồn tại: file Index ở thư mục gốc
+// với chế độ SPA, NotFound nếu được cấu hình, hoặc 404 mặc định.
+//
+// Parameters:
+//   - ctx: Context của request
+//   - fsys: Filesystem chứa static files
+//   - name: Tên file được yêu cầu trong fsys
+//   - config: Cấu hình static
+func staticNotFound(ctx forkCtx.Context, fsys fs.FS, name string, config StaticConfig) {
+	if config.SPA && path.Ext(name) == "" {
+		if info, err := fs.Stat(fsys, config.Index); err == nil && !info.IsDir() {
+			http.ServeFileFS(ctx.Response(), ctx.Request().Request(), fsys, config.Index)
+			return
+		}
+	}
+	if config.NotFound != nil {
+		config.NotFound(ctx)
+		return
+	}
+	ctx.String(http.StatusNotFound, "404 page not found")
+}
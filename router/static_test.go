@@ -0,0 +1,176 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"go.fork.vn/fork/context"
+)
+
+// newStaticRoot tạo thư mục static dùng cho test
+func newStaticRoot(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		"app.js":           "console.log(1)",
+		"docs/index.html":  "<h1>docs</h1>",
+		"blog/home.html":   "<h1>blog</h1>",
+		"files/report.txt": "report",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func serveStaticPath(r Router, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+	return w
+}
+
+func TestDefaultRouter_StaticDefaultConfig(t *testing.T) {
+	root := newStaticRoot(t)
+	r := NewRouter()
+	r.Static("/static", root)
+
+	if w := serveStaticPath(r, "/static/app.js"); w.Code != http.StatusOK || w.Body.String() != "console.log(1)" {
+		t.Errorf("Expected file content, got %d %q", w.Code, w.Body.String())
+	}
+	if w := serveStaticPath(r, "/static/docs/"); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "docs") {
+		t.Errorf("Expected index.html, got %d %q", w.Code, w.Body.String())
+	}
+	if w := serveStaticPath(r, "/static/files/"); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "report.txt") {
+		t.Errorf("Expected directory listing by default, got %d %q", w.Code, w.Body.String())
+	}
+	if w := serveStaticPath(r, "/static/missing.js"); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for missing file, got %d", w.Code)
+	}
+}
+
+func TestDefaultRouter_StaticConfig(t *testing.T) {
+	root := newStaticRoot(t)
+	r := NewRouter()
+	r.Static("/assets", root, StaticConfig{Index: "home.html"})
+	r.Static("/spa", root, StaticConfig{
+		NotFound: func(ctx context.Context) {
+			ctx.String(http.StatusOK, "spa shell")
+		},
+	})
+
+	tests := []struct {
+		path     string
+		code     int
+		body     string
+		location string
+	}{
+		{"/assets/blog/", http.StatusOK, "<h1>blog</h1>", ""},
+		{"/assets/blog?x=1", http.StatusMovedPermanently, "", "/assets/blog/?x=1"},
+		{"/assets/docs/", http.StatusNotFound, "404 page not found", ""},
+		{"/assets/files/", http.StatusNotFound, "404 page not found", ""},
+		{"/spa/docs/", http.StatusOK, "<h1>docs</h1>", ""},
+		{"/spa/users/7", http.StatusOK, "spa shell", ""},
+		{"/spa/files/", http.StatusOK, "spa shell", ""},
+	}
+	for _, tt := range tests {
+		w := serveStaticPath(r, tt.path)
+		if w.Code != tt.code {
+			t.Errorf("%s: expected status %d, got %d", tt.path, tt.code, w.Code)
+		}
+		if tt.body != "" && w.Body.String() != tt.body {
+			t.Errorf("%s: expected body %q, got %q", tt.path, tt.body, w.Body.String())
+		}
+		if got := w.Header().Get("Location"); got != tt.location {
+			t.Errorf("%s: expected Location %q, got %q", tt.path, tt.location, got)
+		}
+	}
+}
+
+func TestDefaultRouter_StaticFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"index.html":     {Data: []byte("<h1>home</h1>")},
+		"css/site.css":   {Data: []byte("body{}")},
+		"img/logo.svg":   {Data: []byte("<svg/>")},
+		"docs/guide.txt": {Data: []byte("guide")},
+	}
+
+	r := NewRouter()
+	r.Group("/ui").StaticFS("/assets", fsys)
+	r.StaticFS("/strict", fsys, StaticConfig{})
+
+	tests := []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/ui/assets/css/site.css", http.StatusOK, "body{}"},
+		{"/ui/assets/", http.StatusOK, "<h1>home</h1>"},
+		{"/ui/assets/docs/", http.StatusOK, "guide.txt"},
+		{"/ui/assets/missing.css", http.StatusNotFound, "404 page not found"},
+		{"/ui/assets/../secret", http.StatusForbidden, "403 Forbidden"},
+		{"/strict/docs/", http.StatusNotFound, "404 page not found"},
+		{"/strict/img/logo.svg", http.StatusOK, "<svg/>"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/", nil)
+		req.URL.Path = tt.path
+		r.ServeHTTP(w, req)
+		if w.Code != tt.code {
+			t.Errorf("%s: expected status %d, got %d", tt.path, tt.code, w.Code)
+		}
+		if !strings.Contains(w.Body.String(), tt.body) {
+			t.Errorf("%s: expected body containing %q, got %q", tt.path, tt.body, w.Body.String())
+		}
+	}
+
+	w := serveStaticPath(r, "/ui/assets/css/site.css")
+	if got := w.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/css") {
+		t.Errorf("Expected text/css Content-Type, got %q", got)
+	}
+}
+
+func TestDefaultRouter_StaticSPA(t *testing.T) {
+	fsys := fstest.MapFS{
+		"index.html":  {Data: []byte("<div id=app></div>")},
+		"assets/a.js": {Data: []byte("app()")},
+		"empty/.keep": {Data: []byte("")},
+	}
+
+	r := NewRouter()
+	r.Handle("GET", "/api/users/:id", func(ctx context.Context) {
+		ctx.String(http.StatusOK, "api user "+ctx.Param("id"))
+	})
+	r.StaticFS("/", fsys, StaticConfig{SPA: true})
+
+	tests := []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/", http.StatusOK, "<div id=app></div>"},
+		{"/users/7", http.StatusOK, "<div id=app></div>"},
+		{"/settings/profile", http.StatusOK, "<div id=app></div>"},
+		{"/empty/", http.StatusOK, "<div id=app></div>"},
+		{"/assets/a.js", http.StatusOK, "app()"},
+		{"/assets/missing.js", http.StatusNotFound, "404 page not found"},
+		{"/api/users/7", http.StatusOK, "api user 7"},
+	}
+	for _, tt := range tests {
+		w := serveStaticPath(r, tt.path)
+		if w.Code != tt.code || w.Body.String() != tt.body {
+			t.Errorf("%s: expected %d %q, got %d %q", tt.path, tt.code, tt.body, w.Code, w.Body.String())
+		}
+	}
+}
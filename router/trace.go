@@ -0,0 +1,175 @@
+package router
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// MatchTraceKey là khóa trong context store chứa *MatchTrace của request khi bật SetMatchTrace.
+const MatchTraceKey = "router.match_trace"
+
+// MatchStep là một bước so khớp khi router duyệt trie.
+type MatchStep struct {
+	// Depth là vị trí segment của request đang được so khớp
+	Depth int
+
+	// Segment là segment của request, rỗng khi đã hết path
+	Segment string
+
+	// Pattern là segment pattern được thử (ví dụ: "users", ":id<\d+>", "*filepath")
+	Pattern string
+
+	// Matched cho biết bước so khớp thành công hay không
+	Matched bool
+
+	// Reason mô tả kết quả của bước so khớp
+	Reason string
+}
+
+// MatchTrace ghi lại quá trình router tìm route cho một request: các segment và constraint
+// đã được thử và lý do khớp hoặc không khớp.
+type MatchTrace struct {
+	// Method là HTTP method của request
+	Method string
+
+	// Path là URL path của request
+	Path string
+
+	// Steps là các bước so khớp theo thứ tự thực hiện
+	Steps []MatchStep
+
+	// Route là route được chọn, nil nếu request không khớp route nào
+	Route *Route
+}
+
+// SetMatchTrace bật hoặc tắt chế độ theo dõi so khớp route (mặc định: tắt). Khi bật, mỗi
+// request được gắn một *MatchTrace đọc qua MatchTraceFrom, kể cả trong NoRoute và NoMethod
+// handlers. Chế độ này duyệt trie thêm một lần cho mỗi request, chỉ nên dùng khi debug.
+//
+// Parameters:
+//   - enabled: true để ghi lại quá trình so khớp
+func (r *DefaultRouter) SetMatchTrace(enabled bool) {
+	r.matchTrace = enabled
+}
+
+// MatchTraceFrom trả về quá trình so khớp route của request.
+//
+// Parameters:
+//   - ctx: Context của request
+//
+// Returns:
+//   - *MatchTrace: Quá trình so khớp
+//   - bool: false nếu router không bật SetMatchTrace
+func MatchTraceFrom(ctx forkCtx.Context) (*MatchTrace, bool) {
+	value, exists := ctx.Get(MatchTraceKey)
+	if !exists {
+		return nil, false
+	}
+	trace, ok := value.(*MatchTrace)
+	return trace, ok
+}
+
+// String trả về quá trình so khớp dạng văn bản, mỗi bước một dòng được thụt lề theo Depth.
+//
+// Returns:
+//   - string: Mô tả quá trình so khớp
+func (t *MatchTrace) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n", t.Method, t.Path)
+	for _, step := range t.Steps {
+		result := "rejected"
+		if step.Matched {
+			result = "matched"
+		}
+		pattern := ""
+		if step.Pattern != "" {
+			pattern = " " + step.Pattern
+		}
+		fmt.Fprintf(&b, "%s[%d] %q%s: %s (%s)\n", strings.Repeat("  ", step.Depth+1), step.Depth, step.Segment, pattern, result, step.Reason)
+	}
+	if t.Route != nil {
+		fmt.Fprintf(&b, "route: %s %s", t.Route.Method, t.Route.Path)
+	} else {
+		b.WriteString("route: none")
+	}
+	return b.String()
+}
+
+// step thêm một bước so khớp vào trace.
+func (t *MatchTrace) step(depth int, segment, pattern string, matched bool, reason string) {
+	t.Steps = append(t.Steps, MatchStep{Depth: depth, Segment: segment, Pattern: pattern, Matched: matched, Reason: reason})
+}
+
+// traceMatch ghi lại quá trình tìm route cho request theo cùng thứ tự với handleRequest:
+// group ràng buộc khớp host, version của request trước, rồi tới routes của router.
+// path là path dùng để so khớp (xem matchPath).
+func (r *DefaultRouter) traceMatch(ctx forkCtx.Context, path string, route *Route) *MatchTrace {
+	trace := &MatchTrace{Method: ctx.Method(), Path: path}
+	if route != nil {
+		matched := *route
+		trace.Route = &matched
+	}
+
+	host, version := requestHost(ctx), r.requestVersion(ctx)
+	for _, group := range r.scopes.load() {
+		segment, pattern, kind := version, "version="+group.version, "version group"
+		if group.host != nil {
+			segment, pattern, kind = host, group.host.raw, "host group"
+		}
+		if _, ok := group.matchScope(host, version); !ok {
+			trace.step(0, segment, pattern, false, kind+" does not match request")
+			continue
+		}
+		trace.step(0, segment, pattern, true, kind)
+		group.traceRoutes(trace)
+	}
+	r.traceRoutes(trace)
+	return trace
+}
+
+// traceRoutes ghi lại quá trình tìm route trong trie của router.
+func (r *DefaultRouter) traceRoutes(trace *MatchTrace) {
+	if !r.enableTrie || r.trie == nil {
+		trace.step(0, "", "", false, "trie disabled, routes are matched by linear search")
+		return
+	}
+	r.trie.trace(trace)
+}
+
+// trace ghi lại quá trình so khớp của trace.Method và trace.Path trong trie.
+func (rt *RouteTrie) trace(trace *MatchTrace) {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+	rt.lookup(trace.Method, rt.splitPath(trace.Path), trace)
+}
+
+// pattern trả về segment pattern của param hoặc wildcard node.
+func (n *TrieNode) pattern() string {
+	if n.isWildcard {
+		return "*" + n.paramName
+	}
+	pattern := ":" + n.paramName
+	if n.regexPattern != "" {
+		pattern += "<" + n.regexPattern + ">"
+	}
+	if n.isOptional {
+		pattern += "?"
+	}
+	return pattern
+}
+
+// endReason mô tả lý do path kết thúc tại node mà không có route cho method.
+func endReason(node *TrieNode, method string) string {
+	if len(node.routes) == 0 {
+		return "path ends at a node without routes"
+	}
+	methods := make([]string, 0, len(node.routes))
+	for m := range node.routes {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	return "no route for method " + method + " (registered: " + strings.Join(methods, ", ") + ")"
+}
@@ -0,0 +1,66 @@
+package router
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_MatchTrace(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	r.SetMatchTrace(true)
+
+	var trace *MatchTrace
+	capture := func(ctx context.Context) {
+		trace, _ = MatchTraceFrom(ctx)
+	}
+	r.Handle("GET", `/users/:id<\d+>`, capture)
+	r.Handle("GET", "/users/:name/posts", capture)
+	r.Handle("POST", "/users/:name", capture)
+	r.NoRoute(capture)
+
+	// Regex constraint từ chối segment, tham số thường được thử tiếp nhưng không có route GET
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/users/alice", nil))
+	if trace == nil {
+		t.Fatal("Expected match trace in NoRoute handler")
+	}
+	if trace.Route != nil {
+		t.Errorf("Expected no matched route, got %v", trace.Route.Path)
+	}
+	text := trace.String()
+	for _, want := range []string{
+		`"alice" :id<\d+>: rejected (regex constraint rejected segment)`,
+		`"alice" :name: matched (parameter)`,
+		"no route for method GET (registered: POST)",
+		"route: none",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected trace to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	trace = nil
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/users/42", nil))
+	if trace == nil || trace.Route == nil || trace.Route.Path != `/users/:id<\d+>` {
+		t.Fatalf("Expected trace of matched route, got %+v", trace)
+	}
+	last := trace.Steps[len(trace.Steps)-1]
+	if !last.Matched || last.Depth != 2 || !strings.Contains(last.Reason, "found") {
+		t.Errorf("Expected last step to report the found route, got %+v", last)
+	}
+}
+
+func TestDefaultRouter_MatchTraceDisabled(t *testing.T) {
+	r := NewRouter()
+	traced := true
+	r.Handle("GET", "/", func(ctx context.Context) {
+		_, traced = MatchTraceFrom(ctx)
+	})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	if traced {
+		t.Error("Expected no match trace when tracing is disabled")
+	}
+}
@@ -2,15 +2,27 @@ package router
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 )
 
-// TrieNode đại diện cho một node trong route trie
+// TrieNode đại diện cho một node trong route trie.
+// Mỗi node tương ứng với một segment của route pattern.
 type TrieNode struct {
-	// children lưu trữ các node con, key là segment path
+	// children lưu trữ các node con tĩnh, key là segment path
 	children map[string]*TrieNode
 
+	// dynamic lưu trữ các node con tham số, key là dạng chuẩn hóa của segment
+	dynamic map[string]*TrieNode
+
+	// params là các node con tham số theo thứ tự ưu tiên khi so khớp:
+	// regex constraint, tham số thường, rồi tới các tham số optional
+	params []*TrieNode
+
+	// wildcard là node con wildcard (*filepath), nil nếu không có
+	wildcard *TrieNode
+
 	// isParam xác định node này có phải là parameter không (:id)
 	isParam bool
 
@@ -20,192 +32,601 @@ type TrieNode struct {
 	// isWildcard xác định node này có phải là wildcard không (*)
 	isWildcard bool
 
-	// isOptional xác định parameter có phải là optional không (?id)
+	// isOptional xác định parameter có phải là optional không (:id?)
 	isOptional bool
 
 	// regexPattern regex constraint cho parameter
 	regexPattern string
 
-	// handlers lưu trữ handlers theo HTTP method
-	handlers map[string]HandlerFunc
+	// regex là regex constraint đã biên dịch, nil nếu pattern không hợp lệ
+	regex *regexp.Regexp
+
+	// routes lưu trữ route kết thúc tại node này theo HTTP method
+	routes map[string]*trieRoute
 
 	// isEndNode xác định đây có phải là node cuối của route không
 	isEndNode bool
+}
+
+// trieRoute là route được lưu tại end node cùng tên tham số của từng segment.
+type trieRoute struct {
+	route Route
 
-	// mu bảo vệ truy cập đồng thời
-	mu sync.RWMutex
+	// names[i] là tên tham số của segment thứ i trong pattern, rỗng với segment tĩnh
+	names []string
+
+	// seq là thứ tự đăng ký của route trong trie
+	seq uint64
 }
 
-// RouteTrie structure for optimized route lookup
+// RouteTrie là radix trie dùng để tìm route theo method và path.
+// Thời gian tìm kiếm tỉ lệ với độ dài path thay vì số lượng routes đã đăng ký;
+// tham số route được thu thập ngay trong quá trình duyệt trie.
+//
+// Thứ tự ưu tiên tại mỗi segment: segment tĩnh, tham số có regex constraint,
+// tham số thường, tham số optional, cuối cùng là wildcard. Khi có route với Priority
+// khác 0 hoặc precedence là PrecedenceRegistration, Lookup xét mọi route khớp và chọn
+// route có Priority cao nhất, rồi theo precedence.
 type RouteTrie struct {
 	root *TrieNode
 	mu   sync.RWMutex
+
+	// methods đếm số route theo HTTP method, dùng cho Methods
+	methods map[string]int
+
+	// seq là thứ tự đăng ký của route tiếp theo
+	seq uint64
+
+	// prioritized đếm số route có Priority khác 0
+	prioritized int
+
+	// precedence xác định route được chọn khi nhiều route cùng khớp và cùng Priority
+	precedence RoutePrecedence
 }
 
 // NewRouteTrie tạo một route trie mới
 func NewRouteTrie() *RouteTrie {
-	return &RouteTrie{
-		root: &TrieNode{
-			children: make(map[string]*TrieNode),
-			handlers: make(map[string]HandlerFunc),
-		},
+	return &RouteTrie{root: newTrieNode(), methods: make(map[string]int)}
+}
+
+// newTrieNode tạo node rỗng
+func newTrieNode() *TrieNode {
+	return &TrieNode{
+		children: make(map[string]*TrieNode),
+		dynamic:  make(map[string]*TrieNode),
+		routes:   make(map[string]*trieRoute),
 	}
 }
 
-// Insert thêm route vào trie
+// Insert thêm route vào trie. Nếu method và path đã được đăng ký,
+// route đăng ký trước được giữ nguyên.
+//
+// Parameters:
+//   - method: HTTP method của route
+//   - path: URL path pattern của route
+//   - handler: Handler của route
 func (rt *RouteTrie) Insert(method, path string, handler HandlerFunc) {
+	rt.insertRoute(Route{Method: method, Path: path, Handler: handler})
+}
+
+// insertRoute thêm route đầy đủ (kể cả metadata) vào trie.
+func (rt *RouteTrie) insertRoute(route Route) {
 	rt.mu.Lock()
 	defer rt.mu.Unlock()
 
+	method, path := route.Method, route.Path
 	segments := rt.splitPath(path)
+	names := make([]string, len(segments))
 	current := rt.root
 
-	for _, segment := range segments {
-		current.mu.Lock()
+	for i, segment := range segments {
+		key, node := rt.processSegment(segment)
+		if node.isParam || node.isWildcard {
+			names[i] = node.paramName
+		}
+		current = current.child(key, node)
+	}
+
+	if _, exists := current.routes[method]; exists {
+		return
+	}
+	current.isEndNode = true
+	rt.methods[method]++
+	if route.Priority != 0 {
+		rt.prioritized++
+	}
+	rt.seq++
+	current.routes[method] = &trieRoute{
+		route: route,
+		names: names,
+		seq:   rt.seq,
+	}
+}
 
-		if current.children == nil {
-			current.children = make(map[string]*TrieNode)
+// child trả về node con theo key, thêm node mới nếu chưa tồn tại.
+func (n *TrieNode) child(key string, node *TrieNode) *TrieNode {
+	switch {
+	case node.isWildcard:
+		if n.wildcard == nil {
+			n.wildcard = node
 		}
+		return n.wildcard
+	case node.isParam:
+		if existing, exists := n.dynamic[key]; exists {
+			return existing
+		}
+		n.dynamic[key] = node
+		n.params = append(n.params, node)
+		sort.SliceStable(n.params, func(i, j int) bool {
+			return paramRank(n.params[i]) < paramRank(n.params[j])
+		})
+		return node
+	default:
+		if existing, exists := n.children[key]; exists {
+			return existing
+		}
+		n.children[key] = node
+		return node
+	}
+}
+
+// paramRank trả về thứ tự ưu tiên của param node, giá trị nhỏ hơn được thử trước.
+func paramRank(n *TrieNode) int {
+	rank := 0
+	if n.regexPattern == "" {
+		rank++
+	}
+	if n.isOptional {
+		rank += 2
+	}
+	return rank
+}
+
+// Remove xóa route theo method và path pattern, đồng thời dọn các node không còn sử dụng.
+//
+// Parameters:
+//   - method: HTTP method của route
+//   - path: URL path pattern của route
+//
+// Returns:
+//   - bool: true nếu route tồn tại và đã được xóa
+func (rt *RouteTrie) Remove(method, path string) bool {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	segments := rt.splitPath(path)
+	trail := make([]*TrieNode, 0, len(segments)+1)
+	keys := make([]string, 0, len(segments))
+	current := rt.root
+	trail = append(trail, current)
 
-		// Xử lý các loại segment khác nhau
+	for _, segment := range segments {
 		key, node := rt.processSegment(segment)
+		var next *TrieNode
+		switch {
+		case node.isWildcard:
+			next = current.wildcard
+		case node.isParam:
+			next = current.dynamic[key]
+		default:
+			next = current.children[key]
+		}
+		if next == nil {
+			return false
+		}
+		keys = append(keys, key)
+		trail = append(trail, next)
+		current = next
+	}
+
+	entry, exists := current.routes[method]
+	if !exists {
+		return false
+	}
+	if entry.route.Priority != 0 {
+		rt.prioritized--
+	}
+	delete(current.routes, method)
+	current.isEndNode = len(current.routes) > 0
+	if rt.methods[method]--; rt.methods[method] <= 0 {
+		delete(rt.methods, method)
+	}
 
-		if existingNode, exists := current.children[key]; exists {
-			current.mu.Unlock()
-			current = existingNode
-		} else {
-			current.children[key] = node
-			current.mu.Unlock()
-			current = node
+	// Dọn các node lá không còn route từ dưới lên
+	for i := len(trail) - 1; i > 0; i-- {
+		node := trail[i]
+		if node.isEndNode || len(node.children) > 0 || len(node.params) > 0 || node.wildcard != nil {
+			break
 		}
+		trail[i-1].detach(keys[i-1], node)
 	}
+	return true
+}
 
-	// Đánh dấu là end node và set handler
-	current.mu.Lock()
-	current.isEndNode = true
-	if current.handlers == nil {
-		current.handlers = make(map[string]HandlerFunc)
+// Conflict tìm route đã đăng ký có cùng method và cùng cấu trúc pattern với path,
+// tức là hai pattern chỉ khác nhau ở tên tham số (ví dụ "/users/:id" và "/users/:name").
+//
+// Parameters:
+//   - method: HTTP method của route
+//   - path: URL path pattern của route
+//
+// Returns:
+//   - *Route: Route đã đăng ký xung đột với path, nil nếu không có
+func (rt *RouteTrie) Conflict(method, path string) *Route {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	current := rt.endNode(path)
+	if current == nil {
+		return nil
+	}
+	if existing, exists := current.routes[method]; exists {
+		route := existing.route
+		return &route
+	}
+	return nil
+}
+
+// setPriority cập nhật Priority của route đã đăng ký với đúng method và path pattern.
+func (rt *RouteTrie) setPriority(method, path string, priority int) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	current := rt.endNode(path)
+	if current == nil {
+		return
+	}
+	entry, exists := current.routes[method]
+	if !exists || entry.route.Path != path {
+		return
+	}
+	if entry.route.Priority != 0 {
+		rt.prioritized--
+	}
+	if priority != 0 {
+		rt.prioritized++
+	}
+	entry.route.Priority = priority
+}
+
+// setMeta thay metadata của route đã đăng ký.
+func (rt *RouteTrie) setMeta(method, path string, meta map[string]interface{}) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	current := rt.endNode(path)
+	if current == nil {
+		return
+	}
+	if entry, exists := current.routes[method]; exists && entry.route.Path == path {
+		entry.route.Meta = meta
+	}
+}
+
+// setPrecedence thiết lập thứ tự chọn route khi nhiều route cùng khớp.
+func (rt *RouteTrie) setPrecedence(precedence RoutePrecedence) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.precedence = precedence
+}
+
+// endNode trả về node kết thúc của pattern có cùng cấu trúc với path, nil nếu không có.
+func (rt *RouteTrie) endNode(path string) *TrieNode {
+	current := rt.root
+	for _, segment := range rt.splitPath(path) {
+		key, node := rt.processSegment(segment)
+		switch {
+		case node.isWildcard:
+			current = current.wildcard
+		case node.isParam:
+			current = current.dynamic[key]
+		default:
+			current = current.children[key]
+		}
+		if current == nil {
+			return nil
+		}
+	}
+	return current
+}
+
+// detach gỡ node con khỏi node hiện tại.
+func (n *TrieNode) detach(key string, node *TrieNode) {
+	switch {
+	case node.isWildcard:
+		n.wildcard = nil
+	case node.isParam:
+		delete(n.dynamic, key)
+		for i, param := range n.params {
+			if param == node {
+				n.params = append(n.params[:i], n.params[i+1:]...)
+				break
+			}
+		}
+	default:
+		delete(n.children, key)
 	}
-	current.handlers[method] = handler
-	current.mu.Unlock()
 }
 
 // Find tìm handler trong trie
+//
+// Parameters:
+//   - method: HTTP method của request
+//   - path: URL path của request
+//
+// Returns:
+//   - HandlerFunc: Handler của route khớp hoặc nil nếu không tìm thấy
 func (rt *RouteTrie) Find(method, path string) HandlerFunc {
+	if route, _ := rt.Lookup(method, path); route != nil {
+		return route.Handler
+	}
+	return nil
+}
+
+// Lookup tìm route khớp với method và path cùng các tham số của route
+// trong một lần duyệt trie.
+//
+// Parameters:
+//   - method: HTTP method của request
+//   - path: URL path của request
+//
+// Returns:
+//   - *Route: Route khớp hoặc nil nếu không tìm thấy
+//   - map[string]string: Tham số của route (optional bị bỏ qua và wildcard rỗng có giá trị "")
+func (rt *RouteTrie) Lookup(method, path string) (*Route, map[string]string) {
 	rt.mu.RLock()
 	defer rt.mu.RUnlock()
 
-	segments := rt.splitPath(path)
-	current := rt.root
+	entry, values := rt.lookup(method, rt.splitPath(path), nil)
+	if entry == nil {
+		return nil, nil
+	}
 
-	return rt.findRecursive(current, segments, method, 0)
+	params := make(map[string]string, len(entry.names))
+	for i, name := range entry.names {
+		if name != "" {
+			params[name] = values[i]
+		}
+	}
+	route := entry.route
+	return &route, params
 }
 
-// findRecursive tìm kiếm đệ quy trong trie
-func (rt *RouteTrie) findRecursive(node *TrieNode, segments []string, method string, index int) HandlerFunc {
-	if node == nil {
-		return nil
+// lookup tìm route khớp với segments. Khi không có route nào có Priority và precedence
+// là PrecedenceSpecificity, route khớp đầu tiên trong quá trình duyệt được chọn;
+// ngược lại mọi route khớp được xét để chọn route xếp hạng cao nhất.
+func (rt *RouteTrie) lookup(method string, segments []string, trace *MatchTrace) (*trieRoute, []string) {
+	values := make([]string, 0, len(segments)+1)
+	if rt.prioritized == 0 && rt.precedence == PrecedenceSpecificity {
+		if trace == nil {
+			return rt.match(rt.root, segments, 0, method, values, nil)
+		}
+		return rt.match(rt.root, segments, 0, method, values, &matchOptions{trace: trace})
 	}
 
-	node.mu.RLock()
-	defer node.mu.RUnlock()
+	var best *trieRoute
+	var bestValues []string
+	rt.match(rt.root, segments, 0, method, values, &matchOptions{trace: trace, accept: func(entry *trieRoute, matched []string) bool {
+		if best == nil || rt.outranks(entry, best) {
+			best = entry
+			bestValues = append([]string(nil), matched...)
+		}
+		return false
+	}})
+	return best, bestValues
+}
 
-	// Nếu đã xử lý hết segments
-	if index >= len(segments) {
-		if node.isEndNode {
-			if handler, exists := node.handlers[method]; exists {
-				return handler
-			}
+// outranks báo cáo route a có được chọn thay cho route b cùng khớp request hay không.
+// Route được tìm thấy trước trong quá trình duyệt là route cụ thể hơn.
+func (rt *RouteTrie) outranks(a, b *trieRoute) bool {
+	if a.route.Priority != b.route.Priority {
+		return a.route.Priority > b.route.Priority
+	}
+	return rt.precedence == PrecedenceRegistration && a.seq < b.seq
+}
+
+// FindCaseInsensitive tìm route khớp với path không phân biệt hoa thường ở các segment tĩnh
+// và trả về path đã được sửa theo cách viết của route.
+//
+// Parameters:
+//   - method: HTTP method của request
+//   - path: URL path của request
+//
+// Returns:
+//   - string: Path đã sửa (segment tĩnh theo route, tham số giữ nguyên, dấu "/" cuối theo pattern)
+//   - bool: true nếu tìm thấy route
+func (rt *RouteTrie) FindCaseInsensitive(method, path string) (string, bool) {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	segments := rt.splitPath(path)
+	entry, values := rt.match(rt.root, segments, 0, method, make([]string, 0, len(segments)+1), &matchOptions{fold: true})
+	if entry == nil {
+		return "", false
+	}
+
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
 		}
-		return nil
 	}
+	fixed := "/" + strings.Join(parts, "/")
+	if fixed != "/" && strings.HasSuffix(entry.route.Path, "/") {
+		fixed += "/"
+	}
+	return fixed, true
+}
 
-	currentSegment := segments[index]
+// Methods trả về các HTTP method có route khớp với path, đã sắp xếp.
+//
+// Parameters:
+//   - path: URL path của request
+//
+// Returns:
+//   - []string: Danh sách method, rỗng nếu path không khớp route nào
+func (rt *RouteTrie) Methods(path string) []string {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
 
-	// 1. Tìm exact match trước
-	if child, exists := node.children[currentSegment]; exists {
-		if result := rt.findRecursive(child, segments, method, index+1); result != nil {
-			return result
+	segments := rt.splitPath(path)
+	values := make([]string, 0, len(segments)+1)
+	var methods []string
+	for method := range rt.methods {
+		if entry, _ := rt.match(rt.root, segments, 0, method, values[:0], nil); entry != nil {
+			methods = append(methods, method)
 		}
 	}
+	sort.Strings(methods)
+	return methods
+}
 
-	// 2. Tìm parameter match
-	for _, child := range node.children {
-		if child.isParam {
-			// Kiểm tra regex constraint nếu có
-			if child.regexPattern != "" {
-				if regex, err := compileRegex(child.regexPattern); err == nil {
-					if !regex.MatchString(currentSegment) {
-						continue
-					}
-				} else {
-					continue
-				}
-			}
+// matchOptions tùy biến quá trình duyệt trie của match.
+type matchOptions struct {
+	// fold so khớp segment tĩnh không phân biệt hoa thường; values chứa segment
+	// tĩnh theo đúng cách viết đã đăng ký
+	fold bool
+
+	// accept nhận mỗi route khớp; việc duyệt tiếp tục khi accept trả về false
+	accept func(*trieRoute, []string) bool
+
+	// trace ghi lại các bước so khớp, nil nếu không theo dõi
+	trace *MatchTrace
+}
+
+// defaultMatchOptions là matchOptions mặc định, dùng khi match được gọi với opts nil.
+var defaultMatchOptions matchOptions
+
+// match duyệt trie theo segments, values chứa giá trị đã khớp của từng segment pattern.
+// opts nil tương đương so khớp mặc định, dừng ở route khớp đầu tiên.
+func (rt *RouteTrie) match(node *TrieNode, segments []string, index int, method string, values []string, opts *matchOptions) (*trieRoute, []string) {
+	if opts == nil {
+		opts = &defaultMatchOptions
+	}
 
-			if result := rt.findRecursive(child, segments, method, index+1); result != nil {
-				return result
+	// Đã xử lý hết segments
+	if index == len(segments) {
+		if entry := node.routes[method]; entry != nil {
+			if opts.trace != nil {
+				opts.trace.step(index, "", "", true, "route "+method+" "+entry.route.Path+" found")
 			}
+			if entry, matched := accepted(entry, values, opts.accept); entry != nil {
+				return entry, matched
+			}
+		} else if opts.trace != nil {
+			opts.trace.step(index, "", "", false, endReason(node, method))
+		}
 
-			// Xử lý optional parameter
+		// Optional parameter và wildcard ở cuối route khớp với phần path rỗng
+		for _, child := range node.params {
 			if child.isOptional {
-				if result := rt.findRecursive(child, segments, method, index); result != nil {
-					return result
+				if opts.trace != nil {
+					opts.trace.step(index, "", child.pattern(), true, "optional parameter skipped")
+				}
+				if entry, matched := rt.match(child, segments, index, method, append(values, ""), opts); entry != nil {
+					return entry, matched
 				}
 			}
 		}
-	}
-
-	// 3. Tìm wildcard match cuối cùng
-	for _, child := range node.children {
-		if child.isWildcard {
-			// Wildcard match với tất cả segments còn lại
-			if child.isEndNode {
-				if handler, exists := child.handlers[method]; exists {
-					return handler
+		if node.wildcard != nil {
+			if entry := node.wildcard.routes[method]; entry != nil {
+				if opts.trace != nil {
+					opts.trace.step(index, "", node.wildcard.pattern(), true, "wildcard matched empty rest, route "+method+" "+entry.route.Path+" found")
 				}
+				return accepted(entry, append(values, ""), opts.accept)
+			}
+			if opts.trace != nil {
+				opts.trace.step(index, "", node.wildcard.pattern(), false, "wildcard has no route for method "+method)
 			}
 		}
+		return nil, nil
 	}
 
-	return nil
-}
+	segment := segments[index]
 
-// processSegment xử lý một segment và trả về key và node tương ứng
-func (rt *RouteTrie) processSegment(segment string) (string, *TrieNode) {
-	node := &TrieNode{
-		children: make(map[string]*TrieNode),
-		handlers: make(map[string]HandlerFunc),
+	// 1. Segment tĩnh
+	if child, exists := node.children[segment]; exists {
+		if opts.trace != nil {
+			opts.trace.step(index, segment, segment, true, "static segment")
+		}
+		if entry, matched := rt.match(child, segments, index+1, method, append(values, segment), opts); entry != nil {
+			return entry, matched
+		}
+	} else if opts.trace != nil && len(node.children) > 0 {
+		opts.trace.step(index, segment, "", false, "no static segment matches")
 	}
-
-	// Static segment
-	if !strings.HasPrefix(segment, ":") && !strings.HasPrefix(segment, "*") {
-		return segment, node
+	if opts.fold {
+		for key, child := range node.children {
+			if key != segment && strings.EqualFold(key, segment) {
+				if entry, matched := rt.match(child, segments, index+1, method, append(values, key), opts); entry != nil {
+					return entry, matched
+				}
+			}
+		}
 	}
 
-	// Parameter segment (:id)
-	if strings.HasPrefix(segment, ":") {
-		paramName := segment[1:]
-		key := ":param"
-
-		// Optional parameter (:id?)
-		if strings.HasSuffix(paramName, "?") {
-			paramName = paramName[:len(paramName)-1]
-			node.isOptional = true
-			key = ":optional"
+	// 2. Tham số theo thứ tự ưu tiên
+	for _, child := range node.params {
+		if child.regexPattern == "" || (child.regex != nil && child.regex.MatchString(segment)) {
+			if opts.trace != nil {
+				opts.trace.step(index, segment, child.pattern(), true, "parameter")
+			}
+			if entry, matched := rt.match(child, segments, index+1, method, append(values, segment), opts); entry != nil {
+				return entry, matched
+			}
+		} else if opts.trace != nil {
+			reason := "regex constraint rejected segment"
+			if child.regex == nil {
+				reason = "invalid regex constraint"
+			}
+			opts.trace.step(index, segment, child.pattern(), false, reason)
 		}
 
-		// Regex constraint (:id<\d+>)
-		if idx := strings.Index(paramName, "<"); idx >= 0 && strings.HasSuffix(paramName, ">") {
-			node.regexPattern = paramName[idx+1 : len(paramName)-1]
-			paramName = paramName[:idx]
-			key = ":regex:" + node.regexPattern
+		// Optional parameter có thể được bỏ qua
+		if child.isOptional {
+			if opts.trace != nil {
+				opts.trace.step(index, segment, child.pattern(), true, "optional parameter skipped")
+			}
+			if entry, matched := rt.match(child, segments, index, method, append(values, ""), opts); entry != nil {
+				return entry, matched
+			}
+		}
+	}
+
+	// 3. Wildcard khớp nhiều segments nhất có thể; với wildcard ở giữa pattern,
+	// số segments được lùi dần cho tới khi phần sau wildcard khớp
+	if wildcard := node.wildcard; wildcard != nil {
+		last := index
+		if len(wildcard.children) == 0 && len(wildcard.params) == 0 && wildcard.wildcard == nil {
+			// Wildcard cuối pattern chỉ có thể nhận toàn bộ phần còn lại
+			last = len(segments) - 1
+		}
+		for end := len(segments); end > last; end-- {
+			value := strings.Join(segments[index:end], "/")
+			if opts.trace != nil {
+				opts.trace.step(index, value, wildcard.pattern(), true, "wildcard")
+			}
+			if entry, matched := rt.match(wildcard, segments, end, method, append(values, value), opts); entry != nil {
+				return entry, matched
+			}
 		}
+	}
 
-		node.isParam = true
-		node.paramName = paramName
-		return key, node
+	return nil, nil
+}
+
+// accepted trả về route khớp nếu accept nil hoặc chấp nhận route, ngược lại trả về nil
+// để match tiếp tục duyệt.
+func accepted(entry *trieRoute, values []string, accept func(*trieRoute, []string) bool) (*trieRoute, []string) {
+	if accept != nil && !accept(entry, values) {
+		return nil, nil
 	}
+	return entry, values
+}
+
+// processSegment xử lý một segment và trả về key và node tương ứng
+func (rt *RouteTrie) processSegment(segment string) (string, *TrieNode) {
+	node := newTrieNode()
 
 	// Wildcard segment (*filepath)
 	if strings.HasPrefix(segment, "*") {
@@ -214,30 +635,45 @@ func (rt *RouteTrie) processSegment(segment string) (string, *TrieNode) {
 		return "*", node
 	}
 
-	return segment, node
-}
-
-// splitPath chia path thành các segments
-func (rt *RouteTrie) splitPath(path string) []string {
-	if path == "/" || path == "" {
-		return []string{}
+	// Static segment
+	if !strings.HasPrefix(segment, ":") {
+		return segment, node
 	}
 
-	// Loại bỏ leading slash
-	if strings.HasPrefix(path, "/") {
-		path = path[1:]
+	// Parameter segment (:id)
+	paramName := segment[1:]
+	key := ":"
+
+	// Optional parameter (:id?)
+	if strings.HasSuffix(paramName, "?") {
+		paramName = paramName[:len(paramName)-1]
+		node.isOptional = true
 	}
 
-	// Loại bỏ trailing slash
-	if strings.HasSuffix(path, "/") {
-		path = path[:len(path)-1]
+	// Regex constraint (:id<\d+>)
+	if idx := strings.Index(paramName, "<"); idx >= 0 && strings.HasSuffix(paramName, ">") {
+		node.regexPattern = paramName[idx+1 : len(paramName)-1]
+		node.regex, _ = compileRegex(node.regexPattern)
+		paramName = paramName[:idx]
+		key += "<" + node.regexPattern + ">"
 	}
+	if node.isOptional {
+		key += "?"
+	}
+
+	node.isParam = true
+	node.paramName = paramName
+	return key, node
+}
 
-	if path == "" {
+// splitPath chia path thành các segments, bỏ qua các segment rỗng (ví dụ "//")
+// để thống nhất với cách DefaultRouter.splitPath tách path.
+func (rt *RouteTrie) splitPath(path string) []string {
+	if path == "/" || path == "" {
 		return []string{}
 	}
 
-	return strings.Split(path, "/")
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
 }
 
 // compileRegex compile regex pattern với caching
@@ -265,17 +701,16 @@ func compileRegex(pattern string) (*regexp.Regexp, error) {
 	return regex, nil
 }
 
-// Clear clears all nodes and handlers from the trie to prevent memory leaks
+// Clear clears all nodes and routes from the trie to prevent memory leaks
 func (rt *RouteTrie) Clear() {
 	rt.mu.Lock()
 	defer rt.mu.Unlock()
 
 	if rt.root != nil {
 		rt.clearNode(rt.root)
-		rt.root = &TrieNode{
-			children: make(map[string]*TrieNode),
-			handlers: make(map[string]HandlerFunc),
-		}
+		rt.root = newTrieNode()
+		rt.methods = make(map[string]int)
+		rt.prioritized = 0
 	}
 }
 
@@ -285,17 +720,19 @@ func (rt *RouteTrie) clearNode(node *TrieNode) {
 		return
 	}
 
-	node.mu.Lock()
-	defer node.mu.Unlock()
-
-	// Clear children recursively
 	for _, child := range node.children {
 		rt.clearNode(child)
 	}
+	for _, child := range node.params {
+		rt.clearNode(child)
+	}
+	rt.clearNode(node.wildcard)
 
-	// Clear maps
 	node.children = nil
-	node.handlers = nil
+	node.dynamic = nil
+	node.params = nil
+	node.wildcard = nil
+	node.routes = nil
 }
 
 // GetNodeCount returns the total number of nodes in the trie for monitoring
@@ -312,12 +749,12 @@ func (rt *RouteTrie) countNodes(node *TrieNode) int {
 		return 0
 	}
 
-	node.mu.RLock()
-	defer node.mu.RUnlock()
-
 	count := 1
 	for _, child := range node.children {
 		count += rt.countNodes(child)
 	}
-	return count
+	for _, child := range node.params {
+		count += rt.countNodes(child)
+	}
+	return count + rt.countNodes(node.wildcard)
 }
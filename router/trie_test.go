@@ -6,6 +6,8 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+
+	"go.fork.vn/fork/context"
 )
 
 // TestSplitPathMemoryUsage tests memory usage patterns of the splitPath caching system
@@ -362,3 +364,189 @@ func naiveSplit(path string) []string {
 
 	return strings.Split(path, "/")
 }
+
+func TestRouteTrieOptionalAndWildcardAtEnd(t *testing.T) {
+	handler := func(context.Context) {}
+	tests := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"/users/:id?", "/users", true},
+		{"/files/*filepath", "/files", true},
+		{"/:a?/:b?/:c?", "/", true},
+		{"/:a/:b", "//ab", false},
+		{"/api/:a?/:b?/users", "/api/users", true},
+		{"/users/:id", "/users", false},
+	}
+
+	r := NewRouter().(*DefaultRouter)
+	for _, tt := range tests {
+		trie := NewRouteTrie()
+		trie.Insert("GET", tt.pattern, handler)
+		if got := trie.Find("GET", tt.path) != nil; got != tt.match {
+			t.Errorf("trie: pattern %q with path %q: expected %v, got %v", tt.pattern, tt.path, tt.match, got)
+		}
+		if got := r.pathMatch(tt.pattern, tt.path); got != tt.match {
+			t.Errorf("pathMatch: pattern %q with path %q: expected %v, got %v", tt.pattern, tt.path, tt.match, got)
+		}
+	}
+}
+
+func TestRouteTrieLookup(t *testing.T) {
+	trie := NewRouteTrie()
+	for _, pattern := range []string{
+		"/users/:id<\\d+>",
+		"/users/:name",
+		"/users/:name/posts/:post",
+		"/users/me",
+		"/api/:version?/items",
+		"/files/*filepath",
+	} {
+		pattern := pattern
+		trie.Insert("GET", pattern, func(context.Context) {})
+	}
+
+	tests := []struct {
+		path    string
+		pattern string
+		params  map[string]string
+	}{
+		{"/users/me", "/users/me", map[string]string{}},
+		{"/users/42", "/users/:id<\\d+>", map[string]string{"id": "42"}},
+		{"/users/alice", "/users/:name", map[string]string{"name": "alice"}},
+		{"/users/alice/posts/7", "/users/:name/posts/:post", map[string]string{"name": "alice", "post": "7"}},
+		{"/users/42/posts/7", "/users/:name/posts/:post", map[string]string{"name": "42", "post": "7"}},
+		{"/api/v2/items", "/api/:version?/items", map[string]string{"version": "v2"}},
+		{"/api/items", "/api/:version?/items", map[string]string{"version": ""}},
+		{"/files/css/site.css", "/files/*filepath", map[string]string{"filepath": "css/site.css"}},
+		{"/files", "/files/*filepath", map[string]string{"filepath": ""}},
+	}
+
+	for _, tt := range tests {
+		route, params := trie.Lookup("GET", tt.path)
+		if route == nil {
+			t.Errorf("Lookup(%q): expected route %q, got nil", tt.path, tt.pattern)
+			continue
+		}
+		if route.Path != tt.pattern || route.Method != "GET" || route.Handler == nil {
+			t.Errorf("Lookup(%q): expected route %q, got %+v", tt.path, tt.pattern, route)
+		}
+		if len(params) != len(tt.params) {
+			t.Errorf("Lookup(%q): expected params %v, got %v", tt.path, tt.params, params)
+		}
+		for name, value := range tt.params {
+			if got, ok := params[name]; !ok || got != value {
+				t.Errorf("Lookup(%q): param %q expected %q, got %q", tt.path, name, value, got)
+			}
+		}
+	}
+
+	if route, _ := trie.Lookup("POST", "/users/me"); route != nil {
+		t.Errorf("Expected no route for POST /users/me, got %q", route.Path)
+	}
+	if route, _ := trie.Lookup("GET", "/unknown"); route != nil {
+		t.Errorf("Expected no route for /unknown, got %q", route.Path)
+	}
+}
+
+func TestRouteTrieInsertKeepsFirstRoute(t *testing.T) {
+	trie := NewRouteTrie()
+	calls := ""
+	trie.Insert("GET", "/dup", func(context.Context) { calls += "first" })
+	trie.Insert("GET", "/dup", func(context.Context) { calls += "second" })
+
+	trie.Find("GET", "/dup")(nil)
+	if calls != "first" {
+		t.Errorf("Expected first registered handler, got %q", calls)
+	}
+}
+
+func TestRouteTrieRemove(t *testing.T) {
+	trie := NewRouteTrie()
+	handler := func(context.Context) {}
+	trie.Insert("GET", "/a/b/:id", handler)
+	trie.Insert("POST", "/a/b/:id", handler)
+	trie.Insert("GET", "/a/*rest", handler)
+	initial := trie.GetNodeCount()
+
+	if !trie.Remove("GET", "/a/b/:id") {
+		t.Fatal("Expected GET /a/b/:id to be removed")
+	}
+	if trie.Remove("GET", "/a/b/:id") {
+		t.Error("Expected second removal to report false")
+	}
+	if route, _ := trie.Lookup("GET", "/a/b/1"); route == nil || route.Path != "/a/*rest" {
+		t.Errorf("Expected fallback to wildcard route, got %+v", route)
+	}
+	if trie.Find("POST", "/a/b/1") == nil {
+		t.Error("Expected POST route to remain")
+	}
+	if trie.GetNodeCount() != initial {
+		t.Errorf("Expected nodes to be kept while POST route exists")
+	}
+
+	trie.Remove("POST", "/a/b/:id")
+	if got := trie.GetNodeCount(); got != initial-2 {
+		t.Errorf("Expected unused nodes to be pruned, got %d nodes (initial %d)", got, initial)
+	}
+}
+
+// BenchmarkRouterLargeTable đo thời gian tìm route khi bảng route lớn
+func BenchmarkRouterLargeTable(b *testing.B) {
+	r := NewRouter().(*DefaultRouter)
+	handler := func(context.Context) {}
+	for i := 0; i < 1000; i++ {
+		group := r.Group(fmt.Sprintf("/svc%d", i))
+		group.Handle("GET", "/items/:id", handler)
+		group.Handle("POST", "/items", handler)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if route, _ := r.findRoute("GET", "/svc999/items/42"); route == nil {
+			b.Fatal("route not found")
+		}
+	}
+}
+
+func TestRouteTrieMiddleWildcard(t *testing.T) {
+	trie := NewRouteTrie()
+	handler := func(ctx context.Context) {}
+	trie.Insert("GET", "/files/*path/meta", handler)
+	trie.Insert("GET", "/files/*path/versions/:version", handler)
+	trie.Insert("GET", "/repos/*name/-/blob/:file", handler)
+
+	tests := []struct {
+		path     string
+		pattern  string
+		expected map[string]string
+	}{
+		{"/files/a/meta", "/files/*path/meta", map[string]string{"path": "a"}},
+		{"/files/a/b/c/meta", "/files/*path/meta", map[string]string{"path": "a/b/c"}},
+		{"/files/meta/meta", "/files/*path/meta", map[string]string{"path": "meta"}},
+		{"/files/a/versions/2/versions/3", "/files/*path/versions/:version", map[string]string{"path": "a/versions/2", "version": "3"}},
+		{"/repos/group/sub/project/-/blob/main.go", "/repos/*name/-/blob/:file", map[string]string{"name": "group/sub/project", "file": "main.go"}},
+		{"/files/meta", "", nil},
+		{"/files/a/b", "", nil},
+	}
+	for _, tt := range tests {
+		route, params := trie.Lookup("GET", tt.path)
+		if tt.pattern == "" {
+			if route != nil {
+				t.Errorf("%s: expected no match, got %s", tt.path, route.Path)
+			}
+			continue
+		}
+		if route == nil || route.Path != tt.pattern {
+			t.Errorf("%s: expected %s, got %v", tt.path, tt.pattern, route)
+			continue
+		}
+		for name, value := range tt.expected {
+			if params[name] != value {
+				t.Errorf("%s: expected %s=%q, got %q", tt.path, name, value, params[name])
+			}
+		}
+	}
+}
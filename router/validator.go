@@ -0,0 +1,32 @@
+package router
+
+import (
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// SetValidator thiết lập validator của ứng dụng, được gắn vào context của mọi request
+// mà router phục vụ. Validation cần được đăng ký trên validator khi khởi tạo ứng dụng
+// (ví dụ WebApp.RegisterValidation), trước khi phục vụ request. Chỉ có hiệu lực ở router gốc.
+// An toàn khi gọi đồng thời với các request đang được xử lý.
+//
+// Parameters:
+//   - v: Validator dùng chung (*validator.Validate hoặc backend tùy chỉnh), nil để context
+//     dùng validator mặc định
+func (r *DefaultRouter) SetValidator(v forkCtx.Validator) {
+	if v == nil {
+		r.root().validator.Store(nil)
+		return
+	}
+	r.root().validator.Store(&v)
+}
+
+// Validator trả về validator được thiết lập qua SetValidator.
+//
+// Returns:
+//   - forkCtx.Validator: Validator của router, nil nếu chưa thiết lập
+func (r *DefaultRouter) Validator() forkCtx.Validator {
+	if v := r.root().validator.Load(); v != nil {
+		return *v
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"go.fork.vn/fork/context"
+)
+
+func TestDefaultRouter_Validator(t *testing.T) {
+	type Input struct {
+		Code string `validate:"even_length"`
+	}
+
+	r := NewRouter().(*DefaultRouter)
+	v := context.NewValidator()
+	if err := v.RegisterValidation("even_length", func(fl validator.FieldLevel) bool {
+		return len(fl.Field().String())%2 == 0
+	}); err != nil {
+		t.Fatal(err)
+	}
+	r.Group("/api").(*DefaultRouter).SetValidator(v)
+	if r.Validator() != v {
+		t.Fatal("Expected validator to be set on the root router")
+	}
+
+	r.Handle("GET", "/register", func(c context.Context) {
+		if c.GetValidator() != v {
+			t.Error("Expected context to use the router validator")
+		}
+		err := c.RegisterValidation("odd_length", func(validator.FieldLevel) bool { return true })
+		if !errors.Is(err, context.ErrSharedValidator) {
+			t.Errorf("Expected ErrSharedValidator, got %v", err)
+		}
+		c.Status(http.StatusNoContent)
+	})
+	r.Handle("GET", "/validate", func(c context.Context) {
+		if err := c.ValidateStruct(Input{Code: "abc"}); err == nil {
+			c.Status(http.StatusOK)
+			return
+		}
+		c.Status(http.StatusUnprocessableEntity)
+	})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/register", nil))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/validate", nil))
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected validation registered at startup to apply, got %d", w.Code)
+	}
+
+	r.SetValidator(nil)
+	if r.Validator() != nil {
+		t.Error("Expected nil validator after SetValidator(nil)")
+	}
+}
+
+func TestDefaultRouter_ValidatorConcurrent(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	r.Handle("GET", "/", func(c context.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			r.SetValidator(context.NewValidator())
+			r.SetLogger(&countingLogger{})
+		}()
+		go func() {
+			defer wg.Done()
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+		}()
+	}
+	wg.Wait()
+}
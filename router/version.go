@@ -0,0 +1,142 @@
+package router
+
+import (
+	"mime"
+	"strings"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// VersionKey là key trong context lưu API version đã được xác định cho request.
+const VersionKey = "router.api_version"
+
+// VersionStrategy xác định cách request chọn API version.
+type VersionStrategy int
+
+const (
+	// VersionPath chọn version theo tiền tố path, ví dụ "/v2/users".
+	VersionPath VersionStrategy = iota
+
+	// VersionHeader chọn version theo header Accept, ví dụ
+	// "application/json; version=2" hoặc "application/vnd.acme.v2+json".
+	VersionHeader
+)
+
+// String trả về tên của strategy.
+func (s VersionStrategy) String() string {
+	switch s {
+	case VersionPath:
+		return "path"
+	case VersionHeader:
+		return "header"
+	default:
+		return "unknown"
+	}
+}
+
+// Version tạo một router group cho API version. Với VersionPath, group có prefix "/v<version>";
+// với VersionHeader, group khớp request có header Accept khai báo version và được ưu tiên
+// hơn routes không ràng buộc version có cùng path. Version đã xác định được lưu trong context,
+// đọc bằng APIVersion(ctx).
+//
+// Parameters:
+//   - version: API version (ví dụ: "2" hoặc "v2")
+//   - strategy: Cách request chọn version
+//
+// Returns:
+//   - Router: Router group của version
+func (r *DefaultRouter) Version(version string, strategy VersionStrategy) Router {
+	name := normalizeVersion(version)
+	if name == "" {
+		panic("router: API version must not be empty")
+	}
+
+	var group *DefaultRouter
+	switch strategy {
+	case VersionPath:
+		group = r.Group("/v" + name).(*DefaultRouter)
+	case VersionHeader:
+		group = r.Group("", func(group *DefaultRouter) { group.version = name }).(*DefaultRouter)
+	default:
+		panic("router: unknown API version strategy " + strategy.String())
+	}
+
+	group.Use(func(ctx forkCtx.Context) {
+		ctx.Set(VersionKey, name)
+		ctx.Next()
+	})
+	return group
+}
+
+// SetDefaultVersion đặt version áp dụng cho request không khai báo version trong header Accept,
+// nhờ đó client cũ không gửi version vẫn khớp version group theo header. Chỉ có hiệu lực
+// khi được gọi trên router gốc.
+//
+// Parameters:
+//   - version: API version mặc định, rỗng để tắt
+func (r *DefaultRouter) SetDefaultVersion(version string) {
+	r.defaultVersion = normalizeVersion(version)
+}
+
+// APIVersion trả về API version đã xác định cho request bởi version group.
+//
+// Parameters:
+//   - ctx: Context của HTTP request
+//
+// Returns:
+//   - string: Version không kèm tiền tố "v", rỗng nếu request không đi qua version group
+func APIVersion(ctx forkCtx.Context) string {
+	value, ok := ctx.Get(VersionKey)
+	if !ok {
+		return ""
+	}
+	version, _ := value.(string)
+	return version
+}
+
+// normalizeVersion chuẩn hóa version về dạng chữ thường, không kèm tiền tố "v".
+func normalizeVersion(version string) string {
+	version = strings.ToLower(strings.TrimSpace(version))
+	return strings.TrimPrefix(version, "v")
+}
+
+// requestVersion trả về version khai báo trong header Accept của request,
+// hoặc version mặc định của router nếu header không khai báo version.
+func (r *DefaultRouter) requestVersion(ctx forkCtx.Context) string {
+	accept := ctx.Request().Request().Header.Get("Accept")
+	for _, mediaRange := range strings.Split(accept, ",") {
+		if version := acceptVersion(mediaRange); version != "" {
+			return version
+		}
+	}
+	return r.defaultVersion
+}
+
+// acceptVersion trích version từ một media range của header Accept: tham số "version"
+// hoặc hậu tố ".v<version>" trong subtype vendor (ví dụ "application/vnd.acme.v2+json").
+//
+// Parameters:
+//   - mediaRange: Một media range của header Accept
+//
+// Returns:
+//   - string: Version đã chuẩn hóa, rỗng nếu media range không khai báo version
+func acceptVersion(mediaRange string) string {
+	mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+	if err != nil {
+		return ""
+	}
+	if version := normalizeVersion(params["version"]); version != "" {
+		return version
+	}
+
+	_, subtype, _ := strings.Cut(mediaType, "/")
+	if !strings.HasPrefix(subtype, "vnd.") {
+		return ""
+	}
+	subtype, _, _ = strings.Cut(subtype, "+")
+	i := strings.LastIndex(subtype, ".v")
+	if i < 0 || i+2 == len(subtype) || subtype[i+2] < '0' || subtype[i+2] > '9' {
+		return ""
+	}
+	return subtype[i+2:]
+}
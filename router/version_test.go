@@ -0,0 +1,141 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.fork.vn/fork/context"
+)
+
+func serveAccept(r Router, path, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", path, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestAcceptVersion(t *testing.T) {
+	tests := []struct {
+		mediaRange string
+		version    string
+	}{
+		{"application/json; version=2", "2"},
+		{"application/json;version=v3", "3"},
+		{"application/vnd.acme.v2+json", "2"},
+		{"application/vnd.acme.v1.1+json", "1.1"},
+		{"application/vnd.acme.video+json", ""},
+		{"application/json", ""},
+		{"*/*", ""},
+		{"not a media type", ""},
+	}
+	for _, tt := range tests {
+		if got := acceptVersion(tt.mediaRange); got != tt.version {
+			t.Errorf("%q: expected version %q, got %q", tt.mediaRange, tt.version, got)
+		}
+	}
+}
+
+func TestDefaultRouter_VersionPath(t *testing.T) {
+	r := NewRouter()
+	handler := func(ctx context.Context) { ctx.String(http.StatusOK, "users v"+APIVersion(ctx)) }
+	r.Version("v1", VersionPath).Handle("GET", "/users", handler)
+	r.Version("2", VersionPath).Handle("GET", "/users", handler)
+
+	tests := []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/v1/users", http.StatusOK, "users v1"},
+		{"/v2/users", http.StatusOK, "users v2"},
+		{"/v3/users", http.StatusNotFound, "404 page not found"},
+	}
+	for _, tt := range tests {
+		w := serveAccept(r, tt.path, "")
+		if w.Code != tt.code || w.Body.String() != tt.body {
+			t.Errorf("%s: expected %d %q, got %d %q", tt.path, tt.code, tt.body, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestDefaultRouter_VersionHeader(t *testing.T) {
+	r := NewRouter()
+	r.Handle("GET", "/users", func(ctx context.Context) { ctx.String(http.StatusOK, "users "+APIVersion(ctx)) })
+	r.Version("1", VersionHeader).Handle("GET", "/users", func(ctx context.Context) {
+		ctx.String(http.StatusOK, "users v"+APIVersion(ctx))
+	})
+	v2 := r.Version("2", VersionHeader)
+	v2.Handle("GET", "/users", func(ctx context.Context) { ctx.String(http.StatusOK, "users v"+APIVersion(ctx)) })
+	v2.Handle("GET", "/reports", func(ctx context.Context) { ctx.String(http.StatusOK, "reports v2") })
+
+	tests := []struct {
+		path   string
+		accept string
+		code   int
+		body   string
+	}{
+		{"/users", "application/json; version=1", http.StatusOK, "users v1"},
+		{"/users", "application/vnd.acme.v2+json", http.StatusOK, "users v2"},
+		{"/users", "text/html, application/json; version=2", http.StatusOK, "users v2"},
+		{"/users", "application/json", http.StatusOK, "users "},
+		{"/users", "application/json; version=9", http.StatusOK, "users "},
+		{"/reports", "application/json; version=2", http.StatusOK, "reports v2"},
+		{"/reports", "application/json; version=1", http.StatusNotFound, "404 page not found"},
+	}
+	for _, tt := range tests {
+		w := serveAccept(r, tt.path, tt.accept)
+		if w.Code != tt.code || w.Body.String() != tt.body {
+			t.Errorf("%s (%s): expected %d %q, got %d %q", tt.path, tt.accept, tt.code, tt.body, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestDefaultRouter_DefaultVersion(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	r.SetDefaultVersion("v1")
+	for _, version := range []string{"1", "2"} {
+		r.Version(version, VersionHeader).Handle("GET", "/users", func(ctx context.Context) {
+			ctx.String(http.StatusOK, "users v"+APIVersion(ctx))
+		})
+	}
+
+	if w := serveAccept(r, "/users", ""); w.Body.String() != "users v1" {
+		t.Errorf("Expected default version, got %d %q", w.Code, w.Body.String())
+	}
+	if w := serveAccept(r, "/users", "application/json; version=2"); w.Body.String() != "users v2" {
+		t.Errorf("Expected requested version, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestDefaultRouter_VersionHeaderInsideHost(t *testing.T) {
+	r := NewRouter().(*DefaultRouter)
+	api := r.Host("api.example.com")
+	api.Handle("GET", "/users", func(ctx context.Context) { ctx.String(http.StatusOK, "api users") })
+	api.Version("2", VersionHeader).Handle("GET", "/users", func(ctx context.Context) {
+		ctx.String(http.StatusOK, "api users v2")
+	})
+
+	req := httptest.NewRequest("GET", "/users", nil)
+	req.Host = "api.example.com"
+	req.Header.Set("Accept", "application/json; version=2")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Body.String() != "api users v2" {
+		t.Errorf("Expected nested version group to win, got %q", w.Body.String())
+	}
+
+	if w := serveHost(r, "GET", "api.example.com", "/users"); w.Body.String() != "api users" {
+		t.Errorf("Expected host route without version, got %q", w.Body.String())
+	}
+	if w := serveAccept(r, "/users", "application/json; version=2"); w.Code != http.StatusNotFound {
+		t.Errorf("Expected version group to require host, got %d", w.Code)
+	}
+
+	if err := r.Err(); err != nil {
+		t.Errorf("Expected version group routes not to conflict, got %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+// Package signedurl cung cấp URL tạm thời được ký HMAC cho link tải xuống, xác minh email
+// hoặc hủy đăng ký mà không cần tra cứu database.
+//
+// Chữ ký được tính trên path, query (đã sắp xếp) và thời điểm hết hạn; host không tham gia
+// vào chữ ký nên URL vẫn hợp lệ phía sau reverse proxy.
+package signedurl
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+	forkerrors "go.fork.vn/fork/errors"
+	"go.fork.vn/fork/router"
+)
+
+// Các tham số query được thêm vào URL đã ký.
+const (
+	ExpiresParam   = "expires"
+	SignatureParam = "signature"
+)
+
+// Các lỗi xác thực URL đã ký.
+var (
+	// ErrMissingSignature được trả về khi URL không có chữ ký
+	ErrMissingSignature = errors.New("signedurl: missing signature")
+
+	// ErrInvalidSignature được trả về khi chữ ký không khớp
+	ErrInvalidSignature = errors.New("signedurl: invalid signature")
+
+	// ErrExpired được trả về khi URL đã hết hạn
+	ErrExpired = errors.New("signedurl: url expired")
+
+	// ErrMissingParam được trả về khi route thiếu tham số để điền vào path
+	ErrMissingParam = errors.New("signedurl: missing route parameter")
+)
+
+// Signer ký và xác thực URL.
+type Signer struct {
+	secret []byte
+	now    func() time.Time
+}
+
+// NewSigner tạo Signer mới.
+//
+// Parameters:
+//   - secret: Khóa HMAC (tối thiểu 32 bytes được khuyến nghị)
+//
+// Returns:
+//   - *Signer: Signer đã khởi tạo
+//
+// Panics:
+//   - Nếu secret rỗng
+func NewSigner(secret []byte) *Signer {
+	if len(secret) == 0 {
+		panic("signedurl: secret is required")
+	}
+	return &Signer{secret: secret, now: time.Now}
+}
+
+// Sign ký một URL (path và query tùy chọn) với thời hạn đã cho.
+// Expiry bằng 0 tạo URL không hết hạn.
+//
+// Parameters:
+//   - rawURL: URL tương đối hoặc tuyệt đối (ví dụ: "/downloads/42?file=report.pdf")
+//   - expiry: Thời hạn của URL
+//
+// Returns:
+//   - string: URL đã ký
+//   - error: Lỗi nếu URL không hợp lệ
+func (s *Signer) Sign(rawURL string, expiry time.Duration) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Del(SignatureParam)
+	query.Del(ExpiresParam)
+	if expiry > 0 {
+		query.Set(ExpiresParam, strconv.FormatInt(s.now().Add(expiry).Unix(), 10))
+	}
+	query.Set(SignatureParam, s.signature(u.EscapedPath(), query))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// SignRoute điền tham số vào route pattern rồi ký URL.
+// Các segment ":name" và "*name" được thay bằng giá trị tương ứng; tham số còn lại
+// được thêm vào query string.
+//
+// Parameters:
+//   - route: Route pattern (ví dụ: "/users/:id/verify")
+//   - params: Giá trị tham số
+//   - expiry: Thời hạn của URL
+//
+// Returns:
+//   - string: URL đã ký
+//   - error: ErrMissingParam nếu route thiếu tham số
+func (s *Signer) SignRoute(route string, params map[string]string, expiry time.Duration) (string, error) {
+	path, query, err := BuildPath(route, params)
+	if err != nil {
+		return "", err
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return s.Sign(path, expiry)
+}
+
+// Verify xác thực URL đã ký của request.
+//
+// Parameters:
+//   - u: URL của request
+//
+// Returns:
+//   - error: ErrMissingSignature, ErrInvalidSignature hoặc ErrExpired
+func (s *Signer) Verify(u *url.URL) error {
+	query := u.Query()
+	signature := query.Get(SignatureParam)
+	if signature == "" {
+		return ErrMissingSignature
+	}
+	query.Del(SignatureParam)
+
+	expected := s.signature(u.EscapedPath(), query)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return ErrInvalidSignature
+	}
+
+	if raw := query.Get(ExpiresParam); raw != "" {
+		expires, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return ErrInvalidSignature
+		}
+		if !s.now().Before(time.Unix(expires, 0)) {
+			return ErrExpired
+		}
+	}
+	return nil
+}
+
+// Middleware trả về middleware từ chối request có URL không được ký hợp lệ
+// với HttpError 403.
+//
+// Returns:
+//   - router.HandlerFunc: Middleware xác thực URL đã ký
+func (s *Signer) Middleware() router.HandlerFunc {
+	return func(ctx forkCtx.Context) {
+		if err := s.Verify(ctx.Request().Request().URL); err != nil {
+			message := "Invalid signature"
+			if errors.Is(err, ErrExpired) {
+				message = "Link has expired"
+			}
+			httpError := forkerrors.NewForbidden(message, nil, err)
+			ctx.JSON(httpError.StatusCode, httpError)
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// signature tính HMAC-SHA256 trên path và query đã chuẩn hóa.
+func (s *Signer) signature(path string, query url.Values) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(path))
+	mac.Write([]byte{'?'})
+	mac.Write([]byte(query.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// BuildPath điền tham số vào route pattern.
+//
+// Parameters:
+//   - route: Route pattern (ví dụ: "/files/:id/*path")
+//   - params: Giá trị tham số
+//
+// Returns:
+//   - string: Path đã điền tham số
+//   - url.Values: Các tham số không dùng trong path
+//   - error: ErrMissingParam nếu thiếu tham số
+func BuildPath(route string, params map[string]string) (string, url.Values, error) {
+	used := make(map[string]bool)
+	segments := strings.Split(route, "/")
+	for i, segment := range segments {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+		name := strings.TrimSuffix(segment[1:], "?")
+		value, ok := params[name]
+		if !ok {
+			if strings.HasSuffix(segment, "?") || segment[0] == '*' {
+				segments[i] = ""
+				continue
+			}
+			return "", nil, fmt.Errorf("%w: %s", ErrMissingParam, name)
+		}
+		used[name] = true
+		if segment[0] == '*' {
+			// Wildcard giữ nguyên dấu "/" trong giá trị
+			parts := strings.Split(value, "/")
+			for j, part := range parts {
+				parts[j] = url.PathEscape(part)
+			}
+			segments[i] = strings.Join(parts, "/")
+		} else {
+			segments[i] = url.PathEscape(value)
+		}
+	}
+
+	query := url.Values{}
+	for name, value := range params {
+		if !used[name] {
+			query.Set(name, value)
+		}
+	}
+
+	path := strings.Join(segments, "/")
+	for strings.Contains(path, "//") {
+		path = strings.ReplaceAll(path, "//", "/")
+	}
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path, query, nil
+}
@@ -0,0 +1,143 @@
+package signedurl
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+func newTestSigner() *Signer {
+	s := NewSigner([]byte("0123456789abcdef0123456789abcdef"))
+	fixed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return fixed }
+	return s
+}
+
+func mustParse(t *testing.T, raw string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("Failed to parse %q: %v", raw, err)
+	}
+	return u
+}
+
+func TestSignAndVerify(t *testing.T) {
+	s := newTestSigner()
+	signed, err := s.Sign("/downloads/42?file=report.pdf", time.Hour)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(signed, "expires=1704070800") || !strings.Contains(signed, "signature=") {
+		t.Fatalf("Expected expires and signature params, got %s", signed)
+	}
+
+	if err := s.Verify(mustParse(t, signed)); err != nil {
+		t.Errorf("Expected valid signature, got %v", err)
+	}
+	// Host không tham gia chữ ký
+	if err := s.Verify(mustParse(t, "https://cdn.example.com"+signed)); err != nil {
+		t.Errorf("Expected absolute URL to verify, got %v", err)
+	}
+}
+
+func TestVerifyErrors(t *testing.T) {
+	s := newTestSigner()
+	signed, _ := s.Sign("/downloads/42?file=report.pdf", time.Hour)
+
+	tests := []struct {
+		name     string
+		url      string
+		expected error
+	}{
+		{"missing signature", "/downloads/42?file=report.pdf", ErrMissingSignature},
+		{"tampered query", strings.Replace(signed, "report.pdf", "secret.pdf", 1), ErrInvalidSignature},
+		{"tampered path", strings.Replace(signed, "/42", "/43", 1), ErrInvalidSignature},
+		{"extended expiry", strings.Replace(signed, "expires=1704070800", "expires=1904070800", 1), ErrInvalidSignature},
+		{"other secret", func() string {
+			other := NewSigner([]byte("another-secret"))
+			u, _ := other.Sign("/downloads/42", 0)
+			return u
+		}(), ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.Verify(mustParse(t, tt.url)); !errors.Is(err, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+
+	s.now = func() time.Time { return time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC) }
+	if err := s.Verify(mustParse(t, signed)); !errors.Is(err, ErrExpired) {
+		t.Errorf("Expected ErrExpired, got %v", err)
+	}
+}
+
+func TestSignWithoutExpiry(t *testing.T) {
+	s := newTestSigner()
+	signed, _ := s.Sign("/unsubscribe/abc", 0)
+	if strings.Contains(signed, ExpiresParam) {
+		t.Errorf("Expected no expires param, got %s", signed)
+	}
+	s.now = func() time.Time { return time.Now().AddDate(10, 0, 0) }
+	if err := s.Verify(mustParse(t, signed)); err != nil {
+		t.Errorf("Expected non-expiring URL to verify, got %v", err)
+	}
+}
+
+func TestBuildPath(t *testing.T) {
+	tests := []struct {
+		route    string
+		params   map[string]string
+		path     string
+		query    string
+		expected error
+	}{
+		{"/users/:id/verify", map[string]string{"id": "42", "ref": "mail"}, "/users/42/verify", "ref=mail", nil},
+		{"/files/*path", map[string]string{"path": "a b/c.txt"}, "/files/a%20b/c.txt", "", nil},
+		{"/posts/:slug?", nil, "/posts", "", nil},
+		{"/users/:id", nil, "", "", ErrMissingParam},
+	}
+
+	for _, tt := range tests {
+		path, query, err := BuildPath(tt.route, tt.params)
+		if !errors.Is(err, tt.expected) {
+			t.Errorf("BuildPath(%q): expected error %v, got %v", tt.route, tt.expected, err)
+			continue
+		}
+		if err == nil && (path != tt.path || query.Encode() != tt.query) {
+			t.Errorf("BuildPath(%q) = %q, %q; expected %q, %q", tt.route, path, query.Encode(), tt.path, tt.query)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	s := newTestSigner()
+	signed, _ := s.SignRoute("/users/:id/verify", map[string]string{"id": "42"}, time.Hour)
+	mw := s.Middleware()
+
+	run := func(target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		ctx := forkCtx.NewContext(w, httptest.NewRequest(http.MethodGet, target, nil))
+		ctx.SetHandlers([]func(forkCtx.Context){mw, func(ctx forkCtx.Context) {
+			ctx.String(http.StatusOK, "verified")
+		}})
+		ctx.Next()
+		return w
+	}
+
+	if w := run(signed); w.Code != http.StatusOK {
+		t.Errorf("Expected signed URL to pass, got %d", w.Code)
+	}
+	if w := run("/users/42/verify"); w.Code != http.StatusForbidden {
+		t.Errorf("Expected unsigned URL to be rejected, got %d", w.Code)
+	}
+}
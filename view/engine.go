@@ -0,0 +1,384 @@
+// Package view cung cấp template engine mặc định dựa trên html/template, hỗ trợ layouts,
+// partials, kế thừa template qua block/define, FuncMap tùy chỉnh và nạp template từ
+// thư mục hoặc embed.FS.
+//
+// Cấu trúc thư mục mặc định:
+//
+//	views/
+//	  layouts/base.html     -> layout, gọi {{ block "content" . }}{{ end }}
+//	  partials/header.html  -> partial, dùng qua {{ template "partials/header" . }}
+//	  users/show.html       -> page, định nghĩa {{ define "content" }}...{{ end }}
+//
+// Page chọn layout bằng directive ở đầu file: {{/* layout: base */}}. Directive
+// "layout: none" tắt layout mặc định. Layout cũng có thể khai báo layout cha để tạo
+// chuỗi kế thừa nhiều cấp.
+package view
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Các lỗi của template engine.
+var (
+	// ErrTemplateNotFound được trả về khi template không tồn tại
+	ErrTemplateNotFound = errors.New("view: template not found")
+
+	// ErrLayoutCycle được trả về khi chuỗi layout tham chiếu vòng
+	ErrLayoutCycle = errors.New("view: layout cycle detected")
+)
+
+// Config chứa cấu hình cho template engine.
+type Config struct {
+	// FS là file system chứa templates (ví dụ: embed.FS).
+	// Nếu nil, Directory được sử dụng.
+	FS fs.FS
+
+	// Directory là thư mục chứa templates khi FS là nil.
+	// Mặc định: "views"
+	Directory string
+
+	// Extension là phần mở rộng của file template.
+	// Mặc định: ".html"
+	Extension string
+
+	// LayoutsDir là thư mục con chứa layouts.
+	// Mặc định: "layouts"
+	LayoutsDir string
+
+	// PartialsDir là thư mục con chứa partials, được nạp vào mọi template.
+	// Mặc định: "partials"
+	PartialsDir string
+
+	// DefaultLayout là layout áp dụng cho page không khai báo layout (ví dụ: "base").
+	// Để trống nếu không dùng layout mặc định.
+	DefaultLayout string
+
+	// Funcs là các template functions bổ sung
+	Funcs template.FuncMap
+
+	// LeftDelim và RightDelim là delimiters của template.
+	// Mặc định: "{{" và "}}"
+	LeftDelim  string
+	RightDelim string
+
+	// Debug bật chế độ phát triển: theo dõi Directory và biên dịch lại templates khi
+	// có thay đổi, đồng thời lỗi render được trả về dưới dạng trang lỗi HTML chi tiết.
+	// Việc theo dõi chỉ áp dụng khi templates được nạp từ Directory (FS là nil).
+	Debug bool
+
+	// OnReload được gọi sau mỗi lần nạp lại templates ở chế độ Debug,
+	// với lỗi biên dịch nếu có.
+	OnReload func(err error)
+}
+
+// source là nội dung một file template đã đọc.
+type source struct {
+	name   string
+	text   string
+	layout string
+	hasDir bool
+}
+
+// Engine là template engine dựa trên html/template.
+// Engine an toàn khi sử dụng đồng thời.
+type Engine struct {
+	config Config
+
+	mu        sync.RWMutex
+	pages     map[string]*template.Template
+	templates []string
+	sources   map[string]*source
+	loadErr   error
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+// New tạo Engine mới và nạp toàn bộ templates.
+//
+// Parameters:
+//   - config: Cấu hình engine
+//
+// Returns:
+//   - *Engine: Engine đã nạp templates
+//   - error: Lỗi nếu không đọc hoặc không parse được templates
+func New(config Config) (*Engine, error) {
+	watchable := config.FS == nil
+	if config.Directory == "" {
+		config.Directory = "views"
+	}
+	if config.FS == nil {
+		config.FS = os.DirFS(config.Directory)
+	}
+	if config.Extension == "" {
+		config.Extension = ".html"
+	}
+	if config.LayoutsDir == "" {
+		config.LayoutsDir = "layouts"
+	}
+	if config.PartialsDir == "" {
+		config.PartialsDir = "partials"
+	}
+	if config.LeftDelim == "" {
+		config.LeftDelim = "{{"
+	}
+	if config.RightDelim == "" {
+		config.RightDelim = "}}"
+	}
+
+	e := &Engine{config: config}
+	if err := e.Load(); err != nil {
+		return nil, err
+	}
+	if config.Debug && watchable {
+		if err := e.watch(config.Directory); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Must là helper panic nếu New trả về lỗi, dùng khi khởi tạo ứng dụng.
+//
+// Parameters:
+//   - e: Engine trả về từ New
+//   - err: Lỗi trả về từ New
+//
+// Returns:
+//   - *Engine: Engine nếu không có lỗi
+func Must(e *Engine, err error) *Engine {
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Load đọc và biên dịch lại toàn bộ templates.
+// Nếu có lỗi, các templates đã nạp trước đó được giữ nguyên.
+//
+// Returns:
+//   - error: Lỗi nếu không đọc hoặc không parse được templates
+func (e *Engine) Load() error {
+	sources, err := e.readSources()
+	if err != nil {
+		return err
+	}
+
+	// Lưu mã nguồn mới ngay cả khi biên dịch lỗi để trang lỗi hiển thị đúng nội dung
+	e.mu.Lock()
+	e.sources = sources
+	e.mu.Unlock()
+
+	pages := make(map[string]*template.Template)
+	names := make([]string, 0)
+	for name, src := range sources {
+		if e.isLayout(name) || e.isPartial(name) {
+			continue
+		}
+		tmpl, err := e.compile(src, sources)
+		if err != nil {
+			return err
+		}
+		pages[name] = tmpl
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	e.mu.Lock()
+	e.pages = pages
+	e.templates = names
+	e.mu.Unlock()
+	return nil
+}
+
+// Render render template thành bytes.
+// Phương thức này thỏa mãn interface template engine mà ctx.Render sử dụng.
+//
+// Parameters:
+//   - name: Tên template, là đường dẫn tương đối không có phần mở rộng (ví dụ: "users/show")
+//   - data: Dữ liệu truyền vào template
+//
+// Returns:
+//   - []byte: Nội dung đã render
+//   - error: ErrTemplateNotFound hoặc lỗi thực thi template
+func (e *Engine) Render(name string, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := e.Execute(&buf, name, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Execute render template và ghi kết quả vào writer.
+//
+// Parameters:
+//   - w: Writer nhận nội dung
+//   - name: Tên template
+//   - data: Dữ liệu truyền vào template
+//
+// Returns:
+//   - error: ErrTemplateNotFound hoặc lỗi thực thi template
+func (e *Engine) Execute(w io.Writer, name string, data interface{}) error {
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "/"), e.config.Extension)
+
+	e.mu.RLock()
+	tmpl, ok := e.pages[name]
+	loadErr := e.loadErr
+	e.mu.RUnlock()
+
+	if !e.config.Debug {
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+		}
+		return tmpl.Execute(w, data)
+	}
+
+	// Ở chế độ Debug, lỗi biên dịch lần nạp gần nhất được ưu tiên báo cáo
+	// thay vì render phiên bản template cũ
+	if loadErr != nil {
+		return e.renderError(name, loadErr)
+	}
+	if !ok {
+		return e.renderError(name, fmt.Errorf("%w: %s", ErrTemplateNotFound, name))
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return e.renderError(name, err)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Templates trả về danh sách tên các page templates đã nạp, đã sắp xếp.
+//
+// Returns:
+//   - []string: Tên các templates
+func (e *Engine) Templates() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	names := make([]string, len(e.templates))
+	copy(names, e.templates)
+	return names
+}
+
+// readSources đọc tất cả files template trong FS.
+func (e *Engine) readSources() (map[string]*source, error) {
+	directive := regexp.MustCompile(`^\s*` + regexp.QuoteMeta(e.config.LeftDelim) +
+		`/\*\s*layout:\s*([\w./-]+)\s*\*/` + regexp.QuoteMeta(e.config.RightDelim))
+
+	sources := make(map[string]*source)
+	err := fs.WalkDir(e.config.FS, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != e.config.Extension {
+			return nil
+		}
+		data, err := fs.ReadFile(e.config.FS, p)
+		if err != nil {
+			return err
+		}
+
+		src := &source{name: strings.TrimSuffix(p, e.config.Extension), text: string(data)}
+		if match := directive.FindStringSubmatch(src.text); match != nil {
+			src.layout = match[1]
+			src.hasDir = true
+		}
+		sources[src.name] = src
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("view: load templates: %w", err)
+	}
+	return sources, nil
+}
+
+// compile biên dịch một page cùng partials và chuỗi layouts của nó.
+func (e *Engine) compile(page *source, sources map[string]*source) (*template.Template, error) {
+	chain, err := e.layoutChain(page, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	root := template.New("").Delims(e.config.LeftDelim, e.config.RightDelim).Funcs(e.config.Funcs)
+
+	// Partials được nạp trước để layouts và page có thể tham chiếu
+	partials := make([]string, 0)
+	for name := range sources {
+		if e.isPartial(name) {
+			partials = append(partials, name)
+		}
+	}
+	sort.Strings(partials)
+	for _, name := range partials {
+		if _, err := root.New(name).Parse(sources[name].text); err != nil {
+			return nil, fmt.Errorf("view: parse %s: %w", name, err)
+		}
+	}
+
+	// Layout ngoài cùng được parse trước để các định nghĩa bên trong ghi đè blocks
+	for _, src := range chain {
+		if _, err := root.New(src.name).Parse(src.text); err != nil {
+			return nil, fmt.Errorf("view: parse %s: %w", src.name, err)
+		}
+	}
+	return root.Lookup(chain[0].name), nil
+}
+
+// layoutChain trả về chuỗi [layout ngoài cùng, ..., page].
+func (e *Engine) layoutChain(page *source, sources map[string]*source) ([]*source, error) {
+	chain := []*source{page}
+	seen := map[string]bool{page.name: true}
+
+	current := page
+	for {
+		layout := current.layout
+		if !current.hasDir && current == page {
+			layout = e.config.DefaultLayout
+		}
+		if layout == "" || layout == "none" {
+			return chain, nil
+		}
+
+		parent := e.findLayout(layout, sources)
+		if parent == nil {
+			return nil, fmt.Errorf("%w: layout %s (used by %s)", ErrTemplateNotFound, layout, current.name)
+		}
+		if seen[parent.name] {
+			return nil, fmt.Errorf("%w: %s", ErrLayoutCycle, parent.name)
+		}
+		seen[parent.name] = true
+		chain = append([]*source{parent}, chain...)
+		current = parent
+	}
+}
+
+// findLayout tìm layout theo tên đầy đủ hoặc tên trong LayoutsDir.
+func (e *Engine) findLayout(name string, sources map[string]*source) *source {
+	name = strings.TrimSuffix(name, e.config.Extension)
+	if src, ok := sources[path.Join(e.config.LayoutsDir, name)]; ok {
+		return src
+	}
+	return sources[name]
+}
+
+func (e *Engine) isLayout(name string) bool {
+	return strings.HasPrefix(name, e.config.LayoutsDir+"/")
+}
+
+func (e *Engine) isPartial(name string) bool {
+	return strings.HasPrefix(name, e.config.PartialsDir+"/")
+}
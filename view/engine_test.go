@@ -0,0 +1,143 @@
+package view
+
+import (
+	"errors"
+	"html/template"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"layouts/base.html": {Data: []byte(
+			`<html><title>{{ block "title" . }}Site{{ end }}</title>{{ template "partials/nav" . }}<main>{{ block "content" . }}{{ end }}</main></html>`)},
+		"layouts/admin.html": {Data: []byte(
+			`{{/* layout: base */}}{{ define "content" }}<aside>admin</aside>{{ block "panel" . }}{{ end }}{{ end }}`)},
+		"partials/nav.html": {Data: []byte(`<nav>{{ upper .User }}</nav>`)},
+		"index.html": {Data: []byte(
+			`{{ define "title" }}Home{{ end }}{{ define "content" }}<p>Hello {{ .User }}</p>{{ end }}`)},
+		"users/show.html": {Data: []byte(
+			`{{/* layout: admin */}}{{ define "panel" }}<p>{{ .User }}</p>{{ end }}`)},
+		"raw.html":  {Data: []byte(`{{/* layout: none */}}<p>{{ .User }}</p>`)},
+		"notes.txt": {Data: []byte(`ignored`)},
+	}
+}
+
+func newTestEngine(t *testing.T) *Engine {
+	t.Helper()
+	e, err := New(Config{
+		FS:            testFS(),
+		DefaultLayout: "base",
+		Funcs:         template.FuncMap{"upper": strings.ToUpper},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create engine: %v", err)
+	}
+	return e
+}
+
+func TestEngineRender(t *testing.T) {
+	e := newTestEngine(t)
+	data := map[string]string{"User": "<an>"}
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{"default layout", "index",
+			`<html><title>Home</title><nav>&lt;AN&gt;</nav><main><p>Hello &lt;an&gt;</p></main></html>`},
+		{"extension and leading slash", "/index.html",
+			`<html><title>Home</title><nav>&lt;AN&gt;</nav><main><p>Hello &lt;an&gt;</p></main></html>`},
+		{"nested layouts", "users/show",
+			`<html><title>Site</title><nav>&lt;AN&gt;</nav><main><aside>admin</aside><p>&lt;an&gt;</p></main></html>`},
+		{"layout none", "raw", `<p>&lt;an&gt;</p>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := e.Render(tt.template, data)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(out) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, out)
+			}
+		})
+	}
+}
+
+func TestEngineTemplates(t *testing.T) {
+	e := newTestEngine(t)
+	expected := []string{"index", "raw", "users/show"}
+	if got := e.Templates(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestEngineNotFound(t *testing.T) {
+	e := newTestEngine(t)
+	if _, err := e.Render("missing", nil); !errors.Is(err, ErrTemplateNotFound) {
+		t.Errorf("Expected ErrTemplateNotFound, got %v", err)
+	}
+}
+
+func TestEngineLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		fs   fstest.MapFS
+		is   error
+	}{
+		{"missing layout", fstest.MapFS{"a.html": {Data: []byte(`{{/* layout: nope */}}x`)}}, ErrTemplateNotFound},
+		{"layout cycle", fstest.MapFS{
+			"layouts/a.html": {Data: []byte(`{{/* layout: b */}}a`)},
+			"layouts/b.html": {Data: []byte(`{{/* layout: a */}}b`)},
+			"page.html":      {Data: []byte(`{{/* layout: a */}}p`)},
+		}, ErrLayoutCycle},
+		{"parse error", fstest.MapFS{"a.html": {Data: []byte(`{{ .Broken `)}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(Config{FS: tt.fs})
+			if err == nil {
+				t.Fatal("Expected error")
+			}
+			if tt.is != nil && !errors.Is(err, tt.is) {
+				t.Errorf("Expected %v, got %v", tt.is, err)
+			}
+		})
+	}
+}
+
+func TestEngineDirectoryAndDelims(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "page.tmpl"), []byte(`<b>[[ .Name ]]</b>`), 0o644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	e, err := New(Config{Directory: dir, Extension: ".tmpl", LeftDelim: "[[", RightDelim: "]]"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out, err := e.Render("page", map[string]string{"Name": "fork"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(out) != "<b>fork</b>" {
+		t.Errorf("Unexpected output %q", out)
+	}
+}
+
+func TestMustPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Must to panic on error")
+		}
+	}()
+	Must(New(Config{FS: fstest.MapFS{"a.html": {Data: []byte(`{{`)}}}))
+}
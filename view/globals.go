@@ -0,0 +1,52 @@
+package view
+
+import (
+	forkCtx "go.fork.vn/fork/context"
+	"go.fork.vn/fork/router"
+)
+
+// GlobalsKey là khóa trong context store chứa hàm trả về dữ liệu view dùng chung.
+// ctx.Render gọi hàm này tại thời điểm render và gộp kết quả vào dữ liệu template.
+const GlobalsKey = "view_globals"
+
+// GlobalsFunc trả về dữ liệu dùng chung cho mọi template của một request,
+// ví dụ: người dùng hiện tại, CSRF token, flash messages, locale.
+type GlobalsFunc func(ctx forkCtx.Context) map[string]interface{}
+
+// Globals tạo middleware gộp dữ liệu dùng chung vào mọi lần gọi ctx.Render.
+//
+// Các hàm được gọi tại thời điểm render (không phải khi middleware chạy), nên dữ liệu
+// do handler hoặc middleware phía sau thiết lập vẫn được phản ánh. Dữ liệu được gộp khi
+// data truyền vào Render là map[string]interface{} hoặc nil; khóa trong data được ưu tiên
+// hơn globals. Nhiều middleware Globals có thể được xếp chồng, hàm đăng ký sau ghi đè khóa
+// trùng của hàm đăng ký trước.
+//
+// Parameters:
+//   - fns: Các hàm cung cấp dữ liệu dùng chung
+//
+// Returns:
+//   - router.HandlerFunc: Middleware gắn dữ liệu dùng chung vào context
+func Globals(fns ...GlobalsFunc) router.HandlerFunc {
+	return func(ctx forkCtx.Context) {
+		var previous func() map[string]interface{}
+		if value, ok := ctx.Get(GlobalsKey); ok {
+			previous, _ = value.(func() map[string]interface{})
+		}
+
+		ctx.Set(GlobalsKey, func() map[string]interface{} {
+			globals := make(map[string]interface{})
+			if previous != nil {
+				for key, value := range previous() {
+					globals[key] = value
+				}
+			}
+			for _, fn := range fns {
+				for key, value := range fn(ctx) {
+					globals[key] = value
+				}
+			}
+			return globals
+		})
+		ctx.Next()
+	}
+}
@@ -0,0 +1,60 @@
+package view
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	forkCtx "go.fork.vn/fork/context"
+)
+
+// recordingEngine ghi lại dữ liệu được truyền vào Render
+type recordingEngine struct {
+	data interface{}
+}
+
+func (r *recordingEngine) Render(name string, data interface{}) ([]byte, error) {
+	r.data = data
+	return []byte(name), nil
+}
+
+func TestGlobals(t *testing.T) {
+	engine := &recordingEngine{}
+	outer := Globals(func(ctx forkCtx.Context) map[string]interface{} {
+		return map[string]interface{}{"csrf": "token", "title": "Site"}
+	})
+	inner := Globals(
+		func(ctx forkCtx.Context) map[string]interface{} {
+			return map[string]interface{}{"user": ctx.GetString("user")}
+		},
+		func(ctx forkCtx.Context) map[string]interface{} {
+			return map[string]interface{}{"csrf": "override"}
+		},
+	)
+
+	tests := []struct {
+		name     string
+		data     interface{}
+		expected interface{}
+	}{
+		{"nil data", nil, map[string]interface{}{"csrf": "override", "title": "Site", "user": "an"}},
+		{"map data wins", map[string]interface{}{"title": "Home"}, map[string]interface{}{"csrf": "override", "title": "Home", "user": "an"}},
+		{"struct data untouched", struct{ A int }{1}, struct{ A int }{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := forkCtx.NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+			ctx.Set("template_engine", engine)
+			ctx.SetHandlers([]func(forkCtx.Context){outer, inner, func(ctx forkCtx.Context) {
+				ctx.Set("user", "an")
+				ctx.Render(200, "page", tt.data)
+			}})
+			ctx.Next()
+
+			if !reflect.DeepEqual(engine.data, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, engine.data)
+			}
+		})
+	}
+}
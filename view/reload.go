@@ -0,0 +1,188 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDelay là khoảng thời gian gom các thay đổi liên tiếp trước khi nạp lại.
+const reloadDelay = 50 * time.Millisecond
+
+// errorLocation trích tên template và số dòng từ lỗi của html/template.
+var errorLocation = regexp.MustCompile(`template: ([^:\s]+):(\d+)`)
+
+// RenderError là lỗi render ở chế độ Debug, kèm trang lỗi HTML chi tiết.
+// ctx.Render nhận diện phương thức ErrorPage và trả trang lỗi với status 500.
+type RenderError struct {
+	// Template là tên template được yêu cầu render
+	Template string
+
+	// Err là lỗi gốc
+	Err error
+
+	// File và Line là vị trí lỗi nếu xác định được
+	File string
+	Line int
+
+	// Source là nội dung file chứa lỗi
+	Source string
+}
+
+// Error trả về thông điệp lỗi.
+func (e *RenderError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap trả về lỗi gốc.
+func (e *RenderError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorPage trả về trang lỗi HTML mô tả lỗi và đoạn mã nguồn liên quan.
+//
+// Returns:
+//   - []byte: Nội dung trang lỗi
+func (e *RenderError) ErrorPage() []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`<!DOCTYPE html><html><head><meta charset="utf-8"><title>Template error</title>`)
+	buf.WriteString(`<style>body{font-family:monospace;margin:2em}pre{background:#f6f6f6;padding:1em}.line{display:block}.error{background:#fdd}</style></head><body>`)
+	fmt.Fprintf(&buf, `<h1>Template error: %s</h1><p>%s</p>`,
+		template.HTMLEscapeString(e.Template), template.HTMLEscapeString(e.Err.Error()))
+
+	if e.Source != "" {
+		fmt.Fprintf(&buf, `<h2>%s</h2><pre>`, template.HTMLEscapeString(e.File))
+		for i, line := range strings.Split(e.Source, "\n") {
+			class := "line"
+			if i+1 == e.Line {
+				class = "line error"
+			}
+			fmt.Fprintf(&buf, `<span class="%s">%4d  %s</span>`, class, i+1, template.HTMLEscapeString(line))
+		}
+		buf.WriteString(`</pre>`)
+	}
+	buf.WriteString(`</body></html>`)
+	return buf.Bytes()
+}
+
+// renderError tạo RenderError với vị trí lỗi và mã nguồn nếu xác định được.
+func (e *Engine) renderError(name string, err error) error {
+	renderErr := &RenderError{Template: name, Err: err}
+	if match := errorLocation.FindStringSubmatch(err.Error()); match != nil {
+		renderErr.File = match[1]
+		renderErr.Line, _ = strconv.Atoi(match[2])
+
+		e.mu.RLock()
+		if src, ok := e.sources[match[1]]; ok {
+			renderErr.Source = src.text
+		}
+		e.mu.RUnlock()
+	}
+	return renderErr
+}
+
+// watch theo dõi thư mục templates và nạp lại khi có thay đổi.
+func (e *Engine) watch(dir string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("view: watch templates: %w", err)
+	}
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return watcher.Add(p)
+		}
+		return nil
+	})
+	if err != nil {
+		watcher.Close()
+		return fmt.Errorf("view: watch templates: %w", err)
+	}
+
+	e.watcher = watcher
+	e.done = make(chan struct{})
+	go e.watchLoop()
+	return nil
+}
+
+// watchLoop xử lý sự kiện thay đổi file, gom các thay đổi liên tiếp thành một lần nạp.
+func (e *Engine) watchLoop() {
+	defer close(e.done)
+
+	var timer *time.Timer
+	var fire <-chan time.Time
+	for {
+		select {
+		case event, ok := <-e.watcher.Events:
+			if !ok {
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			}
+			// Theo dõi cả các thư mục con mới được tạo
+			if event.Has(fsnotify.Create) {
+				if info, err := fs.Stat(e.config.FS, e.relative(event.Name)); err == nil && info.IsDir() {
+					e.watcher.Add(event.Name)
+				}
+			}
+			if timer == nil {
+				timer = time.NewTimer(reloadDelay)
+			} else {
+				timer.Reset(reloadDelay)
+			}
+			fire = timer.C
+		case <-fire:
+			fire = nil
+			e.reload()
+		case _, ok := <-e.watcher.Errors:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// reload nạp lại templates và ghi nhận lỗi biên dịch để báo cáo khi render.
+func (e *Engine) reload() {
+	err := e.Load()
+	e.mu.Lock()
+	e.loadErr = err
+	e.mu.Unlock()
+
+	if e.config.OnReload != nil {
+		e.config.OnReload(err)
+	}
+}
+
+// relative chuyển đường dẫn hệ thống thành đường dẫn trong FS.
+func (e *Engine) relative(name string) string {
+	rel, err := filepath.Rel(e.config.Directory, name)
+	if err != nil {
+		return name
+	}
+	return filepath.ToSlash(rel)
+}
+
+// Close dừng việc theo dõi thư mục templates ở chế độ Debug.
+//
+// Returns:
+//   - error: Lỗi nếu không đóng được watcher
+func (e *Engine) Close() error {
+	if e.watcher == nil {
+		return nil
+	}
+	err := e.watcher.Close()
+	<-e.done
+	return err
+}
@@ -0,0 +1,125 @@
+package view
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeTemplate(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+}
+
+func waitReload(t *testing.T, reloads chan error) error {
+	t.Helper()
+	select {
+	case err := <-reloads:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for template reload")
+		return nil
+	}
+}
+
+func TestEngineDebugHotReload(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "page.html", "v1 {{ .Name }}")
+
+	reloads := make(chan error, 10)
+	e, err := New(Config{
+		Directory: dir,
+		Debug:     true,
+		OnReload:  func(err error) { reloads <- err },
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer e.Close()
+
+	out, _ := e.Render("page", map[string]string{"Name": "fork"})
+	if string(out) != "v1 fork" {
+		t.Fatalf("Unexpected initial output %q", out)
+	}
+
+	writeTemplate(t, dir, "page.html", "v2 {{ .Name }}")
+	if err := waitReload(t, reloads); err != nil {
+		t.Fatalf("Unexpected reload error: %v", err)
+	}
+	out, _ = e.Render("page", map[string]string{"Name": "fork"})
+	if string(out) != "v2 fork" {
+		t.Errorf("Expected reloaded output, got %q", out)
+	}
+
+	// Template lỗi cú pháp: Render trả về RenderError thay vì phiên bản cũ
+	writeTemplate(t, dir, "page.html", "line one\n{{ .Name ")
+	if err := waitReload(t, reloads); err == nil {
+		t.Fatal("Expected reload error for broken template")
+	}
+	_, err = e.Render("page", nil)
+	var renderErr *RenderError
+	if !errors.As(err, &renderErr) {
+		t.Fatalf("Expected RenderError, got %v", err)
+	}
+	if renderErr.File != "page" || renderErr.Line != 2 {
+		t.Errorf("Expected error at page:2, got %s:%d", renderErr.File, renderErr.Line)
+	}
+	if !strings.Contains(string(renderErr.ErrorPage()), `class="line error">   2  {{ .Name </span>`) {
+		t.Errorf("Expected error page to highlight failing line, got %s", renderErr.ErrorPage())
+	}
+
+	// Thư mục con mới cũng được theo dõi
+	writeTemplate(t, dir, "page.html", "fixed")
+	waitReload(t, reloads)
+	writeTemplate(t, dir, "admin/index.html", "admin")
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if out, err := e.Render("admin/index", nil); err == nil && string(out) == "admin" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected template in new subdirectory to be loaded")
+		}
+		waitReload(t, reloads)
+	}
+}
+
+func TestEngineDebugExecuteError(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplate(t, dir, "page.html", "{{ index .Items 5 }}")
+
+	e, err := New(Config{Directory: dir, Debug: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer e.Close()
+
+	_, err = e.Render("page", map[string][]int{"Items": {}})
+	var renderErr *RenderError
+	if !errors.As(err, &renderErr) {
+		t.Fatalf("Expected RenderError, got %v", err)
+	}
+	if renderErr.Source != "{{ index .Items 5 }}" {
+		t.Errorf("Expected source to be attached, got %q", renderErr.Source)
+	}
+
+	if _, err := e.Render("missing", nil); !errors.Is(err, ErrTemplateNotFound) {
+		t.Errorf("Expected RenderError to unwrap to ErrTemplateNotFound, got %v", err)
+	}
+}
+
+func TestEngineCloseWithoutDebug(t *testing.T) {
+	e := newTestEngine(t)
+	if err := e.Close(); err != nil {
+		t.Errorf("Expected Close to be a no-op without Debug, got %v", err)
+	}
+}
@@ -2,7 +2,12 @@ package fork
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"os/signal"
 	"sync"
@@ -10,9 +15,14 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/go-playground/validator/v10"
 	"go.fork.vn/fork/adapter"
+	"go.fork.vn/fork/clock"
 	forkCtx "go.fork.vn/fork/context"
+	forkErrors "go.fork.vn/fork/errors"
 	"go.fork.vn/fork/router"
+	"go.fork.vn/fork/signedurl"
+	"go.fork.vn/fork/view"
 )
 
 // WebApp là đối tượng chính của framework, quản lý HTTP server và routing.
@@ -44,6 +54,64 @@ type WebApp struct {
 
 	// isShuttingDown đánh dấu trạng thái shutdown
 	isShuttingDown bool
+
+	// templateEngine là template engine được ctx.Render sử dụng
+	templateEngine TemplateEngine
+
+	// templateInstalled đánh dấu middleware template engine đã được đăng ký
+	templateInstalled bool
+
+	// viewGlobals là các hàm cung cấp dữ liệu dùng chung cho mọi template
+	viewGlobals []view.GlobalsFunc
+
+	// urlSigner ký và xác thực URL tạm thời
+	urlSigner *signedurl.Signer
+
+	// paginationConfig là cấu hình phân trang dùng cho ctx.Pagination
+	paginationConfig *forkCtx.PaginationConfig
+
+	// validator là backend validation dùng chung của ứng dụng, được gắn vào context của mọi request
+	validator forkCtx.Validator
+
+	// logger là logger của ứng dụng, được gắn vào context của mọi request cho ctx.Logger()
+	logger forkCtx.Logger
+
+	// clock là nguồn thời gian cho graceful shutdown, có thể thay bằng clock.Mock khi test
+	clock clock.Clock
+
+	// plugins là các plugin đã đăng ký, theo thứ tự khởi tạo sau khi boot
+	plugins []Plugin
+
+	// pluginsBooted đánh dấu mọi plugin đã được Register và Boot thành công
+	pluginsBooted bool
+
+	// pluginsInitialized đánh dấu BootPlugins đã thành công ít nhất một lần, cho phép
+	// ServeHTTP/Test kiểm tra nhanh mà không cần khóa; không bị đặt lại khi shutdown
+	pluginsInitialized atomic.Bool
+
+	// pluginMu tuần tự hóa BootPlugins và ShutdownPlugins; không giữ app.mu khi gọi plugin
+	pluginMu sync.Mutex
+
+	// pluginStates ghi lại plugin đã Register/Boot thành công theo tên (bảo vệ bởi pluginMu)
+	pluginStates map[string]pluginState
+
+	// bootedPlugins là các plugin đã Boot thành công theo thứ tự boot (bảo vệ bởi pluginMu)
+	bootedPlugins []Plugin
+}
+
+// TemplateEngine là interface cho template engine được ctx.Render sử dụng.
+// Package view cung cấp implementation mặc định dựa trên html/template.
+type TemplateEngine interface {
+	// Render render template với dữ liệu đã cho.
+	//
+	// Parameters:
+	//   - name: Tên template
+	//   - data: Dữ liệu truyền vào template
+	//
+	// Returns:
+	//   - []byte: Nội dung đã render
+	//   - error: Lỗi nếu template không tồn tại hoặc render thất bại
+	Render(name string, data interface{}) ([]byte, error)
 }
 
 // NewWebApp tạo một instance mới của WebApp.
@@ -59,10 +127,38 @@ func NewWebApp() *WebApp {
 		config:         DefaultWebAppConfig(),
 		shutdownCtx:    ctx,
 		shutdownCancel: cancel,
+		clock:          clock.New(),
+		validator:      forkCtx.NewValidator(),
 	}
+	app.applyValidator()
 	return app
 }
 
+// SetClock thiết lập nguồn thời gian cho các tính năng phụ thuộc thời gian của WebApp
+// như timeout của graceful shutdown. Dùng clock.NewMock trong test để điều khiển thời gian.
+//
+// Parameters:
+//   - c: Clock cần sử dụng, nil để dùng lại đồng hồ hệ thống
+func (app *WebApp) SetClock(c clock.Clock) {
+	if c == nil {
+		c = clock.New()
+	}
+
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	app.clock = c
+}
+
+// Clock trả về nguồn thời gian hiện tại của WebApp.
+//
+// Returns:
+//   - clock.Clock: Clock đang được sử dụng
+func (app *WebApp) Clock() clock.Clock {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+	return app.clock
+}
+
 // SetAdapter thiết lập adapter cho WebApp và cấu hình handler cho adapter.
 // Adapter được sử dụng để giao tiếp với server HTTP.
 //
@@ -96,11 +192,77 @@ func (app *WebApp) Use(middleware ...router.HandlerFunc) {
 //
 // Parameters:
 //   - prefix: Tiền tố đường dẫn cho group
+//   - opts: Các option của group (ví dụ: router.WithoutParentMiddleware())
 //
 // Returns:
 //   - router.Router: Router mới đã được tạo với prefix đã chỉ định
-func (app *WebApp) Group(prefix string) router.Router {
-	return app.router.Group(prefix)
+func (app *WebApp) Group(prefix string, opts ...router.GroupOption) router.Router {
+	return app.router.Group(prefix, opts...)
+}
+
+// Host tạo một router group chỉ khớp request có host phù hợp với mẫu.
+// Nhãn bắt đầu bằng ":" là tham số, ví dụ ":tenant.example.com" cho ctx.Param("tenant").
+//
+// Parameters:
+//   - pattern: Mẫu host (ví dụ: "api.example.com")
+//
+// Returns:
+//   - router.Router: Router group ràng buộc theo host
+func (app *WebApp) Host(pattern string) router.Router {
+	return app.router.Host(pattern)
+}
+
+// Version tạo một router group cho API version, chọn theo tiền tố path "/v<version>"
+// (router.VersionPath) hoặc header Accept (router.VersionHeader).
+// Handlers đọc version đã xác định bằng router.APIVersion(ctx).
+//
+// Parameters:
+//   - version: API version (ví dụ: "2")
+//   - strategy: Cách request chọn version
+//
+// Returns:
+//   - router.Router: Router group của version
+func (app *WebApp) Version(version string, strategy router.VersionStrategy) router.Router {
+	return app.router.Version(version, strategy)
+}
+
+// Mount gắn một http.Handler (http.ServeMux, router của thư viện khác...) dưới prefix.
+// Handler nhận request với prefix đã được cắt khỏi path và chạy sau middlewares của ứng dụng.
+//
+// Parameters:
+//   - prefix: Tiền tố đường dẫn (ví dụ: "/legacy")
+//   - h: Handler được gắn
+func (app *WebApp) Mount(prefix string, h http.Handler) {
+	app.router.Mount(prefix, h)
+}
+
+// MountRouter gắn một Router khác dưới prefix.
+//
+// Parameters:
+//   - prefix: Tiền tố đường dẫn (ví dụ: "/admin")
+//   - sub: Router được gắn
+func (app *WebApp) MountRouter(prefix string, sub router.Router) {
+	app.router.MountRouter(prefix, sub)
+}
+
+// Proxy chuyển tiếp mọi request tới prefix và các path con sang target (reverse proxy).
+//
+// Parameters:
+//   - prefix: Tiền tố đường dẫn (ví dụ: "/api")
+//   - target: URL của upstream
+//   - opts: Các option của proxy (router.WithProxyRewrite, router.WithProxyHeader...)
+func (app *WebApp) Proxy(prefix string, target *url.URL, opts ...router.ProxyOption) {
+	app.router.Proxy(prefix, target, opts...)
+}
+
+// Redirect đăng ký route chỉ chuyển hướng, ví dụ cho URL cũ.
+//
+// Parameters:
+//   - path: URL path pattern cần chuyển hướng (ví dụ: "/old/users/:id")
+//   - targetPath: Path hoặc URL đích, có thể chứa tham số của path (ví dụ: "/users/:id")
+//   - code: Mã chuyển hướng 301, 302, 303, 307 hoặc 308; 0 để dùng 301
+func (app *WebApp) Redirect(path, targetPath string, code int) {
+	app.router.Redirect(path, targetPath, code)
 }
 
 // Static đăng ký một thư mục để phục vụ static files.
@@ -109,8 +271,37 @@ func (app *WebApp) Group(prefix string) router.Router {
 // Parameters:
 //   - prefix: Tiền tố URL để phục vụ static files
 //   - root: Đường dẫn tới thư mục chứa static files
-func (app *WebApp) Static(prefix, root string) {
-	app.router.Static(prefix, root)
+//   - config: Cấu hình tùy chọn (index file, directory listing, 404)
+func (app *WebApp) Static(prefix, root string, config ...router.StaticConfig) {
+	app.router.Static(prefix, root, config...)
+}
+
+// StaticFS phục vụ static files từ một fs.FS, ví dụ embed.FS của binary build với go:embed.
+//
+// Parameters:
+//   - prefix: Tiền tố URL để phục vụ static files
+//   - fsys: Filesystem chứa static files
+//   - config: Cấu hình tùy chọn (index file, directory listing, 404)
+func (app *WebApp) StaticFS(prefix string, fsys fs.FS, config ...router.StaticConfig) {
+	app.router.StaticFS(prefix, fsys, config...)
+}
+
+// NoRoute thiết lập chuỗi handlers xử lý request không khớp route nào.
+// Handlers chạy sau middlewares của ứng dụng, cho phép trả về trang 404 tùy biến.
+//
+// Parameters:
+//   - handlers: Chuỗi handlers xử lý 404
+func (app *WebApp) NoRoute(handlers ...router.HandlerFunc) {
+	app.router.NoRoute(handlers...)
+}
+
+// NoMethod thiết lập chuỗi handlers xử lý request có path khớp route nhưng method
+// không được hỗ trợ. Header Allow đã được thiết lập trước khi handlers chạy.
+//
+// Parameters:
+//   - handlers: Chuỗi handlers xử lý 405
+func (app *WebApp) NoMethod(handlers ...router.HandlerFunc) {
+	app.router.NoMethod(handlers...)
 }
 
 // GET đăng ký handler cho HTTP GET method.
@@ -119,8 +310,11 @@ func (app *WebApp) Static(prefix, root string) {
 // Parameters:
 //   - path: Đường dẫn URL để đăng ký handler
 //   - handlers: Danh sách các handlers xử lý request
-func (app *WebApp) GET(path string, handlers ...router.HandlerFunc) {
-	app.router.Handle(MethodGet, path, handlers...)
+//
+// Returns:
+//   - *router.RouteBuilder: Builder để cấu hình thêm cho route
+func (app *WebApp) GET(path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
+	return app.router.Handle(MethodGet, path, handlers...)
 }
 
 // POST đăng ký handler cho HTTP POST method.
@@ -129,8 +323,11 @@ func (app *WebApp) GET(path string, handlers ...router.HandlerFunc) {
 // Parameters:
 //   - path: Đường dẫn URL để đăng ký handler
 //   - handlers: Danh sách các handlers xử lý request
-func (app *WebApp) POST(path string, handlers ...router.HandlerFunc) {
-	app.router.Handle(MethodPost, path, handlers...)
+//
+// Returns:
+//   - *router.RouteBuilder: Builder để cấu hình thêm cho route
+func (app *WebApp) POST(path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
+	return app.router.Handle(MethodPost, path, handlers...)
 }
 
 // PUT đăng ký handler cho HTTP PUT method.
@@ -139,8 +336,11 @@ func (app *WebApp) POST(path string, handlers ...router.HandlerFunc) {
 // Parameters:
 //   - path: Đường dẫn URL để đăng ký handler
 //   - handlers: Danh sách các handlers xử lý request
-func (app *WebApp) PUT(path string, handlers ...router.HandlerFunc) {
-	app.router.Handle(MethodPut, path, handlers...)
+//
+// Returns:
+//   - *router.RouteBuilder: Builder để cấu hình thêm cho route
+func (app *WebApp) PUT(path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
+	return app.router.Handle(MethodPut, path, handlers...)
 }
 
 // DELETE đăng ký handler cho HTTP DELETE method.
@@ -149,8 +349,11 @@ func (app *WebApp) PUT(path string, handlers ...router.HandlerFunc) {
 // Parameters:
 //   - path: Đường dẫn URL để đăng ký handler
 //   - handlers: Danh sách các handlers xử lý request
-func (app *WebApp) DELETE(path string, handlers ...router.HandlerFunc) {
-	app.router.Handle(MethodDelete, path, handlers...)
+//
+// Returns:
+//   - *router.RouteBuilder: Builder để cấu hình thêm cho route
+func (app *WebApp) DELETE(path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
+	return app.router.Handle(MethodDelete, path, handlers...)
 }
 
 // PATCH đăng ký handler cho HTTP PATCH method.
@@ -159,8 +362,11 @@ func (app *WebApp) DELETE(path string, handlers ...router.HandlerFunc) {
 // Parameters:
 //   - path: Đường dẫn URL để đăng ký handler
 //   - handlers: Danh sách các handlers xử lý request
-func (app *WebApp) PATCH(path string, handlers ...router.HandlerFunc) {
-	app.router.Handle(MethodPatch, path, handlers...)
+//
+// Returns:
+//   - *router.RouteBuilder: Builder để cấu hình thêm cho route
+func (app *WebApp) PATCH(path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
+	return app.router.Handle(MethodPatch, path, handlers...)
 }
 
 // HEAD đăng ký handler cho HTTP HEAD method.
@@ -169,8 +375,11 @@ func (app *WebApp) PATCH(path string, handlers ...router.HandlerFunc) {
 // Parameters:
 //   - path: Đường dẫn URL để đăng ký handler
 //   - handlers: Danh sách các handlers xử lý request
-func (app *WebApp) HEAD(path string, handlers ...router.HandlerFunc) {
-	app.router.Handle(MethodHead, path, handlers...)
+//
+// Returns:
+//   - *router.RouteBuilder: Builder để cấu hình thêm cho route
+func (app *WebApp) HEAD(path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
+	return app.router.Handle(MethodHead, path, handlers...)
 }
 
 // OPTIONS đăng ký handler cho HTTP OPTIONS method.
@@ -179,8 +388,11 @@ func (app *WebApp) HEAD(path string, handlers ...router.HandlerFunc) {
 // Parameters:
 //   - path: Đường dẫn URL để đăng ký handler
 //   - handlers: Danh sách các handlers xử lý request
-func (app *WebApp) OPTIONS(path string, handlers ...router.HandlerFunc) {
-	app.router.Handle(MethodOptions, path, handlers...)
+//
+// Returns:
+//   - *router.RouteBuilder: Builder để cấu hình thêm cho route
+func (app *WebApp) OPTIONS(path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
+	return app.router.Handle(MethodOptions, path, handlers...)
 }
 
 // Any đăng ký handler cho tất cả các HTTP methods phổ biến.
@@ -199,6 +411,20 @@ func (app *WebApp) Any(path string, handlers ...router.HandlerFunc) {
 	}
 }
 
+// Match đăng ký handler cho nhiều HTTP method trên cùng một path,
+// kể cả method không chuẩn như PROPFIND hay REPORT.
+//
+// Parameters:
+//   - methods: Danh sách HTTP method cần đăng ký
+//   - path: Đường dẫn URL để đăng ký handler
+//   - handlers: Danh sách các handlers xử lý request
+//
+// Returns:
+//   - []*router.RouteBuilder: Builder của từng route theo thứ tự methods
+func (app *WebApp) Match(methods []string, path string, handlers ...router.HandlerFunc) []*router.RouteBuilder {
+	return app.router.Match(methods, path, handlers...)
+}
+
 // Handle đăng ký handler cho một HTTP method cụ thể.
 // Đây là phương thức tổng quát cho phép đăng ký handler với bất kỳ HTTP method nào.
 //
@@ -206,8 +432,11 @@ func (app *WebApp) Any(path string, handlers ...router.HandlerFunc) {
 //   - method: HTTP method cần đăng ký (GET, POST, PUT, DELETE, v.v.)
 //   - path: Đường dẫn URL để đăng ký handler
 //   - handlers: Danh sách các handlers xử lý request
-func (app *WebApp) Handle(method, path string, handlers ...router.HandlerFunc) {
-	app.router.Handle(method, path, handlers...)
+//
+// Returns:
+//   - *router.RouteBuilder: Builder để cấu hình thêm cho route
+func (app *WebApp) Handle(method, path string, handlers ...router.HandlerFunc) *router.RouteBuilder {
+	return app.router.Handle(method, path, handlers...)
 }
 
 // Run khởi động HTTP server sử dụng adapter hiện tại.
@@ -218,6 +447,7 @@ func (app *WebApp) Handle(method, path string, handlers ...router.HandlerFunc) {
 //
 // Errors:
 //   - ErrAdapterNotSet: Trả về khi adapter chưa được thiết lập
+//   - ErrPluginDependency: Trả về khi phụ thuộc giữa các plugin không thỏa mãn
 func (app *WebApp) Serve() error {
 	app.mu.RLock()
 	adp := app.adapter
@@ -227,6 +457,10 @@ func (app *WebApp) Serve() error {
 		return ErrAdapterNotSet
 	}
 
+	if err := app.BootPlugins(); err != nil {
+		return err
+	}
+
 	// Đặt router làm handler cho adapter
 	adp.SetHandler(app.router)
 
@@ -247,6 +481,7 @@ func (app *WebApp) Serve() error {
 // Errors:
 //   - ErrAdapterNotSet: Trả về khi adapter chưa được thiết lập
 //   - ErrInvalidCertificate: Trả về khi tệp chứng chỉ hoặc tệp khóa không hợp lệ
+//   - ErrPluginDependency: Trả về khi phụ thuộc giữa các plugin không thỏa mãn
 func (app *WebApp) RunTLS(certFile, keyFile string) error {
 	app.mu.RLock()
 	adp := app.adapter
@@ -261,6 +496,10 @@ func (app *WebApp) RunTLS(certFile, keyFile string) error {
 		return ErrInvalidCertificate
 	}
 
+	if err := app.BootPlugins(); err != nil {
+		return err
+	}
+
 	// Đặt router làm handler cho adapter
 	adp.SetHandler(app.router)
 
@@ -285,29 +524,110 @@ func (app *WebApp) ServeWithGracefulShutdown() error {
 
 // ServeHTTP xử lý HTTP request và implement interface http.Handler.
 // Phương thức này cho phép WebApp hoạt động như một HTTP handler.
+// Plugins được boot ở request đầu tiên nếu Serve/RunTLS/BootPlugins chưa được gọi;
+// nếu boot thất bại, request nhận 500 Internal Server Error.
 //
 // Parameters:
 //   - w: HTTP response writer để ghi response
 //   - r: HTTP request cần xử lý
 func (app *WebApp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := app.ensurePlugins(); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
 	app.router.ServeHTTP(w, r)
 }
 
+// ensurePlugins boot plugins một lần khi WebApp được dùng trực tiếp làm http.Handler
+// hoặc qua Test, để routes do plugin đăng ký hoạt động như khi chạy Serve.
+func (app *WebApp) ensurePlugins() error {
+	if app.pluginsInitialized.Load() {
+		return nil
+	}
+	return app.BootPlugins()
+}
+
+// Test thực thi request qua toàn bộ middleware và router trong bộ nhớ, không cần mở port,
+// và trả về response đã hoàn tất. Phù hợp cho integration test nhanh.
+//
+// Parameters:
+//   - req: Request cần thực thi (ví dụ: tạo bởi httptest.NewRequest)
+//   - timeout: Thời gian chờ tối đa (mặc định 1 giây); giá trị <= 0 để chờ không giới hạn
+//
+// Returns:
+//   - *http.Response: Response đã được ghi bởi handler
+//   - error: Lỗi nếu request không hợp lệ, plugins boot thất bại, hết thời gian chờ hoặc handler panic
+//
+// Errors:
+//   - ErrBadRequest: Khi req là nil
+//   - ErrPluginDependency: Khi phụ thuộc giữa các plugin không thỏa mãn
+//   - ErrRequestTimeout: Khi handler chưa hoàn tất sau timeout
+func (app *WebApp) Test(req *http.Request, timeout ...time.Duration) (*http.Response, error) {
+	if req == nil {
+		return nil, ErrBadRequest
+	}
+	// Boot plugins như Serve để routes của plugin có mặt khi test trong bộ nhớ
+	if err := app.ensurePlugins(); err != nil {
+		return nil, err
+	}
+	if req.RemoteAddr == "" {
+		req.RemoteAddr = "192.0.2.1:1234"
+	}
+
+	wait := time.Second
+	if len(timeout) > 0 {
+		wait = timeout[0]
+	}
+
+	recorder := httptest.NewRecorder()
+	done := make(chan interface{}, 1)
+	go func() {
+		defer func() { done <- recover() }()
+		app.ServeHTTP(recorder, req)
+	}()
+
+	var recovered interface{}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case recovered = <-done:
+		case <-timer.C:
+			return nil, ErrRequestTimeout
+		}
+	} else {
+		recovered = <-done
+	}
+
+	if recovered != nil {
+		return nil, fmt.Errorf("fork: handler panicked: %v", recovered)
+	}
+	return recorder.Result(), nil
+}
+
 // Shutdown đóng HTTP server một cách an toàn, chờ các kết nối hiện tại kết thúc.
 // Phương thức này nên được gọi khi muốn dừng server một cách graceful.
 //
 // Returns:
 //   - error: Lỗi nếu có trong quá trình đóng server
 func (app *WebApp) Shutdown() error {
+	return app.shutdown(context.Background())
+}
+
+// shutdown đóng adapter rồi gọi Shutdown của các plugin đã boot.
+func (app *WebApp) shutdown(ctx context.Context) error {
 	app.mu.RLock()
 	adp := app.adapter
 	app.mu.RUnlock()
 
-	if adp == nil {
-		return nil
+	var err error
+	if adp != nil {
+		err = adp.Shutdown()
 	}
-
-	return adp.Shutdown()
+	if pluginErr := app.ShutdownPlugins(ctx); pluginErr != nil {
+		err = errors.Join(err, pluginErr)
+	}
+	return err
 }
 
 // GracefulShutdown thực hiện graceful shutdown với cấu hình nâng cao
@@ -323,6 +643,7 @@ func (app *WebApp) GracefulShutdown() error {
 	}
 	app.isShuttingDown = true
 	config := app.config.GracefulShutdown
+	clk := app.clock
 	app.mu.Unlock()
 
 	if !config.Enabled {
@@ -335,16 +656,25 @@ func (app *WebApp) GracefulShutdown() error {
 	}
 
 	// Create timeout context
-	shutdownCtx, cancel := context.WithTimeout(app.shutdownCtx, time.Duration(config.Timeout)*time.Second)
+	shutdownCtx, cancel := context.WithCancel(app.shutdownCtx)
 	defer cancel()
+	timer := clk.NewTimer(time.Duration(config.Timeout) * time.Second)
+	defer timer.Stop()
+	go func() {
+		select {
+		case <-timer.C():
+			cancel()
+		case <-shutdownCtx.Done():
+		}
+	}()
 
 	// Wait for connections if enabled
 	if config.WaitForConnections {
-		app.waitForConnections(shutdownCtx)
+		app.waitForConnections(shutdownCtx, clk)
 	}
 
 	// Perform actual shutdown
-	err := app.Shutdown()
+	err := app.shutdown(shutdownCtx)
 
 	// Call appropriate callback
 	if err != nil && config.OnShutdownError != nil {
@@ -388,15 +718,15 @@ func (app *WebApp) GetActiveConnections() int32 {
 }
 
 // waitForConnections chờ tất cả connections kết thúc hoặc timeout
-func (app *WebApp) waitForConnections(ctx context.Context) {
-	ticker := time.NewTicker(100 * time.Millisecond)
+func (app *WebApp) waitForConnections(ctx context.Context, clk clock.Clock) {
+	ticker := clk.NewTicker(100 * time.Millisecond)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			return // Timeout
-		case <-ticker.C:
+		case <-ticker.C():
 			if app.GetActiveConnections() == 0 {
 				return // All connections closed
 			}
@@ -430,7 +760,140 @@ func (app *WebApp) Router() router.Router {
 // Returns:
 //   - forkCtx.Context: Context mới đã được khởi tạo
 func (app *WebApp) NewContext(w http.ResponseWriter, r *http.Request) forkCtx.Context {
-	return forkCtx.NewContext(w, r)
+	ctx := forkCtx.NewContext(w, r)
+	app.mu.RLock()
+	ctx.SetValidator(app.validator)
+	if app.logger != nil {
+		ctx.SetLogger(app.logger)
+	}
+	app.mu.RUnlock()
+	return ctx
+}
+
+// SetValidator thay thế backend validation của ứng dụng, dùng bởi ctx.ValidateStruct,
+// ShouldBindAndValidate và BindAndValidate của mọi request. Cho phép dùng thư viện validation
+// khác go-playground (ozzo-validation, JSON schema...) qua interface forkCtx.Validator.
+// Nên gọi khi khởi tạo ứng dụng, trước khi phục vụ request.
+//
+// Parameters:
+//   - v: Backend validation, nil để dùng lại validator go-playground mặc định
+func (app *WebApp) SetValidator(v forkCtx.Validator) {
+	if validate, ok := v.(*validator.Validate); v == nil || (ok && validate == nil) {
+		v = forkCtx.NewValidator()
+	}
+
+	app.mu.Lock()
+	app.validator = v
+	app.mu.Unlock()
+
+	app.applyValidator()
+}
+
+// applyValidator gắn validator của ứng dụng vào router để router gắn vào context của mỗi request.
+func (app *WebApp) applyValidator() {
+	app.mu.RLock()
+	v := app.validator
+	app.mu.RUnlock()
+
+	if r, ok := app.router.(interface{ SetValidator(forkCtx.Validator) }); ok {
+		r.SetValidator(v)
+	}
+}
+
+// SetLogger thiết lập logger của ứng dụng, dùng làm logger gốc cho ctx.Logger() của mọi request.
+// ServiceProvider tự động gắn log.Manager từ DI container khi Boot.
+//
+// Parameters:
+//   - logger: Logger của ứng dụng (thường là log.Manager), nil để context dùng slog.Default
+func (app *WebApp) SetLogger(logger forkCtx.Logger) {
+	app.mu.Lock()
+	app.logger = logger
+	app.mu.Unlock()
+
+	if r, ok := app.router.(interface{ SetLogger(forkCtx.Logger) }); ok {
+		r.SetLogger(logger)
+	}
+}
+
+// SetEagerRequestID bật hoặc tắt việc gán request ID (header X-Request-ID) cho mọi response.
+// Mặc định request ID chỉ được sinh khi handler hoặc ctx.Logger() gọi ctx.RequestID().
+//
+// Parameters:
+//   - enabled: true để sinh request ID cho mọi request
+func (app *WebApp) SetEagerRequestID(enabled bool) {
+	if r, ok := app.router.(interface{ SetEagerRequestID(bool) }); ok {
+		r.SetEagerRequestID(enabled)
+	}
+}
+
+// Validator trả về validator go-playground của ứng dụng để cấu hình nâng cao
+// (RegisterStructValidation, RegisterAlias...) khi khởi tạo ứng dụng.
+//
+// Returns:
+//   - *validator.Validate: Validator của ứng dụng, nil nếu SetValidator đã thay bằng backend khác
+func (app *WebApp) Validator() *validator.Validate {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+	validate, _ := app.validator.(*validator.Validate)
+	return validate
+}
+
+// RegisterValidation đăng ký một hàm validation tùy chỉnh cho toàn ứng dụng, có hiệu lực với
+// ctx.ValidateStruct, ShouldBindAndValidate và BindAndValidate của mọi request.
+// Nên gọi khi khởi tạo ứng dụng, trước khi phục vụ request.
+//
+// Parameters:
+//   - tag: Tag name sử dụng trong struct tag validate
+//   - fn: Hàm validation tương ứng với tag
+//
+// Returns:
+//   - error: forkCtx.ErrUnsupportedValidator nếu SetValidator đã thay bằng backend khác,
+//     hoặc lỗi đăng ký validation
+func (app *WebApp) RegisterValidation(tag string, fn validator.Func) error {
+	validate := app.Validator()
+	if validate == nil {
+		return forkCtx.ErrUnsupportedValidator
+	}
+	return validate.RegisterValidation(tag, fn)
+}
+
+// RegisterStructValidation đăng ký hàm validation cấp struct cho toàn ứng dụng, dùng cho quy tắc
+// giữa nhiều field (ví dụ StartDate trước EndDate). Lỗi báo bởi sl.ReportError được
+// BindAndValidate trả về như lỗi field thông thường.
+// Nên gọi khi khởi tạo ứng dụng, trước khi phục vụ request.
+//
+// Parameters:
+//   - fn: Hàm validation nhận validator.StructLevel
+//   - types: Giá trị mẫu của các kiểu struct áp dụng
+//
+// Returns:
+//   - error: forkCtx.ErrUnsupportedValidator nếu SetValidator đã thay bằng backend khác
+func (app *WebApp) RegisterStructValidation(fn validator.StructLevelFunc, types ...interface{}) error {
+	validate := app.Validator()
+	if validate == nil {
+		return forkCtx.ErrUnsupportedValidator
+	}
+	validate.RegisterStructValidation(fn, types...)
+	return nil
+}
+
+// RegisterCustomTypeFunc đăng ký hàm chuyển đổi kiểu tùy chỉnh (ví dụ sql.NullString, decimal)
+// về giá trị được validate, cho toàn ứng dụng.
+// Nên gọi khi khởi tạo ứng dụng, trước khi phục vụ request.
+//
+// Parameters:
+//   - fn: Hàm trả về giá trị dùng để validate từ reflect.Value của field
+//   - types: Giá trị mẫu của các kiểu áp dụng
+//
+// Returns:
+//   - error: forkCtx.ErrUnsupportedValidator nếu SetValidator đã thay bằng backend khác
+func (app *WebApp) RegisterCustomTypeFunc(fn validator.CustomTypeFunc, types ...interface{}) error {
+	validate := app.Validator()
+	if validate == nil {
+		return forkCtx.ErrUnsupportedValidator
+	}
+	validate.RegisterCustomTypeFunc(fn, types...)
+	return nil
 }
 
 // GetAdapter trả về adapter hiện tại của WebApp.
@@ -492,6 +955,124 @@ func (app *WebApp) IsShuttingDown() bool {
 	return app.isShuttingDown
 }
 
+// SetTemplateEngine thiết lập template engine cho ctx.Render.
+// Lần gọi đầu tiên đăng ký middleware gắn engine vào context với khóa "template_engine",
+// vì vậy nên gọi trước khi đăng ký routes; các lần gọi sau chỉ thay thế engine.
+//
+// Parameters:
+//   - engine: Template engine (ví dụ: view.New(view.Config{Directory: "views"}))
+func (app *WebApp) SetTemplateEngine(engine TemplateEngine) {
+	app.mu.Lock()
+	installed := app.templateInstalled
+	app.templateEngine = engine
+	app.templateInstalled = true
+	app.mu.Unlock()
+
+	if !installed {
+		app.Use(app.createTemplateEngineMiddleware())
+	}
+}
+
+// TemplateEngine trả về template engine hiện tại của WebApp.
+//
+// Returns:
+//   - TemplateEngine: Template engine hoặc nil nếu chưa thiết lập
+func (app *WebApp) TemplateEngine() TemplateEngine {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	return app.templateEngine
+}
+
+// ViewGlobals đăng ký hàm cung cấp dữ liệu dùng chung cho mọi lần gọi ctx.Render,
+// ví dụ: người dùng hiện tại, CSRF token, flash messages hay locale.
+// Hàm được gọi tại thời điểm render và kết quả được gộp vào data khi data là
+// map[string]interface{} hoặc nil; khóa trong data được ưu tiên hơn globals.
+// Lần gọi đầu tiên đăng ký middleware, vì vậy nên gọi trước khi đăng ký routes.
+//
+// Parameters:
+//   - fn: Hàm trả về dữ liệu dùng chung của request
+func (app *WebApp) ViewGlobals(fn func(ctx forkCtx.Context) map[string]interface{}) {
+	app.mu.Lock()
+	installed := len(app.viewGlobals) > 0
+	app.viewGlobals = append(app.viewGlobals, fn)
+	app.mu.Unlock()
+
+	if !installed {
+		app.Use(view.Globals(app.collectViewGlobals))
+	}
+}
+
+// collectViewGlobals gộp dữ liệu từ tất cả hàm đã đăng ký qua ViewGlobals
+func (app *WebApp) collectViewGlobals(ctx forkCtx.Context) map[string]interface{} {
+	app.mu.RLock()
+	fns := app.viewGlobals
+	app.mu.RUnlock()
+
+	globals := make(map[string]interface{})
+	for _, fn := range fns {
+		for key, value := range fn(ctx) {
+			globals[key] = value
+		}
+	}
+	return globals
+}
+
+// SetURLSigningKey thiết lập khóa HMAC cho SignURL và ValidateSignedURL.
+//
+// Parameters:
+//   - secret: Khóa bí mật (tối thiểu 32 bytes được khuyến nghị)
+func (app *WebApp) SetURLSigningKey(secret []byte) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	app.urlSigner = signedurl.NewSigner(secret)
+}
+
+// SignURL tạo URL tạm thời đã ký cho route, dùng cho link tải xuống, xác minh email
+// hoặc hủy đăng ký. Tham số không xuất hiện trong route được thêm vào query string.
+//
+// Parameters:
+//   - route: Route pattern (ví dụ: "/users/:id/verify")
+//   - params: Giá trị tham số của route
+//   - expiry: Thời hạn của URL, 0 để không hết hạn
+//
+// Returns:
+//   - string: URL tương đối đã ký
+//   - error: ErrInvalidConfiguration nếu chưa gọi SetURLSigningKey, hoặc lỗi điền tham số
+func (app *WebApp) SignURL(route string, params map[string]string, expiry time.Duration) (string, error) {
+	app.mu.RLock()
+	signer := app.urlSigner
+	app.mu.RUnlock()
+
+	if signer == nil {
+		return "", ErrInvalidConfiguration
+	}
+	return signer.SignRoute(route, params, expiry)
+}
+
+// ValidateSignedURL trả về middleware từ chối (403) các request không có chữ ký hợp lệ
+// hoặc đã hết hạn. Khóa được đọc tại thời điểm request nên middleware có thể được tạo
+// trước khi gọi SetURLSigningKey.
+//
+// Returns:
+//   - router.HandlerFunc: Middleware xác thực URL đã ký
+func (app *WebApp) ValidateSignedURL() router.HandlerFunc {
+	return func(c forkCtx.Context) {
+		app.mu.RLock()
+		signer := app.urlSigner
+		app.mu.RUnlock()
+
+		if signer == nil {
+			httpError := forkErrors.NewInternalServerError("URL signing key not configured", nil, ErrInvalidConfiguration)
+			c.JSON(httpError.StatusCode, httpError)
+			c.Abort()
+			return
+		}
+		signer.Middleware()(c)
+	}
+}
+
 // EnableSecurityMiddleware bật các middleware bảo mật tự động
 // Note: Security headers are now handled by the helmet middleware package.
 // Request size, method validation, and timeout are handled by their respective middleware packages:
@@ -513,3 +1094,42 @@ func (app *WebApp) createConnectionTrackingMiddleware() router.HandlerFunc {
 		c.Next()
 	}
 }
+
+// SetPaginationConfig thiết lập cấu hình phân trang (giới hạn và tên tham số query) cho
+// ctx.Pagination, ctx.Paginate và ctx.PaginateCursor trong toàn ứng dụng.
+// Lần gọi đầu tiên đăng ký middleware, vì vậy nên gọi trước khi đăng ký routes.
+//
+// Parameters:
+//   - config: Cấu hình phân trang; các trường để trống nhận giá trị mặc định
+func (app *WebApp) SetPaginationConfig(config forkCtx.PaginationConfig) {
+	app.mu.Lock()
+	installed := app.paginationConfig != nil
+	app.paginationConfig = &config
+	app.mu.Unlock()
+
+	if !installed {
+		app.Use(app.createPaginationMiddleware())
+	}
+}
+
+// createPaginationMiddleware tạo middleware gắn cấu hình phân trang vào context
+func (app *WebApp) createPaginationMiddleware() router.HandlerFunc {
+	return func(c forkCtx.Context) {
+		app.mu.RLock()
+		config := app.paginationConfig
+		app.mu.RUnlock()
+
+		c.Set(forkCtx.PaginationConfigKey, *config)
+		c.Next()
+	}
+}
+
+// createTemplateEngineMiddleware tạo middleware gắn template engine vào context
+func (app *WebApp) createTemplateEngineMiddleware() router.HandlerFunc {
+	return func(c forkCtx.Context) {
+		if engine := app.TemplateEngine(); engine != nil {
+			c.Set("template_engine", engine)
+		}
+		c.Next()
+	}
+}
@@ -1,16 +1,25 @@
 package fork_test
 
 import (
+	"database/sql"
 	"fmt"
+	"io"
+	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"go.fork.vn/fork"
+	"go.fork.vn/fork/clock"
 	forkContext "go.fork.vn/fork/context"
 	forkErrors "go.fork.vn/fork/errors"
 	fork_mocks "go.fork.vn/fork/mocks"
@@ -237,6 +246,176 @@ func TestWebApp_ErrorHandling(t *testing.T) {
 	assert.Equal(t, 500, w.Code)
 }
 
+// TestWebApp_NoRoute tests custom 404 handlers running through the middleware chain
+func TestWebApp_NoRoute(t *testing.T) {
+	app := fork.NewWebApp()
+	app.Use(func(ctx forkContext.Context) {
+		ctx.Header("X-Middleware", "yes")
+		ctx.Next()
+	})
+	app.NoRoute(func(ctx forkContext.Context) {
+		httpError := forkErrors.NewNotFound("Page not found", nil, nil)
+		ctx.JSON(httpError.StatusCode, httpError)
+	})
+
+	req := httptest.NewRequest("GET", "/missing", nil)
+	w := httptest.NewRecorder()
+
+	app.ServeHTTP(w, req)
+
+	assert.Equal(t, 404, w.Code)
+	assert.Equal(t, "yes", w.Header().Get("X-Middleware"))
+	assert.Contains(t, w.Body.String(), "Page not found")
+}
+
+// TestWebApp_NoMethod tests custom 405 handlers with the Allow header
+func TestWebApp_NoMethod(t *testing.T) {
+	app := fork.NewWebApp()
+	app.GET("/items", func(ctx forkContext.Context) {
+		ctx.String(200, "items")
+	})
+	app.NoMethod(func(ctx forkContext.Context) {
+		httpError := forkErrors.NewMethodNotAllowed("Method not allowed", nil, nil)
+		ctx.JSON(httpError.StatusCode, httpError)
+	})
+
+	req := httptest.NewRequest("DELETE", "/items", nil)
+	w := httptest.NewRecorder()
+
+	app.ServeHTTP(w, req)
+
+	assert.Equal(t, 405, w.Code)
+	assert.Equal(t, "GET, OPTIONS", w.Header().Get("Allow"))
+	assert.Contains(t, w.Body.String(), "Method not allowed")
+}
+
+// TestWebApp_Host tests host-constrained route groups with subdomain params
+func TestWebApp_Host(t *testing.T) {
+	app := fork.NewWebApp()
+	app.GET("/", func(ctx forkContext.Context) {
+		ctx.String(200, "main")
+	})
+	app.Host(":tenant.example.com").Handle("GET", "/", func(ctx forkContext.Context) {
+		ctx.String(200, "tenant "+ctx.Param("tenant"))
+	})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Host = "acme.example.com"
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, req)
+	assert.Equal(t, "tenant acme", w.Body.String())
+
+	req = httptest.NewRequest("GET", "/", nil)
+	req.Host = "example.com"
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, req)
+	assert.Equal(t, "main", w.Body.String())
+}
+
+// TestWebApp_Mount tests grafting a plain http.Handler under a prefix
+func TestWebApp_Mount(t *testing.T) {
+	app := fork.NewWebApp()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("healthy"))
+	})
+	app.Mount("/legacy", mux)
+
+	req := httptest.NewRequest("GET", "/legacy/health", nil)
+	w := httptest.NewRecorder()
+
+	app.ServeHTTP(w, req)
+
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "healthy", w.Body.String())
+}
+
+// TestWebApp_RouteMiddleware tests middleware attached to a single route
+func TestWebApp_RouteMiddleware(t *testing.T) {
+	app := fork.NewWebApp()
+	app.GET("/private", func(ctx forkContext.Context) {
+		ctx.String(200, "secret")
+	}).Use(func(ctx forkContext.Context) {
+		if ctx.GetHeader("Authorization") == "" {
+			ctx.String(401, "unauthorized")
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	})
+	app.GET("/public", func(ctx forkContext.Context) {
+		ctx.String(200, "public")
+	})
+
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/private", nil))
+	assert.Equal(t, 401, w.Code)
+
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/public", nil))
+	assert.Equal(t, 200, w.Code)
+}
+
+// TestWebApp_Match tests registering several methods, including custom ones, at once
+func TestWebApp_Match(t *testing.T) {
+	app := fork.NewWebApp()
+	app.Match([]string{"GET", "PROPFIND"}, "/dav", func(ctx forkContext.Context) {
+		ctx.String(200, ctx.Method())
+	})
+
+	for _, method := range []string{"GET", "PROPFIND"} {
+		req := httptest.NewRequest(method, "/dav", nil)
+		w := httptest.NewRecorder()
+
+		app.ServeHTTP(w, req)
+
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, method, w.Body.String())
+	}
+}
+
+// TestWebApp_Proxy tests forwarding requests to an upstream server
+func TestWebApp_Proxy(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("upstream " + r.URL.Path))
+	}))
+	defer upstream.Close()
+	target, _ := url.Parse(upstream.URL)
+
+	app := fork.NewWebApp()
+	app.Proxy("/api", target)
+
+	req := httptest.NewRequest("GET", "/api/users", nil)
+	w := httptest.NewRecorder()
+
+	app.ServeHTTP(w, req)
+
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "upstream /users", w.Body.String())
+}
+
+// TestWebApp_Version tests API version groups selected by path and Accept header
+func TestWebApp_Version(t *testing.T) {
+	app := fork.NewWebApp()
+	app.Version("1", forkRouter.VersionPath).Handle("GET", "/users", func(ctx forkContext.Context) {
+		ctx.String(200, "path v"+forkRouter.APIVersion(ctx))
+	})
+	app.Version("2", forkRouter.VersionHeader).Handle("GET", "/users", func(ctx forkContext.Context) {
+		ctx.String(200, "header v"+forkRouter.APIVersion(ctx))
+	})
+
+	req := httptest.NewRequest("GET", "/v1/users", nil)
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, req)
+	assert.Equal(t, "path v1", w.Body.String())
+
+	req = httptest.NewRequest("GET", "/users", nil)
+	req.Header.Set("Accept", "application/vnd.acme.v2+json")
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, req)
+	assert.Equal(t, "header v2", w.Body.String())
+}
+
 // TestWebApp_ContextValues tests context value storage and retrieval
 func TestWebApp_ContextValues(t *testing.T) {
 	app := fork.NewWebApp()
@@ -467,6 +646,48 @@ func TestWebApp_GracefulShutdown(t *testing.T) {
 	mockAdapter.AssertExpectations(t)
 }
 
+// TestWebApp_GracefulShutdownTimeoutWithMockClock tests that the shutdown timeout
+// follows the injected clock instead of wall time
+func TestWebApp_GracefulShutdownTimeoutWithMockClock(t *testing.T) {
+	app := fork.NewWebApp()
+	mockClock := clock.NewMock(time.Time{})
+	app.SetClock(mockClock)
+	assert.Equal(t, mockClock, app.Clock())
+
+	mockAdapter := fork_mocks.NewMockAdapter(t)
+	mockAdapter.EXPECT().SetHandler(mock.AnythingOfType("*router.DefaultRouter")).Maybe()
+	mockAdapter.EXPECT().Shutdown().Return(nil).Once()
+	app.SetAdapter(mockAdapter)
+
+	// A connection that never finishes forces the shutdown to wait for the timeout
+	app.TrackConnection()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- app.GracefulShutdown()
+	}()
+
+	// Wait for the shutdown timer and the connection polling ticker
+	mockClock.BlockUntil(2)
+	mockClock.Add(29 * time.Second)
+	select {
+	case <-done:
+		t.Fatal("shutdown finished before the timeout elapsed")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	mockClock.Add(time.Second)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("shutdown did not finish after the timeout elapsed")
+	}
+
+	app.SetClock(nil)
+	assert.NotNil(t, app.Clock())
+}
+
 // TestWebApp_ConfigDefaults tests default configuration values
 func TestWebApp_ConfigDefaults(t *testing.T) {
 	app := fork.NewWebApp()
@@ -591,6 +812,200 @@ func TestWebApp_NewContext(t *testing.T) {
 	assert.NotNil(t, ctx)
 }
 
+// TestWebApp_RegisterValidation tests app-wide custom validations
+func TestWebApp_RegisterValidation(t *testing.T) {
+	app := fork.NewWebApp()
+	require.NoError(t, app.RegisterValidation("lowercase_only", func(fl validator.FieldLevel) bool {
+		return strings.ToLower(fl.Field().String()) == fl.Field().String()
+	}))
+
+	type Input struct {
+		Slug string `json:"slug" validate:"lowercase_only"`
+	}
+	app.POST("/slugs", func(c forkContext.Context) {
+		assert.Same(t, app.Validator(), c.GetValidator())
+		var input Input
+		if err := c.ShouldBindAndValidate(&input); err != nil {
+			c.Status(http.StatusUnprocessableEntity)
+			return
+		}
+		c.Status(http.StatusCreated)
+	})
+
+	for body, code := range map[string]int{`{"slug":"hello"}`: http.StatusCreated, `{"slug":"Hello"}`: http.StatusUnprocessableEntity} {
+		req := httptest.NewRequest("POST", "/slugs", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		app.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code, body)
+	}
+
+	ctx := app.NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	assert.Same(t, app.Validator(), ctx.GetValidator())
+}
+
+// TestWebApp_RegisterStructValidation tests cross-field and custom type validation
+func TestWebApp_RegisterStructValidation(t *testing.T) {
+	type Booking struct {
+		Start time.Time      `json:"start" validate:"required"`
+		End   time.Time      `json:"end" validate:"required"`
+		Note  sql.NullString `json:"-" validate:"omitempty,min=3"`
+	}
+
+	app := fork.NewWebApp()
+	require.NoError(t, app.RegisterStructValidation(func(sl validator.StructLevel) {
+		booking := sl.Current().Interface().(Booking)
+		if !booking.Start.Before(booking.End) {
+			sl.ReportError(booking.End, "end", "End", "gtfield_start", "")
+		}
+	}, Booking{}))
+	require.NoError(t, app.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		if value, ok := field.Interface().(sql.NullString); ok && value.Valid {
+			return value.String
+		}
+		return nil
+	}, sql.NullString{}))
+
+	note := ""
+	app.POST("/bookings", func(c forkContext.Context) {
+		var booking Booking
+		booking.Note = sql.NullString{String: note, Valid: note != ""}
+		if err := c.BindAndValidate(&booking); err != nil {
+			return
+		}
+		c.Status(http.StatusCreated)
+	})
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/bookings", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		app.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusCreated, post(`{"start":"2024-01-01T10:00:00Z","end":"2024-01-01T12:00:00Z"}`).Code)
+
+	w := post(`{"start":"2024-01-01T12:00:00Z","end":"2024-01-01T10:00:00Z"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), "gtfield_start")
+
+	note = "ok"
+	w = post(`{"start":"2024-01-01T10:00:00Z","end":"2024-01-01T12:00:00Z"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code, "custom type value should be validated")
+
+	app.SetValidator(forkContext.ValidatorFunc(func(interface{}) error { return nil }))
+	assert.ErrorIs(t, app.RegisterStructValidation(func(validator.StructLevel) {}, Booking{}), forkContext.ErrUnsupportedValidator)
+	assert.ErrorIs(t, app.RegisterCustomTypeFunc(func(reflect.Value) interface{} { return nil }, sql.NullString{}), forkContext.ErrUnsupportedValidator)
+}
+
+// recordingLogger captures log messages with their key-value fields
+type recordingLogger struct {
+	entries [][]interface{}
+}
+
+func (l *recordingLogger) Debug(message string, args ...interface{})   { l.log(message, args) }
+func (l *recordingLogger) Info(message string, args ...interface{})    { l.log(message, args) }
+func (l *recordingLogger) Warning(message string, args ...interface{}) { l.log(message, args) }
+func (l *recordingLogger) Error(message string, args ...interface{})   { l.log(message, args) }
+
+func (l *recordingLogger) log(message string, args []interface{}) {
+	l.entries = append(l.entries, append([]interface{}{message}, args...))
+}
+
+// TestWebApp_SetLogger tests the request-scoped logger sourced from the app logger
+func TestWebApp_SetLogger(t *testing.T) {
+	app := fork.NewWebApp()
+	logger := &recordingLogger{}
+	app.SetLogger(logger)
+
+	app.GET("/orders/:id", func(c forkContext.Context) {
+		c.Logger().Info("order viewed", "order_id", c.Param("id"))
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/orders/9", nil)
+	req.Header.Set(fork.HeaderXRequestID, "abc")
+	app.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, logger.entries, 1)
+	assert.Equal(t, []interface{}{
+		"order viewed",
+		"request_id", "abc",
+		"method", "GET",
+		"path", "/orders/9",
+		"route", "/orders/:id",
+		"order_id", "9",
+	}, logger.entries[0])
+
+	ctx := app.NewContext(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	ctx.Logger().Debug("manual")
+	assert.Len(t, logger.entries, 2)
+}
+
+// TestWebApp_SetEagerRequestID tests that request IDs are only assigned to every response when enabled
+func TestWebApp_SetEagerRequestID(t *testing.T) {
+	app := fork.NewWebApp()
+	app.GET("/ping", func(c forkContext.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/ping", nil))
+	assert.Empty(t, w.Header().Get(fork.HeaderXRequestID))
+
+	app.SetEagerRequestID(true)
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/ping", nil))
+	assert.NotEmpty(t, w.Header().Get(fork.HeaderXRequestID))
+}
+
+// TestWebApp_SetValidator tests replacing the validation backend
+func TestWebApp_SetValidator(t *testing.T) {
+	app := fork.NewWebApp()
+	app.SetValidator(forkContext.ValidatorFunc(func(obj interface{}) error {
+		if v, ok := obj.(interface{ Validate() error }); ok {
+			return v.Validate()
+		}
+		return nil
+	}))
+	assert.Nil(t, app.Validator())
+	assert.ErrorIs(t, app.RegisterValidation("x", func(validator.FieldLevel) bool { return true }), forkContext.ErrUnsupportedValidator)
+
+	app.POST("/signup", func(c forkContext.Context) {
+		var input signupInput
+		if err := c.BindAndValidate(&input); err != nil {
+			return
+		}
+		c.Status(http.StatusCreated)
+	})
+
+	for body, code := range map[string]int{`{"email":"a@b.c"}`: http.StatusCreated, `{"email":"invalid"}`: http.StatusUnprocessableEntity} {
+		req := httptest.NewRequest("POST", "/signup", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		app.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code, body)
+	}
+
+	// nil khôi phục validator go-playground
+	app.SetValidator(nil)
+	require.NotNil(t, app.Validator())
+	assert.NoError(t, app.RegisterValidation("x", func(validator.FieldLevel) bool { return true }))
+}
+
+// signupInput tự validate như struct dùng ozzo-validation
+type signupInput struct {
+	Email string `json:"email"`
+}
+
+func (i signupInput) Validate() error {
+	if !strings.Contains(i.Email, "@") {
+		return fmt.Errorf("email: must be a valid email address")
+	}
+	return nil
+}
+
 // TestWebApp_CleanupResources tests resource cleanup
 func TestWebApp_CleanupResources(t *testing.T) {
 	app := fork.NewWebApp()
@@ -744,3 +1159,155 @@ func TestWebApp_ConfigValidation(t *testing.T) {
 		assert.Equal(t, fork.ErrInvalidConfiguration, err)
 	})
 }
+
+// stubTemplateEngine là template engine đơn giản cho test
+type stubTemplateEngine struct {
+	prefix string
+}
+
+func (s stubTemplateEngine) Render(name string, data interface{}) ([]byte, error) {
+	return []byte(fmt.Sprintf("%s:%s:%v", s.prefix, name, data)), nil
+}
+
+// TestWebApp_SetTemplateEngine tests ctx.Render using the registered engine
+func TestWebApp_SetTemplateEngine(t *testing.T) {
+	app := fork.NewWebApp()
+	assert.Nil(t, app.TemplateEngine())
+
+	app.SetTemplateEngine(stubTemplateEngine{prefix: "v1"})
+	app.GET("/page", func(c forkContext.Context) {
+		c.Render(200, "home", "data")
+	})
+
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/page", nil))
+	assert.Equal(t, "v1:home:data", w.Body.String())
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+
+	// Thay engine không cần đăng ký lại routes
+	app.SetTemplateEngine(stubTemplateEngine{prefix: "v2"})
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/page", nil))
+	assert.Equal(t, "v2:home:data", w.Body.String())
+}
+
+// TestWebApp_ViewGlobals tests merging shared view data into ctx.Render
+func TestWebApp_ViewGlobals(t *testing.T) {
+	app := fork.NewWebApp()
+	app.SetTemplateEngine(stubTemplateEngine{prefix: "v"})
+	app.ViewGlobals(func(c forkContext.Context) map[string]interface{} {
+		return map[string]interface{}{"user": c.GetString("user"), "title": "Site"}
+	})
+	app.ViewGlobals(func(c forkContext.Context) map[string]interface{} {
+		return map[string]interface{}{"locale": "vi"}
+	})
+	app.GET("/page", func(c forkContext.Context) {
+		// Giá trị được thiết lập sau middleware vẫn có hiệu lực khi render
+		c.Set("user", "an")
+		c.Render(200, "home", map[string]interface{}{"title": "Home"})
+	})
+	app.GET("/struct", func(c forkContext.Context) {
+		c.Render(200, "home", 42)
+	})
+
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/page", nil))
+	assert.Equal(t, "v:home:map[locale:vi title:Home user:an]", w.Body.String())
+
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/struct", nil))
+	assert.Equal(t, "v:home:42", w.Body.String())
+}
+
+// TestWebApp_SignURL tests signed temporary URLs
+func TestWebApp_SignURL(t *testing.T) {
+	app := fork.NewWebApp()
+	app.GET("/downloads/:id", app.ValidateSignedURL(), func(c forkContext.Context) {
+		c.String(200, "file "+c.Param("id"))
+	})
+
+	_, err := app.SignURL("/downloads/:id", map[string]string{"id": "7"}, time.Minute)
+	assert.Equal(t, fork.ErrInvalidConfiguration, err)
+
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/downloads/7", nil))
+	assert.Equal(t, 500, w.Code)
+
+	app.SetURLSigningKey([]byte("0123456789abcdef0123456789abcdef"))
+	signed, err := app.SignURL("/downloads/:id", map[string]string{"id": "7"}, time.Minute)
+	assert.NoError(t, err)
+
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", signed, nil))
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "file 7", w.Body.String())
+
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/downloads/7", nil))
+	assert.Equal(t, 403, w.Code)
+}
+
+func TestWebApp_SetPaginationConfig(t *testing.T) {
+	app := fork.NewWebApp()
+	app.SetPaginationConfig(forkContext.PaginationConfig{MaxLimit: 50, PageParam: "p"})
+	app.GET("/items", func(c forkContext.Context) {
+		p := c.Pagination()
+		c.String(200, "%d/%d", p.Page, p.Limit)
+	})
+
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/items?p=3&limit=500", nil))
+	assert.Equal(t, "3/50", w.Body.String())
+
+	app.SetPaginationConfig(forkContext.PaginationConfig{MaxLimit: 10})
+	w = httptest.NewRecorder()
+	app.ServeHTTP(w, httptest.NewRequest("GET", "/items?page=2&limit=500", nil))
+	assert.Equal(t, "2/10", w.Body.String())
+}
+
+// TestWebApp_Test tests executing requests in memory through the full pipeline
+func TestWebApp_Test(t *testing.T) {
+	app := fork.NewWebApp()
+	app.Use(func(c forkContext.Context) {
+		c.Header("X-Middleware", "applied")
+		c.Next()
+	})
+	app.GET("/hello/:name", func(c forkContext.Context) {
+		c.String(200, "hello "+c.Param("name"))
+	})
+	app.GET("/slow", func(c forkContext.Context) {
+		time.Sleep(200 * time.Millisecond)
+		c.String(200, "done")
+	})
+	app.GET("/panic", func(c forkContext.Context) {
+		panic("boom")
+	})
+
+	t.Run("returns realized response", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest("GET", "/hello/fork", nil))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "applied", resp.Header.Get("X-Middleware"))
+		assert.Equal(t, "hello fork", string(body))
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		_, err := app.Test(httptest.NewRequest("GET", "/slow", nil), 10*time.Millisecond)
+		assert.Equal(t, fork.ErrRequestTimeout, err)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/slow", nil), -1)
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+	})
+
+	t.Run("reports panics and nil requests", func(t *testing.T) {
+		_, err := app.Test(httptest.NewRequest("GET", "/panic", nil))
+		assert.ErrorContains(t, err, "boom")
+
+		_, err = app.Test(nil)
+		assert.Equal(t, fork.ErrBadRequest, err)
+	})
+}
@@ -0,0 +1,374 @@
+// Package webhook cung cấp hệ thống gửi webhook ra ngoài với chữ ký HMAC,
+// retry theo exponential backoff, dead-letter hook và delivery log.
+//
+// Dispatcher chạy như một background worker có vòng đời: gọi Start khi ứng dụng khởi động
+// và Stop khi shutdown để chờ các lần gửi đang thực hiện hoàn tất.
+package webhook
+
+import (
+	"bytes"
+	gocontext "context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Các lỗi của Dispatcher.
+var (
+	ErrNotRunning     = errors.New("webhook: dispatcher is not running")
+	ErrAlreadyRunning = errors.New("webhook: dispatcher is already running")
+	ErrQueueFull      = errors.New("webhook: queue is full")
+	ErrStopped        = errors.New("webhook: dispatcher stopped before delivery completed")
+)
+
+// Các header mặc định được gửi kèm mỗi webhook.
+const (
+	HeaderID        = "X-Webhook-ID"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderSignature = "X-Webhook-Signature"
+	HeaderAttempt   = "X-Webhook-Attempt"
+)
+
+// Message là một webhook cần gửi.
+type Message struct {
+	// ID là định danh duy nhất của message, được tạo tự động nếu rỗng.
+	// Bên nhận có thể dùng ID để loại bỏ trùng lặp khi webhook được retry.
+	ID string
+
+	// URL là endpoint nhận webhook
+	URL string
+
+	// Secret là khóa HMAC dùng để ký payload. Nếu rỗng, dùng Config.Secret
+	Secret []byte
+
+	// Event là tên sự kiện (ví dụ: "order.created")
+	Event string
+
+	// Payload là body của webhook
+	Payload []byte
+
+	// ContentType của payload. Mặc định: application/json
+	ContentType string
+
+	// Header chứa các header bổ sung
+	Header http.Header
+}
+
+// DeadLetter chứa message không thể gửi thành công và lần thử cuối cùng.
+type DeadLetter struct {
+	Message Message
+	Last    Attempt
+	Reason  error
+}
+
+// Config chứa cấu hình cho Dispatcher.
+type Config struct {
+	// Client là HTTP client dùng để gửi webhook. Mặc định: http.Client với Timeout
+	Client *http.Client
+
+	// Timeout là thời gian tối đa cho mỗi lần gửi. Mặc định: 10 giây
+	Timeout time.Duration
+
+	// Workers là số goroutine gửi webhook song song. Mặc định: 4
+	Workers int
+
+	// QueueSize là số message tối đa đang chờ gửi. Mặc định: 1000
+	QueueSize int
+
+	// MaxAttempts là số lần gửi tối đa (bao gồm lần đầu). Mặc định: 5
+	MaxAttempts int
+
+	// InitialBackoff là thời gian chờ trước lần retry đầu tiên, nhân đôi sau mỗi lần.
+	// Mặc định: 1 giây
+	InitialBackoff time.Duration
+
+	// MaxBackoff là thời gian chờ tối đa giữa các lần retry. Mặc định: 5 phút
+	MaxBackoff time.Duration
+
+	// Secret là khóa HMAC mặc định khi Message.Secret rỗng.
+	Secret []byte
+
+	// OnDeadLetter được gọi khi message hết số lần thử hoặc gặp lỗi không thể retry.
+	OnDeadLetter func(letter DeadLetter)
+
+	// Log ghi nhận tất cả các lần thử. Nếu nil, không ghi log.
+	Log DeliveryLog
+}
+
+// Dispatcher gửi webhook bất đồng bộ bằng một nhóm worker.
+type Dispatcher struct {
+	config  Config
+	mu      sync.RWMutex
+	queue   chan Message
+	running bool
+	wg      sync.WaitGroup
+	stopCtx gocontext.Context
+	abort   gocontext.CancelFunc
+}
+
+// NewDispatcher tạo Dispatcher mới với cấu hình đã cho.
+//
+// Parameters:
+//   - config: Cấu hình dispatcher
+//
+// Returns:
+//   - *Dispatcher: Dispatcher đã khởi tạo (chưa chạy)
+func NewDispatcher(config Config) *Dispatcher {
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: config.Timeout}
+	}
+	if config.Workers <= 0 {
+		config.Workers = 4
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 1000
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 5
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = time.Second
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = 5 * time.Minute
+	}
+	return &Dispatcher{config: config}
+}
+
+// Start khởi động các worker gửi webhook.
+//
+// Returns:
+//   - error: ErrAlreadyRunning nếu dispatcher đang chạy
+func (d *Dispatcher) Start() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.running {
+		return ErrAlreadyRunning
+	}
+
+	d.queue = make(chan Message, d.config.QueueSize)
+	d.stopCtx, d.abort = gocontext.WithCancel(gocontext.Background())
+	d.running = true
+	for i := 0; i < d.config.Workers; i++ {
+		d.wg.Add(1)
+		go d.worker(d.stopCtx, d.queue)
+	}
+	return nil
+}
+
+// Stop ngừng nhận message mới và chờ các message trong hàng đợi được gửi xong.
+// Khi ctx hết hạn, các lần gửi còn lại bị hủy và chuyển tới OnDeadLetter với lý do ErrStopped.
+//
+// Parameters:
+//   - ctx: Context giới hạn thời gian chờ
+//
+// Returns:
+//   - error: ErrNotRunning nếu dispatcher chưa chạy, hoặc lỗi của ctx nếu hết thời gian chờ
+func (d *Dispatcher) Stop(ctx gocontext.Context) error {
+	d.mu.Lock()
+	if !d.running {
+		d.mu.Unlock()
+		return ErrNotRunning
+	}
+	d.running = false
+	close(d.queue)
+	abort := d.abort
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		abort()
+		return nil
+	case <-ctx.Done():
+		abort()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// Send đưa message vào hàng đợi để gửi bất đồng bộ.
+//
+// Parameters:
+//   - msg: Message cần gửi
+//
+// Returns:
+//   - string: ID của message
+//   - error: ErrNotRunning hoặc ErrQueueFull
+func (d *Dispatcher) Send(msg Message) (string, error) {
+	if msg.ID == "" {
+		msg.ID = newID()
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if !d.running {
+		return "", ErrNotRunning
+	}
+	select {
+	case d.queue <- msg:
+		return msg.ID, nil
+	default:
+		return "", ErrQueueFull
+	}
+}
+
+// worker gửi các message từ hàng đợi cho đến khi hàng đợi bị đóng.
+func (d *Dispatcher) worker(stopCtx gocontext.Context, queue <-chan Message) {
+	defer d.wg.Done()
+	for msg := range queue {
+		d.deliver(stopCtx, msg)
+	}
+}
+
+// deliver gửi message với retry theo exponential backoff.
+func (d *Dispatcher) deliver(stopCtx gocontext.Context, msg Message) {
+	var last Attempt
+	for number := 1; number <= d.config.MaxAttempts; number++ {
+		if stopCtx.Err() != nil {
+			d.deadLetter(msg, last, ErrStopped)
+			return
+		}
+
+		last = d.attempt(stopCtx, msg, number)
+		if d.config.Log != nil {
+			d.config.Log.Record(last)
+		}
+		if last.Success() {
+			return
+		}
+		if !retryable(last) {
+			d.deadLetter(msg, last, errPermanent(last))
+			return
+		}
+		if number == d.config.MaxAttempts {
+			break
+		}
+
+		timer := time.NewTimer(d.backoff(number))
+		select {
+		case <-timer.C:
+		case <-stopCtx.Done():
+			timer.Stop()
+			d.deadLetter(msg, last, ErrStopped)
+			return
+		}
+	}
+	d.deadLetter(msg, last, errExhausted(last))
+}
+
+// attempt thực hiện một lần gửi webhook.
+func (d *Dispatcher) attempt(stopCtx gocontext.Context, msg Message, number int) Attempt {
+	result := Attempt{
+		MessageID: msg.ID,
+		URL:       msg.URL,
+		Event:     msg.Event,
+		Number:    number,
+		Time:      time.Now(),
+	}
+
+	ctx, cancel := gocontext.WithTimeout(stopCtx, d.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.URL, bytes.NewReader(msg.Payload))
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	for name, values := range msg.Header {
+		req.Header[name] = append([]string(nil), values...)
+	}
+	contentType := msg.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(HeaderID, msg.ID)
+	req.Header.Set(HeaderAttempt, strconv.Itoa(number))
+	if msg.Event != "" {
+		req.Header.Set(HeaderEvent, msg.Event)
+	}
+
+	secret := msg.Secret
+	if len(secret) == 0 {
+		secret = d.config.Secret
+	}
+	if len(secret) > 0 {
+		req.Header.Set(HeaderSignature, Sign(secret, result.Time, msg.Payload))
+	}
+
+	resp, err := d.config.Client.Do(req)
+	result.Duration = time.Since(result.Time)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+	result.StatusCode = resp.StatusCode
+	return result
+}
+
+// backoff tính thời gian chờ trước lần retry tiếp theo.
+func (d *Dispatcher) backoff(number int) time.Duration {
+	backoff := d.config.InitialBackoff << uint(number-1)
+	if backoff <= 0 || backoff > d.config.MaxBackoff {
+		return d.config.MaxBackoff
+	}
+	return backoff
+}
+
+// deadLetter chuyển message thất bại tới OnDeadLetter.
+func (d *Dispatcher) deadLetter(msg Message, last Attempt, reason error) {
+	if d.config.OnDeadLetter != nil {
+		d.config.OnDeadLetter(DeadLetter{Message: msg, Last: last, Reason: reason})
+	}
+}
+
+// retryable kiểm tra lần thử thất bại có nên được retry hay không.
+// Lỗi kết nối, 408, 429 và 5xx được retry; các lỗi 4xx khác là vĩnh viễn.
+func retryable(a Attempt) bool {
+	if a.Err != nil {
+		return true
+	}
+	switch {
+	case a.StatusCode == http.StatusRequestTimeout, a.StatusCode == http.StatusTooManyRequests:
+		return true
+	case a.StatusCode >= 500:
+		return true
+	}
+	return false
+}
+
+// errPermanent tạo lỗi cho lần thử thất bại không thể retry.
+func errPermanent(a Attempt) error {
+	return errors.New("webhook: permanent failure with status " + strconv.Itoa(a.StatusCode))
+}
+
+// errExhausted tạo lỗi khi đã hết số lần thử.
+func errExhausted(a Attempt) error {
+	if a.Err != nil {
+		return errors.Join(errors.New("webhook: max attempts reached"), a.Err)
+	}
+	return errors.New("webhook: max attempts reached, last status " + strconv.Itoa(a.StatusCode))
+}
+
+// newID tạo ID ngẫu nhiên cho message.
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
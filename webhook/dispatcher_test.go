@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	gocontext "context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDispatcherDeliversSignedWebhook(t *testing.T) {
+	secret := []byte("s3cr3t")
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	log := NewMemoryLog(10)
+	d := NewDispatcher(Config{Secret: secret, Log: log})
+	if err := d.Start(); err != nil {
+		t.Fatalf("Unexpected start error: %v", err)
+	}
+
+	id, err := d.Send(Message{URL: server.URL, Event: "order.created", Payload: []byte(`{"id":1}`)})
+	if err != nil {
+		t.Fatalf("Unexpected send error: %v", err)
+	}
+
+	r := <-received
+	body := <-bodies
+	if err := d.Stop(gocontext.Background()); err != nil {
+		t.Fatalf("Unexpected stop error: %v", err)
+	}
+
+	if r.Header.Get(HeaderID) != id || r.Header.Get(HeaderEvent) != "order.created" {
+		t.Errorf("Unexpected webhook headers: %v", r.Header)
+	}
+	if r.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("Expected JSON content type, got %q", r.Header.Get("Content-Type"))
+	}
+	if err := Verify(secret, r.Header.Get(HeaderSignature), body, time.Minute); err != nil {
+		t.Errorf("Expected valid signature, got %v", err)
+	}
+
+	attempts := log.Attempts(id)
+	if len(attempts) != 1 || !attempts[0].Success() {
+		t.Errorf("Expected one successful attempt in log, got %+v", attempts)
+	}
+}
+
+func TestDispatcherRetriesThenDeadLetters(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	letters := make(chan DeadLetter, 1)
+	log := NewMemoryLog(10)
+	d := NewDispatcher(Config{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Log:            log,
+		OnDeadLetter:   func(letter DeadLetter) { letters <- letter },
+	})
+	d.Start()
+	defer d.Stop(gocontext.Background())
+
+	id, _ := d.Send(Message{URL: server.URL, Payload: []byte("{}")})
+
+	select {
+	case letter := <-letters:
+		if letter.Message.ID != id || letter.Last.Number != 3 || letter.Last.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("Unexpected dead letter: %+v", letter)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for dead letter")
+	}
+
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+	if got := log.Attempts(id); len(got) != 3 || got[2].Number != 3 {
+		t.Errorf("Expected 3 logged attempts, got %+v", got)
+	}
+}
+
+func TestDispatcherPermanentFailure(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	letters := make(chan DeadLetter, 1)
+	d := NewDispatcher(Config{InitialBackoff: time.Millisecond, OnDeadLetter: func(l DeadLetter) { letters <- l }})
+	d.Start()
+	defer d.Stop(gocontext.Background())
+
+	d.Send(Message{URL: server.URL})
+	<-letters
+	if calls != 1 {
+		t.Errorf("Expected 4xx not to be retried, got %d attempts", calls)
+	}
+}
+
+func TestDispatcherRecoversAfterRetry(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	var deadLetters int32
+	d := NewDispatcher(Config{
+		InitialBackoff: time.Millisecond,
+		OnDeadLetter:   func(DeadLetter) { atomic.AddInt32(&deadLetters, 1) },
+	})
+	d.Start()
+	d.Send(Message{URL: server.URL})
+	d.Stop(gocontext.Background())
+
+	if calls != 2 || deadLetters != 0 {
+		t.Errorf("Expected success on second attempt, got calls=%d deadLetters=%d", calls, deadLetters)
+	}
+}
+
+func TestDispatcherStopTimeoutDeadLetters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var reasons []error
+	d := NewDispatcher(Config{
+		InitialBackoff: time.Hour,
+		OnDeadLetter: func(l DeadLetter) {
+			mu.Lock()
+			defer mu.Unlock()
+			reasons = append(reasons, l.Reason)
+		},
+	})
+	d.Start()
+	d.Send(Message{URL: server.URL})
+
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := d.Stop(ctx); !errors.Is(err, gocontext.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(reasons) != 1 || !errors.Is(reasons[0], ErrStopped) {
+		t.Errorf("Expected message to be dead-lettered with ErrStopped, got %v", reasons)
+	}
+}
+
+func TestDispatcherLifecycleErrors(t *testing.T) {
+	d := NewDispatcher(Config{QueueSize: 1, Workers: 1})
+
+	if _, err := d.Send(Message{}); err != ErrNotRunning {
+		t.Errorf("Expected ErrNotRunning, got %v", err)
+	}
+	if err := d.Stop(gocontext.Background()); err != ErrNotRunning {
+		t.Errorf("Expected ErrNotRunning on stop, got %v", err)
+	}
+
+	d.Start()
+	if err := d.Start(); err != ErrAlreadyRunning {
+		t.Errorf("Expected ErrAlreadyRunning, got %v", err)
+	}
+	d.Stop(gocontext.Background())
+
+	// Có thể khởi động lại sau khi dừng
+	if err := d.Start(); err != nil {
+		t.Errorf("Expected restart to succeed, got %v", err)
+	}
+	d.Stop(gocontext.Background())
+}
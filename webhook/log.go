@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+)
+
+// Attempt là bản ghi của một lần gửi webhook.
+type Attempt struct {
+	// MessageID là ID của message
+	MessageID string
+
+	// URL là endpoint nhận webhook
+	URL string
+
+	// Event là tên sự kiện
+	Event string
+
+	// Number là số thứ tự lần thử, bắt đầu từ 1
+	Number int
+
+	// StatusCode là status code nhận được (0 nếu lỗi kết nối)
+	StatusCode int
+
+	// Err là lỗi của lần thử (nil nếu thành công)
+	Err error
+
+	// Duration là thời gian thực hiện lần thử
+	Duration time.Duration
+
+	// Time là thời điểm bắt đầu lần thử
+	Time time.Time
+}
+
+// Success kiểm tra lần thử có thành công (status 2xx) hay không.
+func (a Attempt) Success() bool {
+	return a.Err == nil && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// DeliveryLog ghi nhận lịch sử các lần gửi webhook.
+// Các triển khai phải an toàn khi sử dụng đồng thời từ nhiều goroutines.
+type DeliveryLog interface {
+	// Record lưu một lần thử gửi webhook.
+	Record(attempt Attempt)
+}
+
+// MemoryLog là DeliveryLog lưu các lần thử gần nhất trong bộ nhớ.
+type MemoryLog struct {
+	mu       sync.RWMutex
+	attempts []Attempt
+	limit    int
+}
+
+// NewMemoryLog tạo MemoryLog mới.
+//
+// Parameters:
+//   - limit: Số lần thử tối đa được giữ lại, các bản ghi cũ nhất bị loại bỏ
+//
+// Returns:
+//   - *MemoryLog: Delivery log đã khởi tạo
+func NewMemoryLog(limit int) *MemoryLog {
+	if limit <= 0 {
+		limit = 1000
+	}
+	return &MemoryLog{limit: limit}
+}
+
+// Record lưu một lần thử, loại bỏ bản ghi cũ nhất khi vượt giới hạn.
+func (l *MemoryLog) Record(attempt Attempt) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.attempts) >= l.limit {
+		copy(l.attempts, l.attempts[1:])
+		l.attempts = l.attempts[:len(l.attempts)-1]
+	}
+	l.attempts = append(l.attempts, attempt)
+}
+
+// Attempts trả về các lần thử đã lưu, có thể lọc theo message ID.
+//
+// Parameters:
+//   - messageID: ID của message, chuỗi rỗng để lấy tất cả
+//
+// Returns:
+//   - []Attempt: Các lần thử theo thứ tự thời gian
+func (l *MemoryLog) Attempts(messageID string) []Attempt {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var result []Attempt
+	for _, attempt := range l.attempts {
+		if messageID == "" || attempt.MessageID == messageID {
+			result = append(result, attempt)
+		}
+	}
+	return result
+}
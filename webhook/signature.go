@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Các lỗi khi xác minh chữ ký webhook.
+var (
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrSignatureExpired = errors.New("webhook: signature timestamp outside tolerance")
+	ErrMalformedHeader  = errors.New("webhook: malformed signature header")
+)
+
+// Sign tạo giá trị header chữ ký cho payload theo định dạng "t=<unix>,v1=<hex>".
+// Chữ ký là HMAC-SHA256 của chuỗi "<unix>.<payload>" với secret, giúp chống replay
+// khi bên nhận kiểm tra timestamp.
+//
+// Parameters:
+//   - secret: Khóa bí mật chia sẻ với bên nhận
+//   - timestamp: Thời điểm ký
+//   - payload: Nội dung body
+//
+// Returns:
+//   - string: Giá trị header chữ ký
+func Sign(secret []byte, timestamp time.Time, payload []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + ts + ",v1=" + computeSignature(secret, ts, payload)
+}
+
+// Verify xác minh header chữ ký được tạo bởi Sign.
+//
+// Parameters:
+//   - secret: Khóa bí mật chia sẻ với bên gửi
+//   - header: Giá trị header chữ ký
+//   - payload: Nội dung body đã nhận
+//   - tolerance: Độ lệch thời gian tối đa cho phép, 0 để bỏ qua kiểm tra
+//
+// Returns:
+//   - error: nil nếu chữ ký hợp lệ
+//
+// Errors:
+//   - ErrMalformedHeader: Header không đúng định dạng
+//   - ErrSignatureExpired: Timestamp nằm ngoài tolerance
+//   - ErrInvalidSignature: Chữ ký không khớp
+func Verify(secret []byte, header string, payload []byte, tolerance time.Duration) error {
+	var ts string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return ErrMalformedHeader
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if ts == "" || len(signatures) == 0 {
+		return ErrMalformedHeader
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ErrMalformedHeader
+	}
+	if tolerance > 0 {
+		age := time.Since(time.Unix(unix, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrSignatureExpired
+		}
+	}
+
+	expected := computeSignature(secret, ts, payload)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// computeSignature tính HMAC-SHA256 dạng hex của "<timestamp>.<payload>".
+func computeSignature(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
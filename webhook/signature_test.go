@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	secret := []byte("secret")
+	payload := []byte(`{"event":"ping"}`)
+	header := Sign(secret, time.Now(), payload)
+
+	if !strings.HasPrefix(header, "t=") || !strings.Contains(header, ",v1=") {
+		t.Fatalf("Unexpected header format %q", header)
+	}
+	if err := Verify(secret, header, payload, time.Minute); err != nil {
+		t.Errorf("Expected valid signature, got %v", err)
+	}
+	if err := Verify([]byte("other"), header, payload, time.Minute); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature for wrong secret, got %v", err)
+	}
+	if err := Verify(secret, header, []byte(`{"event":"pong"}`), time.Minute); err != ErrInvalidSignature {
+		t.Errorf("Expected ErrInvalidSignature for tampered payload, got %v", err)
+	}
+}
+
+func TestVerifyTolerance(t *testing.T) {
+	secret := []byte("secret")
+	payload := []byte("x")
+	old := Sign(secret, time.Now().Add(-time.Hour), payload)
+
+	if err := Verify(secret, old, payload, time.Minute); err != ErrSignatureExpired {
+		t.Errorf("Expected ErrSignatureExpired, got %v", err)
+	}
+	if err := Verify(secret, old, payload, 0); err != nil {
+		t.Errorf("Expected zero tolerance to skip timestamp check, got %v", err)
+	}
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	for _, header := range []string{"", "garbage", "t=abc,v1=00", "v1=00", "t=1"} {
+		if err := Verify([]byte("s"), header, nil, 0); err != ErrMalformedHeader {
+			t.Errorf("Header %q: expected ErrMalformedHeader, got %v", header, err)
+		}
+	}
+}
+
+func TestMemoryLogLimit(t *testing.T) {
+	log := NewMemoryLog(2)
+	log.Record(Attempt{MessageID: "a"})
+	log.Record(Attempt{MessageID: "b"})
+	log.Record(Attempt{MessageID: "c"})
+
+	all := log.Attempts("")
+	if len(all) != 2 || all[0].MessageID != "b" || all[1].MessageID != "c" {
+		t.Errorf("Expected oldest attempt to be dropped, got %+v", all)
+	}
+}